@@ -38,7 +38,8 @@
 )
 
 const (
-	Token = "X-API-Tunnel-Token"
+	Token   = "X-API-Tunnel-Token"
+	Version = "X-API-Tunnel-Version"
 )
 
 func main() {
@@ -173,6 +174,7 @@ func run() error {
 
 	headers := map[string][]string{
 		Token:                      {token},
+		Version:                    {VERSION},
 		rkenodeconfigclient.Params: {base64.StdEncoding.EncodeToString(bytes)},
 	}
 
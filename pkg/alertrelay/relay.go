@@ -0,0 +1,234 @@
+package alertrelay
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/notifiers"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/rancher/wrangler/pkg/randomtoken"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Path is the route of the relay, Alertmanager posts the notifications of a notifier of the cluster to it
+	Path = "/v3/alertrelay/{cluster}/{notifier}"
+
+	// TokenSecretName is the secret in the namespace of a cluster with the token its Alertmanager relays with
+	TokenSecretName = "alert-relay-token"
+	tokenKey        = "token"
+
+	groupParam     = "group"
+	recipientParam = "recipient"
+)
+
+// Relayed returns whether the notifications of the notifier are relayed, Alertmanager has no integration for these
+// notifiers or can not render their template, and sends them to rancher as webhooks
+func Relayed(notifier *v3.Notifier, tmpl *v32.NotificationTemplate) bool {
+	if notifier.Spec.TelegramConfig != nil || notifier.Spec.GoogleChatConfig != nil {
+		return true
+	}
+	if tmpl == nil {
+		return false
+	}
+	if tmpl.Payload != "" && (notifier.Spec.WebhookConfig != nil || notifier.Spec.SlackConfig != nil) {
+		return true
+	}
+	return tmpl.Text != "" && (notifier.Spec.DingtalkConfig != nil || notifier.Spec.MSTeamsConfig != nil)
+}
+
+// URL returns the address Alertmanager posts the notifications of the recipient of the group to
+func URL(serverURL, clusterName, groupID string, recipient v32.Recipient) string {
+	_, notifierName := ref.Parse(recipient.NotifierName)
+	query := url.Values{}
+	query.Set(groupParam, groupID)
+	if recipient.Recipient != "" {
+		query.Set(recipientParam, recipient.Recipient)
+	}
+	return fmt.Sprintf("%s/v3/alertrelay/%s/%s?%s", strings.TrimSuffix(serverURL, "/"), url.PathEscape(clusterName),
+		url.PathEscape(notifierName), query.Encode())
+}
+
+// EnsureToken returns the relay token of the cluster, it is created on first use
+func EnsureToken(secrets v1.SecretInterface, secretLister v1.SecretLister, clusterName string) (string, error) {
+	secret, err := secretLister.Get(clusterName, TokenSecretName)
+	if err == nil {
+		return string(secret.Data[tokenKey]), nil
+	} else if !apierrors.IsNotFound(err) {
+		return "", err
+	}
+
+	token, err := randomtoken.Generate()
+	if err != nil {
+		return "", err
+	}
+	secret, err = secrets.Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TokenSecretName,
+			Namespace: clusterName,
+		},
+		Data: map[string][]byte{tokenKey: []byte(token)},
+	})
+	if apierrors.IsAlreadyExists(err) {
+		secret, err = secrets.GetNamespaced(clusterName, TokenSecretName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[tokenKey]), nil
+}
+
+// Handler renders the notifications Alertmanager relays with the template of the notifier and its alert group, and
+// sends them from the cluster like the other messages of the notifiers
+type Handler struct {
+	secretLister            v1.SecretLister
+	notifierLister          v3.NotifierLister
+	clusterAlertGroupLister v3.ClusterAlertGroupLister
+	projectAlertGroupLister v3.ProjectAlertGroupLister
+	dialerFactory           dialer.Factory
+	send                    func(ctx context.Context, notifier *v3.Notifier, recipient string, msg *notifiers.Message, dialer dialer.Dialer) error
+}
+
+func New(management *config.ScaledContext) *Handler {
+	return &Handler{
+		secretLister:            management.Core.Secrets("").Controller().Lister(),
+		notifierLister:          management.Management.Notifiers("").Controller().Lister(),
+		clusterAlertGroupLister: management.Management.ClusterAlertGroups("").Controller().Lister(),
+		projectAlertGroupLister: management.Management.ProjectAlertGroups("").Controller().Lister(),
+		dialerFactory:           management.Dialer,
+		send:                    notifiers.SendMessage,
+	}
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	vars := mux.Vars(req)
+	clusterName, notifierName := vars["cluster"], vars["notifier"]
+	if !h.authorized(req, clusterName) {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	code, err := h.relay(req, clusterName, notifierName)
+	if err != nil {
+		logrus.Errorf("Failed to relay the notification of notifier %s:%s: %v", clusterName, notifierName, err)
+		http.Error(rw, err.Error(), code)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) authorized(req *http.Request, clusterName string) bool {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	secret, err := h.secretLister.Get(clusterName, TokenSecretName)
+	if err != nil || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), secret.Data[tokenKey]) == 1
+}
+
+// relay returns the status Alertmanager gets on an error, it retries the notifications that fail with a server error
+func (h *Handler) relay(req *http.Request, clusterName, notifierName string) (int, error) {
+	data := &deployer.TemplateData{}
+	if err := json.NewDecoder(req.Body).Decode(data); err != nil {
+		return http.StatusBadRequest, fmt.Errorf("failed to parse the notification: %v", err)
+	}
+
+	notifier, err := h.notifierLister.Get(clusterName, notifierName)
+	if apierrors.IsNotFound(err) {
+		return http.StatusNotFound, err
+	} else if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	groupTemplate, err := h.groupTemplate(clusterName, req.URL.Query().Get(groupParam))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	msg, err := Render(deployer.MergeNotificationTemplates(notifier.Spec.Template, groupTemplate), data)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	clusterDialer, err := h.dialerFactory.ClusterDialer(clusterName)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := h.send(req.Context(), notifier, req.URL.Query().Get(recipientParam), msg, clusterDialer); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// groupTemplate returns the template of the alert group, a group that is not in the cluster has none
+func (h *Handler) groupTemplate(clusterName, groupID string) (*v32.NotificationTemplate, error) {
+	namespace, name := ref.Parse(groupID)
+	if namespace == "" || name == "" {
+		return nil, nil
+	}
+	if namespace == clusterName {
+		group, err := h.clusterAlertGroupLister.Get(namespace, name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return group.Spec.Template, nil
+	}
+
+	group, err := h.projectAlertGroupLister.Get(namespace, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if projectCluster, _ := ref.Parse(group.Spec.ProjectName); projectCluster != clusterName {
+		return nil, nil
+	}
+	return group.Spec.Template, nil
+}
+
+// Render renders the title, the text and the payload of a relayed notification, like Alertmanager renders the Slack
+// messages when the template does not set the title and the text
+func Render(tmpl *v32.NotificationTemplate, data *deployer.TemplateData) (*notifiers.Message, error) {
+	merged := v32.NotificationTemplate{
+		Title: `{{ template "rancher.title" . }}`,
+		Text:  `{{ template "slack.text" . }}`,
+	}
+	if tmpl != nil && tmpl.Title != "" {
+		merged.Title = tmpl.Title
+	}
+	if tmpl != nil && tmpl.Text != "" {
+		merged.Text = tmpl.Text
+	}
+	if tmpl != nil {
+		merged.Payload = tmpl.Payload
+	}
+
+	output, err := deployer.RenderNotificationTemplate(&merged, data)
+	if err != nil {
+		return nil, err
+	}
+	return &notifiers.Message{
+		Title:   output.Title,
+		Content: output.Text,
+		Payload: output.Payload,
+	}, nil
+}
@@ -0,0 +1,242 @@
+package alertrelay
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
+	v1fakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/notifiers"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type fakeDialerFactory struct{}
+
+func (fakeDialerFactory) ClusterDialer(clusterName string) (dialer.Dialer, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		return nil, errors.New("not implemented")
+	}, nil
+}
+
+func (fakeDialerFactory) DockerDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (fakeDialerFactory) NodeDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	return nil, errors.New("not implemented")
+}
+
+const payload = `{"status":"firing","alerts":[{"status":"firing","labels":{"alert_name":"node down","severity":"critical"}}],"commonLabels":{"alert_name":"node down"}}`
+
+type sent struct {
+	notifier  string
+	recipient string
+	msg       *notifiers.Message
+}
+
+func newTestHandler(sendErr error, messages *[]sent) http.Handler {
+	h := &Handler{
+		secretLister: &v1fakes.SecretListerMock{
+			GetFunc: func(namespace, name string) (*corev1.Secret, error) {
+				if namespace != "c-1" {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+				}
+				return &corev1.Secret{Data: map[string][]byte{tokenKey: []byte("token")}}, nil
+			},
+		},
+		notifierLister: &fakes.NotifierListerMock{
+			GetFunc: func(namespace, name string) (*v3.Notifier, error) {
+				if name != "telegram" {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "notifiers"}, name)
+				}
+				return &v3.Notifier{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: v32.NotifierSpec{
+						TelegramConfig: &v32.TelegramConfig{Token: "123:abc"},
+						Template:       &v32.NotificationTemplate{Title: "notifier title"},
+					},
+				}, nil
+			},
+		},
+		clusterAlertGroupLister: &fakes.ClusterAlertGroupListerMock{
+			GetFunc: func(namespace, name string) (*v3.ClusterAlertGroup, error) {
+				return &v3.ClusterAlertGroup{
+					Spec: v32.ClusterGroupSpec{
+						CommonGroupField: v32.CommonGroupField{
+							Template: &v32.NotificationTemplate{Text: "{{ .CommonLabels.alert_name }} is {{ .Status }}"},
+						},
+					},
+				}, nil
+			},
+		},
+		projectAlertGroupLister: &fakes.ProjectAlertGroupListerMock{
+			GetFunc: func(namespace, name string) (*v3.ProjectAlertGroup, error) {
+				return &v3.ProjectAlertGroup{
+					Spec: v32.ProjectGroupSpec{
+						ProjectName: "c-2:p-1",
+						CommonGroupField: v32.CommonGroupField{
+							Template: &v32.NotificationTemplate{Text: "other cluster"},
+						},
+					},
+				}, nil
+			},
+		},
+		dialerFactory: fakeDialerFactory{},
+		send: func(ctx context.Context, notifier *v3.Notifier, recipient string, msg *notifiers.Message, dialer dialer.Dialer) error {
+			*messages = append(*messages, sent{notifier: notifier.Name, recipient: recipient, msg: msg})
+			return sendErr
+		},
+	}
+	router := mux.NewRouter()
+	router.Handle(Path, h)
+	return router
+}
+
+func TestServeHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		url     string
+		token   string
+		sendErr error
+		code    int
+		sent    []sent
+	}{
+		{
+			name:   "cluster group template",
+			method: http.MethodPost,
+			url:    URL("https://rancher", "c-1", "c-1:g-1", v32.Recipient{NotifierName: "c-1:telegram", Recipient: "-200"}),
+			token:  "token",
+			code:   http.StatusOK,
+			sent: []sent{
+				{notifier: "telegram", recipient: "-200", msg: &notifiers.Message{Title: "notifier title", Content: "node down is firing"}},
+			},
+		},
+		{
+			name:   "project group of another cluster",
+			method: http.MethodPost,
+			url:    URL("https://rancher", "c-1", "p-1:g-1", v32.Recipient{NotifierName: "c-1:telegram"}),
+			token:  "token",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "wrong token",
+			method: http.MethodPost,
+			url:    URL("https://rancher", "c-1", "c-1:g-1", v32.Recipient{NotifierName: "c-1:telegram"}),
+			token:  "other",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "token of another cluster",
+			method: http.MethodPost,
+			url:    URL("https://rancher", "c-2", "c-2:g-1", v32.Recipient{NotifierName: "c-2:telegram"}),
+			token:  "token",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "get",
+			method: http.MethodGet,
+			url:    URL("https://rancher", "c-1", "c-1:g-1", v32.Recipient{NotifierName: "c-1:telegram"}),
+			token:  "token",
+			code:   http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "unknown notifier",
+			method: http.MethodPost,
+			url:    URL("https://rancher", "c-1", "c-1:g-1", v32.Recipient{NotifierName: "c-1:slack"}),
+			token:  "token",
+			code:   http.StatusNotFound,
+		},
+		{
+			name:    "send failure",
+			method:  http.MethodPost,
+			url:     URL("https://rancher", "c-1", "c-1:g-1", v32.Recipient{NotifierName: "c-1:telegram"}),
+			token:   "token",
+			sendErr: errors.New("unreachable"),
+			code:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		var messages []sent
+		req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(payload))
+		req.Header.Set("Authorization", "Bearer "+tt.token)
+		rw := httptest.NewRecorder()
+		newTestHandler(tt.sendErr, &messages).ServeHTTP(rw, req)
+
+		if rw.Code != tt.code {
+			t.Errorf("%s: expected status %d, got %d", tt.name, tt.code, rw.Code)
+		}
+		if tt.sent == nil {
+			continue
+		}
+		if len(messages) != len(tt.sent) {
+			t.Errorf("%s: expected %d messages, got %d", tt.name, len(tt.sent), len(messages))
+			continue
+		}
+		for i := range tt.sent {
+			if messages[i].notifier != tt.sent[i].notifier || messages[i].recipient != tt.sent[i].recipient || *messages[i].msg != *tt.sent[i].msg {
+				t.Errorf("%s: expected message %+v, got %+v", tt.name, tt.sent[i], messages[i])
+			}
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	labels := deployer.KV{"alert_name": "node down", "alert_type": "metric", "severity": "critical"}
+	data := &deployer.TemplateData{
+		Status:       "firing",
+		Alerts:       deployer.Alerts{{Status: "firing", Labels: labels}},
+		CommonLabels: labels,
+	}
+
+	msg, err := Render(&v32.NotificationTemplate{Payload: `{"status": "{{ .Status }}"}`}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Payload != `{"status": "firing"}` {
+		t.Errorf("unexpected payload %s", msg.Payload)
+	}
+	if msg.Title == "" || msg.Content == "" {
+		t.Errorf("expected the default title and text, got %+v", msg)
+	}
+
+	if _, err := Render(&v32.NotificationTemplate{Payload: `{"status": {{ .Status }}}`}, data); err == nil {
+		t.Error("expected the invalid payload to fail")
+	}
+}
+
+func TestRelayed(t *testing.T) {
+	webhook := &v3.Notifier{Spec: v32.NotifierSpec{WebhookConfig: &v32.WebhookConfig{URL: "https://example.com"}}}
+	dingtalk := &v3.Notifier{Spec: v32.NotifierSpec{DingtalkConfig: &v32.DingtalkConfig{URL: "https://oapi.dingtalk.com"}}}
+	tests := []struct {
+		name     string
+		notifier *v3.Notifier
+		tmpl     *v32.NotificationTemplate
+		relayed  bool
+	}{
+		{name: "webhook", notifier: webhook},
+		{name: "webhook payload", notifier: webhook, tmpl: &v32.NotificationTemplate{Payload: "{}"}, relayed: true},
+		{name: "dingtalk", notifier: dingtalk, tmpl: &v32.NotificationTemplate{Title: "title"}},
+		{name: "dingtalk text", notifier: dingtalk, tmpl: &v32.NotificationTemplate{Text: "text"}, relayed: true},
+		{name: "telegram", notifier: &v3.Notifier{Spec: v32.NotifierSpec{TelegramConfig: &v32.TelegramConfig{}}}, relayed: true},
+	}
+	for _, tt := range tests {
+		if relayed := Relayed(tt.notifier, tt.tmpl); relayed != tt.relayed {
+			t.Errorf("%s: expected relayed %v, got %v", tt.name, tt.relayed, relayed)
+		}
+	}
+}
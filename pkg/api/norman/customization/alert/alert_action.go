@@ -16,10 +16,12 @@
 )
 
 type Handler struct {
-	ClusterAlertRule v3.ClusterAlertRuleInterface
-	ProjectAlertRule v3.ProjectAlertRuleInterface
-	Notifiers        v3.NotifierInterface
-	DialerFactory    dialer.Factory
+	ClusterAlertRule   v3.ClusterAlertRuleInterface
+	ProjectAlertRule   v3.ProjectAlertRuleInterface
+	ClusterAlertGroups v3.ClusterAlertGroupInterface
+	ProjectAlertGroups v3.ProjectAlertGroupInterface
+	Notifiers          v3.NotifierInterface
+	DialerFactory      dialer.Factory
 }
 
 func RuleFormatter(apiContext *types.APIContext, resource *types.RawResource) {
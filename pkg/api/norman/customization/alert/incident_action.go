@@ -0,0 +1,146 @@
+package alert
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+const incidentStateFiring = "firing"
+
+// incidentRecord holds the fields of the cluster and project incidents the summary needs
+type incidentRecord struct {
+	RuleID        string `json:"ruleId,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	FiredAt       string `json:"firedAt,omitempty"`
+	ResolvedAt    string `json:"resolvedAt,omitempty"`
+	IncidentState string `json:"incidentState,omitempty"`
+}
+
+func AlertIncidentCollectionFormatter(apiContext *types.APIContext, collection *types.GenericCollection) {
+	collection.AddAction(apiContext, "summary")
+}
+
+func (h *Handler) AlertIncidentActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if actionName != "summary" {
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading request body error")
+	}
+	input := client.AlertIncidentSummaryInput{}
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &input); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "unmarshalling input error")
+		}
+	}
+
+	var since time.Time
+	if input.Since != "" {
+		if since, err = time.Parse(time.RFC3339, input.Since); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "since", "since must be a RFC3339 time")
+		}
+	}
+
+	option := &types.QueryOptions{}
+	if input.ClusterID != "" && apiContext.Type == client.ClusterAlertIncidentType {
+		option.Conditions = append(option.Conditions,
+			types.NewConditionFromString(client.ClusterAlertIncidentFieldClusterID, types.ModifierEQ, input.ClusterID))
+	}
+	if input.ProjectID != "" && apiContext.Type == client.ProjectAlertIncidentType {
+		option.Conditions = append(option.Conditions,
+			types.NewConditionFromString(client.ProjectAlertIncidentFieldProjectID, types.ModifierEQ, input.ProjectID))
+	}
+
+	var incidents []map[string]interface{}
+	if err := access.List(apiContext, apiContext.Version, apiContext.Type, option, &incidents); err != nil {
+		return err
+	}
+
+	var records []incidentRecord
+	for _, incident := range incidents {
+		record := incidentRecord{}
+		if err := convert.ToObj(incident, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+
+	output, err := convert.EncodeToMap(&client.AlertIncidentSummaryOutput{
+		Rules: summarizeIncidents(records, since),
+	})
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to encode the summary")
+	}
+	output["type"] = "alertIncidentSummaryOutput"
+
+	apiContext.WriteResponse(http.StatusOK, output)
+	return nil
+}
+
+// summarizeIncidents counts the incidents fired since the given time per rule, the mean time to resolve is the mean
+// duration of the resolved ones. The rules that fired the most come first.
+func summarizeIncidents(records []incidentRecord, since time.Time) []client.AlertRuleSummary {
+	summaries := map[string]*client.AlertRuleSummary{}
+	resolvedSeconds := map[string]int64{}
+	lastFired := map[string]time.Time{}
+
+	for _, r := range records {
+		firedAt, err := time.Parse(time.RFC3339, r.FiredAt)
+		if err != nil || firedAt.Before(since) {
+			continue
+		}
+
+		summary, ok := summaries[r.RuleID]
+		if !ok {
+			summary = &client.AlertRuleSummary{
+				RuleName:    r.RuleID,
+				DisplayName: r.DisplayName,
+			}
+			summaries[r.RuleID] = summary
+		}
+		summary.Fired++
+		if firedAt.After(lastFired[r.RuleID]) {
+			lastFired[r.RuleID] = firedAt
+			summary.LastFiredAt = r.FiredAt
+			summary.DisplayName = r.DisplayName
+		}
+
+		if r.IncidentState == incidentStateFiring {
+			summary.Firing++
+			continue
+		}
+		resolvedAt, err := time.Parse(time.RFC3339, r.ResolvedAt)
+		if err != nil {
+			continue
+		}
+		summary.Resolved++
+		resolvedSeconds[r.RuleID] += int64(resolvedAt.Sub(firedAt).Seconds())
+	}
+
+	result := make([]client.AlertRuleSummary, 0, len(summaries))
+	for ruleID, summary := range summaries {
+		if summary.Resolved > 0 {
+			summary.MeanTimeToResolve = resolvedSeconds[ruleID] / summary.Resolved
+		}
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Fired != result[j].Fired {
+			return result[i].Fired > result[j].Fired
+		}
+		return result[i].RuleName < result[j].RuleName
+	})
+	return result
+}
@@ -0,0 +1,66 @@
+package alert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func TestSummarizeIncidents(t *testing.T) {
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		records []incidentRecord
+		want    []client.AlertRuleSummary
+	}{
+		{
+			name: "no incidents",
+			want: []client.AlertRuleSummary{},
+		},
+		{
+			name: "incidents before since",
+			records: []incidentRecord{
+				{RuleID: "c-1:ops_cpu", FiredAt: "2019-12-31T23:59:59Z", ResolvedAt: "2020-01-01T01:00:00Z", IncidentState: "resolved"},
+				{RuleID: "c-1:ops_cpu", FiredAt: "2020-01-01T00:00:00Z", ResolvedAt: "2020-01-01T00:10:00Z", IncidentState: "resolved"},
+				{RuleID: "c-1:ops_cpu", FiredAt: "not a time", IncidentState: "firing"},
+			},
+			want: []client.AlertRuleSummary{
+				{RuleName: "c-1:ops_cpu", Fired: 1, Resolved: 1, MeanTimeToResolve: 600, LastFiredAt: "2020-01-01T00:00:00Z"},
+			},
+		},
+		{
+			name: "firing and resolved",
+			records: []incidentRecord{
+				{RuleID: "c-1:ops_cpu", DisplayName: "cpu", FiredAt: "2020-01-01T01:00:00Z", ResolvedAt: "2020-01-01T01:10:00Z", IncidentState: "resolved"},
+				{RuleID: "c-1:ops_cpu", DisplayName: "cpu", FiredAt: "2020-01-01T02:00:00Z", ResolvedAt: "2020-01-01T02:30:00Z", IncidentState: "resolved"},
+				{RuleID: "c-1:ops_cpu", DisplayName: "high cpu", FiredAt: "2020-01-01T03:00:00Z", IncidentState: "firing"},
+				{RuleID: "c-1:ops_cpu", DisplayName: "cpu", FiredAt: "2020-01-01T00:30:00Z", IncidentState: "resolved"},
+			},
+			want: []client.AlertRuleSummary{
+				{RuleName: "c-1:ops_cpu", DisplayName: "high cpu", Fired: 4, Firing: 1, Resolved: 2, MeanTimeToResolve: 1200, LastFiredAt: "2020-01-01T03:00:00Z"},
+			},
+		},
+		{
+			name: "most fired first",
+			records: []incidentRecord{
+				{RuleID: "p-1:web_5xx", FiredAt: "2020-01-01T01:00:00Z", IncidentState: "firing"},
+				{RuleID: "c-1:ops_cpu", FiredAt: "2020-01-01T01:00:00Z", IncidentState: "firing"},
+				{RuleID: "c-1:ops_memory", FiredAt: "2020-01-01T01:00:00Z", IncidentState: "firing"},
+				{RuleID: "c-1:ops_memory", FiredAt: "2020-01-01T02:00:00Z", IncidentState: "firing"},
+			},
+			want: []client.AlertRuleSummary{
+				{RuleName: "c-1:ops_memory", Fired: 2, Firing: 2, LastFiredAt: "2020-01-01T02:00:00Z"},
+				{RuleName: "c-1:ops_cpu", Fired: 1, Firing: 1, LastFiredAt: "2020-01-01T01:00:00Z"},
+				{RuleName: "p-1:web_5xx", Fired: 1, Firing: 1, LastFiredAt: "2020-01-01T01:00:00Z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := summarizeIncidents(tt.records, since); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -23,6 +23,7 @@
 func NotifierCollectionFormatter(apiContext *types.APIContext, collection *types.GenericCollection) {
 	if canCreateNotifier(apiContext, nil, "") {
 		collection.AddAction(apiContext, "send")
+		collection.AddAction(apiContext, "preview")
 	}
 }
 
@@ -30,12 +31,15 @@ func NotifierFormatter(apiContext *types.APIContext, resource *types.RawResource
 	if canCreateNotifier(apiContext, resource, "") {
 		resource.AddAction(apiContext, "send")
 	}
+	resource.AddAction(apiContext, "preview")
 }
 
 func (h *Handler) NotifierActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
 	switch actionName {
 	case "send":
 		return h.testNotifier(apiContext.Request.Context(), actionName, action, apiContext)
+	case "preview":
+		return h.previewNotifier(apiContext)
 	}
 
 	return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
@@ -90,6 +94,19 @@ func (h *Handler) testNotifier(ctx context.Context, actionName string, action *t
 	return notifiers.SendMessage(ctx, notifier, "", notifierMessage, dialer)
 }
 
+func (h *Handler) previewNotifier(apiContext *types.APIContext) error {
+	if apiContext.ID == "" {
+		return previewNotification(apiContext, nil, "")
+	}
+
+	ns, id := ref.Parse(apiContext.ID)
+	notifier, err := h.Notifiers.GetNamespaced(ns, id, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return previewNotification(apiContext, notifier.Spec.Template, notifier.Spec.ClusterName)
+}
+
 func canCreateNotifier(apiContext *types.APIContext, resource *types.RawResource, clusterID string) bool {
 	obj := rbac.ObjFromContext(apiContext, resource)
 	if clusterID != "" {
@@ -0,0 +1,88 @@
+package alert
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
+	"github.com/rancher/rancher/pkg/ref"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultPreviewAlertType = "nodeCPU"
+
+func AlertGroupFormatter(apiContext *types.APIContext, resource *types.RawResource) {
+	resource.AddAction(apiContext, "preview")
+}
+
+func (h *Handler) ClusterAlertGroupActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if actionName != "preview" {
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	ns, id := ref.Parse(apiContext.ID)
+	group, err := h.ClusterAlertGroups.GetNamespaced(ns, id, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return previewNotification(apiContext, group.Spec.Template, group.Spec.ClusterName)
+}
+
+func (h *Handler) ProjectAlertGroupActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if actionName != "preview" {
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	ns, id := ref.Parse(apiContext.ID)
+	group, err := h.ProjectAlertGroups.GetNamespaced(ns, id, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return previewNotification(apiContext, group.Spec.Template, group.Spec.ObjClusterName())
+}
+
+// previewNotification renders the template of the input, or the saved template when the input has none, against a sample alert
+func previewNotification(apiContext *types.APIContext, saved *v32.NotificationTemplate, clusterName string) error {
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading request body error")
+	}
+	input := v32.NotificationPreviewInput{}
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &input); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "unmarshalling input error")
+		}
+	}
+
+	tmpl := saved
+	if input.Template != nil {
+		tmpl = input.Template
+	}
+	if tmpl == nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "there is no template to preview")
+	}
+	alertType := input.AlertType
+	if alertType == "" {
+		alertType = defaultPreviewAlertType
+	}
+
+	rendered, err := deployer.RenderNotificationTemplate(tmpl, deployer.SampleTemplateData(alertType, input.Resolved, clusterName))
+	if err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "template", err.Error())
+	}
+	output, err := convert.EncodeToMap(rendered)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to encode the rendered template")
+	}
+	output["type"] = "notificationPreviewOutput"
+
+	apiContext.WriteResponse(http.StatusOK, output)
+	return nil
+}
@@ -2,6 +2,11 @@
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
@@ -9,12 +14,20 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/slice"
 	v3client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 )
 
 const monitoringEnabled = "MonitoringEnabled"
 
+// routeNameRegexp keeps the route names usable in the names of the receivers and the paths of the webhook receiver
+var routeNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
 func ClusterAlertRuleValidator(resquest *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
 	var clusterID string
 	if resquest.ID != "" {
@@ -58,6 +71,10 @@ func ProjectAlertRuleValidator(resquest *types.APIContext, schema *types.Schema,
 		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
 	}
 
+	if err := validateProjectRuleTarget(spec); err != nil {
+		return err
+	}
+
 	if spec.MetricRule != nil {
 		project := &v3client.Project{}
 		if err := access.ByID(resquest, resquest.Version, v3client.ProjectType, projectID, project); err != nil {
@@ -75,3 +92,319 @@ func ProjectAlertRuleValidator(resquest *types.APIContext, schema *types.Schema,
 
 	return nil
 }
+
+// validateProjectRuleTarget checks the rules that watch either a named resource or the resources matching a selector have one of them
+func validateProjectRuleTarget(spec v32.ProjectAlertRuleSpec) error {
+	if spec.VolumeRule != nil && spec.VolumeRule.VolumeClaimName == "" && len(spec.VolumeRule.Selector) == 0 {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, "volumeRule", "either volumeClaimName or selector is required")
+	}
+	if spec.HPARule != nil && spec.HPARule.HPAName == "" && len(spec.HPARule.Selector) == 0 {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, "hpaRule", "either hpaName or selector is required")
+	}
+	if spec.JobRule != nil {
+		if spec.JobRule.WorkloadID == "" && len(spec.JobRule.Selector) == 0 {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "jobRule", "either workloadId or selector is required")
+		}
+		if spec.JobRule.WorkloadID != "" && !strings.HasPrefix(spec.JobRule.WorkloadID, "job:") && !strings.HasPrefix(spec.JobRule.WorkloadID, "cronjob:") {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "jobRule", "workloadId must be the ID of a job or cronjob")
+		}
+	}
+	return nil
+}
+
+func NotifierValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.NotifierSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	configs := 0
+	for _, set := range []bool{spec.SMTPConfig != nil, spec.SlackConfig != nil, spec.PagerdutyConfig != nil,
+		spec.WebhookConfig != nil, spec.WechatConfig != nil, spec.DingtalkConfig != nil, spec.MSTeamsConfig != nil,
+		spec.OpsgenieConfig != nil, spec.TelegramConfig != nil, spec.MattermostConfig != nil, spec.GoogleChatConfig != nil} {
+		if set {
+			configs++
+		}
+	}
+	if configs > 1 {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "a notifier can only have one notifier type")
+	}
+
+	if err := validateClusterGroupAccess(request, schema, v3.NotifierResource.Name, data); err != nil {
+		return err
+	}
+
+	if err := validateNotificationTemplate(spec.Template, configuredTypes(spec)); err != nil {
+		return err
+	}
+
+	switch {
+	case spec.OpsgenieConfig != nil && spec.OpsgenieConfig.APIURL != "":
+		return validateNotifierURL("opsgenieConfig.apiUrl", spec.OpsgenieConfig.APIURL)
+	case spec.TelegramConfig != nil && spec.TelegramConfig.APIURL != "":
+		return validateNotifierURL("telegramConfig.apiUrl", spec.TelegramConfig.APIURL)
+	case spec.MattermostConfig != nil:
+		return validateNotifierURL("mattermostConfig.url", spec.MattermostConfig.URL)
+	case spec.GoogleChatConfig != nil:
+		return validateNotifierURL("googleChatConfig.url", spec.GoogleChatConfig.URL)
+	}
+
+	return nil
+}
+
+func validateNotifierURL(field, value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, field, "must be an http or https URL")
+	}
+	return nil
+}
+
+func ClusterAlertGroupValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.ClusterGroupSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	if err := validateClusterGroupAccess(request, schema, v3.ClusterAlertGroupResource.Name, data); err != nil {
+		return err
+	}
+	if err := validateNotificationTemplate(spec.Template, recipientTypes(spec.Recipients, spec.Routes)); err != nil {
+		return err
+	}
+	return validateRoutes(spec.Routes)
+}
+
+// validateClusterGroupAccess checks the caller could create the object in every member of the cluster group it is
+// scoped to, the controllers copy it to the members without checking the roles of the caller
+func validateClusterGroupAccess(request *types.APIContext, schema *types.Schema, resource string, data map[string]interface{}) error {
+	groupID := convert.ToString(data[v3client.NotifierFieldClusterGroupID])
+	if groupID == "" {
+		return nil
+	}
+	var group v3client.ClusterGroup
+	if err := access.ByID(request, &managementschema.Version, v3client.ClusterGroupType, groupID, &group); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidReference, v3client.NotifierFieldClusterGroupID, "")
+	}
+	if group.Status == nil {
+		return nil
+	}
+	for _, clusterName := range group.Status.Clusters {
+		if err := request.AccessControl.CanDo(v3.NotifierGroupVersionKind.Group, resource, "create", request,
+			map[string]interface{}{"namespaceId": clusterName}, schema); err != nil {
+			return httperror.NewAPIError(httperror.PermissionDenied,
+				fmt.Sprintf("can not create %s in cluster %s of cluster group %s", resource, clusterName, groupID))
+		}
+	}
+	return nil
+}
+
+func ProjectAlertGroupValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.ProjectGroupSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	if err := validateNotificationTemplate(spec.Template, recipientTypes(spec.Recipients, spec.Routes)); err != nil {
+		return err
+	}
+	return validateRoutes(spec.Routes)
+}
+
+func validateRoutes(routes []v32.AlertRoute) error {
+	names := map[string]bool{}
+	for _, r := range routes {
+		if !routeNameRegexp.MatchString(r.Name) {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "routes", fmt.Sprintf("route name %s must consist of lower case alphanumeric characters or '-'", r.Name))
+		}
+		if names[r.Name] {
+			return httperror.NewFieldAPIError(httperror.NotUnique, "routes", fmt.Sprintf("route %s is defined twice", r.Name))
+		}
+		names[r.Name] = true
+
+		if len(r.Recipients) == 0 && len(r.Escalations) == 0 {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "routes", fmt.Sprintf("route %s needs recipients or escalations", r.Name))
+		}
+		for _, severity := range r.Severities {
+			if severity != "info" && severity != "warning" && severity != "critical" {
+				return httperror.NewFieldAPIError(httperror.InvalidOption, "routes", fmt.Sprintf("invalid severity %s of route %s", severity, r.Name))
+			}
+		}
+		for label, value := range r.MatchRegex {
+			if _, err := regexp.Compile(value); err != nil {
+				return httperror.NewFieldAPIError(httperror.InvalidFormat, "routes", fmt.Sprintf("invalid regex of label %s of route %s: %v", label, r.Name, err))
+			}
+		}
+
+		after := 0
+		for _, e := range r.Escalations {
+			if e.AfterMinutes <= after {
+				return httperror.NewFieldAPIError(httperror.InvalidFormat, "routes", fmt.Sprintf("the escalations of route %s must wait longer than the previous one", r.Name))
+			}
+			if len(e.Recipients) == 0 {
+				return httperror.NewFieldAPIError(httperror.MissingRequired, "routes", fmt.Sprintf("an escalation of route %s has no recipients", r.Name))
+			}
+			after = e.AfterMinutes
+		}
+	}
+	return nil
+}
+
+// templateFields are the fields of the notification template each notifier type renders
+var templateFields = map[string][]string{
+	"email":      {"title", "html"},
+	"slack":      {"title", "text", "payload"},
+	"pagerduty":  {"title", "text"},
+	"webhook":    {"payload"},
+	"wechat":     {"text"},
+	"dingtalk":   {"text"},
+	"msteams":    {"text"},
+	"opsgenie":   {"title", "text"},
+	"telegram":   {"text"},
+	"mattermost": {"title", "text"},
+	"googlechat": {"text"},
+}
+
+// validateNotificationTemplate renders the template and rejects the fields no notifier of the types renders, nothing is
+// rejected without types
+func validateNotificationTemplate(tmpl *v32.NotificationTemplate, notifierTypes []string) error {
+	if tmpl == nil {
+		return nil
+	}
+	if err := deployer.ValidateNotificationTemplate(tmpl); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "template", err.Error())
+	}
+	if len(notifierTypes) == 0 {
+		return nil
+	}
+
+	fields := []struct {
+		name, value string
+	}{
+		{"title", tmpl.Title},
+		{"text", tmpl.Text},
+		{"html", tmpl.HTML},
+		{"payload", tmpl.Payload},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		used := false
+		for _, t := range notifierTypes {
+			if slice.ContainsString(templateFields[t], field.name) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return httperror.NewFieldAPIError(httperror.InvalidOption, "template."+field.name,
+				fmt.Sprintf("is not used by the %s notifiers", strings.Join(notifierTypes, ", ")))
+		}
+	}
+	return nil
+}
+
+// configuredTypes returns the type of the notifier
+func configuredTypes(spec v32.NotifierSpec) []string {
+	if notifierType := common.NotifierType(spec); notifierType != "" {
+		return []string{notifierType}
+	}
+	return nil
+}
+
+// recipientTypes returns the notifier types of the recipients of the alert group and of its routes
+func recipientTypes(recipients []v32.Recipient, routes []v32.AlertRoute) []string {
+	var notifierTypes []string
+	add := func(recipients []v32.Recipient) {
+		for _, r := range recipients {
+			if r.NotifierType != "" && !slice.ContainsString(notifierTypes, r.NotifierType) {
+				notifierTypes = append(notifierTypes, r.NotifierType)
+			}
+		}
+	}
+	add(recipients)
+	for _, route := range routes {
+		add(route.Recipients)
+		for _, escalation := range route.Escalations {
+			add(escalation.Recipients)
+		}
+	}
+	sort.Strings(notifierTypes)
+	return notifierTypes
+}
+
+func ClusterAlertSilenceValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.ClusterAlertSilenceSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	return validateSilence(spec.CommonSilenceField)
+}
+
+func ProjectAlertSilenceValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.ProjectAlertSilenceSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	return validateSilence(spec.CommonSilenceField)
+}
+
+func validateSilence(silence v32.CommonSilenceField) error {
+	if len(silence.Matchers) == 0 {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, "matchers", "at least one matcher is required")
+	}
+	for _, m := range silence.Matchers {
+		if m.Name == "" {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "matchers", "the name of a matcher is required")
+		}
+		if m.IsRegex {
+			if _, err := regexp.Compile(m.Value); err != nil {
+				return httperror.NewFieldAPIError(httperror.InvalidFormat, "matchers", fmt.Sprintf("invalid regex of matcher %s: %v", m.Name, err))
+			}
+		}
+	}
+
+	var startsAt, endsAt time.Time
+	var err error
+	if silence.StartsAt != "" {
+		if startsAt, err = time.Parse(time.RFC3339, silence.StartsAt); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "startsAt", "must be an RFC3339 time")
+		}
+	}
+	if silence.EndsAt == "" {
+		if !silence.Acknowledgement {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "endsAt", "the end is required unless the silence is an acknowledgement")
+		}
+		return nil
+	}
+	if endsAt, err = time.Parse(time.RFC3339, silence.EndsAt); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "endsAt", "must be an RFC3339 time")
+	}
+	if !startsAt.IsZero() && !endsAt.After(startsAt) {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "endsAt", "must be after the start")
+	}
+	return nil
+}
+
+func OnCallScheduleValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var spec v32.OnCallScheduleSpec
+	if err := convert.ToObj(data, &spec); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	if len(spec.Participants) == 0 {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, "participants", "at least one participant is required")
+	}
+	for _, p := range spec.Participants {
+		if p.Recipient == "" && p.NotifierName == "" {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "participants", fmt.Sprintf("participant %s needs a recipient or a notifier", p.Name))
+		}
+	}
+	if _, err := time.Parse(time.RFC3339, spec.StartsAt); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "startsAt", "must be an RFC3339 time")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/rancher/norman/types"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+func TestNotifierValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "telegram",
+			data: map[string]interface{}{
+				"telegramConfig": map[string]interface{}{"token": "123:abc", "defaultRecipient": "-100"},
+			},
+		},
+		{
+			name: "telegram api url",
+			data: map[string]interface{}{
+				"telegramConfig": map[string]interface{}{"token": "123:abc", "defaultRecipient": "-100", "apiUrl": "ftp://telegram"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "opsgenie eu instance",
+			data: map[string]interface{}{
+				"opsgenieConfig": map[string]interface{}{"apiKey": "key", "apiUrl": "https://api.eu.opsgenie.com/"},
+			},
+		},
+		{
+			name: "google chat without host",
+			data: map[string]interface{}{
+				"googleChatConfig": map[string]interface{}{"url": "https://"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "two notifier types",
+			data: map[string]interface{}{
+				"slackConfig":      map[string]interface{}{"url": "https://hooks.slack.com/services/x"},
+				"mattermostConfig": map[string]interface{}{"url": "https://chat.example.com/hooks/x"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "webhook payload",
+			data: map[string]interface{}{
+				"webhookConfig": map[string]interface{}{"url": "https://example.com/hook"},
+				"template":      map[string]interface{}{"payload": `{"status": "{{ .Status }}"}`},
+			},
+		},
+		{
+			name: "webhook text",
+			data: map[string]interface{}{
+				"webhookConfig": map[string]interface{}{"url": "https://example.com/hook"},
+				"template":      map[string]interface{}{"text": "{{ .Status }}"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid template",
+			data: map[string]interface{}{
+				"googleChatConfig": map[string]interface{}{"url": "https://chat.googleapis.com/v1/spaces/x"},
+				"template":         map[string]interface{}{"text": "{{ .Status"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		err := NotifierValidator(&types.APIContext{}, &types.Schema{}, tt.data)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateGroupTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		tmpl       *v32.NotificationTemplate
+		recipients []v32.Recipient
+		routes     []v32.AlertRoute
+		wantErr    bool
+	}{
+		{
+			name: "no recipients",
+			tmpl: &v32.NotificationTemplate{HTML: "<b>{{ .Status }}</b>"},
+		},
+		{
+			name:       "payload of a webhook route",
+			tmpl:       &v32.NotificationTemplate{Payload: `{"status": "{{ .Status }}"}`},
+			recipients: []v32.Recipient{{NotifierType: "dingtalk"}},
+			routes: []v32.AlertRoute{
+				{Name: "critical", Escalations: []v32.AlertEscalation{{Recipients: []v32.Recipient{{NotifierType: "webhook"}}}}},
+			},
+		},
+		{
+			name:       "payload of dingtalk",
+			tmpl:       &v32.NotificationTemplate{Payload: `{"status": "{{ .Status }}"}`},
+			recipients: []v32.Recipient{{NotifierType: "dingtalk"}, {NotifierType: "email"}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		err := validateNotificationTemplate(tt.tmpl, recipientTypes(tt.recipients, tt.routes))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateProjectRuleTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v32.ProjectAlertRuleSpec
+		wantErr bool
+	}{
+		{
+			name: "volume claim",
+			spec: v32.ProjectAlertRuleSpec{VolumeRule: &v32.VolumeRule{VolumeClaimName: "ns1:data"}},
+		},
+		{
+			name:    "volume without target",
+			spec:    v32.ProjectAlertRuleSpec{VolumeRule: &v32.VolumeRule{}},
+			wantErr: true,
+		},
+		{
+			name: "hpa selector",
+			spec: v32.ProjectAlertRuleSpec{HPARule: &v32.HPARule{Selector: map[string]string{"app": "web"}}},
+		},
+		{
+			name:    "hpa without target",
+			spec:    v32.ProjectAlertRuleSpec{HPARule: &v32.HPARule{}},
+			wantErr: true,
+		},
+		{
+			name: "cronjob",
+			spec: v32.ProjectAlertRuleSpec{JobRule: &v32.JobRule{WorkloadID: "cronjob:ns1:backup"}},
+		},
+		{
+			name:    "deployment as job",
+			spec:    v32.ProjectAlertRuleSpec{JobRule: &v32.JobRule{WorkloadID: "deployment:ns1:web"}},
+			wantErr: true,
+		},
+		{
+			name:    "job without target",
+			spec:    v32.ProjectAlertRuleSpec{JobRule: &v32.JobRule{}},
+			wantErr: true,
+		},
+		{
+			name: "pod rule",
+			spec: v32.ProjectAlertRuleSpec{PodRule: &v32.PodRule{PodName: "ns1:web"}},
+		},
+	}
+
+	for _, tt := range tests {
+		err := validateProjectRuleTarget(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
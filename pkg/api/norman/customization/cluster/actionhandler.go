@@ -10,6 +10,7 @@
 	gaccess "github.com/rancher/rancher/pkg/api/norman/customization/globalnamespaceaccess"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/user"
 	v1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
@@ -31,6 +32,7 @@ type ActionHandler struct {
 	CisBenchmarkVersionLister     v3.CisBenchmarkVersionLister
 	CisConfigClient               v3.CisConfigInterface
 	CisConfigLister               v3.CisConfigLister
+	ConfigMapLister               corev1.ConfigMapLister
 }
 
 func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
@@ -51,6 +53,14 @@ func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Act
 		return apiContext.AccessControl.CanDo(v3.EtcdBackupGroupVersionKind.Group, v3.EtcdBackupResource.Name, "create", apiContext, backupMap, &etcdBackupSchema) == nil
 	}
 
+	canDeleteCluster := func() bool {
+		cluster := map[string]interface{}{
+			"id": apiContext.ID,
+		}
+
+		return apiContext.AccessControl.CanDo(v3.ClusterGroupVersionKind.Group, v3.ClusterResource.Name, "delete", apiContext, cluster, apiContext.Schema) == nil
+	}
+
 	canCreateClusterTemplate := func() bool {
 
 		callerID := apiContext.Request.Header.Get(gaccess.ImpersonateUserHeader)
@@ -107,6 +117,13 @@ func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Act
 			return httperror.NewAPIError(httperror.PermissionDenied, "can not save the cluster as an RKETemplate")
 		}
 		return a.saveAsTemplate(actionName, action, apiContext)
+	case v32.ClusterActionCapacityHistory:
+		return a.capacityHistory(actionName, action, apiContext)
+	case v32.ClusterActionDecommission:
+		if !canDeleteCluster() {
+			return httperror.NewAPIError(httperror.PermissionDenied, "can not decommission the cluster")
+		}
+		return a.decommission(actionName, action, apiContext)
 	}
 	return httperror.NewAPIError(httperror.NotFound, "not found")
 }
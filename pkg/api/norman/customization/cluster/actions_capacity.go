@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterstats"
+)
+
+const defaultCapacityHistoryRange = 24 * time.Hour
+
+func (a ActionHandler) capacityHistory(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	var cluster mgmtclient.Cluster
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &cluster); err != nil {
+		return httperror.NewAPIError(httperror.NotFound, fmt.Sprintf("failed to get cluster by id %v", apiContext.ID))
+	}
+
+	actionInput, err := parse.ReadBody(apiContext.Request)
+	if err != nil {
+		return err
+	}
+	var input v32.CapacityHistoryInput
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "failed to parse the capacity history input")
+	}
+
+	end := time.Now().UTC()
+	if input.End != "" {
+		if end, err = time.Parse(time.RFC3339, input.End); err != nil {
+			return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid end %q", input.End))
+		}
+	}
+	start := end.Add(-defaultCapacityHistoryRange)
+	if input.Start != "" {
+		if start, err = time.Parse(time.RFC3339, input.Start); err != nil {
+			return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid start %q", input.Start))
+		}
+	}
+	step := time.Hour
+	if input.StepSeconds > 0 {
+		step = time.Duration(input.StepSeconds) * time.Second
+	}
+
+	samples, err := clusterstats.CapacityHistory(a.ConfigMapLister, apiContext.ID, input.NodePoolName, start, end, step)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, err.Error())
+	}
+	output, err := convert.EncodeToMap(v32.CapacityHistoryOutput{
+		Samples:  samples,
+		Forecast: clusterstats.ForecastCapacity(samples),
+	})
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to encode the capacity history")
+	}
+	output["type"] = "capacityHistoryOutput"
+
+	apiContext.WriteResponse(http.StatusOK, output)
+	return nil
+}
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/condition"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/decommission"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// decommission starts the decommission of the cluster, or restarts a failed one. The decommission controller deletes
+// the cluster once the artifacts are stored.
+func (a ActionHandler) decommission(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	var mgmtCluster mgmtclient.Cluster
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &mgmtCluster); err != nil {
+		return httperror.NewAPIError(httperror.NotFound, fmt.Sprintf("failed to get cluster by id %v", apiContext.ID))
+	}
+
+	actionInput, err := parse.ReadBody(apiContext.Request)
+	if err != nil {
+		return err
+	}
+	var input v32.DecommissionConfig
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "failed to parse the decommission input")
+	}
+
+	cluster, err := a.ClusterClient.Get(apiContext.ID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.Internal {
+		return httperror.NewAPIError(httperror.MethodNotAllowed, "the local cluster can not be decommissioned")
+	}
+	if input.SkipExport && cluster.Spec.ImportedConfig == nil && cluster.Status.Driver != v32.ClusterDriverImported {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "skipExport", "only imported clusters can skip the export")
+	}
+	if input.S3BackupConfig == nil && !hasS3BackupConfig(cluster) {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, "s3BackupConfig", "the cluster has no etcd S3 backup configuration to store the artifacts in")
+	}
+	if cluster.Spec.Decommission != nil && !decommissionFailed(cluster) {
+		return httperror.NewAPIError(httperror.Conflict, "the cluster is already being decommissioned")
+	}
+
+	cluster = cluster.DeepCopy()
+	cluster.Spec.Decommission = &input
+	cluster.Status.DecommissionStatus = nil
+	var conditions []v32.ClusterCondition
+	for _, cond := range cluster.Status.Conditions {
+		if !isDecommissionCondition(condition.Cond(cond.Type)) {
+			conditions = append(conditions, cond)
+		}
+	}
+	cluster.Status.Conditions = conditions
+	if _, err := a.ClusterClient.Update(cluster); err != nil {
+		return err
+	}
+
+	apiContext.WriteResponse(http.StatusOK, map[string]interface{}{
+		"message": "decommissioning cluster",
+	})
+	return nil
+}
+
+func hasS3BackupConfig(cluster *v3.Cluster) bool {
+	rkeConfig := cluster.Spec.RancherKubernetesEngineConfig
+	return rkeConfig != nil && rkeConfig.Services.Etcd.BackupConfig != nil && rkeConfig.Services.Etcd.BackupConfig.S3BackupConfig != nil
+}
+
+func decommissionFailed(cluster *v3.Cluster) bool {
+	for _, cond := range decommission.Conditions {
+		if cond.IsFalse(cluster) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDecommissionCondition(cond condition.Cond) bool {
+	for _, decommissionCond := range decommission.Conditions {
+		if cond == decommissionCond {
+			return true
+		}
+	}
+	return false
+}
@@ -53,6 +53,8 @@ func canUserUpdateCluster(request *types.APIContext, resource *types.RawResource
 func (f *Formatter) Formatter(request *types.APIContext, resource *types.RawResource) {
 	if convert.ToBool(resource.Values["internal"]) {
 		delete(resource.Links, "remove")
+	} else if _, ok := resource.Links["remove"]; ok {
+		resource.AddAction(request, v32.ClusterActionDecommission)
 	}
 	shellLink := request.URLBuilder.Link("shell", resource)
 	shellLink = strings.Replace(shellLink, "http", "ws", 1)
@@ -60,6 +62,7 @@ func (f *Formatter) Formatter(request *types.APIContext, resource *types.RawReso
 	resource.Links["shell"] = shellLink
 	resource.AddAction(request, v32.ClusterActionGenerateKubeconfig)
 	resource.AddAction(request, v32.ClusterActionImportYaml)
+	resource.AddAction(request, v32.ClusterActionCapacityHistory)
 	if _, ok := resource.Values["rancherKubernetesEngineConfig"]; ok {
 		resource.AddAction(request, v32.ClusterActionExportYaml)
 		resource.AddAction(request, v32.ClusterActionRotateCertificates)
@@ -15,11 +15,14 @@
 	gaccess "github.com/rancher/rancher/pkg/api/norman/customization/globalnamespaceaccess"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/clustertemplate"
 	"github.com/rancher/rancher/pkg/controllers/management/k3sbasedupgrade"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/cis"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/ref"
 	mgmtSchema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/robfig/cron"
@@ -55,6 +58,10 @@ func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, d
 		return err
 	}
 
+	if err := v.validateTemplateConstraints(request, &clientClusterSpec); err != nil {
+		return err
+	}
+
 	if err := v.validateLocalClusterAuthEndpoint(request, &clusterSpec); err != nil {
 		return err
 	}
@@ -67,6 +74,14 @@ func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, d
 		return err
 	}
 
+	if err := validateCertificateRotation(&clientClusterSpec); err != nil {
+		return err
+	}
+
+	if err := maintenance.Validate(clusterSpec.MaintenanceWindow); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, "MaintenanceWindow", err.Error())
+	}
+
 	if err := v.validateGenericEngineConfig(request, &clusterSpec); err != nil {
 		return err
 	}
@@ -143,6 +158,22 @@ func validateScheduledClusterScan(spec *mgmtclient.Cluster) error {
 	return nil
 }
 
+func validateCertificateRotation(spec *mgmtclient.Cluster) error {
+	if spec.CertificateRotation == nil || !spec.CertificateRotation.Enabled {
+		return nil
+	}
+	// clusters created from a template get their rke config from the revision
+	if spec.RancherKubernetesEngineConfig == nil && spec.ClusterTemplateRevisionID == "" {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "CertificateRotation.Enabled", "Can only enable CertificateRotation with RKE")
+	}
+	if window := spec.CertificateRotation.Window; window != nil {
+		if _, err := cron.ParseStandard(window.CronSchedule); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "CertificateRotation.Window.CronSchedule", fmt.Sprintf("error parsing cron schedule: %v", err))
+		}
+	}
+	return nil
+}
+
 func (v *Validator) validateLocalClusterAuthEndpoint(request *types.APIContext, spec *v32.ClusterSpec) error {
 	if !spec.LocalClusterAuthEndpoint.Enabled {
 		return nil
@@ -219,6 +250,53 @@ func (v *Validator) validateEnforcement(request *types.APIContext, data map[stri
 	return nil
 }
 
+// validateTemplateConstraints checks the answers of a cluster created or updated from a clusterTemplateRevision
+// against the limits of its questions and its constraints
+func (v *Validator) validateTemplateConstraints(request *types.APIContext, spec *mgmtclient.Cluster) error {
+	if spec.ClusterTemplateRevisionID == "" {
+		return nil
+	}
+	_, revisionName := ref.Parse(spec.ClusterTemplateRevisionID)
+	revision, err := v.ClusterTemplateRevisionLister.Get(namespace.GlobalNamespace, revisionName)
+	if err != nil {
+		// the cluster store reports revisions that cannot be found
+		return nil
+	}
+	if len(revision.Spec.Questions) == 0 && len(revision.Spec.Constraints) == 0 {
+		return nil
+	}
+
+	cluster := &v3.Cluster{}
+	if request.Method != http.MethodPost {
+		existing, err := v.ClusterLister.Get("", request.ID)
+		if err != nil {
+			return err
+		}
+		cluster = existing.DeepCopy()
+	}
+	cluster.Spec.ClusterTemplateAnswers = v32.Answer{}
+	if spec.ClusterTemplateAnswers != nil {
+		cluster.Spec.ClusterTemplateAnswers.Values = spec.ClusterTemplateAnswers.Values
+	}
+
+	templateSpec, err := clustertemplate.RevisionSpec(revision, cluster)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+	violations, err := clustertemplate.CheckConstraints(revision, templateSpec, cluster.Spec.ClusterTemplateAnswers.Values)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to evaluate the clusterTemplateRevision constraints")
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.String())
+	}
+	return httperror.NewFieldAPIError(httperror.InvalidOption, violations[0].Field, strings.Join(messages, "; "))
+}
+
 // TODO: test validator
 // prevents downgrades, no-ops, and upgrading before versions have been set
 func (v *Validator) validateK3sBasedVersionUpgrade(request *types.APIContext, spec *v32.ClusterSpec) error {
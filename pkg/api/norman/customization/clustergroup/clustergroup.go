@@ -0,0 +1,293 @@
+package clustergroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	gaccess "github.com/rancher/rancher/pkg/api/norman/customization/globalnamespaceaccess"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/clustergroup"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/ref"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type Handler struct {
+	Clusters           v3.ClusterInterface
+	ClusterGroupLister v3.ClusterGroupLister
+	EtcdBackups        v3.EtcdBackupInterface
+	RoleTemplateLister v3.RoleTemplateLister
+	Escalation         *clustergroup.Escalation
+}
+
+func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if !canUpdateGroup(apiContext, resource) {
+		return
+	}
+	resource.AddAction(apiContext, v32.ClusterGroupActionBackupEtcd)
+	resource.AddAction(apiContext, v32.ClusterGroupActionRotateCertificates)
+	resource.AddAction(apiContext, v32.ClusterGroupActionUpgradeKubernetes)
+}
+
+// Validator checks the cluster selector of a group, an empty selector would select every cluster
+func Validator(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	value, ok := data[client.ClusterGroupSelectorSpecFieldClusterSelector]
+	if !ok && apiContext.Method == http.MethodPut {
+		return nil
+	}
+	selector := strings.TrimSpace(convert.ToString(value))
+	if selector == "" {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, client.ClusterGroupSelectorSpecFieldClusterSelector, "")
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, client.ClusterGroupSelectorSpecFieldClusterSelector, err.Error())
+	}
+	return nil
+}
+
+// BindingValidator checks a binding grants a cluster role to a single subject, and that the caller could grant the
+// role in every member of the group
+func (h *Handler) BindingValidator(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if apiContext.Method != http.MethodPost {
+		return nil
+	}
+	subjects := 0
+	for _, field := range []string{
+		client.ClusterGroupRoleTemplateBindingFieldUserID,
+		client.ClusterGroupRoleTemplateBindingFieldUserPrincipalID,
+		client.ClusterGroupRoleTemplateBindingFieldGroupID,
+		client.ClusterGroupRoleTemplateBindingFieldGroupPrincipalID,
+	} {
+		if convert.ToString(data[field]) != "" {
+			subjects++
+		}
+	}
+	if subjects != 1 {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "a binding must have exactly one of userId, userPrincipalId, groupId or groupPrincipalId")
+	}
+
+	roleTemplateName := convert.ToString(data[client.ClusterGroupRoleTemplateBindingFieldRoleTemplateID])
+	roleTemplate, err := h.RoleTemplateLister.Get("", roleTemplateName)
+	if apierrors.IsNotFound(err) {
+		return httperror.NewFieldAPIError(httperror.InvalidReference, client.ClusterGroupRoleTemplateBindingFieldRoleTemplateID, "")
+	} else if err != nil {
+		return err
+	}
+	if roleTemplate.Context != "cluster" {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, client.ClusterGroupRoleTemplateBindingFieldRoleTemplateID,
+			fmt.Sprintf("role template %s is not a cluster role", roleTemplateName))
+	}
+	if roleTemplate.Locked {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, client.ClusterGroupRoleTemplateBindingFieldRoleTemplateID,
+			fmt.Sprintf("role template %s is locked", roleTemplateName))
+	}
+	return h.ensureCanGrant(apiContext, convert.ToString(data[client.ClusterGroupRoleTemplateBindingFieldClusterGroupID]), roleTemplate)
+}
+
+// ensureCanGrant checks the caller could create a cluster role template binding for the role template in every member
+// of the group, the controllers check the members that join the group later
+func (h *Handler) ensureCanGrant(apiContext *types.APIContext, groupID string, roleTemplate *v3.RoleTemplate) error {
+	callerID := apiContext.Request.Header.Get(gaccess.ImpersonateUserHeader)
+	if isAdmin, err := h.Escalation.IsAdmin(callerID); err != nil || isAdmin {
+		return err
+	}
+	_, groupName := ref.Parse(groupID)
+	group, err := h.ClusterGroupLister.Get("", groupName)
+	if apierrors.IsNotFound(err) {
+		return httperror.NewFieldAPIError(httperror.InvalidReference, client.ClusterGroupRoleTemplateBindingFieldClusterGroupID, "")
+	} else if err != nil {
+		return err
+	}
+	crtbSchema := apiContext.Schemas.Schema(&managementschema.Version, client.ClusterRoleTemplateBindingType)
+	for _, clusterName := range group.Status.Clusters {
+		if err := apiContext.AccessControl.CanDo(v3.ClusterRoleTemplateBindingGroupVersionKind.Group, v3.ClusterRoleTemplateBindingResource.Name,
+			"create", apiContext, map[string]interface{}{"namespaceId": clusterName}, crtbSchema); err != nil {
+			return httperror.NewAPIError(httperror.PermissionDenied, fmt.Sprintf("can not create cluster role template bindings in cluster %s", clusterName))
+		}
+		canGrant, err := h.Escalation.CanGrant(callerID, clusterName, roleTemplate)
+		if err != nil {
+			return err
+		}
+		if !canGrant {
+			return httperror.NewAPIError(httperror.PermissionDenied, fmt.Sprintf("can not grant role template %s in cluster %s", roleTemplate.Name, clusterName))
+		}
+	}
+	return nil
+}
+
+// ActionHandler runs a bulk action on every member of the group the user can update, the result of each member is
+// returned rather than failing on the first error
+func (h *Handler) ActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if !canUpdateGroup(apiContext, nil) {
+		return httperror.NewAPIError(httperror.NotFound, "not found")
+	}
+	group, err := h.ClusterGroupLister.Get("", apiContext.ID)
+	if err != nil {
+		return err
+	}
+
+	var apply func(cluster *v3.Cluster) (string, error)
+	switch actionName {
+	case v32.ClusterGroupActionBackupEtcd:
+		apply = h.backupEtcd
+	case v32.ClusterGroupActionRotateCertificates:
+		input := client.RotateCertificateInput{}
+		if err := readInput(apiContext, &input); err != nil {
+			return err
+		}
+		apply = func(cluster *v3.Cluster) (string, error) {
+			return h.rotateCertificates(cluster, input)
+		}
+	case v32.ClusterGroupActionUpgradeKubernetes:
+		input := v32.ClusterGroupUpgradeInput{}
+		if err := readInput(apiContext, &input); err != nil {
+			return err
+		}
+		if input.KubernetesVersion == "" {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "kubernetesVersion", "")
+		}
+		apply = func(cluster *v3.Cluster) (string, error) {
+			return h.upgradeKubernetes(cluster, input.KubernetesVersion)
+		}
+	default:
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	output := v32.ClusterGroupActionOutput{
+		Clusters: []v32.ClusterGroupActionResult{},
+	}
+	for _, clusterName := range group.Status.Clusters {
+		result := v32.ClusterGroupActionResult{ClusterName: clusterName}
+		if err := canUpdateCluster(apiContext, clusterName, actionName); err != nil {
+			result.Error = err.Error()
+		} else if cluster, err := h.Clusters.Get(clusterName, metav1.GetOptions{}); err != nil {
+			result.Error = err.Error()
+		} else if result.Message, err = apply(cluster); err != nil {
+			result.Error = err.Error()
+		}
+		output.Clusters = append(output.Clusters, result)
+	}
+
+	data, err := convert.EncodeToMap(output)
+	if err != nil {
+		return err
+	}
+	data["type"] = "clusterGroupActionOutput"
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+func (h *Handler) backupEtcd(cluster *v3.Cluster) (string, error) {
+	if cluster.Spec.RancherKubernetesEngineConfig == nil {
+		return "", fmt.Errorf("etcd backups are only supported on RKE clusters")
+	}
+	backup, err := etcdbackup.NewBackupObject(cluster, true)
+	if err != nil {
+		return "", err
+	}
+	backup, err = h.EtcdBackups.Create(backup)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("started etcd backup %s:%s", backup.Namespace, backup.Name), nil
+}
+
+func (h *Handler) rotateCertificates(cluster *v3.Cluster, input client.RotateCertificateInput) (string, error) {
+	if cluster.Spec.RancherKubernetesEngineConfig == nil {
+		return "", fmt.Errorf("certificate rotation is only supported on RKE clusters")
+	}
+	cluster = cluster.DeepCopy()
+	cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates = &rketypes.RotateCertificates{
+		CACertificates: input.CACertificates,
+		Services:       []string{input.Services},
+	}
+	if _, err := h.Clusters.Update(cluster); err != nil {
+		return "", err
+	}
+	if input.CACertificates {
+		return "rotating CA certificates and all components", nil
+	} else if input.Services != "" {
+		return fmt.Sprintf("rotating %s certificates", input.Services), nil
+	}
+	return "rotating certificates for all components", nil
+}
+
+func (h *Handler) upgradeKubernetes(cluster *v3.Cluster, version string) (string, error) {
+	cluster = cluster.DeepCopy()
+	var previous string
+	switch {
+	case cluster.Spec.RancherKubernetesEngineConfig != nil:
+		previous = cluster.Spec.RancherKubernetesEngineConfig.Version
+		cluster.Spec.RancherKubernetesEngineConfig.Version = version
+	case cluster.Spec.K3sConfig != nil:
+		previous = cluster.Spec.K3sConfig.Version
+		cluster.Spec.K3sConfig.Version = version
+	case cluster.Spec.Rke2Config != nil:
+		previous = cluster.Spec.Rke2Config.Version
+		cluster.Spec.Rke2Config.Version = version
+	default:
+		return "", fmt.Errorf("the kubernetes version of the cluster is not managed by rancher")
+	}
+	if previous == version {
+		return fmt.Sprintf("already at kubernetes version %s", version), nil
+	}
+	if _, err := h.Clusters.Update(cluster); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("upgrading kubernetes from %s to %s", previous, version), nil
+}
+
+func readInput(apiContext *types.APIContext, input interface{}) error {
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "failed to read request body")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, input); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "failed to parse request body")
+	}
+	return nil
+}
+
+func canUpdateGroup(apiContext *types.APIContext, resource *types.RawResource) bool {
+	obj := rbac.ObjFromContext(apiContext, resource)
+	return apiContext.AccessControl.CanDo(v3.ClusterGroupGroupVersionKind.Group, v3.ClusterGroupResource.Name,
+		"update", apiContext, obj, apiContext.Schema) == nil
+}
+
+// canUpdateCluster checks the user could run the action on the cluster itself
+func canUpdateCluster(apiContext *types.APIContext, clusterName, actionName string) error {
+	if actionName == v32.ClusterGroupActionBackupEtcd {
+		backup := map[string]interface{}{"namespaceId": clusterName}
+		if err := apiContext.AccessControl.CanDo(v3.EtcdBackupGroupVersionKind.Group, v3.EtcdBackupResource.Name, "create", apiContext, backup,
+			apiContext.Schemas.Schema(&managementschema.Version, client.EtcdBackupType)); err != nil {
+			return fmt.Errorf("can not back up cluster")
+		}
+		return nil
+	}
+	var cluster map[string]interface{}
+	if err := access.ByID(apiContext, &managementschema.Version, client.ClusterType, clusterName, &cluster); err != nil {
+		return fmt.Errorf("can not access cluster")
+	}
+	if err := apiContext.AccessControl.CanDo(v3.ClusterGroupVersionKind.Group, v3.ClusterResource.Name, "update", apiContext,
+		map[string]interface{}{"id": clusterName}, apiContext.Schemas.Schema(&managementschema.Version, client.ClusterType)); err != nil {
+		return fmt.Errorf("can not update cluster")
+	}
+	return nil
+}
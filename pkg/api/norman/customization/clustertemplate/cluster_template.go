@@ -18,6 +18,7 @@
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/norman/types/values"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	clustertemplatecontroller "github.com/rancher/rancher/pkg/controllers/management/clustertemplate"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/namespace"
 	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
@@ -27,8 +28,9 @@
 )
 
 const (
-	enableRevisionAction  = "enable"
-	disableRevisionAction = "disable"
+	enableRevisionAction      = "enable"
+	disableRevisionAction     = "disable"
+	driftReportRevisionAction = "driftreport"
 )
 
 type Wrapper struct {
@@ -37,6 +39,7 @@ type Wrapper struct {
 	ClusterTemplateRevisionLister v3.ClusterTemplateRevisionLister
 	ClusterTemplateRevisions      v3.ClusterTemplateRevisionInterface
 	ClusterTemplateQuestions      []v32.Question
+	ClusterLister                 v3.ClusterLister
 }
 
 func (w Wrapper) Formatter(apiContext *types.APIContext, resource *types.RawResource) {
@@ -52,6 +55,7 @@ func (w Wrapper) RevisionFormatter(apiContext *types.APIContext, resource *types
 			resource.AddAction(apiContext, enableRevisionAction)
 		}
 	}
+	resource.AddAction(apiContext, driftReportRevisionAction)
 }
 
 func (w Wrapper) CollectionFormatter(request *types.APIContext, collection *types.GenericCollection) {
@@ -113,6 +117,8 @@ func (w Wrapper) ClusterTemplateRevisionsActionHandler(actionName string, action
 		return w.updateEnabledFlagOnRevision(apiContext, true)
 	case "listquestions":
 		return w.listRevisionQuestions(actionName, action, apiContext)
+	case driftReportRevisionAction:
+		return w.driftReport(apiContext)
 
 	}
 	return httperror.NewAPIError(httperror.NotFound, "not found")
@@ -173,6 +179,49 @@ func (w Wrapper) loadRevision(apiContext *types.APIContext) (*v3.ClusterTemplate
 	return revision, nil
 }
 
+// driftReport compares the clusters the user can see that use the revision with its clusterConfig
+func (w Wrapper) driftReport(apiContext *types.APIContext) error {
+	revision, err := w.loadRevision(apiContext)
+	if err != nil {
+		return err
+	}
+
+	conditions := []*types.QueryCondition{
+		types.NewConditionFromString(client.ClusterFieldClusterTemplateRevisionID, types.ModifierEQ, apiContext.ID),
+	}
+	var clusters []client.Cluster
+	if err := access.List(apiContext, &managementschema.Version, client.ClusterType, &types.QueryOptions{Conditions: conditions}, &clusters); err != nil {
+		return err
+	}
+
+	report := v32.ClusterTemplateDriftReport{}
+	for _, c := range clusters {
+		cluster, err := w.ClusterLister.Get("", c.ID)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return httperror.WrapAPIError(err, httperror.ServerError, "failed to load cluster")
+		}
+		drift, err := clustertemplatecontroller.Drift(revision, cluster)
+		if err != nil {
+			return httperror.WrapAPIError(err, httperror.ServerError, fmt.Sprintf("failed to compare cluster %s with the clusterTemplateRevision", cluster.Name))
+		}
+		report.Clusters = append(report.Clusters, drift)
+	}
+	sort.Slice(report.Clusters, func(i, j int) bool {
+		return report.Clusters[i].ClusterName < report.Clusters[j].ClusterName
+	})
+
+	res, err := json.Marshal(report)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.ServerError, "failed to marshal the drift report")
+	}
+	apiContext.Response.Header().Set("Content-Type", "application/json")
+	apiContext.Response.Write(res)
+	return nil
+}
+
 func (w Wrapper) listRevisionQuestions(actionName string, action *types.Action, apiContext *types.APIContext) error {
 	questionsOutput := v32.ClusterTemplateQuestionsOutput{}
 
@@ -0,0 +1,124 @@
+package clustertemplate
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/ref"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RolloutHandler struct {
+	Rollouts                      v3.ClusterTemplateRolloutInterface
+	ClusterTemplateRevisionLister v3.ClusterTemplateRevisionLister
+}
+
+func RolloutFormatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if !canUpdateRollout(apiContext, resource) {
+		return
+	}
+	switch v32.ClusterTemplateRolloutPhase(convert.ToString(values.GetValueN(resource.Values, "status", "phase"))) {
+	case v32.ClusterTemplateRolloutPhaseCompleted, v32.ClusterTemplateRolloutPhaseFailed:
+		return
+	}
+	if convert.ToBool(resource.Values["paused"]) {
+		resource.AddAction(apiContext, v32.ClusterTemplateRolloutActionResume)
+	} else {
+		resource.AddAction(apiContext, v32.ClusterTemplateRolloutActionPause)
+	}
+}
+
+func (h *RolloutHandler) ActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if !canUpdateRollout(apiContext, nil) {
+		return httperror.NewAPIError(httperror.NotFound, "not found")
+	}
+
+	ns, name := ref.Parse(apiContext.ID)
+	rollout, err := h.Rollouts.GetNamespaced(ns, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	rollout = rollout.DeepCopy()
+
+	switch rollout.Status.Phase {
+	case v32.ClusterTemplateRolloutPhaseCompleted, v32.ClusterTemplateRolloutPhaseFailed:
+		return httperror.NewAPIError(httperror.ActionNotAvailable, "rollout is finished")
+	}
+
+	switch actionName {
+	case v32.ClusterTemplateRolloutActionPause:
+		if rollout.Spec.Paused {
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "rollout is already paused")
+		}
+		rollout.Spec.Paused = true
+	case v32.ClusterTemplateRolloutActionResume:
+		if !rollout.Spec.Paused {
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "rollout is not paused")
+		}
+		rollout.Spec.Paused = false
+	default:
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	if _, err := h.Rollouts.Update(rollout); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &data); err != nil {
+		return err
+	}
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+// Validator checks a new rollout targets an enabled revision and clusters the user can update, the rollout
+// controller updates the clusters on behalf of the user
+func (h *RolloutHandler) Validator(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if apiContext.Method != http.MethodPost {
+		return nil
+	}
+
+	revisionID := convert.ToString(data[client.ClusterTemplateRolloutSpecFieldClusterTemplateRevisionID])
+	var revisionData map[string]interface{}
+	if err := access.ByID(apiContext, &managementschema.Version, client.ClusterTemplateRevisionType, revisionID, &revisionData); err != nil {
+		return httperror.NewAPIError(httperror.NotFound, fmt.Sprintf("unable to find clusterTemplateRevision [%s]", revisionID))
+	}
+	_, revisionName := ref.Parse(revisionID)
+	revision, err := h.ClusterTemplateRevisionLister.Get(namespace.GlobalNamespace, revisionName)
+	if err != nil {
+		return httperror.NewAPIError(httperror.NotFound, fmt.Sprintf("unable to find clusterTemplateRevision [%s]", revisionID))
+	}
+	if revision.Spec.Enabled != nil && !*revision.Spec.Enabled {
+		return httperror.NewAPIError(httperror.InvalidOption, "clusterTemplateRevision is disabled")
+	}
+
+	clusterIDs := convert.ToStringSlice(data[client.ClusterTemplateRolloutSpecFieldClusterIDs])
+	if len(clusterIDs) == 0 {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, client.ClusterTemplateRolloutSpecFieldClusterIDs, "")
+	}
+	for _, clusterID := range clusterIDs {
+		cluster := map[string]interface{}{"id": clusterID}
+		if err := apiContext.AccessControl.CanDo(v3.ClusterGroupVersionKind.Group, v3.ClusterResource.Name, "update", apiContext, cluster, apiContext.Schemas.Schema(&managementschema.Version, client.ClusterType)); err != nil {
+			return httperror.NewAPIError(httperror.PermissionDenied, fmt.Sprintf("can not update cluster [%s]", clusterID))
+		}
+	}
+	return nil
+}
+
+func canUpdateRollout(apiContext *types.APIContext, resource *types.RawResource) bool {
+	obj := rbac.ObjFromContext(apiContext, resource)
+	return apiContext.AccessControl.CanDo(v3.ClusterTemplateRolloutGroupVersionKind.Group, v3.ClusterTemplateRolloutResource.Name,
+		"update", apiContext, obj, apiContext.Schema) == nil
+}
@@ -0,0 +1,95 @@
+package clusterupgradeplan
+
+import (
+	"net/http"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/ref"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Handler struct {
+	UpgradePlans v3.ClusterUpgradePlanInterface
+}
+
+func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if !canUpdatePlan(apiContext, resource) {
+		return
+	}
+	paused := convert.ToBool(values.GetValueN(resource.Values, "paused"))
+	switch v32.ClusterUpgradePhase(convert.ToString(values.GetValueN(resource.Values, "status", "phase"))) {
+	case v32.ClusterUpgradePhaseControlPlane, v32.ClusterUpgradePhaseWorkers:
+		if !paused {
+			resource.AddAction(apiContext, v32.ClusterUpgradePlanActionPause)
+		}
+		resource.AddAction(apiContext, v32.ClusterUpgradePlanActionRollback)
+	case v32.ClusterUpgradePhasePaused:
+		resource.AddAction(apiContext, v32.ClusterUpgradePlanActionResume)
+		resource.AddAction(apiContext, v32.ClusterUpgradePlanActionRollback)
+	case v32.ClusterUpgradePhaseCompleted, v32.ClusterUpgradePhaseFailed:
+		if convert.ToString(values.GetValueN(resource.Values, "status", "snapshotId")) != "" {
+			resource.AddAction(apiContext, v32.ClusterUpgradePlanActionRollback)
+		}
+	}
+}
+
+func (h *Handler) ActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if !canUpdatePlan(apiContext, nil) {
+		return httperror.NewAPIError(httperror.NotFound, "not found")
+	}
+
+	ns, name := ref.Parse(apiContext.ID)
+	plan, err := h.UpgradePlans.GetNamespaced(ns, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	plan = plan.DeepCopy()
+
+	switch actionName {
+	case v32.ClusterUpgradePlanActionPause:
+		if plan.Status.Phase != v32.ClusterUpgradePhaseControlPlane && plan.Status.Phase != v32.ClusterUpgradePhaseWorkers {
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "upgrade is not in progress")
+		}
+		plan.Spec.Paused = true
+	case v32.ClusterUpgradePlanActionResume:
+		if !plan.Spec.Paused {
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "upgrade is not paused")
+		}
+		plan.Spec.Paused = false
+	case v32.ClusterUpgradePlanActionRollback:
+		if plan.Status.SnapshotName == "" || plan.Status.PreviousKubernetesVersion == "" {
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "upgrade has not started")
+		}
+		switch plan.Status.Phase {
+		case v32.ClusterUpgradePhaseRollingBack, v32.ClusterUpgradePhaseRolledBack:
+			return httperror.NewAPIError(httperror.ActionNotAvailable, "upgrade is already rolled back")
+		}
+		plan.Spec.Rollback = true
+	default:
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+
+	if _, err := h.UpgradePlans.Update(plan); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &data); err != nil {
+		return err
+	}
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+func canUpdatePlan(apiContext *types.APIContext, resource *types.RawResource) bool {
+	obj := rbac.ObjFromContext(apiContext, resource)
+	return apiContext.AccessControl.CanDo(v3.ClusterUpgradePlanGroupVersionKind.Group, v3.ClusterUpgradePlanResource.Name,
+		"update", apiContext, obj, apiContext.Schema) == nil
+}
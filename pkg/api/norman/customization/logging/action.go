@@ -20,6 +20,7 @@
 	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/configsyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/deployer"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/generator"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/utils"
 	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	projectv3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
@@ -84,6 +85,7 @@ func (h *Handler) ActionHandler(actionName string, action *types.Action, apiCont
 	var target v33.LoggingTargets
 	var clusterName, projectID, projectName, level, containerLogSourceTag string
 	var outputTags map[string]string
+	var parsingInput v33.LoggingParsingTestInput
 
 	switch apiContext.Type {
 	case mgmtv3client.ClusterLoggingType:
@@ -106,6 +108,7 @@ func (h *Handler) ActionHandler(actionName string, action *types.Action, apiCont
 		level = loggingconfig.ClusterLevel
 		containerLogSourceTag = level
 		outputTags = input.OutputTags
+		parsingInput = input.LoggingParsingTestInput
 
 	case mgmtv3client.ProjectLoggingType:
 
@@ -125,13 +128,19 @@ func (h *Handler) ActionHandler(actionName string, action *types.Action, apiCont
 		level = loggingconfig.ProjectLevel
 		containerLogSourceTag = projectID
 		outputTags = input.OutputTags
+		parsingInput = input.LoggingParsingTestInput
 
 		if !canPerformLoggingAction(apiContext, nil, projectName) {
 			return httperror.NewAPIError(httperror.NotFound, "not found")
 		}
 	}
 
-	if err := validate(level, containerLogSourceTag, target, outputTags); err != nil {
+	commonField := v33.LoggingCommonField{
+		OutputTags:     outputTags,
+		ParsingRules:   parsingInput.ParsingRules,
+		FieldTransform: parsingInput.FieldTransform,
+	}
+	if err := validate(level, containerLogSourceTag, target, commonField); err != nil {
 		return err
 	}
 
@@ -141,7 +150,23 @@ func (h *Handler) ActionHandler(actionName string, action *types.Action, apiCont
 			return httperror.NewAPIError(httperror.ServerError, err.Error())
 		}
 
-		apiContext.WriteResponse(http.StatusNoContent, nil)
+		if len(parsingInput.SampleLines) == 0 {
+			apiContext.WriteResponse(http.StatusNoContent, nil)
+			return nil
+		}
+
+		records, err := generator.ParseSampleLines(parsingInput.ParsingRules, parsingInput.FieldTransform, parsingInput.SampleNamespace, parsingInput.SampleLines)
+		if err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+		}
+
+		output, err := convert.EncodeToMap(v33.LoggingTestOutput{Records: records})
+		if err != nil {
+			return httperror.WrapAPIError(err, httperror.ServerError, "failed to encode the parsed records")
+		}
+		output["type"] = "loggingTestOutput"
+
+		apiContext.WriteResponse(http.StatusOK, output)
 		return nil
 
 	case "dryRun":
@@ -19,7 +19,11 @@ func ClusterLoggingValidator(resquest *types.APIContext, schema *types.Schema, d
 		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
 	}
 
-	return validate(loggingconfig.ClusterLevel, "cluster", spec.LoggingTargets, spec.OutputTags)
+	if err := validate(loggingconfig.ClusterLevel, "cluster", spec.LoggingTargets, spec.LoggingCommonField); err != nil {
+		return err
+	}
+
+	return validateAuditLogSources(spec)
 }
 
 func ProjectLoggingValidator(resquest *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
@@ -28,10 +32,14 @@ func ProjectLoggingValidator(resquest *types.APIContext, schema *types.Schema, d
 		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
 	}
 
-	return validate(loggingconfig.ProjectLevel, spec.ProjectName, spec.LoggingTargets, spec.OutputTags)
+	return validate(loggingconfig.ProjectLevel, spec.ProjectName, spec.LoggingTargets, spec.LoggingCommonField)
 }
 
-func validate(level, containerLogSourceTag string, loggingTargets v32.LoggingTargets, outputTags map[string]string) error {
+func validate(level, containerLogSourceTag string, loggingTargets v32.LoggingTargets, commonField v32.LoggingCommonField) error {
+	if err := validateCommonField(level, containerLogSourceTag, commonField); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
 	if loggingTargets.KafkaConfig != nil {
 		if err := validateKafka(loggingTargets.KafkaConfig); err != nil {
 			return err
@@ -48,7 +56,7 @@ func validate(level, containerLogSourceTag string, loggingTargets v32.LoggingTar
 	}
 
 	loggingCommomFileds := v32.LoggingCommonField{
-		OutputTags: outputTags,
+		OutputTags: commonField.OutputTags,
 	}
 
 	if loggingTargets.FluentForwarderConfig != nil && wrapTarget.EnableShareKey {
@@ -76,7 +84,7 @@ func validate(level, containerLogSourceTag string, loggingTargets v32.LoggingTar
 		}
 	}
 
-	if len(outputTags) != 0 {
+	if len(commonField.OutputTags) != 0 {
 		if err = generator.ValidateCustomTags(wrap); err != nil {
 			return err
 		}
@@ -85,6 +93,59 @@ func validate(level, containerLogSourceTag string, loggingTargets v32.LoggingTar
 	return generator.ValidateCustomTarget(wrap)
 }
 
+// validateCommonField checks the parsing rules, the field transform, the selector and the debug sampling whether a
+// target is configured or not, the sample lines of the test action are parsed without one
+func validateCommonField(level, containerLogSourceTag string, commonField v32.LoggingCommonField) error {
+	loggingCommomFileds := v32.LoggingCommonField{
+		ParsingRules:   commonField.ParsingRules,
+		FieldTransform: commonField.FieldTransform,
+		Selector:       commonField.Selector,
+		DebugSampling:  commonField.DebugSampling,
+	}
+	parsingRules := generator.NewParsingRuleWraps(containerLogSourceTag, commonField.ParsingRules)
+	dropping := generator.NewDroppingTemplateWrap(containerLogSourceTag, commonField)
+
+	var wrap interface{}
+	if level == loggingconfig.ProjectLevel {
+		wrap = generator.ProjectLoggingTemplateWrap{
+			ContainerLogSourceTag: containerLogSourceTag,
+			LoggingCommonField:    loggingCommomFileds,
+			WrapParsingRules:      parsingRules,
+		}
+	} else {
+		wrap = generator.ClusterLoggingTemplateWrap{
+			ContainerLogSourceTag: containerLogSourceTag,
+			LoggingCommonField:    loggingCommomFileds,
+			WrapParsingRules:      parsingRules,
+		}
+	}
+
+	if err := generator.ValidateParsing(loggingCommomFileds, parsingRules, wrap); err != nil {
+		return err
+	}
+	return generator.ValidateDropping(loggingCommomFileds, dropping)
+}
+
+func validateAuditLogSources(spec v32.ClusterLoggingSpec) error {
+	if spec.AuditLogSources == nil {
+		return nil
+	}
+
+	wrapTarget, err := generator.NewLoggingTargetTemplateWrap(spec.LoggingTargets)
+	if err != nil {
+		return err
+	}
+
+	var currentTarget string
+	if wrapTarget != nil {
+		currentTarget = wrapTarget.CurrentTarget
+	}
+	if err := generator.ValidateAuditLogSources(spec.ClusterName, spec.AuditLogSources, currentTarget); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+	return nil
+}
+
 func validateKafka(kafkaConfig *v32.KafkaConfig) error {
 	if kafkaConfig.SaslType == "plain" && kafkaConfig.ClientCert == "" && kafkaConfig.ClientKey == "" {
 		return httperror.NewAPIError(httperror.InvalidBodyContent, "Plain SASL authentication requires SSL is configured")
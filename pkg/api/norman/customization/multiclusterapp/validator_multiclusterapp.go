@@ -8,9 +8,11 @@
 	"strings"
 	"time"
 
+	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
 
 	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/norman/types/set"
@@ -86,6 +88,19 @@ func (w Wrapper) LinkHandler(apiContext *types.APIContext, next types.RequestHan
 	return nil
 }
 
+// ensureAdmin checks that the caller can target cluster groups, the targets of the members of a group are added by
+// the controllers without checking the roles of the caller in their projects
+func ensureAdmin(ma gaccess.MemberAccess, callerID string) error {
+	isAdmin, err := ma.IsAdmin(callerID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return httperror.NewAPIError(httperror.PermissionDenied, "only administrators can target cluster groups")
+	}
+	return nil
+}
+
 func (w Wrapper) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
 	if request.Method != http.MethodPut && request.Method != http.MethodPost {
 		return nil
@@ -112,6 +127,15 @@ func (w Wrapper) Validator(request *types.APIContext, schema *types.Schema, data
 		for _, t := range targets {
 			targetProjects = append(targetProjects, convert.ToString(t[client.TargetFieldProjectID]))
 		}
+		groupTargets, _ := values.GetSlice(data, client.MultiClusterAppFieldClusterGroupTargets)
+		if len(targets) == 0 && len(groupTargets) == 0 {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, client.MultiClusterAppFieldTargets, "")
+		}
+		if len(groupTargets) > 0 {
+			if err := ensureAdmin(ma, callerID); err != nil {
+				return err
+			}
+		}
 		roleTemplates := convert.ToStringSlice(data[client.MultiClusterAppFieldRoles])
 		return ma.EnsureRoleInTargets(targetProjects, roleTemplates, callerID)
 	}
@@ -140,6 +164,17 @@ func (w Wrapper) Validator(request *types.APIContext, schema *types.Schema, data
 		return fmt.Errorf("read-only members cannot update multiclusterapp")
 	}
 	ownerAccess := accessType == gaccess.OwnerAccess
+	if input, ok := data[client.MultiClusterAppFieldClusterGroupTargets]; ok {
+		var groupTargets []v33.ClusterGroupTarget
+		if err := convert.ToObj(input, &groupTargets); err != nil {
+			return err
+		}
+		if len(groupTargets) != len(mcapp.Spec.ClusterGroupTargets) || (len(groupTargets) > 0 && !reflect.DeepEqual(groupTargets, mcapp.Spec.ClusterGroupTargets)) {
+			if err := ensureAdmin(ma, callerID); err != nil {
+				return err
+			}
+		}
+	}
 	// only members and roles list, and templateversion/answers can be edited through PUT, for updating target projects, we need to use actions only
 	// that's why target projects field has been made non updatable in rancher/types
 	if err := gaccess.CheckAccessToUpdateMembers(mcapp.Spec.Members, data, ownerAccess); err != nil {
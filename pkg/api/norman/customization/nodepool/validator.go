@@ -7,9 +7,11 @@
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	mgmtSchema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type Validator struct {
@@ -17,6 +19,16 @@ type Validator struct {
 }
 
 func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if err := validateAutoscaling(data); err != nil {
+		return err
+	}
+	if err := validateRolloutStrategy(data); err != nil {
+		return err
+	}
+	if err := validateHealthCheck(data); err != nil {
+		return err
+	}
+
 	// validate access to nodetemplate
 	nodetemplateID, ok := data["nodeTemplateId"].(string)
 	if !ok {
@@ -47,6 +59,66 @@ func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, d
 	return nil
 }
 
+// validateAutoscaling checks the quantity bounds of pools with autoscaling enabled, which the autoscaler only
+// supports on worker pools as it drains nodes before removing them
+func validateAutoscaling(data map[string]interface{}) error {
+	maxQuantity, _ := convert.ToNumber(data["maxQuantity"])
+	if maxQuantity == 0 {
+		return nil
+	}
+
+	minQuantity, _ := convert.ToNumber(data["minQuantity"])
+	if minQuantity > maxQuantity {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "minQuantity must be less than or equal to maxQuantity")
+	}
+	if convert.ToBool(data["etcd"]) || convert.ToBool(data["controlPlane"]) {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "autoscaling is only supported for worker node pools")
+	}
+	return nil
+}
+
+func validateRolloutStrategy(data map[string]interface{}) error {
+	strategy := convert.ToMapInterface(data["rolloutStrategy"])
+	if strategy == nil {
+		return nil
+	}
+
+	for _, field := range []string{"maxSurge", "maxUnavailable"} {
+		value := convert.ToString(strategy[field])
+		if value == "" {
+			continue
+		}
+		parsed := intstr.Parse(value)
+		if v, err := intstr.GetValueFromIntOrPercent(&parsed, 100, false); err != nil || v < 0 {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("rolloutStrategy.%s must be a number or a percentage", field))
+		}
+	}
+	return nil
+}
+
+func validateHealthCheck(data map[string]interface{}) error {
+	healthCheck := convert.ToMapInterface(data["healthCheck"])
+	if healthCheck == nil {
+		return nil
+	}
+
+	if value := convert.ToString(healthCheck["maxUnhealthy"]); value != "" {
+		parsed := intstr.Parse(value)
+		if v, err := intstr.GetValueFromIntOrPercent(&parsed, 100, false); err != nil || v < 0 {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "healthCheck.maxUnhealthy must be a number or a percentage")
+		}
+	}
+	for _, item := range convert.ToMapSlice(healthCheck["unhealthyConditions"]) {
+		if convert.ToString(item["type"]) == "" {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "healthCheck.unhealthyConditions require a type")
+		}
+		if timeout, _ := convert.ToNumber(item["timeoutSeconds"]); timeout <= 0 {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "healthCheck.unhealthyConditions require a timeoutSeconds greater than 0")
+		}
+	}
+	return nil
+}
+
 func checkNodetemplateAccess(request *types.APIContext, nodetemplateID string) error {
 	if err := access.ByID(request, &mgmtSchema.Version, mgmtclient.NodeTemplateType, nodetemplateID, nil); err != nil {
 		if httperror.IsNotFound(err) || httperror.IsForbidden(err) {
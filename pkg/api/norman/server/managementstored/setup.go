@@ -14,9 +14,11 @@
 	"github.com/rancher/rancher/pkg/api/norman/customization/authn"
 	"github.com/rancher/rancher/pkg/api/norman/customization/catalog"
 	ccluster "github.com/rancher/rancher/pkg/api/norman/customization/cluster"
+	"github.com/rancher/rancher/pkg/api/norman/customization/clustergroup"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clusterregistrationtokens"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clusterscan"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clustertemplate"
+	"github.com/rancher/rancher/pkg/api/norman/customization/clusterupgradeplan"
 	"github.com/rancher/rancher/pkg/api/norman/customization/cred"
 	"github.com/rancher/rancher/pkg/api/norman/customization/etcdbackup"
 	"github.com/rancher/rancher/pkg/api/norman/customization/feature"
@@ -65,6 +67,7 @@
 	projectclient "github.com/rancher/rancher/pkg/client/generated/project/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	"github.com/rancher/rancher/pkg/clusterrouter"
+	mgmtclustergroup "github.com/rancher/rancher/pkg/controllers/management/clustergroup"
 	"github.com/rancher/rancher/pkg/controllers/management/compose/common"
 	md "github.com/rancher/rancher/pkg/controllers/management/kontainerdrivermetadata"
 	"github.com/rancher/rancher/pkg/namespace"
@@ -92,11 +95,14 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.ClusterCatalogType,
 		client.ClusterLoggingType,
 		client.ClusterAlertRuleType,
+		client.ClusterAlertSilenceType,
+		client.ClusterAlertIncidentType,
 		client.ClusterMonitorGraphType,
 		client.ClusterRegistrationTokenType,
 		client.ClusterRoleTemplateBindingType,
 		client.ClusterScanType,
 		client.ClusterType,
+		client.ClusterUpgradePlanType,
 		client.ComposeConfigType,
 		client.DynamicSchemaType,
 		client.EtcdBackupType,
@@ -114,6 +120,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.NodeTemplateType,
 		client.NodeType,
 		client.NotifierType,
+		client.OnCallScheduleType,
 		client.PodSecurityPolicyTemplateProjectBindingType,
 		client.PodSecurityPolicyTemplateType,
 		client.PreferenceType,
@@ -122,6 +129,8 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.ProjectCatalogType,
 		client.ProjectLoggingType,
 		client.ProjectAlertRuleType,
+		client.ProjectAlertSilenceType,
+		client.ProjectAlertIncidentType,
 		client.ProjectMonitorGraphType,
 		client.ProjectNetworkPolicyType,
 		client.ProjectRoleTemplateBindingType,
@@ -144,6 +153,9 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.GlobalDnsProviderType,
 		client.ClusterTemplateType,
 		client.ClusterTemplateRevisionType,
+		client.ClusterTemplateRolloutType,
+		client.ClusterGroupType,
+		client.ClusterGroupRoleTemplateBindingType,
 	)
 
 	factory.BatchCreateCRDs(ctx, config.ManagementStorageContext, schemas, &projectschema.Version,
@@ -194,9 +206,11 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 	Monitor(schemas, apiContext, clusterManager)
 	KontainerDriver(schemas, apiContext)
 	ClusterTemplates(schemas, apiContext)
+	ClusterGroups(schemas, apiContext)
 	ClusterScans(schemas, apiContext, clusterManager)
 	SystemImages(schemas, apiContext)
 	EtcdBackups(schemas, apiContext)
+	ClusterUpgradePlans(schemas, apiContext)
 
 	if err := NodeTypes(schemas, apiContext); err != nil {
 		return err
@@ -268,6 +282,7 @@ func Clusters(schemas *types.Schemas, managementContext *config.ScaledContext, c
 		CisConfigLister:               managementContext.Management.CisConfigs("").Controller().Lister(),
 		CisBenchmarkVersionClient:     managementContext.Management.CisBenchmarkVersions(""),
 		CisBenchmarkVersionLister:     managementContext.Management.CisBenchmarkVersions("").Controller().Lister(),
+		ConfigMapLister:               managementContext.Core.ConfigMaps("").Controller().Lister(),
 	}
 
 	schema.ActionHandler = handler.ClusterActionHandler
@@ -564,16 +579,29 @@ func LoggingTypes(schemas *types.Schemas, management *config.ScaledContext, clus
 
 func Alert(schemas *types.Schemas, management *config.ScaledContext) {
 	handler := &alert.Handler{
-		ClusterAlertRule: management.Management.ClusterAlertRules(""),
-		ProjectAlertRule: management.Management.ProjectAlertRules(""),
-		Notifiers:        management.Management.Notifiers(""),
-		DialerFactory:    management.Dialer,
+		ClusterAlertRule:   management.Management.ClusterAlertRules(""),
+		ProjectAlertRule:   management.Management.ProjectAlertRules(""),
+		ClusterAlertGroups: management.Management.ClusterAlertGroups(""),
+		ProjectAlertGroups: management.Management.ProjectAlertGroups(""),
+		Notifiers:          management.Management.Notifiers(""),
+		DialerFactory:      management.Dialer,
 	}
 
 	schema := schemas.Schema(&managementschema.Version, client.NotifierType)
 	schema.CollectionFormatter = alert.NotifierCollectionFormatter
 	schema.Formatter = alert.NotifierFormatter
 	schema.ActionHandler = handler.NotifierActionHandler
+	schema.Validator = alert.NotifierValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertGroupType)
+	schema.Formatter = alert.AlertGroupFormatter
+	schema.Validator = alert.ClusterAlertGroupValidator
+	schema.ActionHandler = handler.ClusterAlertGroupActionHandler
+
+	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertGroupType)
+	schema.Formatter = alert.AlertGroupFormatter
+	schema.Validator = alert.ProjectAlertGroupValidator
+	schema.ActionHandler = handler.ProjectAlertGroupActionHandler
 
 	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertRuleType)
 	schema.Formatter = alert.RuleFormatter
@@ -585,6 +613,23 @@ func Alert(schemas *types.Schemas, management *config.ScaledContext) {
 	schema.Validator = alert.ProjectAlertRuleValidator
 	schema.ActionHandler = handler.ProjectAlertRuleActionHandler
 
+	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertSilenceType)
+	schema.Validator = alert.ClusterAlertSilenceValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertSilenceType)
+	schema.Validator = alert.ProjectAlertSilenceValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.OnCallScheduleType)
+	schema.Validator = alert.OnCallScheduleValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertIncidentType)
+	schema.CollectionFormatter = alert.AlertIncidentCollectionFormatter
+	schema.ActionHandler = handler.AlertIncidentActionHandler
+
+	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertIncidentType)
+	schema.CollectionFormatter = alert.AlertIncidentCollectionFormatter
+	schema.ActionHandler = handler.AlertIncidentActionHandler
+
 	//old schema just for migrate
 	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertType)
 	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertType)
@@ -831,6 +876,7 @@ func ClusterTemplates(schemas *types.Schemas, management *config.ScaledContext)
 		ClusterTemplateLister:         management.Management.ClusterTemplates("").Controller().Lister(),
 		ClusterTemplateRevisionLister: management.Management.ClusterTemplateRevisions("").Controller().Lister(),
 		ClusterTemplateRevisions:      management.Management.ClusterTemplateRevisions(""),
+		ClusterLister:                 management.Management.Clusters("").Controller().Lister(),
 	}
 	wrapper.ClusterTemplateQuestions = wrapper.BuildQuestionsFromSchema(schemas.Schema(&managementschema.Version, client.ClusterSpecBaseType), schemas, "")
 
@@ -847,6 +893,38 @@ func ClusterTemplates(schemas *types.Schemas, management *config.ScaledContext)
 	revisionSchema.Formatter = wrapper.RevisionFormatter
 	revisionSchema.CollectionFormatter = wrapper.CollectionFormatter
 	revisionSchema.ActionHandler = wrapper.ClusterTemplateRevisionsActionHandler
+
+	rolloutHandler := &clustertemplate.RolloutHandler{
+		Rollouts:                      management.Management.ClusterTemplateRollouts(""),
+		ClusterTemplateRevisionLister: management.Management.ClusterTemplateRevisions("").Controller().Lister(),
+	}
+	rolloutSchema := schemas.Schema(&managementschema.Version, client.ClusterTemplateRolloutType)
+	rolloutSchema.Store = namespacedresource.Wrap(rolloutSchema.Store, management.Core.Namespaces(""), namespace.GlobalNamespace)
+	rolloutSchema.Formatter = clustertemplate.RolloutFormatter
+	rolloutSchema.ActionHandler = rolloutHandler.ActionHandler
+	rolloutSchema.Validator = rolloutHandler.Validator
+}
+
+func ClusterGroups(schemas *types.Schemas, management *config.ScaledContext) {
+	handler := &clustergroup.Handler{
+		Clusters:           management.Management.Clusters(""),
+		ClusterGroupLister: management.Management.ClusterGroups("").Controller().Lister(),
+		EtcdBackups:        management.Management.EtcdBackups(""),
+		RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		Escalation: &mgmtclustergroup.Escalation{
+			GrbLister:          management.Management.GlobalRoleBindings("").Controller().Lister(),
+			GrLister:           management.Management.GlobalRoles("").Controller().Lister(),
+			CrtbLister:         management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+			RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		},
+	}
+	schema := schemas.Schema(&managementschema.Version, client.ClusterGroupType)
+	schema.Formatter = clustergroup.Formatter
+	schema.Validator = clustergroup.Validator
+	schema.ActionHandler = handler.ActionHandler
+
+	bindingSchema := schemas.Schema(&managementschema.Version, client.ClusterGroupRoleTemplateBindingType)
+	bindingSchema.Validator = handler.BindingValidator
 }
 
 func ClusterScans(schemas *types.Schemas, management *config.ScaledContext, clusterManager *clustermanager.Manager) error {
@@ -869,3 +947,12 @@ func EtcdBackups(schemas *types.Schemas, management *config.ScaledContext) {
 	schema := schemas.Schema(&managementschema.Version, client.EtcdBackupType)
 	schema.Formatter = etcdbackup.Formatter
 }
+
+func ClusterUpgradePlans(schemas *types.Schemas, management *config.ScaledContext) {
+	handler := &clusterupgradeplan.Handler{
+		UpgradePlans: management.Management.ClusterUpgradePlans(""),
+	}
+	schema := schemas.Schema(&managementschema.Version, client.ClusterUpgradePlanType)
+	schema.Formatter = clusterupgradeplan.Formatter
+	schema.ActionHandler = handler.ActionHandler
+}
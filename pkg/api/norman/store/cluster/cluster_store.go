@@ -175,6 +175,17 @@ func (r *Store) ByID(apiContext *types.APIContext, schema *types.Schema, id stri
 	return r.Store.ByID(apiContext, schema, id)
 }
 
+func (r *Store) Delete(apiContext *types.APIContext, schema *types.Schema, id string) (map[string]interface{}, error) {
+	if settings.ClusterDecommissionRequired.Get() == "true" {
+		cluster, err := r.ClusterLister.Get("", id)
+		if err == nil && !cluster.Spec.Internal && !v32.ClusterConditionDecommissioned.IsTrue(cluster) {
+			return nil, httperror.NewAPIError(httperror.MethodNotAllowed,
+				"clusters must be decommissioned before they are deleted, use the decommission action")
+		}
+	}
+	return r.Store.Delete(apiContext, schema, id)
+}
+
 func (r *Store) Create(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}) (map[string]interface{}, error) {
 	name := convert.ToString(data["name"])
 	if name == "" {
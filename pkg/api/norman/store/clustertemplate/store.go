@@ -10,7 +10,9 @@
 	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/norman/types/values"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clustertemplate"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	managementv3 "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	clustertemplatecontroller "github.com/rancher/rancher/pkg/controllers/management/clustertemplate"
 	"github.com/rancher/rancher/pkg/controllers/management/rbac"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
@@ -63,6 +65,9 @@ func (p *Store) Create(apiContext *types.APIContext, schema *types.Schema, data
 		if err != nil {
 			return nil, err
 		}
+		if err := checkConstraints(data); err != nil {
+			return nil, err
+		}
 		if err := setLabelsAndOwnerRef(apiContext, data); err != nil {
 			return nil, err
 		}
@@ -92,6 +97,9 @@ func (p *Store) Update(apiContext *types.APIContext, schema *types.Schema, data
 		if err != nil {
 			return nil, err
 		}
+		if err := checkConstraints(data); err != nil {
+			return nil, err
+		}
 
 		isUsed, err := p.isTemplateInUse(apiContext, id)
 		if err != nil {
@@ -283,6 +291,17 @@ func (p *Store) checkKubernetesVersionFormat(apiContext *types.APIContext, data
 	return nil
 }
 
+func checkConstraints(data map[string]interface{}) error {
+	var constraints []v32.ClusterTemplateConstraint
+	if err := convert.ToObj(data[managementv3.ClusterTemplateRevisionFieldConstraints], &constraints); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid constraints")
+	}
+	if err := clustertemplatecontroller.ValidateConstraints(constraints); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, managementv3.ClusterTemplateRevisionFieldConstraints, err.Error())
+	}
+	return nil
+}
+
 func (p *Store) checkMembersAccessType(data map[string]interface{}) error {
 	members := convert.ToMapSlice(data[managementv3.ClusterTemplateFieldMembers])
 	for _, m := range members {
@@ -89,13 +89,15 @@ func (p *ProjectAlertSpec) ObjClusterName() string {
 type Recipient struct {
 	Recipient    string `json:"recipient,omitempty"`
 	NotifierName string `json:"notifierName,omitempty" norman:"required,type=reference[notifier]"`
-	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook|wechat|dingtalk|msteams"`
+	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook|wechat|dingtalk|msteams|opsgenie|telegram|mattermost|googlechat"`
+	// OnCallScheduleName replaces the recipient, and the notifier when the participant has one, with whoever is on call
+	OnCallScheduleName string `json:"onCallScheduleName,omitempty" norman:"type=reference[onCallSchedule]"`
 }
 
 type TargetNode struct {
 	NodeName     string            `json:"nodeName,omitempty" norman:"type=reference[node]"`
 	Selector     map[string]string `json:"selector,omitempty"`
-	Condition    string            `json:"condition,omitempty" norman:"required,options=notready|mem|cpu,default=notready"`
+	Condition    string            `json:"condition,omitempty" norman:"required,options=notready|mem|cpu|diskpressure,default=notready"`
 	MemThreshold int               `json:"memThreshold,omitempty" norman:"min=1,max=100,default=70"`
 	CPUThreshold int               `json:"cpuThreshold,omitempty" norman:"min=1,default=70"`
 }
@@ -171,6 +173,8 @@ func (p *ProjectAlertGroup) ObjClusterName() string {
 type ClusterGroupSpec struct {
 	ClusterName string      `json:"clusterName" norman:"type=reference[cluster]"`
 	Recipients  []Recipient `json:"recipients,omitempty"`
+	// ClusterGroupName copies the alert group and its rules to every member of the cluster group
+	ClusterGroupName string `json:"clusterGroupName,omitempty" norman:"type=reference[clusterGroup]"`
 	CommonGroupField
 }
 
@@ -250,11 +254,15 @@ func (p *ProjectAlertRule) ObjClusterName() string {
 
 type ProjectAlertRuleSpec struct {
 	CommonRuleField
-	ProjectName  string        `json:"projectName" norman:"type=reference[project]"`
-	GroupName    string        `json:"groupName" norman:"type=reference[projectAlertGroup]"`
-	PodRule      *PodRule      `json:"podRule,omitempty"`
-	WorkloadRule *WorkloadRule `json:"workloadRule,omitempty"`
-	MetricRule   *MetricRule   `json:"metricRule,omitempty"`
+	ProjectName     string           `json:"projectName" norman:"type=reference[project]"`
+	GroupName       string           `json:"groupName" norman:"type=reference[projectAlertGroup]"`
+	PodRule         *PodRule         `json:"podRule,omitempty"`
+	WorkloadRule    *WorkloadRule    `json:"workloadRule,omitempty"`
+	MetricRule      *MetricRule      `json:"metricRule,omitempty"`
+	VolumeRule      *VolumeRule      `json:"volumeRule,omitempty"`
+	CertificateRule *CertificateRule `json:"certificateRule,omitempty"`
+	JobRule         *JobRule         `json:"jobRule,omitempty"`
+	HPARule         *HPARule         `json:"hpaRule,omitempty"`
 }
 
 func (p *ProjectAlertRuleSpec) ObjClusterName() string {
@@ -267,9 +275,32 @@ func (p *ProjectAlertRuleSpec) ObjClusterName() string {
 type CommonGroupField struct {
 	DisplayName string `json:"displayName,omitempty" norman:"required"`
 	Description string `json:"description,omitempty"`
+	// Template overrides the template of the notifiers for the alerts of the group
+	Template *NotificationTemplate `json:"template,omitempty"`
+	// Routes send the alerts they match to their own recipients, the first route that matches and doesn't
+	// continue takes the alerts away from the recipients of the group
+	Routes []AlertRoute `json:"routes,omitempty"`
 	TimingField
 }
 
+type AlertRoute struct {
+	Name string `json:"name,omitempty" norman:"required"`
+	// Severities, Match and MatchRegex must all match the labels of the alert, a route without any matches every alert
+	Severities []string          `json:"severities,omitempty"`
+	Match      map[string]string `json:"match,omitempty"`
+	MatchRegex map[string]string `json:"matchRegex,omitempty"`
+	Recipients []Recipient       `json:"recipients,omitempty"`
+	// Continue also sends the alerts to the next routes and the recipients of the group
+	Continue    bool              `json:"continue,omitempty"`
+	Escalations []AlertEscalation `json:"escalations,omitempty"`
+}
+
+// AlertEscalation notifies more recipients about the alerts of a route that are neither resolved nor acknowledged
+type AlertEscalation struct {
+	AfterMinutes int         `json:"afterMinutes,omitempty" norman:"required,min=1,default=30"`
+	Recipients   []Recipient `json:"recipients,omitempty" norman:"required"`
+}
+
 type CommonRuleField struct {
 	DisplayName string `json:"displayName,omitempty"`
 	Severity    string `json:"severity,omitempty" norman:"required,options=info|critical|warning,default=critical"`
@@ -290,6 +321,208 @@ type MetricRule struct {
 	ThresholdValue float64 `json:"thresholdValue,omitempty" norman:"type=float"`
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAlertSilence struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterAlertSilenceSpec `json:"spec"`
+	// Most recent observed status of the silence. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status AlertSilenceStatus `json:"status"`
+}
+
+func (c *ClusterAlertSilence) ObjClusterName() string {
+	return c.Spec.ObjClusterName()
+}
+
+type ClusterAlertSilenceSpec struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	CommonSilenceField
+}
+
+func (c *ClusterAlertSilenceSpec) ObjClusterName() string {
+	return c.ClusterName
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectAlertSilence struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectAlertSilenceSpec `json:"spec"`
+	// Most recent observed status of the silence. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status AlertSilenceStatus `json:"status"`
+}
+
+func (p *ProjectAlertSilence) ObjClusterName() string {
+	return p.Spec.ObjClusterName()
+}
+
+type ProjectAlertSilenceSpec struct {
+	ProjectName string `json:"projectName" norman:"type=reference[project]"`
+	CommonSilenceField
+}
+
+func (p *ProjectAlertSilenceSpec) ObjClusterName() string {
+	if parts := strings.SplitN(p.ProjectName, ":", 2); len(parts) == 2 {
+		return parts[0]
+	}
+	return ""
+}
+
+type CommonSilenceField struct {
+	Matchers []SilenceMatcher `json:"matchers,omitempty" norman:"required"`
+	// StartsAt defaults to the creation time, both times are RFC3339
+	StartsAt string `json:"startsAt,omitempty"`
+	EndsAt   string `json:"endsAt,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+	// Acknowledgement silences the alerts until they resolve, EndsAt is optional
+	Acknowledgement bool `json:"acknowledgement,omitempty"`
+}
+
+type SilenceMatcher struct {
+	Name    string `json:"name,omitempty" norman:"required"`
+	Value   string `json:"value,omitempty" norman:"required"`
+	IsRegex bool   `json:"isRegex,omitempty"`
+}
+
+type AlertSilenceStatus struct {
+	SilenceState string `json:"silenceState,omitempty" norman:"options=pending|active|expired,default=pending"`
+	// SilenceID is the id of the silence in the Alertmanager of the cluster
+	SilenceID string `json:"silenceId,omitempty"`
+	ExpiredAt string `json:"expiredAt,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAlertIncident records an alert of a cluster rule from the time it fires until it resolves
+type ClusterAlertIncident struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterAlertIncidentSpec `json:"spec"`
+	// Most recent observed status of the incident. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status AlertIncidentStatus `json:"status"`
+}
+
+func (c *ClusterAlertIncident) ObjClusterName() string {
+	return c.Spec.ObjClusterName()
+}
+
+type ClusterAlertIncidentSpec struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	GroupName   string `json:"groupName,omitempty" norman:"type=reference[clusterAlertGroup]"`
+	RuleName    string `json:"ruleName,omitempty" norman:"type=reference[clusterAlertRule]"`
+	CommonIncidentField
+}
+
+func (c *ClusterAlertIncidentSpec) ObjClusterName() string {
+	return c.ClusterName
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectAlertIncident records an alert of a project rule from the time it fires until it resolves
+type ProjectAlertIncident struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectAlertIncidentSpec `json:"spec"`
+	// Most recent observed status of the incident. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status AlertIncidentStatus `json:"status"`
+}
+
+func (p *ProjectAlertIncident) ObjClusterName() string {
+	return p.Spec.ObjClusterName()
+}
+
+type ProjectAlertIncidentSpec struct {
+	ProjectName string `json:"projectName" norman:"type=reference[project]"`
+	GroupName   string `json:"groupName,omitempty" norman:"type=reference[projectAlertGroup]"`
+	RuleName    string `json:"ruleName,omitempty" norman:"type=reference[projectAlertRule]"`
+	CommonIncidentField
+}
+
+func (p *ProjectAlertIncidentSpec) ObjClusterName() string {
+	if parts := strings.SplitN(p.ProjectName, ":", 2); len(parts) == 2 {
+		return parts[0]
+	}
+	return ""
+}
+
+type CommonIncidentField struct {
+	DisplayName string            `json:"displayName,omitempty"`
+	AlertType   string            `json:"alertType,omitempty"`
+	Severity    string            `json:"severity,omitempty"`
+	AlertLabels map[string]string `json:"alertLabels,omitempty"`
+	// Fingerprint identifies the alert in the Alertmanager of the cluster
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// FiredAt is the RFC3339 time the alert started
+	FiredAt string `json:"firedAt,omitempty"`
+}
+
+type AlertIncidentStatus struct {
+	IncidentState string `json:"incidentState,omitempty" norman:"options=firing|resolved,default=firing"`
+	ResolvedAt    string `json:"resolvedAt,omitempty"`
+	// Notifiers are the notifiers of the receivers Alertmanager sent the alert to
+	NotifierNames []string        `json:"notifierNames,omitempty" norman:"type=array[reference[notifier]]"`
+	Timeline      []IncidentEvent `json:"timeline,omitempty"`
+}
+
+type IncidentEvent struct {
+	Time      string `json:"time,omitempty"`
+	EventType string `json:"eventType,omitempty" norman:"options=fired|suppressed|unsuppressed|escalated|resolved"`
+	Message   string `json:"message,omitempty"`
+}
+
+type AlertIncidentSummaryInput struct {
+	ClusterName string `json:"clusterName,omitempty" norman:"type=reference[cluster]"`
+	ProjectName string `json:"projectName,omitempty" norman:"type=reference[project]"`
+	// Since is the RFC3339 time from which the incidents are counted, all of them when empty
+	Since string `json:"since,omitempty"`
+}
+
+type AlertIncidentSummaryOutput struct {
+	Rules []AlertRuleSummary `json:"rules,omitempty"`
+}
+
+// AlertRuleSummary are the incidents of a rule, the noisiest rules come first
+type AlertRuleSummary struct {
+	RuleName          string `json:"ruleName,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	Fired             int    `json:"fired,omitempty"`
+	Resolved          int    `json:"resolved,omitempty"`
+	Firing            int    `json:"firing,omitempty"`
+	MeanTimeToResolve int    `json:"meanTimeToResolveSeconds,omitempty"`
+	LastFiredAt       string `json:"lastFiredAt,omitempty"`
+}
+
 type TimingField struct {
 	GroupWaitSeconds      int `json:"groupWaitSeconds,omitempty" norman:"required,default=30,min=1"`
 	GroupIntervalSeconds  int `json:"groupIntervalSeconds,omitempty" norman:"required,default=180,min=1"`
@@ -299,7 +532,7 @@ type TimingField struct {
 type NodeRule struct {
 	NodeName     string            `json:"nodeName,omitempty" norman:"type=reference[node]"`
 	Selector     map[string]string `json:"selector,omitempty"`
-	Condition    string            `json:"condition,omitempty" norman:"required,options=notready|mem|cpu,default=notready"`
+	Condition    string            `json:"condition,omitempty" norman:"required,options=notready|mem|cpu|diskpressure,default=notready"`
 	MemThreshold int               `json:"memThreshold,omitempty" norman:"min=1,max=100,default=70"`
 	CPUThreshold int               `json:"cpuThreshold,omitempty" norman:"min=1,default=70"`
 }
@@ -322,6 +555,31 @@ type WorkloadRule struct {
 	AvailablePercentage int               `json:"availablePercentage,omitempty" norman:"required,min=1,max=100,default=70"`
 }
 
+type VolumeRule struct {
+	VolumeClaimName string            `json:"volumeClaimName,omitempty" norman:"type=reference[/v3/projects/schemas/persistentVolumeClaim]"`
+	Selector        map[string]string `json:"selector,omitempty"`
+	UsedPercentage  int               `json:"usedPercentage,omitempty" norman:"required,min=1,max=100,default=80"`
+}
+
+type CertificateRule struct {
+	CertificateName string `json:"certificateName,omitempty" norman:"type=reference[/v3/projects/schemas/namespacedCertificate]"`
+	// IngressOnly limits the rule to the certificates the ingresses of the project serve
+	IngressOnly   bool `json:"ingressOnly,omitempty"`
+	ExpiresInDays int  `json:"expiresInDays,omitempty" norman:"required,min=1,default=14"`
+}
+
+type JobRule struct {
+	// WorkloadID is the ID of a job or cronjob workload, like cronjob:default:backup
+	WorkloadID string            `json:"workloadId,omitempty"`
+	Selector   map[string]string `json:"selector,omitempty"`
+}
+
+type HPARule struct {
+	HPAName         string            `json:"hpaName,omitempty" norman:"type=reference[/v3/projects/schemas/horizontalPodAutoscaler]"`
+	Selector        map[string]string `json:"selector,omitempty"`
+	DurationSeconds int               `json:"durationSeconds,omitempty" norman:"required,min=30,default=600"`
+}
+
 type SystemServiceRule struct {
 	Condition string `json:"condition,omitempty" norman:"required,options=etcd|controller-manager|scheduler,default=scheduler"`
 }
@@ -349,17 +607,25 @@ func (n *Notifier) ObjClusterName() string {
 
 type NotifierSpec struct {
 	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
-
-	DisplayName     string           `json:"displayName,omitempty" norman:"required"`
-	Description     string           `json:"description,omitempty"`
-	SendResolved    bool             `json:"sendResolved,omitempty"`
-	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty"`
-	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
-	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
-	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
-	WechatConfig    *WechatConfig    `json:"wechatConfig,omitempty"`
-	DingtalkConfig  *DingtalkConfig  `json:"dingtalkConfig,omitempty"`
-	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+	// ClusterGroupName copies the notifier to every member of the cluster group
+	ClusterGroupName string `json:"clusterGroupName,omitempty" norman:"type=reference[clusterGroup]"`
+
+	DisplayName      string            `json:"displayName,omitempty" norman:"required"`
+	Description      string            `json:"description,omitempty"`
+	SendResolved     bool              `json:"sendResolved,omitempty"`
+	SMTPConfig       *SMTPConfig       `json:"smtpConfig,omitempty"`
+	SlackConfig      *SlackConfig      `json:"slackConfig,omitempty"`
+	PagerdutyConfig  *PagerdutyConfig  `json:"pagerdutyConfig,omitempty"`
+	WebhookConfig    *WebhookConfig    `json:"webhookConfig,omitempty"`
+	WechatConfig     *WechatConfig     `json:"wechatConfig,omitempty"`
+	DingtalkConfig   *DingtalkConfig   `json:"dingtalkConfig,omitempty"`
+	MSTeamsConfig    *MSTeamsConfig    `json:"msteamsConfig,omitempty"`
+	OpsgenieConfig   *OpsgenieConfig   `json:"opsgenieConfig,omitempty"`
+	TelegramConfig   *TelegramConfig   `json:"telegramConfig,omitempty"`
+	MattermostConfig *MattermostConfig `json:"mattermostConfig,omitempty"`
+	GoogleChatConfig *GoogleChatConfig `json:"googleChatConfig,omitempty"`
+
+	Template *NotificationTemplate `json:"template,omitempty"`
 }
 
 func (n *NotifierSpec) ObjClusterName() string {
@@ -367,14 +633,18 @@ func (n *NotifierSpec) ObjClusterName() string {
 }
 
 type Notification struct {
-	Message         string           `json:"message,omitempty"`
-	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty"`
-	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
-	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
-	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
-	WechatConfig    *WechatConfig    `json:"wechatConfig,omitempty"`
-	DingtalkConfig  *DingtalkConfig  `json:"dingtalkConfig,omitempty"`
-	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+	Message          string            `json:"message,omitempty"`
+	SMTPConfig       *SMTPConfig       `json:"smtpConfig,omitempty"`
+	SlackConfig      *SlackConfig      `json:"slackConfig,omitempty"`
+	PagerdutyConfig  *PagerdutyConfig  `json:"pagerdutyConfig,omitempty"`
+	WebhookConfig    *WebhookConfig    `json:"webhookConfig,omitempty"`
+	WechatConfig     *WechatConfig     `json:"wechatConfig,omitempty"`
+	DingtalkConfig   *DingtalkConfig   `json:"dingtalkConfig,omitempty"`
+	MSTeamsConfig    *MSTeamsConfig    `json:"msteamsConfig,omitempty"`
+	OpsgenieConfig   *OpsgenieConfig   `json:"opsgenieConfig,omitempty"`
+	TelegramConfig   *TelegramConfig   `json:"telegramConfig,omitempty"`
+	MattermostConfig *MattermostConfig `json:"mattermostConfig,omitempty"`
+	GoogleChatConfig *GoogleChatConfig `json:"googleChatConfig,omitempty"`
 }
 
 type SMTPConfig struct {
@@ -424,9 +694,113 @@ type MSTeamsConfig struct {
 	*HTTPClientConfig
 }
 
+type OpsgenieConfig struct {
+	APIKey string `json:"apiKey,omitempty" norman:"type=password,required"`
+	// APIURL is the Opsgenie API of the account, https://api.eu.opsgenie.com/ for the EU instance
+	APIURL string `json:"apiUrl,omitempty"`
+	// DefaultRecipient is the team the alerts are assigned to
+	DefaultRecipient string `json:"defaultRecipient,omitempty"`
+	*HTTPClientConfig
+}
+
+type TelegramConfig struct {
+	Token string `json:"token,omitempty" norman:"type=password,required"`
+	// DefaultRecipient is the id of the chat the bot sends the alerts to
+	DefaultRecipient string `json:"defaultRecipient,omitempty" norman:"required"`
+	APIURL           string `json:"apiUrl,omitempty"`
+	*HTTPClientConfig
+}
+
+// MattermostConfig sends the alerts to a Mattermost or Rocket.Chat incoming webhook, both accept Slack messages
+type MattermostConfig struct {
+	DefaultRecipient string `json:"defaultRecipient,omitempty"`
+	URL              string `json:"url,omitempty" norman:"required"`
+	*HTTPClientConfig
+}
+
+type GoogleChatConfig struct {
+	URL string `json:"url,omitempty" norman:"required"`
+	*HTTPClientConfig
+}
+
 type NotifierStatus struct {
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type OnCallSchedule struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OnCallScheduleSpec `json:"spec"`
+	// Most recent observed status of the schedule. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status OnCallScheduleStatus `json:"status"`
+}
+
+func (o *OnCallSchedule) ObjClusterName() string {
+	return o.Spec.ObjClusterName()
+}
+
+type OnCallScheduleSpec struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	DisplayName string `json:"displayName,omitempty" norman:"required"`
+	Description string `json:"description,omitempty"`
+	// Participants take turns in this order, the first one is on call from StartsAt
+	Participants []OnCallParticipant `json:"participants,omitempty" norman:"required"`
+	// StartsAt is the RFC3339 time of the first handoff
+	StartsAt      string `json:"startsAt,omitempty" norman:"required"`
+	RotationHours int    `json:"rotationHours,omitempty" norman:"required,min=1,default=168"`
+}
+
+func (o *OnCallScheduleSpec) ObjClusterName() string {
+	return o.ClusterName
+}
+
+type OnCallParticipant struct {
+	Name string `json:"name,omitempty" norman:"required"`
+	// Recipient is the email address, channel or key the notifier sends to
+	Recipient    string `json:"recipient,omitempty"`
+	NotifierName string `json:"notifierName,omitempty" norman:"type=reference[notifier]"`
+}
+
+type OnCallScheduleStatus struct {
+	CurrentParticipant string `json:"currentParticipant,omitempty"`
+	NextHandoff        string `json:"nextHandoff,omitempty"`
+}
+
+// NotificationTemplate replaces the default alert messages. The fields are Alertmanager templates and
+// can use the built-in "rancher.title", "slack.text" and "email.text" templates.
+type NotificationTemplate struct {
+	// Title is the email subject and the title of the chat, pagerduty and opsgenie messages
+	Title string `json:"title,omitempty"`
+	// Text is the body of the chat, pagerduty and opsgenie messages
+	Text string `json:"text,omitempty"`
+	// HTML is the body of the emails
+	HTML string `json:"html,omitempty"`
+	// Payload is a JSON document posted instead of the default message by the slack and webhook notifiers
+	Payload string `json:"payload,omitempty"`
+}
+
+type NotificationPreviewInput struct {
+	// Template is rendered instead of the template of the notifier or alert group
+	Template  *NotificationTemplate `json:"template,omitempty"`
+	AlertType string                `json:"alertType,omitempty" norman:"options=event|systemService|nodeHealthy|nodeCPU|nodeMemory|nodeDiskPressure|podNotScheduled|podNotRunning|podRestarts|workload|volumeUsage|certificateExpiry|jobFailed|hpaSaturation|metric,default=nodeCPU"`
+	Resolved  bool                  `json:"resolved,omitempty"`
+}
+
+type NotificationPreviewOutput struct {
+	Title   string `json:"title,omitempty"`
+	Text    string `json:"text,omitempty"`
+	HTML    string `json:"html,omitempty"`
+	Payload string `json:"payload,omitempty"`
+}
+
 // HTTPClientConfig configures an HTTP client.
 type HTTPClientConfig struct {
 	// HTTP proxy server to use to connect to the targets.
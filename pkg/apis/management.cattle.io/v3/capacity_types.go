@@ -0,0 +1,41 @@
+package v3
+
+// CapacityHistoryInput selects the capacity history of a cluster, or of one of its node pools, to return
+type CapacityHistoryInput struct {
+	// Start and End are RFC3339 dates, the last day is returned without them
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+	// StepSeconds is the interval between two returned samples
+	StepSeconds  int    `json:"stepSeconds,omitempty" norman:"default=3600,min=300"`
+	NodePoolName string `json:"nodePoolId,omitempty" norman:"type=reference[nodePool]"`
+}
+
+type CapacityHistoryOutput struct {
+	Samples  []CapacitySample  `json:"samples,omitempty"`
+	Forecast *CapacityForecast `json:"forecast,omitempty"`
+}
+
+// CapacitySample is the capacity of the schedulable worker nodes at a point in time, cpu is in millicores and memory
+// in bytes
+type CapacitySample struct {
+	Timestamp         string `json:"timestamp"`
+	CPUAllocatable    int64  `json:"cpuAllocatable"`
+	CPURequested      int64  `json:"cpuRequested"`
+	CPULimits         int64  `json:"cpuLimits"`
+	MemoryAllocatable int64  `json:"memoryAllocatable"`
+	MemoryRequested   int64  `json:"memoryRequested"`
+	MemoryLimits      int64  `json:"memoryLimits"`
+	PodsAllocatable   int64  `json:"podsAllocatable"`
+	PodsRequested     int64  `json:"podsRequested"`
+}
+
+// CapacityForecast projects the growth of the requests over the returned samples
+type CapacityForecast struct {
+	// CPURequestsPerDay and MemoryRequestsPerDay are the growth of the requests, in millicores and bytes
+	CPURequestsPerDay    int64 `json:"cpuRequestsPerDay"`
+	MemoryRequestsPerDay int64 `json:"memoryRequestsPerDay"`
+	// CPUExhaustion and MemoryExhaustion are the RFC3339 dates the requests reach the allocatable resources, they are
+	// empty when the requests do not grow
+	CPUExhaustion    string `json:"cpuExhaustion,omitempty"`
+	MemoryExhaustion string `json:"memoryExhaustion,omitempty"`
+}
@@ -0,0 +1,79 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ClusterGroupActionBackupEtcd         = "backupEtcd"
+	ClusterGroupActionRotateCertificates = "rotateCertificates"
+	ClusterGroupActionUpgradeKubernetes  = "upgradeKubernetes"
+
+	// ClusterGroupLabel is set on the objects created for the members of a cluster group to the name of the group
+	ClusterGroupLabel = "management.cattle.io/cluster-group"
+	// ClusterGroupSourceLabel is set on the copies of the alert groups, alert rules and notifiers scoped to a cluster
+	// group to the namespace of the object they are copied from
+	ClusterGroupSourceLabel = "management.cattle.io/cluster-group-source"
+	// ClusterGroupBindingLabel is set on the cluster role template bindings created for a
+	// ClusterGroupRoleTemplateBinding to its name
+	ClusterGroupBindingLabel = "management.cattle.io/cluster-group-binding"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterGroup selects clusters by their labels so that they can be acted on together, its members are recomputed as
+// the labels of the clusters change
+type ClusterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupSelectorSpec `json:"spec"`
+	Status ClusterGroupStatus       `json:"status"`
+}
+
+type ClusterGroupSelectorSpec struct {
+	DisplayName string `json:"displayName" norman:"required"`
+	Description string `json:"description,omitempty"`
+	// ClusterSelector is a label selector in the format of kubectl, such as "env=prod,region in (eu,us)"
+	ClusterSelector string `json:"clusterSelector" norman:"required"`
+}
+
+type ClusterGroupStatus struct {
+	// Clusters are the names of the members of the group, sorted
+	Clusters []string `json:"clusters,omitempty" norman:"type=array[reference[cluster]],nocreate,noupdate"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterGroupRoleTemplateBinding grants a cluster role on every member of a cluster group, through a cluster role
+// template binding per member
+type ClusterGroupRoleTemplateBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	UserName           string `json:"userName,omitempty" norman:"noupdate,type=reference[user]"`
+	UserPrincipalName  string `json:"userPrincipalName,omitempty" norman:"noupdate,type=reference[principal]"`
+	GroupName          string `json:"groupName,omitempty" norman:"noupdate,type=reference[group]"`
+	GroupPrincipalName string `json:"groupPrincipalName,omitempty" norman:"noupdate,type=reference[principal]"`
+	ClusterGroupName   string `json:"clusterGroupName,omitempty" norman:"required,noupdate,type=reference[clusterGroup]"`
+	RoleTemplateName   string `json:"roleTemplateName,omitempty" norman:"required,noupdate,type=reference[roleTemplate]"`
+}
+
+type ClusterGroupUpgradeInput struct {
+	KubernetesVersion string `json:"kubernetesVersion" norman:"required"`
+}
+
+// ClusterGroupActionOutput is the result of a bulk action on each member of a cluster group
+type ClusterGroupActionOutput struct {
+	Clusters []ClusterGroupActionResult `json:"clusters"`
+}
+
+type ClusterGroupActionResult struct {
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+	Message     string `json:"message,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
@@ -1,7 +1,9 @@
 package v3
 
 import (
+	"github.com/rancher/norman/condition"
 	"github.com/rancher/norman/types"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -49,8 +51,131 @@ type ClusterTemplateRevisionSpec struct {
 
 	Questions     []Question       `json:"questions,omitempty"`
 	ClusterConfig *ClusterSpecBase `json:"clusterConfig" norman:"required"`
+	// Constraints limit the values clusters created from the revision can get through the answers to Questions
+	Constraints []ClusterTemplateConstraint `json:"constraints,omitempty"`
+}
+
+// ClusterTemplateConstraint is a rule on a field of the cluster spec built from a revision and the answers of a cluster
+type ClusterTemplateConstraint struct {
+	// Field is the path of the field in the cluster spec, using the same names as question variables
+	Field         string   `json:"field" norman:"required"`
+	AllowedValues []string `json:"allowedValues,omitempty"`
+	Min           *int64   `json:"min,omitempty"`
+	Max           *int64   `json:"max,omitempty"`
+	// Pattern is a regular expression the whole value has to match
+	Pattern string `json:"pattern,omitempty"`
+	// VersionRange is a semver range the Kubernetes version in the field has to be in, such as ">=1.17.0 <1.19.0"
+	VersionRange string `json:"versionRange,omitempty"`
+	// Required fields cannot be empty
+	Required bool `json:"required,omitempty"`
+	// When limits the constraint to clusters where another field has a value
+	When *ClusterTemplateConstraintCondition `json:"when,omitempty"`
+	// Message replaces the error returned when the constraint is not met
+	Message string `json:"message,omitempty"`
+}
+
+type ClusterTemplateConstraintCondition struct {
+	Field string `json:"field" norman:"required"`
+	Value string `json:"value"`
 }
 
 type ClusterTemplateQuestionsOutput struct {
 	Questions []Question `json:"questions,omitempty"`
 }
+
+type ClusterTemplateDriftReport struct {
+	Clusters []ClusterTemplateDrift `json:"clusters,omitempty"`
+}
+
+// ClusterTemplateDrift compares the spec of a cluster with the clusterConfig of the revision it was created from
+type ClusterTemplateDrift struct {
+	ClusterName string                      `json:"clusterName" norman:"type=reference[cluster]"`
+	Drifted     bool                        `json:"drifted"`
+	Differences []ClusterTemplateDifference `json:"differences,omitempty"`
+}
+
+type ClusterTemplateDifference struct {
+	// Field is the path of the field in the cluster spec, such as rancherKubernetesEngineConfig.network.plugin
+	Field         string `json:"field"`
+	RevisionValue string `json:"revisionValue,omitempty"`
+	ClusterValue  string `json:"clusterValue,omitempty"`
+	// Answered is true when the value comes from the answer to a question of the revision
+	Answered bool `json:"answered"`
+}
+
+type ClusterTemplateRolloutPhase string
+
+const (
+	ClusterTemplateRolloutConditionCompleted condition.Cond = "Completed"
+
+	ClusterTemplateRolloutPhaseInProgress ClusterTemplateRolloutPhase = "inprogress"
+	ClusterTemplateRolloutPhasePaused     ClusterTemplateRolloutPhase = "paused"
+	ClusterTemplateRolloutPhaseCompleted  ClusterTemplateRolloutPhase = "completed"
+	ClusterTemplateRolloutPhaseFailed     ClusterTemplateRolloutPhase = "failed"
+
+	ClusterTemplateRolloutClusterPending  = "pending"
+	ClusterTemplateRolloutClusterUpdating = "updating"
+	ClusterTemplateRolloutClusterUpdated  = "updated"
+	ClusterTemplateRolloutClusterFailed   = "failed"
+	ClusterTemplateRolloutClusterSkipped  = "skipped"
+
+	ClusterTemplateRolloutActionPause  = "pause"
+	ClusterTemplateRolloutActionResume = "resume"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplateRollout moves clusters to a new revision of their cluster template in batches
+type ClusterTemplateRollout struct {
+	types.Namespaced
+
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterTemplateRolloutSpec   `json:"spec"`
+	Status ClusterTemplateRolloutStatus `yaml:"status" json:"status,omitempty"`
+}
+
+type ClusterTemplateRolloutSpec struct {
+	DisplayName                 string   `json:"displayName"`
+	ClusterTemplateRevisionName string   `json:"clusterTemplateRevisionName" norman:"type=reference[clusterTemplateRevision],required,noupdate"`
+	ClusterNames                []string `json:"clusterNames" norman:"type=array[reference[cluster]],required,noupdate"`
+	// Number of clusters updated at the same time, a batch starts once all clusters of the previous one are active
+	BatchSize int `json:"batchSize,omitempty" norman:"default=1,min=1"`
+	// Number of clusters that can fail to update before the rollout stops
+	MaxFailures int  `json:"maxFailures,omitempty" norman:"default=0,min=0"`
+	Paused      bool `json:"paused"`
+}
+
+type ClusterTemplateRolloutClusterStatus struct {
+	ClusterName          string `json:"clusterName" norman:"type=reference[cluster]"`
+	State                string `json:"state"`
+	PreviousRevisionName string `json:"previousRevisionName,omitempty" norman:"type=reference[clusterTemplateRevision]"`
+	Message              string `json:"message,omitempty"`
+	StartedAt            string `json:"startedAt,omitempty"`
+	CompletedAt          string `json:"completedAt,omitempty"`
+}
+
+type ClusterTemplateRolloutCondition struct {
+	// Type of condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime string `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	Message string `json:"message,omitempty"`
+}
+
+type ClusterTemplateRolloutStatus struct {
+	Phase      ClusterTemplateRolloutPhase           `json:"phase,omitempty"`
+	Conditions []ClusterTemplateRolloutCondition     `json:"conditions,omitempty"`
+	Clusters   []ClusterTemplateRolloutClusterStatus `json:"clusters,omitempty"`
+	Updated    int                                   `json:"updated"`
+	Failed     int                                   `json:"failed"`
+}
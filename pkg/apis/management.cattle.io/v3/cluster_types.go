@@ -35,6 +35,8 @@ func init() {
 	ClusterActionRotateCertificates    = "rotateCertificates"
 	ClusterActionRunSecurityScan       = "runSecurityScan"
 	ClusterActionSaveAsTemplate        = "saveAsTemplate"
+	ClusterActionCapacityHistory       = "capacityHistory"
+	ClusterActionDecommission          = "decommission"
 
 	// ClusterConditionReady Cluster ready to serve API (healthy when true, unhealthy when false)
 	ClusterConditionReady          condition.Cond = "Ready"
@@ -68,6 +70,21 @@ func init() {
 	ClusterConditionPrometheusOperatorDeployed condition.Cond = "PrometheusOperatorDeployed"
 	ClusterConditionMonitoringEnabled          condition.Cond = "MonitoringEnabled"
 	ClusterConditionAlertingEnabled            condition.Cond = "AlertingEnabled"
+	// ClusterConditionCertificatesRotated false when the automatic rotation of expiring certificates failed
+	ClusterConditionCertificatesRotated condition.Cond = "CertificatesRotated"
+	// The health probe conditions are false when the health syncer finds the component of the downstream cluster
+	// unhealthy, see healthsyncer.Probe
+	ClusterConditionAPIServerHealthy   condition.Cond = "APIServerHealthy"
+	ClusterConditionEtcdHealthy        condition.Cond = "EtcdHealthy"
+	ClusterConditionDNSHealthy         condition.Cond = "DNSHealthy"
+	ClusterConditionNetworkHealthy     condition.Cond = "NetworkHealthy"
+	ClusterConditionAgentTunnelHealthy condition.Cond = "AgentTunnelHealthy"
+	// The decommission conditions are true once the step stored its artifact in the backup target, the cluster is
+	// deleted when Decommissioned is true
+	ClusterConditionDecommissionEtcdBackedUp      condition.Cond = "DecommissionEtcdBackedUp"
+	ClusterConditionDecommissionResourcesExported condition.Cond = "DecommissionResourcesExported"
+	ClusterConditionDecommissionConfigExported    condition.Cond = "DecommissionConfigExported"
+	ClusterConditionDecommissioned                condition.Cond = "Decommissioned"
 
 	ClusterDriverImported = "imported"
 	ClusterDriverLocal    = "local"
@@ -110,6 +127,8 @@ type ClusterSpecBase struct {
 	WindowsPreferedCluster               bool                                    `json:"windowsPreferedCluster" norman:"noupdate"`
 	LocalClusterAuthEndpoint             LocalClusterAuthEndpoint                `json:"localClusterAuthEndpoint,omitempty"`
 	ScheduledClusterScan                 *ScheduledClusterScan                   `json:"scheduledClusterScan,omitempty"`
+	CertificateRotation                  *CertificateRotationConfig              `json:"certificateRotation,omitempty"`
+	MaintenanceWindow                    *MaintenanceWindow                      `json:"maintenanceWindow,omitempty"`
 	FleetWorkspaceName                   string                                  `json:"fleetWorkspaceName,omitempty"`
 }
 
@@ -130,6 +149,7 @@ type ClusterSpec struct {
 	ClusterTemplateRevisionName         string                      `json:"clusterTemplateRevisionName,omitempty" norman:"type=reference[clusterTemplateRevision]"`
 	ClusterTemplateAnswers              Answer                      `json:"answers,omitempty"`
 	ClusterTemplateQuestions            []Question                  `json:"questions,omitempty" norman:"nocreate,noupdate"`
+	Decommission                        *DecommissionConfig         `json:"decommission,omitempty" norman:"nocreate,noupdate"`
 }
 
 type ImportedConfig struct {
@@ -169,6 +189,9 @@ type ClusterStatus struct {
 	ScheduledClusterScanStatus           *ScheduledClusterScanStatus `json:"scheduledClusterScanStatus,omitempty"`
 	CurrentCisRunName                    string                      `json:"currentCisRunName,omitempty"`
 	EKSStatus                            EKSStatus                   `json:"eksStatus,omitempty" norman:"nocreate,noupdate"`
+	CertificateRotationStatus            *CertificateRotationStatus  `json:"certificateRotationStatus,omitempty" norman:"nocreate,noupdate"`
+	MaintenanceStatus                    *MaintenanceStatus          `json:"maintenanceStatus,omitempty" norman:"nocreate,noupdate"`
+	DecommissionStatus                   *DecommissionStatus         `json:"decommissionStatus,omitempty" norman:"nocreate,noupdate"`
 }
 
 type ClusterComponentStatus struct {
@@ -333,6 +356,37 @@ type CertExpiration struct {
 	ExpirationDate string `json:"expirationDate,omitempty"`
 }
 
+// CertificateRotationConfig rotates the certificates of an RKE cluster before they expire
+type CertificateRotationConfig struct {
+	Enabled bool `json:"enabled,omitempty" norman:"default=false"`
+	// DaysBeforeExpiration is how early the certificates are rotated
+	DaysBeforeExpiration int  `json:"daysBeforeExpiration,omitempty" norman:"default=30,min=1"`
+	CACertificates       bool `json:"caCertificates,omitempty"`
+	// Window limits the rotation to the given times, rotations start as soon as the certificates are due without it
+	Window *CertificateRotationWindow `json:"window,omitempty"`
+	// MaxRetries is the number of failed rotations retried before giving up until the certificates change
+	MaxRetries int         `json:"maxRetries,omitempty" norman:"default=3,min=0"`
+	Recipients []Recipient `json:"recipients,omitempty"`
+}
+
+type CertificateRotationWindow struct {
+	// Cron expression for the start of the window
+	CronSchedule    string `json:"cronSchedule" norman:"required"`
+	DurationMinutes int    `json:"durationMinutes,omitempty" norman:"default=120,min=1"`
+}
+
+type CertificateRotationStatus struct {
+	// EarliestExpiration is the expiration date of the first certificate to expire
+	EarliestExpiration string `json:"earliestExpiration,omitempty"`
+	// NextRotation is when the controller plans to rotate the certificates
+	NextRotation    string `json:"nextRotation,omitempty"`
+	LastRotation    string `json:"lastRotation,omitempty"`
+	RotationStarted string `json:"rotationStarted,omitempty"`
+	FailedAttempts  int    `json:"failedAttempts,omitempty"`
+	LastFailure     string `json:"lastFailure,omitempty"`
+	LastFailureTime string `json:"lastFailureTime,omitempty"`
+}
+
 type SaveAsTemplateInput struct {
 	ClusterTemplateName         string `json:"clusterTemplateName,omitempty"`
 	ClusterTemplateRevisionName string `json:"clusterTemplateRevisionName,omitempty"`
@@ -0,0 +1,120 @@
+package v3
+
+import (
+	"github.com/rancher/norman/condition"
+	"github.com/rancher/norman/types"
+	rketypes "github.com/rancher/rke/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClusterUpgradePhase string
+
+const (
+	ClusterUpgradePlanConditionPreflightPassed      condition.Cond = "PreflightPassed"
+	ClusterUpgradePlanConditionSnapshotTaken        condition.Cond = "SnapshotTaken"
+	ClusterUpgradePlanConditionControlPlaneUpgraded condition.Cond = "ControlPlaneUpgraded"
+	ClusterUpgradePlanConditionWorkersUpgraded      condition.Cond = "WorkersUpgraded"
+	ClusterUpgradePlanConditionHealthy              condition.Cond = "Healthy"
+	ClusterUpgradePlanConditionRolledBack           condition.Cond = "RolledBack"
+
+	ClusterUpgradePhasePreflight    ClusterUpgradePhase = "preflight"
+	ClusterUpgradePhaseControlPlane ClusterUpgradePhase = "controlplane"
+	ClusterUpgradePhaseWorkers      ClusterUpgradePhase = "workers"
+	ClusterUpgradePhasePaused       ClusterUpgradePhase = "paused"
+	ClusterUpgradePhaseCompleted    ClusterUpgradePhase = "completed"
+	ClusterUpgradePhaseFailed       ClusterUpgradePhase = "failed"
+	ClusterUpgradePhaseRollingBack  ClusterUpgradePhase = "rollingback"
+	ClusterUpgradePhaseRolledBack   ClusterUpgradePhase = "rolledback"
+
+	ClusterUpgradePlanActionPause    = "pause"
+	ClusterUpgradePlanActionResume   = "resume"
+	ClusterUpgradePlanActionRollback = "rollback"
+
+	// ClusterUpgradeWorkerGateAnnotation is set on a cluster while an upgrade plan holds back the worker batches
+	ClusterUpgradeWorkerGateAnnotation = "upgradeplan.cattle.io/worker-gate"
+
+	PreflightCheckDeprecatedAPIs       = "deprecatedApis"
+	PreflightCheckPodDisruptionBudgets = "podDisruptionBudgets"
+	PreflightCheckNodePressure         = "nodePressure"
+	PreflightCheckEtcdBackup           = "etcdBackup"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterUpgradePlan struct {
+	types.Namespaced
+
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterUpgradePlanSpec   `json:"spec"`
+	Status ClusterUpgradePlanStatus `yaml:"status" json:"status,omitempty"`
+}
+
+func (c *ClusterUpgradePlan) ObjClusterName() string {
+	return c.Spec.ObjClusterName()
+}
+
+type ClusterUpgradePlanSpec struct {
+	DisplayName string `json:"displayName"`
+	ClusterName string `json:"clusterName" norman:"required,type=reference[cluster],noupdate"`
+	// RKE Kubernetes version to upgrade to
+	KubernetesVersion string `json:"kubernetesVersion" norman:"required,noupdate"`
+	// Upgrade strategy written to the cluster before the upgrade starts, the cluster's own strategy is used when empty
+	UpgradeStrategy *rketypes.NodeUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	PreflightChecks UpgradePreflightChecks        `json:"preflightChecks,omitempty"`
+	// Hold the upgrade at the next phase gate
+	Paused bool `json:"paused"`
+	// Restore the pre-upgrade snapshot as soon as a health check fails
+	AutoRollback bool `json:"autoRollback"`
+	// Restore the pre-upgrade snapshot and the previous Kubernetes version
+	Rollback bool `json:"rollback" norman:"nocreate"`
+}
+
+func (c *ClusterUpgradePlanSpec) ObjClusterName() string {
+	return c.ClusterName
+}
+
+type UpgradePreflightChecks struct {
+	SkipDeprecatedAPIs       bool `json:"skipDeprecatedApis"`
+	SkipPodDisruptionBudgets bool `json:"skipPodDisruptionBudgets"`
+	SkipNodePressure         bool `json:"skipNodePressure"`
+	// A completed etcd backup younger than this is reused as the pre-upgrade snapshot, otherwise a new one is taken
+	EtcdBackupMaxAgeMinutes int `json:"etcdBackupMaxAgeMinutes,omitempty" norman:"default=60,min=1"`
+}
+
+type UpgradePreflightResult struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+type ClusterUpgradePlanCondition struct {
+	// Type of condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime string `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	Message string `json:"message,omitempty"`
+}
+
+type ClusterUpgradePlanStatus struct {
+	Phase            ClusterUpgradePhase           `json:"phase,omitempty"`
+	Conditions       []ClusterUpgradePlanCondition `json:"conditions,omitempty"`
+	PreflightResults []UpgradePreflightResult      `json:"preflightResults,omitempty"`
+	// Kubernetes version the cluster ran before the upgrade, restored on rollback
+	PreviousKubernetesVersion string `json:"previousKubernetesVersion,omitempty"`
+	// etcd backup taken or selected before the upgrade, restored on rollback
+	SnapshotName  string `json:"snapshotName,omitempty" norman:"type=reference[etcdBackup]"`
+	UpgradedNodes int    `json:"upgradedNodes"`
+	TotalNodes    int    `json:"totalNodes"`
+	CompletedTime string `json:"completedTime,omitempty"`
+}
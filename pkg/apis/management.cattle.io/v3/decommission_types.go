@@ -0,0 +1,26 @@
+package v3
+
+import (
+	rketypes "github.com/rancher/rke/types"
+)
+
+// DecommissionConfig requests the decommission of a cluster: a final etcd backup, an export of its namespaced
+// resources but secrets and an export of its projects and role bindings are stored in the backup target, then the
+// cluster is deleted
+type DecommissionConfig struct {
+	// S3BackupConfig is the backup target of the artifacts, the etcd S3 backup target of the cluster is used when
+	// it is not set
+	S3BackupConfig *rketypes.S3BackupConfig `json:"s3BackupConfig,omitempty"`
+	// SkipExport deletes an imported cluster once its projects and role bindings are stored, without waiting for an
+	// export of its resources that may never succeed if the cluster can not be reached
+	SkipExport bool `json:"skipExport,omitempty"`
+}
+
+type DecommissionStatus struct {
+	StartedAt   string `json:"startedAt,omitempty"`
+	CompletedAt string `json:"completedAt,omitempty"`
+	// EtcdBackupName is the final etcd backup of the cluster, the snapshot is copied to the backup target
+	EtcdBackupName string `json:"etcdBackupName,omitempty"`
+	// Artifacts are the URLs of the objects stored in the backup target
+	Artifacts []string `json:"artifacts,omitempty"`
+}
@@ -58,6 +58,56 @@ type LoggingCommonField struct {
 	OutputFlushInterval int               `json:"outputFlushInterval,omitempty" norman:"default=60"`
 	OutputTags          map[string]string `json:"outputTags,omitempty"`
 	EnableJSONParsing   bool              `json:"enableJSONParsing,omitempty"`
+	// ParsingRules parse the log lines of the containers in order
+	ParsingRules   []LoggingParsingRule   `json:"parsingRules,omitempty"`
+	FieldTransform *LoggingFieldTransform `json:"fieldTransform,omitempty"`
+	// Selector and DebugSampling drop lines before they are sent, the dropped lines are counted in the status
+	Selector      *LoggingSelector      `json:"selector,omitempty"`
+	DebugSampling *LoggingDebugSampling `json:"debugSampling,omitempty"`
+}
+
+type LoggingParsingRule struct {
+	Name string `json:"name,omitempty" norman:"required"`
+	// Namespaces limit the rule to the containers of the namespaces, it applies to all of them when empty
+	Namespaces []string `json:"namespaces,omitempty"`
+	Format     string   `json:"format,omitempty" norman:"required,type=enum,options=json|regexp|keyValue"`
+	// Expression is a regular expression with named groups for the regexp format, the groups become fields
+	Expression string `json:"expression,omitempty"`
+	// KeyValueDelimiter separates the pairs, a space by default, and KeyValueSeparator the key from the value, = by
+	// default, of the keyValue format
+	KeyValueDelimiter string `json:"keyValueDelimiter,omitempty"`
+	KeyValueSeparator string `json:"keyValueSeparator,omitempty"`
+}
+
+// LoggingFieldTransform changes the fields of the records after they are parsed, the fields are only renamed and
+// redacted in the records that have them
+type LoggingFieldTransform struct {
+	RenameFields map[string]string `json:"renameFields,omitempty"`
+	DropFields   []string          `json:"dropFields,omitempty"`
+	RedactFields []string          `json:"redactFields,omitempty"`
+}
+
+// LoggingSelector chooses the containers whose lines are sent. Workloads are namespace:name, the pods of a workload
+// are matched by their generated names. A container is sent when its namespace or its workload is included, all of
+// them are when none is, and neither is excluded.
+type LoggingSelector struct {
+	IncludeNamespaces []string `json:"includeNamespaces,omitempty"`
+	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
+	IncludeWorkloads  []string `json:"includeWorkloads,omitempty"`
+	ExcludeWorkloads  []string `json:"excludeWorkloads,omitempty"`
+}
+
+// LoggingDebugSampling keeps a percentage of the debug lines, which are the lines whose level field is debug or that
+// mention debug when they don't have the field
+type LoggingDebugSampling struct {
+	KeepPercentage int    `json:"keepPercentage,omitempty" norman:"default=10,min=0,max=100"`
+	LevelField     string `json:"levelField,omitempty" norman:"default=level"`
+}
+
+// LoggingDroppedLines count the lines dropped since the fluentd pods started
+type LoggingDroppedLines struct {
+	Excluded int64 `json:"excluded,omitempty"`
+	Sampled  int64 `json:"sampled,omitempty"`
 }
 
 type LoggingTargets struct {
@@ -74,6 +124,26 @@ type ClusterLoggingSpec struct {
 	LoggingCommonField
 	ClusterName            string `json:"clusterName" norman:"type=reference[cluster]"`
 	IncludeSystemComponent *bool  `json:"includeSystemComponent,omitempty" norman:"default=true"`
+	// AuditLogSources ship audit logs with the container logs, they aren't selected or sampled. The
+	// custom target can't ship them, and the AuditLogShipped condition reports the ones fluentd doesn't tail.
+	AuditLogSources *LoggingAuditLogSources `json:"auditLogSources,omitempty"`
+}
+
+type LoggingAuditLogSources struct {
+	// KubeAPIServer is the audit log RKE configures for kube-apiserver on the controlplane nodes
+	KubeAPIServer *LoggingAuditLogSource `json:"kubeApiServer,omitempty"`
+	// Rancher is the audit log the Rancher server pods print from their audit log sidecar, it is only in the local
+	// cluster
+	Rancher *LoggingAuditLogSource `json:"rancher,omitempty"`
+}
+
+// LoggingAuditLogSource is an audit log of JSON lines, its records are parsed into structured fields
+type LoggingAuditLogSource struct {
+	// Path is the audit log file on the nodes, the default path of the source when empty
+	Path string `json:"path,omitempty"`
+	// Index sends the records to their own index of Elasticsearch and Splunk, topic of Kafka or program of syslog
+	// instead of the ones of the container logs
+	Index string `json:"index,omitempty"`
 }
 
 func (c *ClusterLoggingSpec) ObjClusterName() string {
@@ -94,19 +164,24 @@ func (p *ProjectLoggingSpec) ObjClusterName() string {
 }
 
 type ClusterLoggingStatus struct {
-	Conditions  []LoggingCondition  `json:"conditions,omitempty"`
-	AppliedSpec ClusterLoggingSpec  `json:"appliedSpec,omitempty"`
-	FailedSpec  *ClusterLoggingSpec `json:"failedSpec,omitempty"`
+	Conditions   []LoggingCondition   `json:"conditions,omitempty"`
+	AppliedSpec  ClusterLoggingSpec   `json:"appliedSpec,omitempty"`
+	FailedSpec   *ClusterLoggingSpec  `json:"failedSpec,omitempty"`
+	DroppedLines *LoggingDroppedLines `json:"droppedLines,omitempty"`
 }
 
 type ProjectLoggingStatus struct {
-	Conditions  []LoggingCondition `json:"conditions,omitempty"`
-	AppliedSpec ProjectLoggingSpec `json:"appliedSpec,omitempty"`
+	Conditions   []LoggingCondition   `json:"conditions,omitempty"`
+	AppliedSpec  ProjectLoggingSpec   `json:"appliedSpec,omitempty"`
+	DroppedLines *LoggingDroppedLines `json:"droppedLines,omitempty"`
 }
 
 var (
 	LoggingConditionProvisioned condition.Cond = "Provisioned"
 	LoggingConditionUpdated     condition.Cond = "Updated"
+	// LoggingConditionAuditLogShipped is false when fluentd doesn't tail an audit log of the cluster logging on the
+	// nodes that have it, the file is missing or fluentd doesn't run there
+	LoggingConditionAuditLogShipped condition.Cond = "AuditLogShipped"
 )
 
 type LoggingCondition struct {
@@ -208,6 +283,7 @@ type ClusterTestInput struct {
 	ClusterName string `json:"clusterId" norman:"required,type=reference[cluster]"`
 	LoggingTargets
 	OutputTags map[string]string `json:"outputTags,omitempty"`
+	LoggingParsingTestInput
 }
 
 func (c *ClusterTestInput) ObjClusterName() string {
@@ -218,6 +294,26 @@ type ProjectTestInput struct {
 	ProjectName string `json:"projectId" norman:"required,type=reference[project]"`
 	LoggingTargets
 	OutputTags map[string]string `json:"outputTags,omitempty"`
+	LoggingParsingTestInput
+}
+
+// LoggingParsingTestInput are parsing rules and the sample lines to test them against
+type LoggingParsingTestInput struct {
+	ParsingRules   []LoggingParsingRule   `json:"parsingRules,omitempty"`
+	FieldTransform *LoggingFieldTransform `json:"fieldTransform,omitempty"`
+	// SampleLines are lines written by a container, SampleNamespace is its namespace
+	SampleLines     []string `json:"sampleLines,omitempty"`
+	SampleNamespace string   `json:"sampleNamespace,omitempty"`
+}
+
+type LoggingTestOutput struct {
+	Records []LoggingTestRecord `json:"records,omitempty"`
+}
+
+type LoggingTestRecord struct {
+	// Fields are the fields of the record, the values that aren't strings are JSON encoded
+	Fields map[string]string `json:"fields,omitempty"`
+	Errors []string          `json:"errors,omitempty"`
 }
 
 func (p *ProjectTestInput) ObjClusterName() string {
@@ -100,6 +100,15 @@ type NodeStatus struct {
 	DockerInfo         *DockerInfo             `json:"dockerInfo,omitempty"`
 	NodePlan           *NodePlan               `json:"nodePlan,omitempty"`
 	AppliedNodeVersion int                     `json:"appliedNodeVersion,omitempty"`
+	// Remediations are the latest actions the node pool health check took on this node, oldest first
+	Remediations []NodeRemediation `json:"remediations,omitempty"`
+}
+
+type NodeRemediation struct {
+	Time      string `json:"time"`
+	Condition string `json:"condition"`
+	Action    string `json:"action" norman:"type=enum,options=reboot|replace|alert"`
+	Message   string `json:"message,omitempty"`
 }
 
 type DockerInfo struct {
@@ -153,7 +162,25 @@ type NodeCondition struct {
 }
 
 var (
-	NodePoolConditionUpdated condition.Cond = "Updated"
+	NodePoolConditionUpdated   condition.Cond = "Updated"
+	NodePoolConditionRolledOut condition.Cond = "RolledOut"
+	// NodePoolConditionRemediationAllowed is false while more nodes are unhealthy than the health check tolerates
+	NodePoolConditionRemediationAllowed condition.Cond = "RemediationAllowed"
+)
+
+const (
+	RemediationReboot  = "reboot"
+	RemediationReplace = "replace"
+	RemediationAlert   = "alert"
+
+	// UnhealthyConditionKubeletStopped matches nodes whose kubelet stopped posting status
+	UnhealthyConditionKubeletStopped = "KubeletStopped"
+	// UnhealthyConditionDockerUnhealthy matches nodes the kubelet reports as not ready because of the container runtime
+	UnhealthyConditionDockerUnhealthy = "DockerUnhealthy"
+
+	// NodeRebootAnnotation asks the node controller to reboot the machine through its node driver, the value is
+	// when the reboot was requested
+	NodeRebootAnnotation = "nodepool.cattle.io/reboot"
 )
 
 // +genclient
@@ -191,14 +218,68 @@ type NodePoolSpec struct {
 	ClusterName string `json:"clusterName,omitempty" norman:"type=reference[cluster],noupdate,required"`
 
 	DeleteNotReadyAfterSecs time.Duration `json:"deleteNotReadyAfterSecs" norman:"default=0,max=31540000,min=0"`
+
+	// MinQuantity and MaxQuantity bound the quantity the autoscaler can set, it is enabled when MaxQuantity is set
+	MinQuantity int `json:"minQuantity,omitempty" norman:"min=0"`
+	MaxQuantity int `json:"maxQuantity,omitempty" norman:"min=0"`
+
+	// RolloutStrategy replaces the nodes of the pool when its node template changes, existing nodes are left alone
+	// when it is not set
+	RolloutStrategy *NodePoolRolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// HealthCheck remediates nodes of the pool that stay unhealthy
+	HealthCheck *MachineHealthCheck `json:"healthCheck,omitempty"`
+}
+
+type MachineHealthCheck struct {
+	// Conditions that make a node unhealthy once they last longer than their timeout, Ready being False or
+	// Unknown for 5 minutes when empty
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty"`
+	// Number or percentage of unhealthy nodes above which no node is remediated
+	MaxUnhealthy string `json:"maxUnhealthy,omitempty" norman:"default=40%"`
+	Remediation  string `json:"remediation,omitempty" norman:"type=enum,options=reboot|replace|alert,default=replace"`
+}
+
+type UnhealthyCondition struct {
+	// Type is a node condition type such as Ready or DiskPressure, or one of KubeletStopped and DockerUnhealthy
+	Type           string             `json:"type" norman:"required"`
+	Status         v1.ConditionStatus `json:"status,omitempty"`
+	TimeoutSeconds int                `json:"timeoutSeconds" norman:"required,min=1"`
+}
+
+type NodePoolRolloutStrategy struct {
+	// Number or percentage of nodes that can be created above the pool quantity during a rollout
+	MaxSurge string `json:"maxSurge,omitempty" norman:"default=1"`
+	// Number or percentage of nodes that can be unavailable during a rollout
+	MaxUnavailable string `json:"maxUnavailable,omitempty" norman:"default=0"`
+	// Drain options used before deleting a replaced node
+	NodeDrainInput *NodeDrainInput `json:"nodeDrainInput,omitempty"`
 }
 
 func (n *NodePoolSpec) ObjClusterName() string {
 	return n.ClusterName
 }
 
+func (n *NodePoolSpec) AutoscalingEnabled() bool {
+	return n.MaxQuantity > 0
+}
+
 type NodePoolStatus struct {
 	Conditions []Condition `json:"conditions"`
+	// LastScaleTime is when the autoscaler last changed the quantity of the pool
+	LastScaleTime string `json:"lastScaleTime,omitempty"`
+	// Rollout is the progress of replacing nodes created from an older node template revision
+	Rollout *NodePoolRolloutStatus `json:"rollout,omitempty"`
+}
+
+type NodePoolRolloutStatus struct {
+	TemplateRevision      string `json:"templateRevision,omitempty"`
+	UpdatedNodes          int    `json:"updatedNodes"`
+	AvailableUpdatedNodes int    `json:"availableUpdatedNodes"`
+	OldNodes              int    `json:"oldNodes"`
+	StartedAt             string `json:"startedAt,omitempty"`
+	CompletedAt           string `json:"completedAt,omitempty"`
+	Message               string `json:"message,omitempty"`
 }
 
 type CustomConfig struct {
@@ -0,0 +1,44 @@
+package v3
+
+const (
+	MaintenanceActionEtcdBackup          = "etcdBackup"
+	MaintenanceActionRKEUpdate           = "rkeUpdate"
+	MaintenanceActionSystemImageUpgrade  = "systemImageUpgrade"
+	MaintenanceActionK3sBasedUpgrade     = "k3sBasedUpgrade"
+	MaintenanceActionCertificateRotation = "certificateRotation"
+)
+
+// MaintenanceWindow limits when controllers run disruptive operations on a cluster, operations that come due outside
+// of the windows wait for the next one
+type MaintenanceWindow struct {
+	// Windows are the times disruptive operations can start, they can start at any time outside of blackouts without any
+	Windows []MaintenanceWindowSchedule `json:"windows,omitempty"`
+	// Blackouts are periods where no disruptive operation starts, even inside a window
+	Blackouts []MaintenanceBlackout `json:"blackouts,omitempty"`
+	// OverrideUntil lets disruptive operations start at any time until the given RFC3339 date, for emergencies
+	OverrideUntil string `json:"overrideUntil,omitempty"`
+}
+
+type MaintenanceWindowSchedule struct {
+	// Cron expression for the start of the window, in UTC
+	CronSchedule    string `json:"cronSchedule" norman:"required"`
+	DurationMinutes int    `json:"durationMinutes,omitempty" norman:"default=120,min=1"`
+}
+
+type MaintenanceBlackout struct {
+	// Start and End are RFC3339 dates
+	Start  string `json:"start" norman:"required"`
+	End    string `json:"end" norman:"required"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type MaintenanceStatus struct {
+	// PlannedActions are the operations waiting for the window, earliest first
+	PlannedActions []PlannedMaintenanceAction `json:"plannedActions,omitempty"`
+}
+
+type PlannedMaintenanceAction struct {
+	Action      string `json:"action"`
+	Message     string `json:"message,omitempty"`
+	PlannedTime string `json:"plannedTime,omitempty"`
+}
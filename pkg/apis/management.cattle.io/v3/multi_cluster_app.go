@@ -35,11 +35,14 @@ type MultiClusterAppSpec struct {
 	Answers              []Answer        `json:"answers,omitempty"`
 	Wait                 bool            `json:"wait,omitempty"`
 	Timeout              int             `json:"timeout,omitempty" norman:"min=1,default=300"`
-	Targets              []Target        `json:"targets,omitempty" norman:"required,noupdate"`
+	Targets              []Target        `json:"targets,omitempty" norman:"noupdate"`
 	Members              []Member        `json:"members,omitempty"`
 	Roles                []string        `json:"roles,omitempty" norman:"type=array[reference[roleTemplate]],required"`
 	RevisionHistoryLimit int             `json:"revisionHistoryLimit,omitempty" norman:"default=10"`
 	UpgradeStrategy      UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
+	// ClusterGroupTargets add a target for a project of every member of a cluster group
+	ClusterGroupTargets []ClusterGroupTarget `json:"clusterGroupTargets,omitempty"`
 }
 
 type MultiClusterAppStatus struct {
@@ -53,6 +56,14 @@ type Target struct {
 	AppName     string `json:"appName,omitempty" norman:"type=reference[v3/projects/schemas/app]"`
 	State       string `json:"state,omitempty"`
 	Healthstate string `json:"healthState,omitempty"`
+	// ClusterGroupName is set on the targets added for the members of a cluster group
+	ClusterGroupName string `json:"clusterGroupName,omitempty" norman:"type=reference[clusterGroup],nocreate,noupdate"`
+}
+
+type ClusterGroupTarget struct {
+	ClusterGroupName string `json:"clusterGroupName" norman:"type=reference[clusterGroup],required"`
+	// ProjectDisplayName is the project of each member the app is deployed in
+	ProjectDisplayName string `json:"projectDisplayName,omitempty" norman:"default=Default"`
 }
 
 func (t *Target) ObjClusterName() string {
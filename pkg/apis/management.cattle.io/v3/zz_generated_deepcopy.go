@@ -246,6 +246,169 @@ func (in *AlertCommonSpec) DeepCopy() *AlertCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertEscalation) DeepCopyInto(out *AlertEscalation) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertEscalation.
+func (in *AlertEscalation) DeepCopy() *AlertEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertIncidentStatus) DeepCopyInto(out *AlertIncidentStatus) {
+	*out = *in
+	if in.NotifierNames != nil {
+		in, out := &in.NotifierNames, &out.NotifierNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = make([]IncidentEvent, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertIncidentStatus.
+func (in *AlertIncidentStatus) DeepCopy() *AlertIncidentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertIncidentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertIncidentSummaryInput) DeepCopyInto(out *AlertIncidentSummaryInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertIncidentSummaryInput.
+func (in *AlertIncidentSummaryInput) DeepCopy() *AlertIncidentSummaryInput {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertIncidentSummaryInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertIncidentSummaryOutput) DeepCopyInto(out *AlertIncidentSummaryOutput) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AlertRuleSummary, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertIncidentSummaryOutput.
+func (in *AlertIncidentSummaryOutput) DeepCopy() *AlertIncidentSummaryOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertIncidentSummaryOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRoute) DeepCopyInto(out *AlertRoute) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchRegex != nil {
+		in, out := &in.MatchRegex, &out.MatchRegex
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
+		copy(*out, *in)
+	}
+	if in.Escalations != nil {
+		in, out := &in.Escalations, &out.Escalations
+		*out = make([]AlertEscalation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoute.
+func (in *AlertRoute) DeepCopy() *AlertRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleSummary) DeepCopyInto(out *AlertRuleSummary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleSummary.
+func (in *AlertRuleSummary) DeepCopy() *AlertRuleSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceStatus) DeepCopyInto(out *AlertSilenceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceStatus.
+func (in *AlertSilenceStatus) DeepCopy() *AlertSilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
 	*out = *in
@@ -680,6 +843,80 @@ func (in *Capabilities) DeepCopy() *Capabilities {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecast) DeepCopyInto(out *CapacityForecast) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecast.
+func (in *CapacityForecast) DeepCopy() *CapacityForecast {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityHistoryInput) DeepCopyInto(out *CapacityHistoryInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityHistoryInput.
+func (in *CapacityHistoryInput) DeepCopy() *CapacityHistoryInput {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityHistoryInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityHistoryOutput) DeepCopyInto(out *CapacityHistoryOutput) {
+	*out = *in
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]CapacitySample, len(*in))
+		copy(*out, *in)
+	}
+	if in.Forecast != nil {
+		in, out := &in.Forecast, &out.Forecast
+		*out = new(CapacityForecast)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityHistoryOutput.
+func (in *CapacityHistoryOutput) DeepCopy() *CapacityHistoryOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityHistoryOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacitySample) DeepCopyInto(out *CapacitySample) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacitySample.
+func (in *CapacitySample) DeepCopy() *CapacitySample {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacitySample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Catalog) DeepCopyInto(out *Catalog) {
 	*out = *in
@@ -960,6 +1197,80 @@ func (in *CertExpiration) DeepCopy() *CertExpiration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotationConfig) DeepCopyInto(out *CertificateRotationConfig) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(CertificateRotationWindow)
+		**out = **in
+	}
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotationConfig.
+func (in *CertificateRotationConfig) DeepCopy() *CertificateRotationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotationStatus) DeepCopyInto(out *CertificateRotationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotationStatus.
+func (in *CertificateRotationStatus) DeepCopy() *CertificateRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotationWindow) DeepCopyInto(out *CertificateRotationWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotationWindow.
+func (in *CertificateRotationWindow) DeepCopy() *CertificateRotationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRule) DeepCopyInto(out *CertificateRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRule.
+func (in *CertificateRule) DeepCopy() *CertificateRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChangePasswordInput) DeepCopyInto(out *ChangePasswordInput) {
 	*out = *in
@@ -1353,32 +1664,28 @@ func (in *ClusterAlertGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
+func (in *ClusterAlertIncident) DeepCopyInto(out *ClusterAlertIncident) {
 	*out = *in
+	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterAlert, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertList.
-func (in *ClusterAlertList) DeepCopy() *ClusterAlertList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertIncident.
+func (in *ClusterAlertIncident) DeepCopy() *ClusterAlertIncident {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAlertList)
+	out := new(ClusterAlertIncident)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
+func (in *ClusterAlertIncident) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1386,13 +1693,96 @@ func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAlertRule) DeepCopyInto(out *ClusterAlertRule) {
+func (in *ClusterAlertIncidentList) DeepCopyInto(out *ClusterAlertIncidentList) {
 	*out = *in
-	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlertIncident, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertIncidentList.
+func (in *ClusterAlertIncidentList) DeepCopy() *ClusterAlertIncidentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertIncidentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertIncidentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertIncidentSpec) DeepCopyInto(out *ClusterAlertIncidentSpec) {
+	*out = *in
+	in.CommonIncidentField.DeepCopyInto(&out.CommonIncidentField)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertIncidentSpec.
+func (in *ClusterAlertIncidentSpec) DeepCopy() *ClusterAlertIncidentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertIncidentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertList.
+func (in *ClusterAlertList) DeepCopy() *ClusterAlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertRule) DeepCopyInto(out *ClusterAlertRule) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
@@ -1489,6 +1879,85 @@ func (in *ClusterAlertRuleSpec) DeepCopy() *ClusterAlertRuleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertSilence) DeepCopyInto(out *ClusterAlertSilence) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertSilence.
+func (in *ClusterAlertSilence) DeepCopy() *ClusterAlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertSilence) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertSilenceList) DeepCopyInto(out *ClusterAlertSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertSilenceList.
+func (in *ClusterAlertSilenceList) DeepCopy() *ClusterAlertSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertSilenceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertSilenceSpec) DeepCopyInto(out *ClusterAlertSilenceSpec) {
+	*out = *in
+	in.CommonSilenceField.DeepCopyInto(&out.CommonSilenceField)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertSilenceSpec.
+func (in *ClusterAlertSilenceSpec) DeepCopy() *ClusterAlertSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAlertSpec) DeepCopyInto(out *ClusterAlertSpec) {
 	*out = *in
@@ -1618,35 +2087,78 @@ func (in *ClusterCondition) DeepCopy() *ClusterCondition {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterGroupSpec) DeepCopyInto(out *ClusterGroupSpec) {
+func (in *ClusterGroup) DeepCopyInto(out *ClusterGroup) {
 	*out = *in
-	if in.Recipients != nil {
-		in, out := &in.Recipients, &out.Recipients
-		*out = make([]Recipient, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroup.
+func (in *ClusterGroup) DeepCopy() *ClusterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupActionOutput) DeepCopyInto(out *ClusterGroupActionOutput) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterGroupActionResult, len(*in))
 		copy(*out, *in)
 	}
-	out.CommonGroupField = in.CommonGroupField
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupSpec.
-func (in *ClusterGroupSpec) DeepCopy() *ClusterGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupActionOutput.
+func (in *ClusterGroupActionOutput) DeepCopy() *ClusterGroupActionOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterGroupSpec)
+	out := new(ClusterGroupActionOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *ClusterGroupActionResult) DeepCopyInto(out *ClusterGroupActionResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupActionResult.
+func (in *ClusterGroupActionResult) DeepCopy() *ClusterGroupActionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupActionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupList) DeepCopyInto(out *ClusterGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
+		*out = make([]ClusterGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1654,18 +2166,18 @@ func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupList.
+func (in *ClusterGroupList) DeepCopy() *ClusterGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(ClusterGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
+func (in *ClusterGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1673,28 +2185,25 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterLogging) DeepCopyInto(out *ClusterLogging) {
+func (in *ClusterGroupRoleTemplateBinding) DeepCopyInto(out *ClusterGroupRoleTemplateBinding) {
 	*out = *in
-	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLogging.
-func (in *ClusterLogging) DeepCopy() *ClusterLogging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupRoleTemplateBinding.
+func (in *ClusterGroupRoleTemplateBinding) DeepCopy() *ClusterGroupRoleTemplateBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterLogging)
+	out := new(ClusterGroupRoleTemplateBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterLogging) DeepCopyObject() runtime.Object {
+func (in *ClusterGroupRoleTemplateBinding) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1702,13 +2211,13 @@ func (in *ClusterLogging) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterLoggingList) DeepCopyInto(out *ClusterLoggingList) {
+func (in *ClusterGroupRoleTemplateBindingList) DeepCopyInto(out *ClusterGroupRoleTemplateBindingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterLogging, len(*in))
+		*out = make([]ClusterGroupRoleTemplateBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1716,18 +2225,18 @@ func (in *ClusterLoggingList) DeepCopyInto(out *ClusterLoggingList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingList.
-func (in *ClusterLoggingList) DeepCopy() *ClusterLoggingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupRoleTemplateBindingList.
+func (in *ClusterGroupRoleTemplateBindingList) DeepCopy() *ClusterGroupRoleTemplateBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterLoggingList)
+	out := new(ClusterGroupRoleTemplateBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterLoggingList) DeepCopyObject() runtime.Object {
+func (in *ClusterGroupRoleTemplateBindingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1735,101 +2244,297 @@ func (in *ClusterLoggingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterLoggingSpec) DeepCopyInto(out *ClusterLoggingSpec) {
+func (in *ClusterGroupSelectorSpec) DeepCopyInto(out *ClusterGroupSelectorSpec) {
 	*out = *in
-	in.LoggingTargets.DeepCopyInto(&out.LoggingTargets)
-	in.LoggingCommonField.DeepCopyInto(&out.LoggingCommonField)
-	if in.IncludeSystemComponent != nil {
-		in, out := &in.IncludeSystemComponent, &out.IncludeSystemComponent
-		*out = new(bool)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingSpec.
-func (in *ClusterLoggingSpec) DeepCopy() *ClusterLoggingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupSelectorSpec.
+func (in *ClusterGroupSelectorSpec) DeepCopy() *ClusterGroupSelectorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterLoggingSpec)
+	out := new(ClusterGroupSelectorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterLoggingStatus) DeepCopyInto(out *ClusterLoggingStatus) {
+func (in *ClusterGroupSpec) DeepCopyInto(out *ClusterGroupSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]LoggingCondition, len(*in))
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
 		copy(*out, *in)
 	}
-	in.AppliedSpec.DeepCopyInto(&out.AppliedSpec)
-	if in.FailedSpec != nil {
-		in, out := &in.FailedSpec, &out.FailedSpec
-		*out = new(ClusterLoggingSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	in.CommonGroupField.DeepCopyInto(&out.CommonGroupField)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingStatus.
-func (in *ClusterLoggingStatus) DeepCopy() *ClusterLoggingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupSpec.
+func (in *ClusterGroupSpec) DeepCopy() *ClusterGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterLoggingStatus)
+	out := new(ClusterGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMetricNamesInput) DeepCopyInto(out *ClusterMetricNamesInput) {
+func (in *ClusterGroupStatus) DeepCopyInto(out *ClusterGroupStatus) {
 	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMetricNamesInput.
-func (in *ClusterMetricNamesInput) DeepCopy() *ClusterMetricNamesInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupStatus.
+func (in *ClusterGroupStatus) DeepCopy() *ClusterGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterMetricNamesInput)
+	out := new(ClusterGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMonitorGraph) DeepCopyInto(out *ClusterMonitorGraph) {
+func (in *ClusterGroupTarget) DeepCopyInto(out *ClusterGroupTarget) {
 	*out = *in
-	out.Namespaced = in.Namespaced
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMonitorGraph.
-func (in *ClusterMonitorGraph) DeepCopy() *ClusterMonitorGraph {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupTarget.
+func (in *ClusterGroupTarget) DeepCopy() *ClusterGroupTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterMonitorGraph)
+	out := new(ClusterGroupTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterMonitorGraph) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupUpgradeInput) DeepCopyInto(out *ClusterGroupUpgradeInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupUpgradeInput.
+func (in *ClusterGroupUpgradeInput) DeepCopy() *ClusterGroupUpgradeInput {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ClusterGroupUpgradeInput)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMonitorGraphList) DeepCopyInto(out *ClusterMonitorGraphList) {
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLogging) DeepCopyInto(out *ClusterLogging) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLogging.
+func (in *ClusterLogging) DeepCopy() *ClusterLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterLogging) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLoggingList) DeepCopyInto(out *ClusterLoggingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterLogging, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingList.
+func (in *ClusterLoggingList) DeepCopy() *ClusterLoggingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLoggingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterLoggingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLoggingSpec) DeepCopyInto(out *ClusterLoggingSpec) {
+	*out = *in
+	in.LoggingTargets.DeepCopyInto(&out.LoggingTargets)
+	in.LoggingCommonField.DeepCopyInto(&out.LoggingCommonField)
+	if in.IncludeSystemComponent != nil {
+		in, out := &in.IncludeSystemComponent, &out.IncludeSystemComponent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuditLogSources != nil {
+		in, out := &in.AuditLogSources, &out.AuditLogSources
+		*out = new(LoggingAuditLogSources)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingSpec.
+func (in *ClusterLoggingSpec) DeepCopy() *ClusterLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLoggingStatus) DeepCopyInto(out *ClusterLoggingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]LoggingCondition, len(*in))
+		copy(*out, *in)
+	}
+	in.AppliedSpec.DeepCopyInto(&out.AppliedSpec)
+	if in.FailedSpec != nil {
+		in, out := &in.FailedSpec, &out.FailedSpec
+		*out = new(ClusterLoggingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DroppedLines != nil {
+		in, out := &in.DroppedLines, &out.DroppedLines
+		*out = new(LoggingDroppedLines)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLoggingStatus.
+func (in *ClusterLoggingStatus) DeepCopy() *ClusterLoggingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLoggingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMetricNamesInput) DeepCopyInto(out *ClusterMetricNamesInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMetricNamesInput.
+func (in *ClusterMetricNamesInput) DeepCopy() *ClusterMetricNamesInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMetricNamesInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMonitorGraph) DeepCopyInto(out *ClusterMonitorGraph) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMonitorGraph.
+func (in *ClusterMonitorGraph) DeepCopy() *ClusterMonitorGraph {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMonitorGraph)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMonitorGraph) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMonitorGraphList) DeepCopyInto(out *ClusterMonitorGraphList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
@@ -2238,6 +2943,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Decommission != nil {
+		in, out := &in.Decommission, &out.Decommission
+		*out = new(DecommissionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2270,6 +2980,16 @@ func (in *ClusterSpecBase) DeepCopyInto(out *ClusterSpecBase) {
 		*out = new(ScheduledClusterScan)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertificateRotation != nil {
+		in, out := &in.CertificateRotation, &out.CertificateRotation
+		*out = new(CertificateRotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2363,6 +3083,21 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		**out = **in
 	}
 	in.EKSStatus.DeepCopyInto(&out.EKSStatus)
+	if in.CertificateRotationStatus != nil {
+		in, out := &in.CertificateRotationStatus, &out.CertificateRotationStatus
+		*out = new(CertificateRotationStatus)
+		**out = **in
+	}
+	if in.MaintenanceStatus != nil {
+		in, out := &in.MaintenanceStatus, &out.MaintenanceStatus
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DecommissionStatus != nil {
+		in, out := &in.DecommissionStatus, &out.DecommissionStatus
+		*out = new(DecommissionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2405,84 +3140,196 @@ func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+func (in *ClusterTemplateConstraint) DeepCopyInto(out *ClusterTemplateConstraint) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ClusterTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int64)
+		**out = **in
+	}
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = new(ClusterTemplateConstraintCondition)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
-func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateConstraint.
+func (in *ClusterTemplateConstraint) DeepCopy() *ClusterTemplateConstraint {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTemplateList)
+	out := new(ClusterTemplateConstraint)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTemplateQuestionsOutput) DeepCopyInto(out *ClusterTemplateQuestionsOutput) {
+func (in *ClusterTemplateConstraintCondition) DeepCopyInto(out *ClusterTemplateConstraintCondition) {
 	*out = *in
-	if in.Questions != nil {
-		in, out := &in.Questions, &out.Questions
-		*out = make([]Question, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateQuestionsOutput.
-func (in *ClusterTemplateQuestionsOutput) DeepCopy() *ClusterTemplateQuestionsOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateConstraintCondition.
+func (in *ClusterTemplateConstraintCondition) DeepCopy() *ClusterTemplateConstraintCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTemplateQuestionsOutput)
+	out := new(ClusterTemplateConstraintCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTemplateRevision) DeepCopyInto(out *ClusterTemplateRevision) {
+func (in *ClusterTemplateDifference) DeepCopyInto(out *ClusterTemplateDifference) {
 	*out = *in
-	out.Namespaced = in.Namespaced
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRevision.
-func (in *ClusterTemplateRevision) DeepCopy() *ClusterTemplateRevision {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateDifference.
+func (in *ClusterTemplateDifference) DeepCopy() *ClusterTemplateDifference {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTemplateRevision)
+	out := new(ClusterTemplateDifference)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterTemplateRevision) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateDrift) DeepCopyInto(out *ClusterTemplateDrift) {
+	*out = *in
+	if in.Differences != nil {
+		in, out := &in.Differences, &out.Differences
+		*out = make([]ClusterTemplateDifference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateDrift.
+func (in *ClusterTemplateDrift) DeepCopy() *ClusterTemplateDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateDriftReport) DeepCopyInto(out *ClusterTemplateDriftReport) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterTemplateDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateDriftReport.
+func (in *ClusterTemplateDriftReport) DeepCopy() *ClusterTemplateDriftReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateDriftReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateQuestionsOutput) DeepCopyInto(out *ClusterTemplateQuestionsOutput) {
+	*out = *in
+	if in.Questions != nil {
+		in, out := &in.Questions, &out.Questions
+		*out = make([]Question, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateQuestionsOutput.
+func (in *ClusterTemplateQuestionsOutput) DeepCopy() *ClusterTemplateQuestionsOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateQuestionsOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateRevision) DeepCopyInto(out *ClusterTemplateRevision) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRevision.
+func (in *ClusterTemplateRevision) DeepCopy() *ClusterTemplateRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
@@ -2541,6 +3388,13 @@ func (in *ClusterTemplateRevisionSpec) DeepCopyInto(out *ClusterTemplateRevision
 		*out = new(ClusterSpecBase)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]ClusterTemplateConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2555,190 +3409,215 @@ func (in *ClusterTemplateRevisionSpec) DeepCopy() *ClusterTemplateRevisionSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+func (in *ClusterTemplateRollout) DeepCopyInto(out *ClusterTemplateRollout) {
 	*out = *in
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]Member, len(*in))
-		copy(*out, *in)
-	}
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
-func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRollout.
+func (in *ClusterTemplateRollout) DeepCopy() *ClusterTemplateRollout {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTemplateSpec)
+	out := new(ClusterTemplateRollout)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterTestInput) DeepCopyInto(out *ClusterTestInput) {
+func (in *ClusterTemplateRolloutClusterStatus) DeepCopyInto(out *ClusterTemplateRolloutClusterStatus) {
 	*out = *in
-	in.LoggingTargets.DeepCopyInto(&out.LoggingTargets)
-	if in.OutputTags != nil {
-		in, out := &in.OutputTags, &out.OutputTags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTestInput.
-func (in *ClusterTestInput) DeepCopy() *ClusterTestInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutClusterStatus.
+func (in *ClusterTemplateRolloutClusterStatus) DeepCopy() *ClusterTemplateRolloutClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterTestInput)
+	out := new(ClusterTemplateRolloutClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterUpgradeStrategy) DeepCopyInto(out *ClusterUpgradeStrategy) {
+func (in *ClusterTemplateRolloutCondition) DeepCopyInto(out *ClusterTemplateRolloutCondition) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeStrategy.
-func (in *ClusterUpgradeStrategy) DeepCopy() *ClusterUpgradeStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutCondition.
+func (in *ClusterTemplateRolloutCondition) DeepCopy() *ClusterTemplateRolloutCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterUpgradeStrategy)
+	out := new(ClusterTemplateRolloutCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonGroupField) DeepCopyInto(out *CommonGroupField) {
+func (in *ClusterTemplateRolloutList) DeepCopyInto(out *ClusterTemplateRolloutList) {
 	*out = *in
-	out.TimingField = in.TimingField
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplateRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonGroupField.
-func (in *CommonGroupField) DeepCopy() *CommonGroupField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutList.
+func (in *ClusterTemplateRolloutList) DeepCopy() *ClusterTemplateRolloutList {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonGroupField)
+	out := new(ClusterTemplateRolloutList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonMonitorGraphSpec) DeepCopyInto(out *CommonMonitorGraphSpec) {
+func (in *ClusterTemplateRolloutSpec) DeepCopyInto(out *ClusterTemplateRolloutSpec) {
 	*out = *in
-	if in.MetricsSelector != nil {
-		in, out := &in.MetricsSelector, &out.MetricsSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.DetailsMetricsSelector != nil {
-		in, out := &in.DetailsMetricsSelector, &out.DetailsMetricsSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.ClusterNames != nil {
+		in, out := &in.ClusterNames, &out.ClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out.YAxis = in.YAxis
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonMonitorGraphSpec.
-func (in *CommonMonitorGraphSpec) DeepCopy() *CommonMonitorGraphSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutSpec.
+func (in *ClusterTemplateRolloutSpec) DeepCopy() *ClusterTemplateRolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonMonitorGraphSpec)
+	out := new(ClusterTemplateRolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonQueryMetricInput) DeepCopyInto(out *CommonQueryMetricInput) {
+func (in *ClusterTemplateRolloutStatus) DeepCopyInto(out *ClusterTemplateRolloutStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ClusterTemplateRolloutCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterTemplateRolloutClusterStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonQueryMetricInput.
-func (in *CommonQueryMetricInput) DeepCopy() *CommonQueryMetricInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRolloutStatus.
+func (in *ClusterTemplateRolloutStatus) DeepCopy() *ClusterTemplateRolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonQueryMetricInput)
+	out := new(ClusterTemplateRolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonRuleField) DeepCopyInto(out *CommonRuleField) {
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
 	*out = *in
-	if in.Inherited != nil {
-		in, out := &in.Inherited, &out.Inherited
-		*out = new(bool)
-		**out = **in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		copy(*out, *in)
 	}
-	out.TimingField = in.TimingField
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonRuleField.
-func (in *CommonRuleField) DeepCopy() *CommonRuleField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonRuleField)
+	out := new(ClusterTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeCondition) DeepCopyInto(out *ComposeCondition) {
+func (in *ClusterTestInput) DeepCopyInto(out *ClusterTestInput) {
 	*out = *in
+	in.LoggingTargets.DeepCopyInto(&out.LoggingTargets)
+	if in.OutputTags != nil {
+		in, out := &in.OutputTags, &out.OutputTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.LoggingParsingTestInput.DeepCopyInto(&out.LoggingParsingTestInput)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeCondition.
-func (in *ComposeCondition) DeepCopy() *ComposeCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTestInput.
+func (in *ClusterTestInput) DeepCopy() *ClusterTestInput {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeCondition)
+	out := new(ClusterTestInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeConfig) DeepCopyInto(out *ComposeConfig) {
+func (in *ClusterUpgradePlan) DeepCopyInto(out *ClusterUpgradePlan) {
 	*out = *in
+	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeConfig.
-func (in *ComposeConfig) DeepCopy() *ComposeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradePlan.
+func (in *ClusterUpgradePlan) DeepCopy() *ClusterUpgradePlan {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeConfig)
+	out := new(ClusterUpgradePlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComposeConfig) DeepCopyObject() runtime.Object {
+func (in *ClusterUpgradePlan) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2746,13 +3625,29 @@ func (in *ComposeConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeConfigList) DeepCopyInto(out *ComposeConfigList) {
+func (in *ClusterUpgradePlanCondition) DeepCopyInto(out *ClusterUpgradePlanCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradePlanCondition.
+func (in *ClusterUpgradePlanCondition) DeepCopy() *ClusterUpgradePlanCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradePlanCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradePlanList) DeepCopyInto(out *ClusterUpgradePlanList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ComposeConfig, len(*in))
+		*out = make([]ClusterUpgradePlan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2760,18 +3655,18 @@ func (in *ComposeConfigList) DeepCopyInto(out *ComposeConfigList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeConfigList.
-func (in *ComposeConfigList) DeepCopy() *ComposeConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradePlanList.
+func (in *ClusterUpgradePlanList) DeepCopy() *ClusterUpgradePlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeConfigList)
+	out := new(ClusterUpgradePlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ComposeConfigList) DeepCopyObject() runtime.Object {
+func (in *ClusterUpgradePlanList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2779,187 +3674,249 @@ func (in *ComposeConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeSpec) DeepCopyInto(out *ComposeSpec) {
+func (in *ClusterUpgradePlanSpec) DeepCopyInto(out *ClusterUpgradePlanSpec) {
 	*out = *in
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(types.NodeUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	out.PreflightChecks = in.PreflightChecks
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeSpec.
-func (in *ComposeSpec) DeepCopy() *ComposeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradePlanSpec.
+func (in *ClusterUpgradePlanSpec) DeepCopy() *ClusterUpgradePlanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeSpec)
+	out := new(ClusterUpgradePlanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeStatus) DeepCopyInto(out *ComposeStatus) {
+func (in *ClusterUpgradePlanStatus) DeepCopyInto(out *ClusterUpgradePlanStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]ComposeCondition, len(*in))
+		*out = make([]ClusterUpgradePlanCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreflightResults != nil {
+		in, out := &in.PreflightResults, &out.PreflightResults
+		*out = make([]UpgradePreflightResult, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeStatus.
-func (in *ComposeStatus) DeepCopy() *ComposeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradePlanStatus.
+func (in *ClusterUpgradePlanStatus) DeepCopy() *ClusterUpgradePlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeStatus)
+	out := new(ClusterUpgradePlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Condition) DeepCopyInto(out *Condition) {
+func (in *ClusterUpgradeStrategy) DeepCopyInto(out *ClusterUpgradeStrategy) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
-func (in *Condition) DeepCopy() *Condition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeStrategy.
+func (in *ClusterUpgradeStrategy) DeepCopy() *ClusterUpgradeStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(Condition)
+	out := new(ClusterUpgradeStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerResourceLimit) DeepCopyInto(out *ContainerResourceLimit) {
+func (in *CommonGroupField) DeepCopyInto(out *CommonGroupField) {
 	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(NotificationTemplate)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AlertRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.TimingField = in.TimingField
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceLimit.
-func (in *ContainerResourceLimit) DeepCopy() *ContainerResourceLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonGroupField.
+func (in *CommonGroupField) DeepCopy() *CommonGroupField {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerResourceLimit)
+	out := new(CommonGroupField)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomConfig) DeepCopyInto(out *CustomConfig) {
+func (in *CommonIncidentField) DeepCopyInto(out *CommonIncidentField) {
 	*out = *in
-	if in.Label != nil {
-		in, out := &in.Label, &out.Label
+	if in.AlertLabels != nil {
+		in, out := &in.AlertLabels, &out.AlertLabels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonIncidentField.
+func (in *CommonIncidentField) DeepCopy() *CommonIncidentField {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonIncidentField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonMonitorGraphSpec) DeepCopyInto(out *CommonMonitorGraphSpec) {
+	*out = *in
+	if in.MetricsSelector != nil {
+		in, out := &in.MetricsSelector, &out.MetricsSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DetailsMetricsSelector != nil {
+		in, out := &in.DetailsMetricsSelector, &out.DetailsMetricsSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
+	out.YAxis = in.YAxis
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomConfig.
-func (in *CustomConfig) DeepCopy() *CustomConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonMonitorGraphSpec.
+func (in *CommonMonitorGraphSpec) DeepCopy() *CommonMonitorGraphSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomConfig)
+	out := new(CommonMonitorGraphSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomTargetConfig) DeepCopyInto(out *CustomTargetConfig) {
+func (in *CommonQueryMetricInput) DeepCopyInto(out *CommonQueryMetricInput) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTargetConfig.
-func (in *CustomTargetConfig) DeepCopy() *CustomTargetConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonQueryMetricInput.
+func (in *CommonQueryMetricInput) DeepCopy() *CommonQueryMetricInput {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomTargetConfig)
+	out := new(CommonQueryMetricInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DingtalkConfig) DeepCopyInto(out *DingtalkConfig) {
+func (in *CommonRuleField) DeepCopyInto(out *CommonRuleField) {
 	*out = *in
-	if in.HTTPClientConfig != nil {
-		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
-		*out = new(HTTPClientConfig)
+	if in.Inherited != nil {
+		in, out := &in.Inherited, &out.Inherited
+		*out = new(bool)
 		**out = **in
 	}
+	out.TimingField = in.TimingField
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DingtalkConfig.
-func (in *DingtalkConfig) DeepCopy() *DingtalkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonRuleField.
+func (in *CommonRuleField) DeepCopy() *CommonRuleField {
 	if in == nil {
 		return nil
 	}
-	out := new(DingtalkConfig)
+	out := new(CommonRuleField)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DockerInfo) DeepCopyInto(out *DockerInfo) {
+func (in *CommonSilenceField) DeepCopyInto(out *CommonSilenceField) {
 	*out = *in
-	if in.SecurityOptions != nil {
-		in, out := &in.SecurityOptions, &out.SecurityOptions
-		*out = make([]string, len(*in))
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]SilenceMatcher, len(*in))
 		copy(*out, *in)
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSilenceField.
+func (in *CommonSilenceField) DeepCopy() *CommonSilenceField {
+	if in == nil {
+		return nil
 	}
+	out := new(CommonSilenceField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComposeCondition) DeepCopyInto(out *ComposeCondition) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerInfo.
-func (in *DockerInfo) DeepCopy() *DockerInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeCondition.
+func (in *ComposeCondition) DeepCopy() *ComposeCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(DockerInfo)
+	out := new(ComposeCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicSchema) DeepCopyInto(out *DynamicSchema) {
+func (in *ComposeConfig) DeepCopyInto(out *ComposeConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchema.
-func (in *DynamicSchema) DeepCopy() *DynamicSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeConfig.
+func (in *ComposeConfig) DeepCopy() *ComposeConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicSchema)
+	out := new(ComposeConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DynamicSchema) DeepCopyObject() runtime.Object {
+func (in *ComposeConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2967,13 +3924,13 @@ func (in *DynamicSchema) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicSchemaList) DeepCopyInto(out *DynamicSchemaList) {
+func (in *ComposeConfigList) DeepCopyInto(out *ComposeConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DynamicSchema, len(*in))
+		*out = make([]ComposeConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2981,18 +3938,18 @@ func (in *DynamicSchemaList) DeepCopyInto(out *DynamicSchemaList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaList.
-func (in *DynamicSchemaList) DeepCopy() *DynamicSchemaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeConfigList.
+func (in *ComposeConfigList) DeepCopy() *ComposeConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicSchemaList)
+	out := new(ComposeConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DynamicSchemaList) DeepCopyObject() runtime.Object {
+func (in *ComposeConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3000,230 +3957,209 @@ func (in *DynamicSchemaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicSchemaSpec) DeepCopyInto(out *DynamicSchemaSpec) {
+func (in *ComposeSpec) DeepCopyInto(out *ComposeSpec) {
 	*out = *in
-	if in.ResourceMethods != nil {
-		in, out := &in.ResourceMethods, &out.ResourceMethods
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ResourceFields != nil {
-		in, out := &in.ResourceFields, &out.ResourceFields
-		*out = make(map[string]Field, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	if in.ResourceActions != nil {
-		in, out := &in.ResourceActions, &out.ResourceActions
-		*out = make(map[string]Action, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.CollectionMethods != nil {
-		in, out := &in.CollectionMethods, &out.CollectionMethods
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.CollectionFields != nil {
-		in, out := &in.CollectionFields, &out.CollectionFields
-		*out = make(map[string]Field, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	if in.CollectionActions != nil {
-		in, out := &in.CollectionActions, &out.CollectionActions
-		*out = make(map[string]Action, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.CollectionFilters != nil {
-		in, out := &in.CollectionFilters, &out.CollectionFilters
-		*out = make(map[string]Filter, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	if in.IncludeableLinks != nil {
-		in, out := &in.IncludeableLinks, &out.IncludeableLinks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaSpec.
-func (in *DynamicSchemaSpec) DeepCopy() *DynamicSchemaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeSpec.
+func (in *ComposeSpec) DeepCopy() *ComposeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicSchemaSpec)
+	out := new(ComposeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicSchemaStatus) DeepCopyInto(out *DynamicSchemaStatus) {
+func (in *ComposeStatus) DeepCopyInto(out *ComposeStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ComposeCondition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaStatus.
-func (in *DynamicSchemaStatus) DeepCopy() *DynamicSchemaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeStatus.
+func (in *ComposeStatus) DeepCopy() *ComposeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicSchemaStatus)
+	out := new(ComposeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EKSStatus) DeepCopyInto(out *EKSStatus) {
+func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
-	if in.UpstreamSpec != nil {
-		in, out := &in.UpstreamSpec, &out.UpstreamSpec
-		*out = new(ekscattleiov1.EKSClusterConfigSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSStatus.
-func (in *EKSStatus) DeepCopy() *EKSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
 	if in == nil {
 		return nil
 	}
-	out := new(EKSStatus)
+	out := new(Condition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticsearchConfig) DeepCopyInto(out *ElasticsearchConfig) {
+func (in *ContainerResourceLimit) DeepCopyInto(out *ContainerResourceLimit) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchConfig.
-func (in *ElasticsearchConfig) DeepCopy() *ElasticsearchConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceLimit.
+func (in *ContainerResourceLimit) DeepCopy() *ContainerResourceLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticsearchConfig)
+	out := new(ContainerResourceLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
+func (in *CustomConfig) DeepCopyInto(out *CustomConfig) {
 	*out = *in
-	out.Namespaced = in.Namespaced
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackup.
-func (in *EtcdBackup) DeepCopy() *EtcdBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomConfig.
+func (in *CustomConfig) DeepCopy() *CustomConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EtcdBackup)
+	out := new(CustomConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EtcdBackup) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTargetConfig) DeepCopyInto(out *CustomTargetConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTargetConfig.
+func (in *CustomTargetConfig) DeepCopy() *CustomTargetConfig {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CustomTargetConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EtcdBackupList) DeepCopyInto(out *EtcdBackupList) {
+func (in *DecommissionConfig) DeepCopyInto(out *DecommissionConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]EtcdBackup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.S3BackupConfig != nil {
+		in, out := &in.S3BackupConfig, &out.S3BackupConfig
+		*out = new(types.S3BackupConfig)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupList.
-func (in *EtcdBackupList) DeepCopy() *EtcdBackupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecommissionConfig.
+func (in *DecommissionConfig) DeepCopy() *DecommissionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EtcdBackupList)
+	out := new(DecommissionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EtcdBackupList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DecommissionStatus) DeepCopyInto(out *DecommissionStatus) {
+	*out = *in
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecommissionStatus.
+func (in *DecommissionStatus) DeepCopy() *DecommissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DecommissionStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventRule) DeepCopyInto(out *EventRule) {
+func (in *DingtalkConfig) DeepCopyInto(out *DingtalkConfig) {
 	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRule.
-func (in *EventRule) DeepCopy() *EventRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DingtalkConfig.
+func (in *DingtalkConfig) DeepCopy() *DingtalkConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EventRule)
+	out := new(DingtalkConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExportOutput) DeepCopyInto(out *ExportOutput) {
+func (in *DockerInfo) DeepCopyInto(out *DockerInfo) {
 	*out = *in
+	if in.SecurityOptions != nil {
+		in, out := &in.SecurityOptions, &out.SecurityOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOutput.
-func (in *ExportOutput) DeepCopy() *ExportOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerInfo.
+func (in *DockerInfo) DeepCopy() *DockerInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(ExportOutput)
+	out := new(DockerInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Feature) DeepCopyInto(out *Feature) {
+func (in *DynamicSchema) DeepCopyInto(out *DynamicSchema) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3232,18 +4168,18 @@ func (in *Feature) DeepCopyInto(out *Feature) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Feature.
-func (in *Feature) DeepCopy() *Feature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchema.
+func (in *DynamicSchema) DeepCopy() *DynamicSchema {
 	if in == nil {
 		return nil
 	}
-	out := new(Feature)
+	out := new(DynamicSchema)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Feature) DeepCopyObject() runtime.Object {
+func (in *DynamicSchema) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3251,13 +4187,13 @@ func (in *Feature) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureList) DeepCopyInto(out *FeatureList) {
+func (in *DynamicSchemaList) DeepCopyInto(out *DynamicSchemaList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Feature, len(*in))
+		*out = make([]DynamicSchema, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3265,18 +4201,18 @@ func (in *FeatureList) DeepCopyInto(out *FeatureList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureList.
-func (in *FeatureList) DeepCopy() *FeatureList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaList.
+func (in *DynamicSchemaList) DeepCopy() *DynamicSchemaList {
 	if in == nil {
 		return nil
 	}
-	out := new(FeatureList)
+	out := new(DynamicSchemaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FeatureList) DeepCopyObject() runtime.Object {
+func (in *DynamicSchemaList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3284,107 +4220,391 @@ func (in *FeatureList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureSpec) DeepCopyInto(out *FeatureSpec) {
+func (in *DynamicSchemaSpec) DeepCopyInto(out *DynamicSchemaSpec) {
 	*out = *in
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(bool)
-		**out = **in
+	if in.ResourceMethods != nil {
+		in, out := &in.ResourceMethods, &out.ResourceMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceFields != nil {
+		in, out := &in.ResourceFields, &out.ResourceFields
+		*out = make(map[string]Field, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ResourceActions != nil {
+		in, out := &in.ResourceActions, &out.ResourceActions
+		*out = make(map[string]Action, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CollectionMethods != nil {
+		in, out := &in.CollectionMethods, &out.CollectionMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CollectionFields != nil {
+		in, out := &in.CollectionFields, &out.CollectionFields
+		*out = make(map[string]Field, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.CollectionActions != nil {
+		in, out := &in.CollectionActions, &out.CollectionActions
+		*out = make(map[string]Action, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CollectionFilters != nil {
+		in, out := &in.CollectionFilters, &out.CollectionFilters
+		*out = make(map[string]Filter, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.IncludeableLinks != nil {
+		in, out := &in.IncludeableLinks, &out.IncludeableLinks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureSpec.
-func (in *FeatureSpec) DeepCopy() *FeatureSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaSpec.
+func (in *DynamicSchemaSpec) DeepCopy() *DynamicSchemaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FeatureSpec)
+	out := new(DynamicSchemaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureStatus) DeepCopyInto(out *FeatureStatus) {
+func (in *DynamicSchemaStatus) DeepCopyInto(out *DynamicSchemaStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureStatus.
-func (in *FeatureStatus) DeepCopy() *FeatureStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSchemaStatus.
+func (in *DynamicSchemaStatus) DeepCopy() *DynamicSchemaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FeatureStatus)
+	out := new(DynamicSchemaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Field) DeepCopyInto(out *Field) {
+func (in *EKSStatus) DeepCopyInto(out *EKSStatus) {
 	*out = *in
-	in.Default.DeepCopyInto(&out.Default)
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
+	if in.UpstreamSpec != nil {
+		in, out := &in.UpstreamSpec, &out.UpstreamSpec
+		*out = new(ekscattleiov1.EKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Field.
-func (in *Field) DeepCopy() *Field {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSStatus.
+func (in *EKSStatus) DeepCopy() *EKSStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Field)
+	out := new(EKSStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *File) DeepCopyInto(out *File) {
+func (in *ElasticsearchConfig) DeepCopyInto(out *ElasticsearchConfig) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
-func (in *File) DeepCopy() *File {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchConfig.
+func (in *ElasticsearchConfig) DeepCopy() *ElasticsearchConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(File)
+	out := new(ElasticsearchConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Filter) DeepCopyInto(out *Filter) {
+func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
 	*out = *in
-	if in.Modifiers != nil {
-		in, out := &in.Modifiers, &out.Modifiers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
-func (in *Filter) DeepCopy() *Filter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackup.
+func (in *EtcdBackup) DeepCopy() *EtcdBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(Filter)
+	out := new(EtcdBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EtcdBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FleetWorkspace) DeepCopyInto(out *FleetWorkspace) {
+func (in *EtcdBackupList) DeepCopyInto(out *EtcdBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Status = in.Status
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EtcdBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupList.
+func (in *EtcdBackupList) DeepCopy() *EtcdBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EtcdBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRule) DeepCopyInto(out *EventRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRule.
+func (in *EventRule) DeepCopy() *EventRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportOutput) DeepCopyInto(out *ExportOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOutput.
+func (in *ExportOutput) DeepCopy() *ExportOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Feature) DeepCopyInto(out *Feature) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Feature.
+func (in *Feature) DeepCopy() *Feature {
+	if in == nil {
+		return nil
+	}
+	out := new(Feature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Feature) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureList) DeepCopyInto(out *FeatureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Feature, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureList.
+func (in *FeatureList) DeepCopy() *FeatureList {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FeatureList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureSpec) DeepCopyInto(out *FeatureSpec) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureSpec.
+func (in *FeatureSpec) DeepCopy() *FeatureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureStatus) DeepCopyInto(out *FeatureStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureStatus.
+func (in *FeatureStatus) DeepCopy() *FeatureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Field) DeepCopyInto(out *Field) {
+	*out = *in
+	in.Default.DeepCopyInto(&out.Default)
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Field.
+func (in *Field) DeepCopy() *Field {
+	if in == nil {
+		return nil
+	}
+	out := new(Field)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *File) DeepCopyInto(out *File) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
+func (in *File) DeepCopy() *File {
+	if in == nil {
+		return nil
+	}
+	out := new(File)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Modifiers != nil {
+		in, out := &in.Modifiers, &out.Modifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetWorkspace) DeepCopyInto(out *FleetWorkspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Status = in.Status
 	return
 }
 
@@ -4151,6 +5371,27 @@ func (in *GlobalRoleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleChatConfig) DeepCopyInto(out *GoogleChatConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleChatConfig.
+func (in *GoogleChatConfig) DeepCopy() *GoogleChatConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleChatConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleOAuthProvider) DeepCopyInto(out *GoogleOAuthProvider) {
 	*out = *in
@@ -4360,44 +5601,425 @@ func (in *GroupList) DeepCopyInto(out *GroupList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
-func (in *GroupList) DeepCopy() *GroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
+func (in *GroupList) DeepCopy() *GroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupMember) DeepCopyInto(out *GroupMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMember.
+func (in *GroupMember) DeepCopy() *GroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupMemberList) DeepCopyInto(out *GroupMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMemberList.
+func (in *GroupMemberList) DeepCopy() *GroupMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPARule) DeepCopyInto(out *HPARule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPARule.
+func (in *HPARule) DeepCopy() *HPARule {
+	if in == nil {
+		return nil
+	}
+	out := new(HPARule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPClientConfig) DeepCopyInto(out *HTTPClientConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPClientConfig.
+func (in *HTTPClientConfig) DeepCopy() *HTTPClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportClusterYamlInput) DeepCopyInto(out *ImportClusterYamlInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportClusterYamlInput.
+func (in *ImportClusterYamlInput) DeepCopy() *ImportClusterYamlInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportClusterYamlInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportYamlOutput) DeepCopyInto(out *ImportYamlOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportYamlOutput.
+func (in *ImportYamlOutput) DeepCopy() *ImportYamlOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportYamlOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedConfig) DeepCopyInto(out *ImportedConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedConfig.
+func (in *ImportedConfig) DeepCopy() *ImportedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentEvent) DeepCopyInto(out *IncidentEvent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentEvent.
+func (in *IncidentEvent) DeepCopy() *IncidentEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressCapabilities) DeepCopyInto(out *IngressCapabilities) {
+	*out = *in
+	if in.CustomDefaultBackend != nil {
+		in, out := &in.CustomDefaultBackend, &out.CustomDefaultBackend
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressCapabilities.
+func (in *IngressCapabilities) DeepCopy() *IngressCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobRule) DeepCopyInto(out *JobRule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobRule.
+func (in *JobRule) DeepCopy() *JobRule {
+	if in == nil {
+		return nil
+	}
+	out := new(JobRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K3sConfig) DeepCopyInto(out *K3sConfig) {
+	*out = *in
+	out.ClusterUpgradeStrategy = in.ClusterUpgradeStrategy
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K3sConfig.
+func (in *K3sConfig) DeepCopy() *K3sConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(K3sConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
+	*out = *in
+	if in.BrokerEndpoints != nil {
+		in, out := &in.BrokerEndpoints, &out.BrokerEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfig.
+func (in *KafkaConfig) DeepCopy() *KafkaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyCloakConfig) DeepCopyInto(out *KeyCloakConfig) {
+	*out = *in
+	in.SamlConfig.DeepCopyInto(&out.SamlConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyCloakConfig.
+func (in *KeyCloakConfig) DeepCopy() *KeyCloakConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyCloakConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyCloakProvider) DeepCopyInto(out *KeyCloakProvider) {
+	*out = *in
+	in.SamlProvider.DeepCopyInto(&out.SamlProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyCloakProvider.
+func (in *KeyCloakProvider) DeepCopy() *KeyCloakProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyCloakProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KontainerDriver) DeepCopyInto(out *KontainerDriver) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriver.
+func (in *KontainerDriver) DeepCopy() *KontainerDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(KontainerDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KontainerDriver) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KontainerDriverList) DeepCopyInto(out *KontainerDriverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KontainerDriver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverList.
+func (in *KontainerDriverList) DeepCopy() *KontainerDriverList {
+	if in == nil {
+		return nil
+	}
+	out := new(KontainerDriverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KontainerDriverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KontainerDriverSpec) DeepCopyInto(out *KontainerDriverSpec) {
+	*out = *in
+	if in.WhitelistDomains != nil {
+		in, out := &in.WhitelistDomains, &out.WhitelistDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverSpec.
+func (in *KontainerDriverSpec) DeepCopy() *KontainerDriverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KontainerDriverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KontainerDriverStatus) DeepCopyInto(out *KontainerDriverStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverStatus.
+func (in *KontainerDriverStatus) DeepCopy() *KontainerDriverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupList)
+	out := new(KontainerDriverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupMember) DeepCopyInto(out *GroupMember) {
+func (in *LdapConfig) DeepCopyInto(out *LdapConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
+	in.LdapFields.DeepCopyInto(&out.LdapFields)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMember.
-func (in *GroupMember) DeepCopy() *GroupMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapConfig.
+func (in *LdapConfig) DeepCopy() *LdapConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupMember)
+	out := new(LdapConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupMember) DeepCopyObject() runtime.Object {
+func (in *LdapConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4405,13 +6027,13 @@ func (in *GroupMember) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupMemberList) DeepCopyInto(out *GroupMemberList) {
+func (in *LdapConfigList) DeepCopyInto(out *LdapConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GroupMember, len(*in))
+		*out = make([]LdapConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4419,18 +6041,18 @@ func (in *GroupMemberList) DeepCopyInto(out *GroupMemberList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupMemberList.
-func (in *GroupMemberList) DeepCopy() *GroupMemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapConfigList.
+func (in *LdapConfigList) DeepCopy() *LdapConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupMemberList)
+	out := new(LdapConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupMemberList) DeepCopyObject() runtime.Object {
+func (in *LdapConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4438,184 +6060,187 @@ func (in *GroupMemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPClientConfig) DeepCopyInto(out *HTTPClientConfig) {
+func (in *LdapFields) DeepCopyInto(out *LdapFields) {
 	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPClientConfig.
-func (in *HTTPClientConfig) DeepCopy() *HTTPClientConfig {
-	if in == nil {
-		return nil
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(HTTPClientConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImportClusterYamlInput) DeepCopyInto(out *ImportClusterYamlInput) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportClusterYamlInput.
-func (in *ImportClusterYamlInput) DeepCopy() *ImportClusterYamlInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapFields.
+func (in *LdapFields) DeepCopy() *LdapFields {
 	if in == nil {
 		return nil
 	}
-	out := new(ImportClusterYamlInput)
+	out := new(LdapFields)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImportYamlOutput) DeepCopyInto(out *ImportYamlOutput) {
+func (in *LdapTestAndApplyInput) DeepCopyInto(out *LdapTestAndApplyInput) {
 	*out = *in
+	in.LdapConfig.DeepCopyInto(&out.LdapConfig)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportYamlOutput.
-func (in *ImportYamlOutput) DeepCopy() *ImportYamlOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapTestAndApplyInput.
+func (in *LdapTestAndApplyInput) DeepCopy() *LdapTestAndApplyInput {
 	if in == nil {
 		return nil
 	}
-	out := new(ImportYamlOutput)
+	out := new(LdapTestAndApplyInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImportedConfig) DeepCopyInto(out *ImportedConfig) {
+func (in *ListOpts) DeepCopyInto(out *ListOpts) {
 	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedConfig.
-func (in *ImportedConfig) DeepCopy() *ImportedConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListOpts.
+func (in *ListOpts) DeepCopy() *ListOpts {
 	if in == nil {
 		return nil
 	}
-	out := new(ImportedConfig)
+	out := new(ListOpts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressCapabilities) DeepCopyInto(out *IngressCapabilities) {
+func (in *LoadBalancerCapabilities) DeepCopyInto(out *LoadBalancerCapabilities) {
 	*out = *in
-	if in.CustomDefaultBackend != nil {
-		in, out := &in.CustomDefaultBackend, &out.CustomDefaultBackend
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProtocolsSupported != nil {
+		in, out := &in.ProtocolsSupported, &out.ProtocolsSupported
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressCapabilities.
-func (in *IngressCapabilities) DeepCopy() *IngressCapabilities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerCapabilities.
+func (in *LoadBalancerCapabilities) DeepCopy() *LoadBalancerCapabilities {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressCapabilities)
+	out := new(LoadBalancerCapabilities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K3sConfig) DeepCopyInto(out *K3sConfig) {
+func (in *LocalClusterAuthEndpoint) DeepCopyInto(out *LocalClusterAuthEndpoint) {
 	*out = *in
-	out.ClusterUpgradeStrategy = in.ClusterUpgradeStrategy
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K3sConfig.
-func (in *K3sConfig) DeepCopy() *K3sConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalClusterAuthEndpoint.
+func (in *LocalClusterAuthEndpoint) DeepCopy() *LocalClusterAuthEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(K3sConfig)
+	out := new(LocalClusterAuthEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
+func (in *LocalConfig) DeepCopyInto(out *LocalConfig) {
 	*out = *in
-	if in.BrokerEndpoints != nil {
-		in, out := &in.BrokerEndpoints, &out.BrokerEndpoints
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfig.
-func (in *KafkaConfig) DeepCopy() *KafkaConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfig.
+func (in *LocalConfig) DeepCopy() *LocalConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConfig)
+	out := new(LocalConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyCloakConfig) DeepCopyInto(out *KeyCloakConfig) {
-	*out = *in
-	in.SamlConfig.DeepCopyInto(&out.SamlConfig)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyCloakConfig.
-func (in *KeyCloakConfig) DeepCopy() *KeyCloakConfig {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(KeyCloakConfig)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyCloakProvider) DeepCopyInto(out *KeyCloakProvider) {
+func (in *LocalConfigList) DeepCopyInto(out *LocalConfigList) {
 	*out = *in
-	in.SamlProvider.DeepCopyInto(&out.SamlProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocalConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyCloakProvider.
-func (in *KeyCloakProvider) DeepCopy() *KeyCloakProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfigList.
+func (in *LocalConfigList) DeepCopy() *LocalConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyCloakProvider)
+	out := new(LocalConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KontainerDriver) DeepCopyInto(out *KontainerDriver) {
+func (in *LocalProvider) DeepCopyInto(out *LocalProvider) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.AuthProvider.DeepCopyInto(&out.AuthProvider)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriver.
-func (in *KontainerDriver) DeepCopy() *KontainerDriver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalProvider.
+func (in *LocalProvider) DeepCopy() *LocalProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(KontainerDriver)
+	out := new(LocalProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KontainerDriver) DeepCopyObject() runtime.Object {
+func (in *LocalProvider) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4623,13 +6248,13 @@ func (in *KontainerDriver) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KontainerDriverList) DeepCopyInto(out *KontainerDriverList) {
+func (in *LocalProviderList) DeepCopyInto(out *LocalProviderList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KontainerDriver, len(*in))
+		*out = make([]LocalProvider, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4637,18 +6262,18 @@ func (in *KontainerDriverList) DeepCopyInto(out *KontainerDriverList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverList.
-func (in *KontainerDriverList) DeepCopy() *KontainerDriverList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalProviderList.
+func (in *LocalProviderList) DeepCopy() *LocalProviderList {
 	if in == nil {
 		return nil
 	}
-	out := new(KontainerDriverList)
+	out := new(LocalProviderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KontainerDriverList) DeepCopyObject() runtime.Object {
+func (in *LocalProviderList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4656,242 +6281,315 @@ func (in *KontainerDriverList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KontainerDriverSpec) DeepCopyInto(out *KontainerDriverSpec) {
+func (in *LoggingAuditLogSource) DeepCopyInto(out *LoggingAuditLogSource) {
 	*out = *in
-	if in.WhitelistDomains != nil {
-		in, out := &in.WhitelistDomains, &out.WhitelistDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingAuditLogSource.
+func (in *LoggingAuditLogSource) DeepCopy() *LoggingAuditLogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingAuditLogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingAuditLogSources) DeepCopyInto(out *LoggingAuditLogSources) {
+	*out = *in
+	if in.KubeAPIServer != nil {
+		in, out := &in.KubeAPIServer, &out.KubeAPIServer
+		*out = new(LoggingAuditLogSource)
+		**out = **in
+	}
+	if in.Rancher != nil {
+		in, out := &in.Rancher, &out.Rancher
+		*out = new(LoggingAuditLogSource)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverSpec.
-func (in *KontainerDriverSpec) DeepCopy() *KontainerDriverSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingAuditLogSources.
+func (in *LoggingAuditLogSources) DeepCopy() *LoggingAuditLogSources {
 	if in == nil {
 		return nil
 	}
-	out := new(KontainerDriverSpec)
+	out := new(LoggingAuditLogSources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KontainerDriverStatus) DeepCopyInto(out *KontainerDriverStatus) {
+func (in *LoggingCommonField) DeepCopyInto(out *LoggingCommonField) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		copy(*out, *in)
+	if in.OutputTags != nil {
+		in, out := &in.OutputTags, &out.OutputTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ParsingRules != nil {
+		in, out := &in.ParsingRules, &out.ParsingRules
+		*out = make([]LoggingParsingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FieldTransform != nil {
+		in, out := &in.FieldTransform, &out.FieldTransform
+		*out = new(LoggingFieldTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(LoggingSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DebugSampling != nil {
+		in, out := &in.DebugSampling, &out.DebugSampling
+		*out = new(LoggingDebugSampling)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KontainerDriverStatus.
-func (in *KontainerDriverStatus) DeepCopy() *KontainerDriverStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCommonField.
+func (in *LoggingCommonField) DeepCopy() *LoggingCommonField {
 	if in == nil {
 		return nil
 	}
-	out := new(KontainerDriverStatus)
+	out := new(LoggingCommonField)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LdapConfig) DeepCopyInto(out *LdapConfig) {
+func (in *LoggingCondition) DeepCopyInto(out *LoggingCondition) {
 	*out = *in
-	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
-	in.LdapFields.DeepCopyInto(&out.LdapFields)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapConfig.
-func (in *LdapConfig) DeepCopy() *LdapConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCondition.
+func (in *LoggingCondition) DeepCopy() *LoggingCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(LdapConfig)
+	out := new(LoggingCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LdapConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LdapConfigList) DeepCopyInto(out *LdapConfigList) {
+func (in *LoggingDebugSampling) DeepCopyInto(out *LoggingDebugSampling) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]LdapConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapConfigList.
-func (in *LdapConfigList) DeepCopy() *LdapConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingDebugSampling.
+func (in *LoggingDebugSampling) DeepCopy() *LoggingDebugSampling {
 	if in == nil {
 		return nil
 	}
-	out := new(LdapConfigList)
+	out := new(LoggingDebugSampling)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LdapConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LdapFields) DeepCopyInto(out *LdapFields) {
+func (in *LoggingDroppedLines) DeepCopyInto(out *LoggingDroppedLines) {
 	*out = *in
-	if in.Servers != nil {
-		in, out := &in.Servers, &out.Servers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapFields.
-func (in *LdapFields) DeepCopy() *LdapFields {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingDroppedLines.
+func (in *LoggingDroppedLines) DeepCopy() *LoggingDroppedLines {
 	if in == nil {
 		return nil
 	}
-	out := new(LdapFields)
+	out := new(LoggingDroppedLines)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LdapTestAndApplyInput) DeepCopyInto(out *LdapTestAndApplyInput) {
+func (in *LoggingFieldTransform) DeepCopyInto(out *LoggingFieldTransform) {
 	*out = *in
-	in.LdapConfig.DeepCopyInto(&out.LdapConfig)
+	if in.RenameFields != nil {
+		in, out := &in.RenameFields, &out.RenameFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DropFields != nil {
+		in, out := &in.DropFields, &out.DropFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedactFields != nil {
+		in, out := &in.RedactFields, &out.RedactFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapTestAndApplyInput.
-func (in *LdapTestAndApplyInput) DeepCopy() *LdapTestAndApplyInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingFieldTransform.
+func (in *LoggingFieldTransform) DeepCopy() *LoggingFieldTransform {
 	if in == nil {
 		return nil
 	}
-	out := new(LdapTestAndApplyInput)
+	out := new(LoggingFieldTransform)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListOpts) DeepCopyInto(out *ListOpts) {
+func (in *LoggingParsingRule) DeepCopyInto(out *LoggingParsingRule) {
 	*out = *in
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListOpts.
-func (in *ListOpts) DeepCopy() *ListOpts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingParsingRule.
+func (in *LoggingParsingRule) DeepCopy() *LoggingParsingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ListOpts)
+	out := new(LoggingParsingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerCapabilities) DeepCopyInto(out *LoadBalancerCapabilities) {
+func (in *LoggingParsingTestInput) DeepCopyInto(out *LoggingParsingTestInput) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.ParsingRules != nil {
+		in, out := &in.ParsingRules, &out.ParsingRules
+		*out = make([]LoggingParsingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ProtocolsSupported != nil {
-		in, out := &in.ProtocolsSupported, &out.ProtocolsSupported
+	if in.FieldTransform != nil {
+		in, out := &in.FieldTransform, &out.FieldTransform
+		*out = new(LoggingFieldTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SampleLines != nil {
+		in, out := &in.SampleLines, &out.SampleLines
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerCapabilities.
-func (in *LoadBalancerCapabilities) DeepCopy() *LoadBalancerCapabilities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingParsingTestInput.
+func (in *LoggingParsingTestInput) DeepCopy() *LoggingParsingTestInput {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerCapabilities)
+	out := new(LoggingParsingTestInput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalClusterAuthEndpoint) DeepCopyInto(out *LocalClusterAuthEndpoint) {
+func (in *LoggingSelector) DeepCopyInto(out *LoggingSelector) {
 	*out = *in
+	if in.IncludeNamespaces != nil {
+		in, out := &in.IncludeNamespaces, &out.IncludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNamespaces != nil {
+		in, out := &in.ExcludeNamespaces, &out.ExcludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeWorkloads != nil {
+		in, out := &in.IncludeWorkloads, &out.IncludeWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeWorkloads != nil {
+		in, out := &in.ExcludeWorkloads, &out.ExcludeWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalClusterAuthEndpoint.
-func (in *LocalClusterAuthEndpoint) DeepCopy() *LocalClusterAuthEndpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSelector.
+func (in *LoggingSelector) DeepCopy() *LoggingSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalClusterAuthEndpoint)
+	out := new(LoggingSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalConfig) DeepCopyInto(out *LocalConfig) {
+func (in *LoggingTargets) DeepCopyInto(out *LoggingTargets) {
 	*out = *in
-	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
+	if in.ElasticsearchConfig != nil {
+		in, out := &in.ElasticsearchConfig, &out.ElasticsearchConfig
+		*out = new(ElasticsearchConfig)
+		**out = **in
+	}
+	if in.SplunkConfig != nil {
+		in, out := &in.SplunkConfig, &out.SplunkConfig
+		*out = new(SplunkConfig)
+		**out = **in
+	}
+	if in.KafkaConfig != nil {
+		in, out := &in.KafkaConfig, &out.KafkaConfig
+		*out = new(KafkaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyslogConfig != nil {
+		in, out := &in.SyslogConfig, &out.SyslogConfig
+		*out = new(SyslogConfig)
+		**out = **in
+	}
+	if in.FluentForwarderConfig != nil {
+		in, out := &in.FluentForwarderConfig, &out.FluentForwarderConfig
+		*out = new(FluentForwarderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomTargetConfig != nil {
+		in, out := &in.CustomTargetConfig, &out.CustomTargetConfig
+		*out = new(CustomTargetConfig)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfig.
-func (in *LocalConfig) DeepCopy() *LocalConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTargets.
+func (in *LoggingTargets) DeepCopy() *LoggingTargets {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalConfig)
+	out := new(LoggingTargets)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalConfigList) DeepCopyInto(out *LocalConfigList) {
+func (in *LoggingTestOutput) DeepCopyInto(out *LoggingTestOutput) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]LocalConfig, len(*in))
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]LoggingTestRecord, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4899,186 +6597,161 @@ func (in *LocalConfigList) DeepCopyInto(out *LocalConfigList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfigList.
-func (in *LocalConfigList) DeepCopy() *LocalConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTestOutput.
+func (in *LoggingTestOutput) DeepCopy() *LoggingTestOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalConfigList)
+	out := new(LoggingTestOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalProvider) DeepCopyInto(out *LocalProvider) {
+func (in *LoggingTestRecord) DeepCopyInto(out *LoggingTestRecord) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.AuthProvider.DeepCopyInto(&out.AuthProvider)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalProvider.
-func (in *LocalProvider) DeepCopy() *LocalProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTestRecord.
+func (in *LoggingTestRecord) DeepCopy() *LoggingTestRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalProvider)
+	out := new(LoggingTestRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalProvider) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalProviderList) DeepCopyInto(out *LocalProviderList) {
+func (in *MSTeamsConfig) DeepCopyInto(out *MSTeamsConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]LocalProvider, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalProviderList.
-func (in *LocalProviderList) DeepCopy() *LocalProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsConfig.
+func (in *MSTeamsConfig) DeepCopy() *MSTeamsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalProviderList)
+	out := new(MSTeamsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalProviderList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingCommonField) DeepCopyInto(out *LoggingCommonField) {
+func (in *MachineHealthCheck) DeepCopyInto(out *MachineHealthCheck) {
 	*out = *in
-	if in.OutputTags != nil {
-		in, out := &in.OutputTags, &out.OutputTags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.UnhealthyConditions != nil {
+		in, out := &in.UnhealthyConditions, &out.UnhealthyConditions
+		*out = make([]UnhealthyCondition, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCommonField.
-func (in *LoggingCommonField) DeepCopy() *LoggingCommonField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheck.
+func (in *MachineHealthCheck) DeepCopy() *MachineHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingCommonField)
+	out := new(MachineHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingCondition) DeepCopyInto(out *LoggingCondition) {
+func (in *MaintenanceBlackout) DeepCopyInto(out *MaintenanceBlackout) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCondition.
-func (in *LoggingCondition) DeepCopy() *LoggingCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBlackout.
+func (in *MaintenanceBlackout) DeepCopy() *MaintenanceBlackout {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingCondition)
+	out := new(MaintenanceBlackout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingTargets) DeepCopyInto(out *LoggingTargets) {
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
 	*out = *in
-	if in.ElasticsearchConfig != nil {
-		in, out := &in.ElasticsearchConfig, &out.ElasticsearchConfig
-		*out = new(ElasticsearchConfig)
-		**out = **in
-	}
-	if in.SplunkConfig != nil {
-		in, out := &in.SplunkConfig, &out.SplunkConfig
-		*out = new(SplunkConfig)
-		**out = **in
-	}
-	if in.KafkaConfig != nil {
-		in, out := &in.KafkaConfig, &out.KafkaConfig
-		*out = new(KafkaConfig)
-		(*in).DeepCopyInto(*out)
+	if in.PlannedActions != nil {
+		in, out := &in.PlannedActions, &out.PlannedActions
+		*out = make([]PlannedMaintenanceAction, len(*in))
+		copy(*out, *in)
 	}
-	if in.SyslogConfig != nil {
-		in, out := &in.SyslogConfig, &out.SyslogConfig
-		*out = new(SyslogConfig)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
 	}
-	if in.FluentForwarderConfig != nil {
-		in, out := &in.FluentForwarderConfig, &out.FluentForwarderConfig
-		*out = new(FluentForwarderConfig)
-		(*in).DeepCopyInto(*out)
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindowSchedule, len(*in))
+		copy(*out, *in)
 	}
-	if in.CustomTargetConfig != nil {
-		in, out := &in.CustomTargetConfig, &out.CustomTargetConfig
-		*out = new(CustomTargetConfig)
-		**out = **in
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]MaintenanceBlackout, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTargets.
-func (in *LoggingTargets) DeepCopy() *LoggingTargets {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingTargets)
+	out := new(MaintenanceWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSTeamsConfig) DeepCopyInto(out *MSTeamsConfig) {
+func (in *MaintenanceWindowSchedule) DeepCopyInto(out *MaintenanceWindowSchedule) {
 	*out = *in
-	if in.HTTPClientConfig != nil {
-		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
-		*out = new(HTTPClientConfig)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsConfig.
-func (in *MSTeamsConfig) DeepCopy() *MSTeamsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSchedule.
+func (in *MaintenanceWindowSchedule) DeepCopy() *MaintenanceWindowSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(MSTeamsConfig)
+	out := new(MaintenanceWindowSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5123,6 +6796,27 @@ func (in MapStringInterface) DeepCopyInto(out *MapStringInterface) {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Member) DeepCopyInto(out *Member) {
 	*out = *in
@@ -5525,6 +7219,11 @@ func (in *MultiClusterAppSpec) DeepCopyInto(out *MultiClusterAppSpec) {
 		copy(*out, *in)
 	}
 	in.UpgradeStrategy.DeepCopyInto(&out.UpgradeStrategy)
+	if in.ClusterGroupTargets != nil {
+		in, out := &in.ClusterGroupTargets, &out.ClusterGroupTargets
+		*out = make([]ClusterGroupTarget, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5892,6 +7591,43 @@ func (in *NodePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolRolloutStatus) DeepCopyInto(out *NodePoolRolloutStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolRolloutStatus.
+func (in *NodePoolRolloutStatus) DeepCopy() *NodePoolRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolRolloutStrategy) DeepCopyInto(out *NodePoolRolloutStrategy) {
+	*out = *in
+	if in.NodeDrainInput != nil {
+		in, out := &in.NodeDrainInput, &out.NodeDrainInput
+		*out = new(types.NodeDrainInput)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolRolloutStrategy.
+func (in *NodePoolRolloutStrategy) DeepCopy() *NodePoolRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 	*out = *in
@@ -5916,6 +7652,16 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(NodePoolRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(MachineHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5937,6 +7683,11 @@ func (in *NodePoolStatus) DeepCopyInto(out *NodePoolStatus) {
 		*out = make([]Condition, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(NodePoolRolloutStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -5950,6 +7701,22 @@ func (in *NodePoolStatus) DeepCopy() *NodePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRemediation) DeepCopyInto(out *NodeRemediation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRemediation.
+func (in *NodeRemediation) DeepCopy() *NodeRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeRule) DeepCopyInto(out *NodeRule) {
 	*out = *in
@@ -6077,6 +7844,11 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(NodePlan)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]NodeRemediation, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -6251,6 +8023,26 @@ func (in *Notification) DeepCopyInto(out *Notification) {
 		*out = new(MSTeamsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		*out = new(OpsgenieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TelegramConfig != nil {
+		in, out := &in.TelegramConfig, &out.TelegramConfig
+		*out = new(TelegramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MattermostConfig != nil {
+		in, out := &in.MattermostConfig, &out.MattermostConfig
+		*out = new(MattermostConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleChatConfig != nil {
+		in, out := &in.GoogleChatConfig, &out.GoogleChatConfig
+		*out = new(GoogleChatConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6264,6 +8056,59 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPreviewInput) DeepCopyInto(out *NotificationPreviewInput) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(NotificationTemplate)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPreviewInput.
+func (in *NotificationPreviewInput) DeepCopy() *NotificationPreviewInput {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPreviewInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPreviewOutput) DeepCopyInto(out *NotificationPreviewOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPreviewOutput.
+func (in *NotificationPreviewOutput) DeepCopy() *NotificationPreviewOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPreviewOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationTemplate) DeepCopyInto(out *NotificationTemplate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationTemplate.
+func (in *NotificationTemplate) DeepCopy() *NotificationTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Notifier) DeepCopyInto(out *Notifier) {
 	*out = *in
@@ -6364,6 +8209,31 @@ func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
 		*out = new(MSTeamsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		*out = new(OpsgenieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TelegramConfig != nil {
+		in, out := &in.TelegramConfig, &out.TelegramConfig
+		*out = new(TelegramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MattermostConfig != nil {
+		in, out := &in.MattermostConfig, &out.MattermostConfig
+		*out = new(MattermostConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleChatConfig != nil {
+		in, out := &in.GoogleChatConfig, &out.GoogleChatConfig
+		*out = new(GoogleChatConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(NotificationTemplate)
+		**out = **in
+	}
 	return
 }
 
@@ -6405,24 +8275,139 @@ func (in *OKTAConfig) DeepCopy() *OKTAConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OKTAConfig)
+	out := new(OKTAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OKTAProvider) DeepCopyInto(out *OKTAProvider) {
+	*out = *in
+	in.SamlProvider.DeepCopyInto(&out.SamlProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OKTAProvider.
+func (in *OKTAProvider) DeepCopy() *OKTAProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OKTAProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallParticipant) DeepCopyInto(out *OnCallParticipant) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallParticipant.
+func (in *OnCallParticipant) DeepCopy() *OnCallParticipant {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallParticipant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallSchedule) DeepCopyInto(out *OnCallSchedule) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallSchedule.
+func (in *OnCallSchedule) DeepCopy() *OnCallSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleList) DeepCopyInto(out *OnCallScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OnCallSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleList.
+func (in *OnCallScheduleList) DeepCopy() *OnCallScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleSpec) DeepCopyInto(out *OnCallScheduleSpec) {
+	*out = *in
+	if in.Participants != nil {
+		in, out := &in.Participants, &out.Participants
+		*out = make([]OnCallParticipant, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleSpec.
+func (in *OnCallScheduleSpec) DeepCopy() *OnCallScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OKTAProvider) DeepCopyInto(out *OKTAProvider) {
+func (in *OnCallScheduleStatus) DeepCopyInto(out *OnCallScheduleStatus) {
 	*out = *in
-	in.SamlProvider.DeepCopyInto(&out.SamlProvider)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OKTAProvider.
-func (in *OKTAProvider) DeepCopy() *OKTAProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleStatus.
+func (in *OnCallScheduleStatus) DeepCopy() *OnCallScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OKTAProvider)
+	out := new(OnCallScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6521,6 +8506,27 @@ func (in *OpenLdapTestAndApplyInput) DeepCopy() *OpenLdapTestAndApplyInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsgenieConfig) DeepCopyInto(out *OpsgenieConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsgenieConfig.
+func (in *OpsgenieConfig) DeepCopy() *OpsgenieConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsgenieConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerdutyConfig) DeepCopyInto(out *PagerdutyConfig) {
 	*out = *in
@@ -6576,6 +8582,22 @@ func (in *PingProvider) DeepCopy() *PingProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedMaintenanceAction) DeepCopyInto(out *PlannedMaintenanceAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedMaintenanceAction.
+func (in *PlannedMaintenanceAction) DeepCopy() *PlannedMaintenanceAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedMaintenanceAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodRule) DeepCopyInto(out *PodRule) {
 	*out = *in
@@ -6981,6 +9003,85 @@ func (in *ProjectAlertGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertIncident) DeepCopyInto(out *ProjectAlertIncident) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertIncident.
+func (in *ProjectAlertIncident) DeepCopy() *ProjectAlertIncident {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertIncident)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertIncident) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertIncidentList) DeepCopyInto(out *ProjectAlertIncidentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAlertIncident, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertIncidentList.
+func (in *ProjectAlertIncidentList) DeepCopy() *ProjectAlertIncidentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertIncidentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertIncidentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertIncidentSpec) DeepCopyInto(out *ProjectAlertIncidentSpec) {
+	*out = *in
+	in.CommonIncidentField.DeepCopyInto(&out.CommonIncidentField)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertIncidentSpec.
+func (in *ProjectAlertIncidentSpec) DeepCopy() *ProjectAlertIncidentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertIncidentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectAlertList) DeepCopyInto(out *ProjectAlertList) {
 	*out = *in
@@ -7095,6 +9196,26 @@ func (in *ProjectAlertRuleSpec) DeepCopyInto(out *ProjectAlertRuleSpec) {
 		*out = new(MetricRule)
 		**out = **in
 	}
+	if in.VolumeRule != nil {
+		in, out := &in.VolumeRule, &out.VolumeRule
+		*out = new(VolumeRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateRule != nil {
+		in, out := &in.CertificateRule, &out.CertificateRule
+		*out = new(CertificateRule)
+		**out = **in
+	}
+	if in.JobRule != nil {
+		in, out := &in.JobRule, &out.JobRule
+		*out = new(JobRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HPARule != nil {
+		in, out := &in.HPARule, &out.HPARule
+		*out = new(HPARule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7108,6 +9229,85 @@ func (in *ProjectAlertRuleSpec) DeepCopy() *ProjectAlertRuleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertSilence) DeepCopyInto(out *ProjectAlertSilence) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertSilence.
+func (in *ProjectAlertSilence) DeepCopy() *ProjectAlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertSilence) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertSilenceList) DeepCopyInto(out *ProjectAlertSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertSilenceList.
+func (in *ProjectAlertSilenceList) DeepCopy() *ProjectAlertSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertSilenceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertSilenceSpec) DeepCopyInto(out *ProjectAlertSilenceSpec) {
+	*out = *in
+	in.CommonSilenceField.DeepCopyInto(&out.CommonSilenceField)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertSilenceSpec.
+func (in *ProjectAlertSilenceSpec) DeepCopy() *ProjectAlertSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectAlertSpec) DeepCopyInto(out *ProjectAlertSpec) {
 	*out = *in
@@ -7218,7 +9418,7 @@ func (in *ProjectGroupSpec) DeepCopyInto(out *ProjectGroupSpec) {
 		*out = make([]Recipient, len(*in))
 		copy(*out, *in)
 	}
-	out.CommonGroupField = in.CommonGroupField
+	in.CommonGroupField.DeepCopyInto(&out.CommonGroupField)
 	return
 }
 
@@ -7354,6 +9554,11 @@ func (in *ProjectLoggingStatus) DeepCopyInto(out *ProjectLoggingStatus) {
 		copy(*out, *in)
 	}
 	in.AppliedSpec.DeepCopyInto(&out.AppliedSpec)
+	if in.DroppedLines != nil {
+		in, out := &in.DroppedLines, &out.DroppedLines
+		*out = new(LoggingDroppedLines)
+		**out = **in
+	}
 	return
 }
 
@@ -7705,6 +9910,7 @@ func (in *ProjectTestInput) DeepCopyInto(out *ProjectTestInput) {
 			(*out)[key] = val
 		}
 	}
+	in.LoggingParsingTestInput.DeepCopyInto(&out.LoggingParsingTestInput)
 	return
 }
 
@@ -8840,6 +11046,22 @@ func (in *ShibbolethProvider) DeepCopy() *ShibbolethProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceMatcher) DeepCopyInto(out *SilenceMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceMatcher.
+func (in *SilenceMatcher) DeepCopy() *SilenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackConfig) DeepCopyInto(out *SlackConfig) {
 	*out = *in
@@ -9040,6 +11262,27 @@ func (in *TargetWorkload) DeepCopy() *TargetWorkload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelegramConfig) DeepCopyInto(out *TelegramConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegramConfig.
+func (in *TelegramConfig) DeepCopy() *TelegramConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TelegramConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
@@ -9445,6 +11688,22 @@ func (in *TokenList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyCondition) DeepCopyInto(out *UnhealthyCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyCondition.
+func (in *UnhealthyCondition) DeepCopy() *UnhealthyCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateGlobalDNSTargetsInput) DeepCopyInto(out *UpdateGlobalDNSTargetsInput) {
 	*out = *in
@@ -9494,6 +11753,38 @@ func (in *UpdateMultiClusterAppTargetsInput) DeepCopy() *UpdateMultiClusterAppTa
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePreflightChecks) DeepCopyInto(out *UpgradePreflightChecks) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePreflightChecks.
+func (in *UpgradePreflightChecks) DeepCopy() *UpgradePreflightChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePreflightChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePreflightResult) DeepCopyInto(out *UpgradePreflightResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePreflightResult.
+func (in *UpgradePreflightResult) DeepCopy() *UpgradePreflightResult {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePreflightResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
 	*out = *in
@@ -9749,6 +12040,29 @@ func (in *VersionCommits) DeepCopy() *VersionCommits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRule) DeepCopyInto(out *VolumeRule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRule.
+func (in *VolumeRule) DeepCopy() *VolumeRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
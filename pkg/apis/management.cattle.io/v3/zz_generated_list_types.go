@@ -281,6 +281,74 @@ func NewClusterAlertRule(namespace, name string, obj ClusterAlertRule) *ClusterA
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ClusterAlertSilenceList is a list of ClusterAlertSilence resources
+type ClusterAlertSilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterAlertSilence `json:"items"`
+}
+
+func NewClusterAlertSilence(namespace, name string, obj ClusterAlertSilence) *ClusterAlertSilence {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterAlertSilence").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAlertIncidentList is a list of ClusterAlertIncident resources
+type ClusterAlertIncidentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterAlertIncident `json:"items"`
+}
+
+func NewClusterAlertIncident(namespace, name string, obj ClusterAlertIncident) *ClusterAlertIncident {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterAlertIncident").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterGroupList is a list of ClusterGroup resources
+type ClusterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterGroup `json:"items"`
+}
+
+func NewClusterGroup(namespace, name string, obj ClusterGroup) *ClusterGroup {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterGroup").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterGroupRoleTemplateBindingList is a list of ClusterGroupRoleTemplateBinding resources
+type ClusterGroupRoleTemplateBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterGroupRoleTemplateBinding `json:"items"`
+}
+
+func NewClusterGroupRoleTemplateBinding(namespace, name string, obj ClusterGroupRoleTemplateBinding) *ClusterGroupRoleTemplateBinding {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterGroupRoleTemplateBinding").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ClusterLoggingList is a list of ClusterLogging resources
 type ClusterLoggingList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -400,6 +468,40 @@ func NewClusterTemplateRevision(namespace, name string, obj ClusterTemplateRevis
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ClusterTemplateRolloutList is a list of ClusterTemplateRollout resources
+type ClusterTemplateRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterTemplateRollout `json:"items"`
+}
+
+func NewClusterTemplateRollout(namespace, name string, obj ClusterTemplateRollout) *ClusterTemplateRollout {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterTemplateRollout").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterUpgradePlanList is a list of ClusterUpgradePlan resources
+type ClusterUpgradePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ClusterUpgradePlan `json:"items"`
+}
+
+func NewClusterUpgradePlan(namespace, name string, obj ClusterUpgradePlan) *ClusterUpgradePlan {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ClusterUpgradePlan").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ComposeConfigList is a list of ComposeConfig resources
 type ComposeConfigList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -808,6 +910,23 @@ func NewNotifier(namespace, name string, obj Notifier) *Notifier {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// OnCallScheduleList is a list of OnCallSchedule resources
+type OnCallScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OnCallSchedule `json:"items"`
+}
+
+func NewOnCallSchedule(namespace, name string, obj OnCallSchedule) *OnCallSchedule {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("OnCallSchedule").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // OpenLdapProviderList is a list of OpenLdapProvider resources
 type OpenLdapProviderList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -961,6 +1080,40 @@ func NewProjectAlertRule(namespace, name string, obj ProjectAlertRule) *ProjectA
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ProjectAlertSilenceList is a list of ProjectAlertSilence resources
+type ProjectAlertSilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ProjectAlertSilence `json:"items"`
+}
+
+func NewProjectAlertSilence(namespace, name string, obj ProjectAlertSilence) *ProjectAlertSilence {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ProjectAlertSilence").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectAlertIncidentList is a list of ProjectAlertIncident resources
+type ProjectAlertIncidentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ProjectAlertIncident `json:"items"`
+}
+
+func NewProjectAlertIncident(namespace, name string, obj ProjectAlertIncident) *ProjectAlertIncident {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ProjectAlertIncident").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ProjectLoggingList is a list of ProjectLogging resources
 type ProjectLoggingList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -43,7 +43,11 @@
 	ClusterAlertResourceName                            = "clusteralerts"
 	ClusterAlertGroupResourceName                       = "clusteralertgroups"
 	ClusterAlertRuleResourceName                        = "clusteralertrules"
+	ClusterAlertSilenceResourceName                     = "clusteralertsilences"
+	ClusterAlertIncidentResourceName                    = "clusteralertincidents"
 	ClusterCatalogResourceName                          = "clustercatalogs"
+	ClusterGroupResourceName                            = "clustergroups"
+	ClusterGroupRoleTemplateBindingResourceName         = "clustergrouproletemplatebindings"
 	ClusterLoggingResourceName                          = "clusterloggings"
 	ClusterMonitorGraphResourceName                     = "clustermonitorgraphs"
 	ClusterRegistrationTokenResourceName                = "clusterregistrationtokens"
@@ -51,6 +55,8 @@
 	ClusterScanResourceName                             = "clusterscans"
 	ClusterTemplateResourceName                         = "clustertemplates"
 	ClusterTemplateRevisionResourceName                 = "clustertemplaterevisions"
+	ClusterTemplateRolloutResourceName                  = "clustertemplaterollouts"
+	ClusterUpgradePlanResourceName                      = "clusterupgradeplans"
 	ComposeConfigResourceName                           = "composeconfigs"
 	DynamicSchemaResourceName                           = "dynamicschemas"
 	EtcdBackupResourceName                              = "etcdbackups"
@@ -75,6 +81,7 @@
 	NodePoolResourceName                                = "nodepools"
 	NodeTemplateResourceName                            = "nodetemplates"
 	NotifierResourceName                                = "notifiers"
+	OnCallScheduleResourceName                          = "oncallschedules"
 	OpenLdapProviderResourceName                        = "openldapproviders"
 	PodSecurityPolicyTemplateResourceName               = "podsecuritypolicytemplates"
 	PodSecurityPolicyTemplateProjectBindingResourceName = "podsecuritypolicytemplateprojectbindings"
@@ -84,6 +91,8 @@
 	ProjectAlertResourceName                            = "projectalerts"
 	ProjectAlertGroupResourceName                       = "projectalertgroups"
 	ProjectAlertRuleResourceName                        = "projectalertrules"
+	ProjectAlertSilenceResourceName                     = "projectalertsilences"
+	ProjectAlertIncidentResourceName                    = "projectalertincidents"
 	ProjectCatalogResourceName                          = "projectcatalogs"
 	ProjectLoggingResourceName                          = "projectloggings"
 	ProjectMonitorGraphResourceName                     = "projectmonitorgraphs"
@@ -155,8 +164,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAlertGroupList{},
 		&ClusterAlertRule{},
 		&ClusterAlertRuleList{},
+		&ClusterAlertSilence{},
+		&ClusterAlertSilenceList{},
+		&ClusterAlertIncident{},
+		&ClusterAlertIncidentList{},
 		&ClusterCatalog{},
 		&ClusterCatalogList{},
+		&ClusterGroup{},
+		&ClusterGroupList{},
+		&ClusterGroupRoleTemplateBinding{},
+		&ClusterGroupRoleTemplateBindingList{},
 		&ClusterLogging{},
 		&ClusterLoggingList{},
 		&ClusterMonitorGraph{},
@@ -171,6 +188,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterTemplateList{},
 		&ClusterTemplateRevision{},
 		&ClusterTemplateRevisionList{},
+		&ClusterTemplateRollout{},
+		&ClusterTemplateRolloutList{},
+		&ClusterUpgradePlan{},
+		&ClusterUpgradePlanList{},
 		&ComposeConfig{},
 		&ComposeConfigList{},
 		&DynamicSchema{},
@@ -219,6 +240,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeTemplateList{},
 		&Notifier{},
 		&NotifierList{},
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
 		&OpenLdapProvider{},
 		&OpenLdapProviderList{},
 		&PodSecurityPolicyTemplate{},
@@ -237,6 +260,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectAlertGroupList{},
 		&ProjectAlertRule{},
 		&ProjectAlertRuleList{},
+		&ProjectAlertSilence{},
+		&ProjectAlertSilenceList{},
+		&ProjectAlertIncident{},
+		&ProjectAlertIncidentList{},
 		&ProjectCatalog{},
 		&ProjectCatalogList{},
 		&ProjectLogging{},
@@ -0,0 +1,16 @@
+package client
+
+const (
+	AlertIncidentStatusType               = "alertIncidentStatus"
+	AlertIncidentStatusFieldIncidentState = "incidentState"
+	AlertIncidentStatusFieldNotifierIDs   = "notifierIds"
+	AlertIncidentStatusFieldResolvedAt    = "resolvedAt"
+	AlertIncidentStatusFieldTimeline      = "timeline"
+)
+
+type AlertIncidentStatus struct {
+	IncidentState string          `json:"incidentState,omitempty" yaml:"incidentState,omitempty"`
+	NotifierIDs   []string        `json:"notifierIds,omitempty" yaml:"notifierIds,omitempty"`
+	ResolvedAt    string          `json:"resolvedAt,omitempty" yaml:"resolvedAt,omitempty"`
+	Timeline      []IncidentEvent `json:"timeline,omitempty" yaml:"timeline,omitempty"`
+}
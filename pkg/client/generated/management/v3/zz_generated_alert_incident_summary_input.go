@@ -0,0 +1,14 @@
+package client
+
+const (
+	AlertIncidentSummaryInputType           = "alertIncidentSummaryInput"
+	AlertIncidentSummaryInputFieldClusterID = "clusterId"
+	AlertIncidentSummaryInputFieldProjectID = "projectId"
+	AlertIncidentSummaryInputFieldSince     = "since"
+)
+
+type AlertIncidentSummaryInput struct {
+	ClusterID string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	ProjectID string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Since     string `json:"since,omitempty" yaml:"since,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	AlertIncidentSummaryOutputType       = "alertIncidentSummaryOutput"
+	AlertIncidentSummaryOutputFieldRules = "rules"
+)
+
+type AlertIncidentSummaryOutput struct {
+	Rules []AlertRuleSummary `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
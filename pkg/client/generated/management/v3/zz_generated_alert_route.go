@@ -0,0 +1,22 @@
+package client
+
+const (
+	AlertRouteType             = "alertRoute"
+	AlertRouteFieldContinue    = "continue"
+	AlertRouteFieldEscalations = "escalations"
+	AlertRouteFieldMatch       = "match"
+	AlertRouteFieldMatchRegex  = "matchRegex"
+	AlertRouteFieldName        = "name"
+	AlertRouteFieldRecipients  = "recipients"
+	AlertRouteFieldSeverities  = "severities"
+)
+
+type AlertRoute struct {
+	Continue    bool              `json:"continue,omitempty" yaml:"continue,omitempty"`
+	Escalations []AlertEscalation `json:"escalations,omitempty" yaml:"escalations,omitempty"`
+	Match       map[string]string `json:"match,omitempty" yaml:"match,omitempty"`
+	MatchRegex  map[string]string `json:"matchRegex,omitempty" yaml:"matchRegex,omitempty"`
+	Name        string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Recipients  []Recipient       `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Severities  []string          `json:"severities,omitempty" yaml:"severities,omitempty"`
+}
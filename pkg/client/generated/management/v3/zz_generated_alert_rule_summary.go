@@ -0,0 +1,22 @@
+package client
+
+const (
+	AlertRuleSummaryType                   = "alertRuleSummary"
+	AlertRuleSummaryFieldDisplayName       = "displayName"
+	AlertRuleSummaryFieldFired             = "fired"
+	AlertRuleSummaryFieldFiring            = "firing"
+	AlertRuleSummaryFieldLastFiredAt       = "lastFiredAt"
+	AlertRuleSummaryFieldMeanTimeToResolve = "meanTimeToResolveSeconds"
+	AlertRuleSummaryFieldResolved          = "resolved"
+	AlertRuleSummaryFieldRuleName          = "ruleName"
+)
+
+type AlertRuleSummary struct {
+	DisplayName       string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Fired             int64  `json:"fired,omitempty" yaml:"fired,omitempty"`
+	Firing            int64  `json:"firing,omitempty" yaml:"firing,omitempty"`
+	LastFiredAt       string `json:"lastFiredAt,omitempty" yaml:"lastFiredAt,omitempty"`
+	MeanTimeToResolve int64  `json:"meanTimeToResolveSeconds,omitempty" yaml:"meanTimeToResolveSeconds,omitempty"`
+	Resolved          int64  `json:"resolved,omitempty" yaml:"resolved,omitempty"`
+	RuleName          string `json:"ruleName,omitempty" yaml:"ruleName,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	AlertSilenceStatusType              = "alertSilenceStatus"
+	AlertSilenceStatusFieldExpiredAt    = "expiredAt"
+	AlertSilenceStatusFieldSilenceID    = "silenceId"
+	AlertSilenceStatusFieldSilenceState = "silenceState"
+)
+
+type AlertSilenceStatus struct {
+	ExpiredAt    string `json:"expiredAt,omitempty" yaml:"expiredAt,omitempty"`
+	SilenceID    string `json:"silenceId,omitempty" yaml:"silenceId,omitempty"`
+	SilenceState string `json:"silenceState,omitempty" yaml:"silenceState,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	CapacityForecastType                      = "capacityForecast"
+	CapacityForecastFieldCPUExhaustion        = "cpuExhaustion"
+	CapacityForecastFieldCPURequestsPerDay    = "cpuRequestsPerDay"
+	CapacityForecastFieldMemoryExhaustion     = "memoryExhaustion"
+	CapacityForecastFieldMemoryRequestsPerDay = "memoryRequestsPerDay"
+)
+
+type CapacityForecast struct {
+	CPUExhaustion        string `json:"cpuExhaustion,omitempty" yaml:"cpuExhaustion,omitempty"`
+	CPURequestsPerDay    int64  `json:"cpuRequestsPerDay,omitempty" yaml:"cpuRequestsPerDay,omitempty"`
+	MemoryExhaustion     string `json:"memoryExhaustion,omitempty" yaml:"memoryExhaustion,omitempty"`
+	MemoryRequestsPerDay int64  `json:"memoryRequestsPerDay,omitempty" yaml:"memoryRequestsPerDay,omitempty"`
+}
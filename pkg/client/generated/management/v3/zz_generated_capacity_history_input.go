@@ -0,0 +1,16 @@
+package client
+
+const (
+	CapacityHistoryInputType              = "capacityHistoryInput"
+	CapacityHistoryInputFieldEnd          = "end"
+	CapacityHistoryInputFieldNodePoolName = "nodePoolId"
+	CapacityHistoryInputFieldStart        = "start"
+	CapacityHistoryInputFieldStepSeconds  = "stepSeconds"
+)
+
+type CapacityHistoryInput struct {
+	End          string `json:"end,omitempty" yaml:"end,omitempty"`
+	NodePoolName string `json:"nodePoolId,omitempty" yaml:"nodePoolId,omitempty"`
+	Start        string `json:"start,omitempty" yaml:"start,omitempty"`
+	StepSeconds  int64  `json:"stepSeconds,omitempty" yaml:"stepSeconds,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	CapacityHistoryOutputType          = "capacityHistoryOutput"
+	CapacityHistoryOutputFieldForecast = "forecast"
+	CapacityHistoryOutputFieldSamples  = "samples"
+)
+
+type CapacityHistoryOutput struct {
+	Forecast *CapacityForecast `json:"forecast,omitempty" yaml:"forecast,omitempty"`
+	Samples  []CapacitySample  `json:"samples,omitempty" yaml:"samples,omitempty"`
+}
@@ -0,0 +1,26 @@
+package client
+
+const (
+	CapacitySampleType                   = "capacitySample"
+	CapacitySampleFieldCPUAllocatable    = "cpuAllocatable"
+	CapacitySampleFieldCPULimits         = "cpuLimits"
+	CapacitySampleFieldCPURequested      = "cpuRequested"
+	CapacitySampleFieldMemoryAllocatable = "memoryAllocatable"
+	CapacitySampleFieldMemoryLimits      = "memoryLimits"
+	CapacitySampleFieldMemoryRequested   = "memoryRequested"
+	CapacitySampleFieldPodsAllocatable   = "podsAllocatable"
+	CapacitySampleFieldPodsRequested     = "podsRequested"
+	CapacitySampleFieldTimestamp         = "timestamp"
+)
+
+type CapacitySample struct {
+	CPUAllocatable    int64  `json:"cpuAllocatable,omitempty" yaml:"cpuAllocatable,omitempty"`
+	CPULimits         int64  `json:"cpuLimits,omitempty" yaml:"cpuLimits,omitempty"`
+	CPURequested      int64  `json:"cpuRequested,omitempty" yaml:"cpuRequested,omitempty"`
+	MemoryAllocatable int64  `json:"memoryAllocatable,omitempty" yaml:"memoryAllocatable,omitempty"`
+	MemoryLimits      int64  `json:"memoryLimits,omitempty" yaml:"memoryLimits,omitempty"`
+	MemoryRequested   int64  `json:"memoryRequested,omitempty" yaml:"memoryRequested,omitempty"`
+	PodsAllocatable   int64  `json:"podsAllocatable,omitempty" yaml:"podsAllocatable,omitempty"`
+	PodsRequested     int64  `json:"podsRequested,omitempty" yaml:"podsRequested,omitempty"`
+	Timestamp         string `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+}
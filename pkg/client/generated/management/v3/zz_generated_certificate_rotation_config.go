@@ -0,0 +1,20 @@
+package client
+
+const (
+	CertificateRotationConfigType                      = "certificateRotationConfig"
+	CertificateRotationConfigFieldCACertificates       = "caCertificates"
+	CertificateRotationConfigFieldDaysBeforeExpiration = "daysBeforeExpiration"
+	CertificateRotationConfigFieldEnabled              = "enabled"
+	CertificateRotationConfigFieldMaxRetries           = "maxRetries"
+	CertificateRotationConfigFieldRecipients           = "recipients"
+	CertificateRotationConfigFieldWindow               = "window"
+)
+
+type CertificateRotationConfig struct {
+	CACertificates       bool                       `json:"caCertificates,omitempty" yaml:"caCertificates,omitempty"`
+	DaysBeforeExpiration int64                      `json:"daysBeforeExpiration,omitempty" yaml:"daysBeforeExpiration,omitempty"`
+	Enabled              bool                       `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	MaxRetries           int64                      `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+	Recipients           []Recipient                `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Window               *CertificateRotationWindow `json:"window,omitempty" yaml:"window,omitempty"`
+}
@@ -0,0 +1,22 @@
+package client
+
+const (
+	CertificateRotationStatusType                    = "certificateRotationStatus"
+	CertificateRotationStatusFieldEarliestExpiration = "earliestExpiration"
+	CertificateRotationStatusFieldFailedAttempts     = "failedAttempts"
+	CertificateRotationStatusFieldLastFailure        = "lastFailure"
+	CertificateRotationStatusFieldLastFailureTime    = "lastFailureTime"
+	CertificateRotationStatusFieldLastRotation       = "lastRotation"
+	CertificateRotationStatusFieldNextRotation       = "nextRotation"
+	CertificateRotationStatusFieldRotationStarted    = "rotationStarted"
+)
+
+type CertificateRotationStatus struct {
+	EarliestExpiration string `json:"earliestExpiration,omitempty" yaml:"earliestExpiration,omitempty"`
+	FailedAttempts     int64  `json:"failedAttempts,omitempty" yaml:"failedAttempts,omitempty"`
+	LastFailure        string `json:"lastFailure,omitempty" yaml:"lastFailure,omitempty"`
+	LastFailureTime    string `json:"lastFailureTime,omitempty" yaml:"lastFailureTime,omitempty"`
+	LastRotation       string `json:"lastRotation,omitempty" yaml:"lastRotation,omitempty"`
+	NextRotation       string `json:"nextRotation,omitempty" yaml:"nextRotation,omitempty"`
+	RotationStarted    string `json:"rotationStarted,omitempty" yaml:"rotationStarted,omitempty"`
+}
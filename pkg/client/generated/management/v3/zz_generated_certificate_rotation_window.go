@@ -0,0 +1,12 @@
+package client
+
+const (
+	CertificateRotationWindowType                 = "certificateRotationWindow"
+	CertificateRotationWindowFieldCronSchedule    = "cronSchedule"
+	CertificateRotationWindowFieldDurationMinutes = "durationMinutes"
+)
+
+type CertificateRotationWindow struct {
+	CronSchedule    string `json:"cronSchedule,omitempty" yaml:"cronSchedule,omitempty"`
+	DurationMinutes int64  `json:"durationMinutes,omitempty" yaml:"durationMinutes,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	CertificateRuleType                 = "certificateRule"
+	CertificateRuleFieldCertificateName = "certificateName"
+	CertificateRuleFieldExpiresInDays   = "expiresInDays"
+	CertificateRuleFieldIngressOnly     = "ingressOnly"
+)
+
+type CertificateRule struct {
+	CertificateName string `json:"certificateName,omitempty" yaml:"certificateName,omitempty"`
+	ExpiresInDays   int64  `json:"expiresInDays,omitempty" yaml:"expiresInDays,omitempty"`
+	IngressOnly     bool   `json:"ingressOnly,omitempty" yaml:"ingressOnly,omitempty"`
+}
@@ -45,10 +45,15 @@ type Client struct {
 	ClusterAlert                            ClusterAlertOperations
 	ProjectAlert                            ProjectAlertOperations
 	Notifier                                NotifierOperations
+	OnCallSchedule                          OnCallScheduleOperations
 	ClusterAlertGroup                       ClusterAlertGroupOperations
 	ProjectAlertGroup                       ProjectAlertGroupOperations
 	ClusterAlertRule                        ClusterAlertRuleOperations
+	ClusterAlertSilence                     ClusterAlertSilenceOperations
+	ClusterAlertIncident                    ClusterAlertIncidentOperations
 	ProjectAlertRule                        ProjectAlertRuleOperations
+	ProjectAlertSilence                     ProjectAlertSilenceOperations
+	ProjectAlertIncident                    ProjectAlertIncidentOperations
 	ComposeConfig                           ComposeConfigOperations
 	ProjectCatalog                          ProjectCatalogOperations
 	ClusterCatalog                          ClusterCatalogOperations
@@ -58,6 +63,7 @@ type Client struct {
 	GlobalDnsProvider                       GlobalDnsProviderOperations
 	KontainerDriver                         KontainerDriverOperations
 	EtcdBackup                              EtcdBackupOperations
+	ClusterUpgradePlan                      ClusterUpgradePlanOperations
 	ClusterScan                             ClusterScanOperations
 	MonitorMetric                           MonitorMetricOperations
 	ClusterMonitorGraph                     ClusterMonitorGraphOperations
@@ -66,6 +72,9 @@ type Client struct {
 	ManagementSecret                        ManagementSecretOperations
 	ClusterTemplate                         ClusterTemplateOperations
 	ClusterTemplateRevision                 ClusterTemplateRevisionOperations
+	ClusterTemplateRollout                  ClusterTemplateRolloutOperations
+	ClusterGroup                            ClusterGroupOperations
+	ClusterGroupRoleTemplateBinding         ClusterGroupRoleTemplateBindingOperations
 	RkeK8sSystemImage                       RkeK8sSystemImageOperations
 	RkeK8sServiceOption                     RkeK8sServiceOptionOperations
 	RkeAddon                                RkeAddonOperations
@@ -121,10 +130,15 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.ClusterAlert = newClusterAlertClient(client)
 	client.ProjectAlert = newProjectAlertClient(client)
 	client.Notifier = newNotifierClient(client)
+	client.OnCallSchedule = newOnCallScheduleClient(client)
 	client.ClusterAlertGroup = newClusterAlertGroupClient(client)
 	client.ProjectAlertGroup = newProjectAlertGroupClient(client)
 	client.ClusterAlertRule = newClusterAlertRuleClient(client)
+	client.ClusterAlertSilence = newClusterAlertSilenceClient(client)
+	client.ClusterAlertIncident = newClusterAlertIncidentClient(client)
 	client.ProjectAlertRule = newProjectAlertRuleClient(client)
+	client.ProjectAlertSilence = newProjectAlertSilenceClient(client)
+	client.ProjectAlertIncident = newProjectAlertIncidentClient(client)
 	client.ComposeConfig = newComposeConfigClient(client)
 	client.ProjectCatalog = newProjectCatalogClient(client)
 	client.ClusterCatalog = newClusterCatalogClient(client)
@@ -134,6 +148,7 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.GlobalDnsProvider = newGlobalDnsProviderClient(client)
 	client.KontainerDriver = newKontainerDriverClient(client)
 	client.EtcdBackup = newEtcdBackupClient(client)
+	client.ClusterUpgradePlan = newClusterUpgradePlanClient(client)
 	client.ClusterScan = newClusterScanClient(client)
 	client.MonitorMetric = newMonitorMetricClient(client)
 	client.ClusterMonitorGraph = newClusterMonitorGraphClient(client)
@@ -142,6 +157,9 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.ManagementSecret = newManagementSecretClient(client)
 	client.ClusterTemplate = newClusterTemplateClient(client)
 	client.ClusterTemplateRevision = newClusterTemplateRevisionClient(client)
+	client.ClusterTemplateRollout = newClusterTemplateRolloutClient(client)
+	client.ClusterGroup = newClusterGroupClient(client)
+	client.ClusterGroupRoleTemplateBinding = newClusterGroupRoleTemplateBindingClient(client)
 	client.RkeK8sSystemImage = newRkeK8sSystemImageClient(client)
 	client.RkeK8sServiceOption = newRkeK8sServiceOptionClient(client)
 	client.RkeAddon = newRkeAddonClient(client)
@@ -19,6 +19,8 @@
 	ClusterFieldCACert                               = "caCert"
 	ClusterFieldCapabilities                         = "capabilities"
 	ClusterFieldCapacity                             = "capacity"
+	ClusterFieldCertificateRotation                  = "certificateRotation"
+	ClusterFieldCertificateRotationStatus            = "certificateRotationStatus"
 	ClusterFieldCertificatesExpiration               = "certificatesExpiration"
 	ClusterFieldClusterTemplateAnswers               = "answers"
 	ClusterFieldClusterTemplateID                    = "clusterTemplateId"
@@ -29,6 +31,8 @@
 	ClusterFieldCreated                              = "created"
 	ClusterFieldCreatorID                            = "creatorId"
 	ClusterFieldCurrentCisRunName                    = "currentCisRunName"
+	ClusterFieldDecommission                         = "decommission"
+	ClusterFieldDecommissionStatus                   = "decommissionStatus"
 	ClusterFieldDefaultClusterRoleForProjectMembers  = "defaultClusterRoleForProjectMembers"
 	ClusterFieldDefaultPodSecurityPolicyTemplateID   = "defaultPodSecurityPolicyTemplateId"
 	ClusterFieldDescription                          = "description"
@@ -50,6 +54,8 @@
 	ClusterFieldLabels                               = "labels"
 	ClusterFieldLimits                               = "limits"
 	ClusterFieldLocalClusterAuthEndpoint             = "localClusterAuthEndpoint"
+	ClusterFieldMaintenanceStatus                    = "maintenanceStatus"
+	ClusterFieldMaintenanceWindow                    = "maintenanceWindow"
 	ClusterFieldMonitoringStatus                     = "monitoringStatus"
 	ClusterFieldName                                 = "name"
 	ClusterFieldNodeCount                            = "nodeCount"
@@ -85,6 +91,8 @@ type Cluster struct {
 	CACert                               string                         `json:"caCert,omitempty" yaml:"caCert,omitempty"`
 	Capabilities                         *Capabilities                  `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
 	Capacity                             map[string]string              `json:"capacity,omitempty" yaml:"capacity,omitempty"`
+	CertificateRotation                  *CertificateRotationConfig     `json:"certificateRotation,omitempty" yaml:"certificateRotation,omitempty"`
+	CertificateRotationStatus            *CertificateRotationStatus     `json:"certificateRotationStatus,omitempty" yaml:"certificateRotationStatus,omitempty"`
 	CertificatesExpiration               map[string]CertExpiration      `json:"certificatesExpiration,omitempty" yaml:"certificatesExpiration,omitempty"`
 	ClusterTemplateAnswers               *Answer                        `json:"answers,omitempty" yaml:"answers,omitempty"`
 	ClusterTemplateID                    string                         `json:"clusterTemplateId,omitempty" yaml:"clusterTemplateId,omitempty"`
@@ -95,6 +103,8 @@ type Cluster struct {
 	Created                              string                         `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID                            string                         `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	CurrentCisRunName                    string                         `json:"currentCisRunName,omitempty" yaml:"currentCisRunName,omitempty"`
+	Decommission                         *DecommissionConfig            `json:"decommission,omitempty" yaml:"decommission,omitempty"`
+	DecommissionStatus                   *DecommissionStatus            `json:"decommissionStatus,omitempty" yaml:"decommissionStatus,omitempty"`
 	DefaultClusterRoleForProjectMembers  string                         `json:"defaultClusterRoleForProjectMembers,omitempty" yaml:"defaultClusterRoleForProjectMembers,omitempty"`
 	DefaultPodSecurityPolicyTemplateID   string                         `json:"defaultPodSecurityPolicyTemplateId,omitempty" yaml:"defaultPodSecurityPolicyTemplateId,omitempty"`
 	Description                          string                         `json:"description,omitempty" yaml:"description,omitempty"`
@@ -116,6 +126,8 @@ type Cluster struct {
 	Labels                               map[string]string              `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Limits                               map[string]string              `json:"limits,omitempty" yaml:"limits,omitempty"`
 	LocalClusterAuthEndpoint             *LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty" yaml:"localClusterAuthEndpoint,omitempty"`
+	MaintenanceStatus                    *MaintenanceStatus             `json:"maintenanceStatus,omitempty" yaml:"maintenanceStatus,omitempty"`
+	MaintenanceWindow                    *MaintenanceWindow             `json:"maintenanceWindow,omitempty" yaml:"maintenanceWindow,omitempty"`
 	MonitoringStatus                     *MonitoringStatus              `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
 	Name                                 string                         `json:"name,omitempty" yaml:"name,omitempty"`
 	NodeCount                            int64                          `json:"nodeCount,omitempty" yaml:"nodeCount,omitempty"`
@@ -157,6 +169,10 @@ type ClusterOperations interface {
 
 	ActionBackupEtcd(resource *Cluster) error
 
+	ActionCapacityHistory(resource *Cluster, input *CapacityHistoryInput) (*CapacityHistoryOutput, error)
+
+	ActionDecommission(resource *Cluster, input *DecommissionConfig) error
+
 	ActionDisableMonitoring(resource *Cluster) error
 
 	ActionEditMonitoring(resource *Cluster, input *MonitoringInput) error
@@ -254,6 +270,17 @@ func (c *ClusterClient) ActionBackupEtcd(resource *Cluster) error {
 	return err
 }
 
+func (c *ClusterClient) ActionCapacityHistory(resource *Cluster, input *CapacityHistoryInput) (*CapacityHistoryOutput, error) {
+	resp := &CapacityHistoryOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "capacityHistory", &resource.Resource, input, resp)
+	return resp, err
+}
+
+func (c *ClusterClient) ActionDecommission(resource *Cluster, input *DecommissionConfig) error {
+	err := c.apiClient.Ops.DoAction(ClusterType, "decommission", &resource.Resource, input, nil)
+	return err
+}
+
 func (c *ClusterClient) ActionDisableMonitoring(resource *Cluster) error {
 	err := c.apiClient.Ops.DoAction(ClusterType, "disableMonitoring", &resource.Resource, nil, nil)
 	return err
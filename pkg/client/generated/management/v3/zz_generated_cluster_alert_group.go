@@ -8,6 +8,7 @@
 	ClusterAlertGroupType                       = "clusterAlertGroup"
 	ClusterAlertGroupFieldAlertState            = "alertState"
 	ClusterAlertGroupFieldAnnotations           = "annotations"
+	ClusterAlertGroupFieldClusterGroupID        = "clusterGroupId"
 	ClusterAlertGroupFieldClusterID             = "clusterId"
 	ClusterAlertGroupFieldCreated               = "created"
 	ClusterAlertGroupFieldCreatorID             = "creatorId"
@@ -21,7 +22,9 @@
 	ClusterAlertGroupFieldRecipients            = "recipients"
 	ClusterAlertGroupFieldRemoved               = "removed"
 	ClusterAlertGroupFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ClusterAlertGroupFieldRoutes                = "routes"
 	ClusterAlertGroupFieldState                 = "state"
+	ClusterAlertGroupFieldTemplate              = "template"
 	ClusterAlertGroupFieldTransitioning         = "transitioning"
 	ClusterAlertGroupFieldTransitioningMessage  = "transitioningMessage"
 	ClusterAlertGroupFieldUUID                  = "uuid"
@@ -29,25 +32,28 @@
 
 type ClusterAlertGroup struct {
 	types.Resource
-	AlertState            string            `json:"alertState,omitempty" yaml:"alertState,omitempty"`
-	Annotations           map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterID             string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description           string            `json:"description,omitempty" yaml:"description,omitempty"`
-	GroupIntervalSeconds  int64             `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64             `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name                  string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId           string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences       []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	Recipients            []Recipient       `json:"recipients,omitempty" yaml:"recipients,omitempty"`
-	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	RepeatIntervalSeconds int64             `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
-	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
-	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage  string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                  string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	AlertState            string                `json:"alertState,omitempty" yaml:"alertState,omitempty"`
+	Annotations           map[string]string     `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterGroupID        string                `json:"clusterGroupId,omitempty" yaml:"clusterGroupId,omitempty"`
+	ClusterID             string                `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created               string                `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID             string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description           string                `json:"description,omitempty" yaml:"description,omitempty"`
+	GroupIntervalSeconds  int64                 `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64                 `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	Labels                map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                  string                `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId           string                `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences       []OwnerReference      `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Recipients            []Recipient           `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Removed               string                `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RepeatIntervalSeconds int64                 `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute          `json:"routes,omitempty" yaml:"routes,omitempty"`
+	State                 string                `json:"state,omitempty" yaml:"state,omitempty"`
+	Template              *NotificationTemplate `json:"template,omitempty" yaml:"template,omitempty"`
+	Transitioning         string                `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage  string                `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                  string                `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 
 type ClusterAlertGroupCollection struct {
@@ -68,6 +74,8 @@ type ClusterAlertGroupOperations interface {
 	Replace(existing *ClusterAlertGroup) (*ClusterAlertGroup, error)
 	ByID(id string) (*ClusterAlertGroup, error)
 	Delete(container *ClusterAlertGroup) error
+
+	ActionPreview(resource *ClusterAlertGroup, input *NotificationPreviewInput) (*NotificationPreviewOutput, error)
 }
 
 func newClusterAlertGroupClient(apiClient *Client) *ClusterAlertGroupClient {
@@ -138,3 +146,9 @@ func (c *ClusterAlertGroupClient) ByID(id string) (*ClusterAlertGroup, error) {
 func (c *ClusterAlertGroupClient) Delete(container *ClusterAlertGroup) error {
 	return c.apiClient.Ops.DoResourceDelete(ClusterAlertGroupType, &container.Resource)
 }
+
+func (c *ClusterAlertGroupClient) ActionPreview(resource *ClusterAlertGroup, input *NotificationPreviewInput) (*NotificationPreviewOutput, error) {
+	resp := &NotificationPreviewOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterAlertGroupType, "preview", &resource.Resource, input, resp)
+	return resp, err
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterAlertIncidentType                      = "clusterAlertIncident"
+	ClusterAlertIncidentFieldAlertLabels          = "alertLabels"
+	ClusterAlertIncidentFieldAlertType            = "alertType"
+	ClusterAlertIncidentFieldAnnotations          = "annotations"
+	ClusterAlertIncidentFieldClusterID            = "clusterId"
+	ClusterAlertIncidentFieldCreated              = "created"
+	ClusterAlertIncidentFieldCreatorID            = "creatorId"
+	ClusterAlertIncidentFieldDisplayName          = "displayName"
+	ClusterAlertIncidentFieldFingerprint          = "fingerprint"
+	ClusterAlertIncidentFieldFiredAt              = "firedAt"
+	ClusterAlertIncidentFieldGroupID              = "groupId"
+	ClusterAlertIncidentFieldIncidentState        = "incidentState"
+	ClusterAlertIncidentFieldLabels               = "labels"
+	ClusterAlertIncidentFieldName                 = "name"
+	ClusterAlertIncidentFieldNamespaceId          = "namespaceId"
+	ClusterAlertIncidentFieldNotifierIDs          = "notifierIds"
+	ClusterAlertIncidentFieldOwnerReferences      = "ownerReferences"
+	ClusterAlertIncidentFieldRemoved              = "removed"
+	ClusterAlertIncidentFieldResolvedAt           = "resolvedAt"
+	ClusterAlertIncidentFieldRuleID               = "ruleId"
+	ClusterAlertIncidentFieldSeverity             = "severity"
+	ClusterAlertIncidentFieldState                = "state"
+	ClusterAlertIncidentFieldTimeline             = "timeline"
+	ClusterAlertIncidentFieldTransitioning        = "transitioning"
+	ClusterAlertIncidentFieldTransitioningMessage = "transitioningMessage"
+	ClusterAlertIncidentFieldUUID                 = "uuid"
+)
+
+type ClusterAlertIncident struct {
+	types.Resource
+	AlertLabels          map[string]string `json:"alertLabels,omitempty" yaml:"alertLabels,omitempty"`
+	AlertType            string            `json:"alertType,omitempty" yaml:"alertType,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DisplayName          string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Fingerprint          string            `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	FiredAt              string            `json:"firedAt,omitempty" yaml:"firedAt,omitempty"`
+	GroupID              string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	IncidentState        string            `json:"incidentState,omitempty" yaml:"incidentState,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NotifierIDs          []string          `json:"notifierIds,omitempty" yaml:"notifierIds,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ResolvedAt           string            `json:"resolvedAt,omitempty" yaml:"resolvedAt,omitempty"`
+	RuleID               string            `json:"ruleId,omitempty" yaml:"ruleId,omitempty"`
+	Severity             string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Timeline             []IncidentEvent   `json:"timeline,omitempty" yaml:"timeline,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ClusterAlertIncidentCollection struct {
+	types.Collection
+	Data   []ClusterAlertIncident `json:"data,omitempty"`
+	client *ClusterAlertIncidentClient
+}
+
+type ClusterAlertIncidentClient struct {
+	apiClient *Client
+}
+
+type ClusterAlertIncidentOperations interface {
+	List(opts *types.ListOpts) (*ClusterAlertIncidentCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterAlertIncidentCollection, error)
+	Create(opts *ClusterAlertIncident) (*ClusterAlertIncident, error)
+	Update(existing *ClusterAlertIncident, updates interface{}) (*ClusterAlertIncident, error)
+	Replace(existing *ClusterAlertIncident) (*ClusterAlertIncident, error)
+	ByID(id string) (*ClusterAlertIncident, error)
+	Delete(container *ClusterAlertIncident) error
+
+	CollectionActionSummary(resource *ClusterAlertIncidentCollection, input *AlertIncidentSummaryInput) (*AlertIncidentSummaryOutput, error)
+}
+
+func newClusterAlertIncidentClient(apiClient *Client) *ClusterAlertIncidentClient {
+	return &ClusterAlertIncidentClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterAlertIncidentClient) Create(container *ClusterAlertIncident) (*ClusterAlertIncident, error) {
+	resp := &ClusterAlertIncident{}
+	err := c.apiClient.Ops.DoCreate(ClusterAlertIncidentType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertIncidentClient) Update(existing *ClusterAlertIncident, updates interface{}) (*ClusterAlertIncident, error) {
+	resp := &ClusterAlertIncident{}
+	err := c.apiClient.Ops.DoUpdate(ClusterAlertIncidentType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertIncidentClient) Replace(obj *ClusterAlertIncident) (*ClusterAlertIncident, error) {
+	resp := &ClusterAlertIncident{}
+	err := c.apiClient.Ops.DoReplace(ClusterAlertIncidentType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertIncidentClient) List(opts *types.ListOpts) (*ClusterAlertIncidentCollection, error) {
+	resp := &ClusterAlertIncidentCollection{}
+	err := c.apiClient.Ops.DoList(ClusterAlertIncidentType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterAlertIncidentClient) ListAll(opts *types.ListOpts) (*ClusterAlertIncidentCollection, error) {
+	resp := &ClusterAlertIncidentCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterAlertIncidentCollection) Next() (*ClusterAlertIncidentCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterAlertIncidentCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterAlertIncidentClient) ByID(id string) (*ClusterAlertIncident, error) {
+	resp := &ClusterAlertIncident{}
+	err := c.apiClient.Ops.DoByID(ClusterAlertIncidentType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertIncidentClient) Delete(container *ClusterAlertIncident) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterAlertIncidentType, &container.Resource)
+}
+
+func (c *ClusterAlertIncidentClient) CollectionActionSummary(resource *ClusterAlertIncidentCollection, input *AlertIncidentSummaryInput) (*AlertIncidentSummaryOutput, error) {
+	resp := &AlertIncidentSummaryOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(ClusterAlertIncidentType, "summary", &resource.Collection, input, resp)
+	return resp, err
+}
@@ -0,0 +1,26 @@
+package client
+
+const (
+	ClusterAlertIncidentSpecType             = "clusterAlertIncidentSpec"
+	ClusterAlertIncidentSpecFieldAlertLabels = "alertLabels"
+	ClusterAlertIncidentSpecFieldAlertType   = "alertType"
+	ClusterAlertIncidentSpecFieldClusterID   = "clusterId"
+	ClusterAlertIncidentSpecFieldDisplayName = "displayName"
+	ClusterAlertIncidentSpecFieldFingerprint = "fingerprint"
+	ClusterAlertIncidentSpecFieldFiredAt     = "firedAt"
+	ClusterAlertIncidentSpecFieldGroupID     = "groupId"
+	ClusterAlertIncidentSpecFieldRuleID      = "ruleId"
+	ClusterAlertIncidentSpecFieldSeverity    = "severity"
+)
+
+type ClusterAlertIncidentSpec struct {
+	AlertLabels map[string]string `json:"alertLabels,omitempty" yaml:"alertLabels,omitempty"`
+	AlertType   string            `json:"alertType,omitempty" yaml:"alertType,omitempty"`
+	ClusterID   string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	DisplayName string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Fingerprint string            `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	FiredAt     string            `json:"firedAt,omitempty" yaml:"firedAt,omitempty"`
+	GroupID     string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	RuleID      string            `json:"ruleId,omitempty" yaml:"ruleId,omitempty"`
+	Severity    string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+}
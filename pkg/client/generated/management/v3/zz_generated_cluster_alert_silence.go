@@ -0,0 +1,144 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterAlertSilenceType                      = "clusterAlertSilence"
+	ClusterAlertSilenceFieldAcknowledgement      = "acknowledgement"
+	ClusterAlertSilenceFieldAnnotations          = "annotations"
+	ClusterAlertSilenceFieldClusterID            = "clusterId"
+	ClusterAlertSilenceFieldComment              = "comment"
+	ClusterAlertSilenceFieldCreated              = "created"
+	ClusterAlertSilenceFieldCreatorID            = "creatorId"
+	ClusterAlertSilenceFieldEndsAt               = "endsAt"
+	ClusterAlertSilenceFieldExpiredAt            = "expiredAt"
+	ClusterAlertSilenceFieldLabels               = "labels"
+	ClusterAlertSilenceFieldMatchers             = "matchers"
+	ClusterAlertSilenceFieldName                 = "name"
+	ClusterAlertSilenceFieldNamespaceId          = "namespaceId"
+	ClusterAlertSilenceFieldOwnerReferences      = "ownerReferences"
+	ClusterAlertSilenceFieldRemoved              = "removed"
+	ClusterAlertSilenceFieldSilenceID            = "silenceId"
+	ClusterAlertSilenceFieldSilenceState         = "silenceState"
+	ClusterAlertSilenceFieldStartsAt             = "startsAt"
+	ClusterAlertSilenceFieldState                = "state"
+	ClusterAlertSilenceFieldTransitioning        = "transitioning"
+	ClusterAlertSilenceFieldTransitioningMessage = "transitioningMessage"
+	ClusterAlertSilenceFieldUUID                 = "uuid"
+)
+
+type ClusterAlertSilence struct {
+	types.Resource
+	Acknowledgement      bool              `json:"acknowledgement,omitempty" yaml:"acknowledgement,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Comment              string            `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	EndsAt               string            `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	ExpiredAt            string            `json:"expiredAt,omitempty" yaml:"expiredAt,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Matchers             []SilenceMatcher  `json:"matchers,omitempty" yaml:"matchers,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SilenceID            string            `json:"silenceId,omitempty" yaml:"silenceId,omitempty"`
+	SilenceState         string            `json:"silenceState,omitempty" yaml:"silenceState,omitempty"`
+	StartsAt             string            `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ClusterAlertSilenceCollection struct {
+	types.Collection
+	Data   []ClusterAlertSilence `json:"data,omitempty"`
+	client *ClusterAlertSilenceClient
+}
+
+type ClusterAlertSilenceClient struct {
+	apiClient *Client
+}
+
+type ClusterAlertSilenceOperations interface {
+	List(opts *types.ListOpts) (*ClusterAlertSilenceCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterAlertSilenceCollection, error)
+	Create(opts *ClusterAlertSilence) (*ClusterAlertSilence, error)
+	Update(existing *ClusterAlertSilence, updates interface{}) (*ClusterAlertSilence, error)
+	Replace(existing *ClusterAlertSilence) (*ClusterAlertSilence, error)
+	ByID(id string) (*ClusterAlertSilence, error)
+	Delete(container *ClusterAlertSilence) error
+}
+
+func newClusterAlertSilenceClient(apiClient *Client) *ClusterAlertSilenceClient {
+	return &ClusterAlertSilenceClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterAlertSilenceClient) Create(container *ClusterAlertSilence) (*ClusterAlertSilence, error) {
+	resp := &ClusterAlertSilence{}
+	err := c.apiClient.Ops.DoCreate(ClusterAlertSilenceType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertSilenceClient) Update(existing *ClusterAlertSilence, updates interface{}) (*ClusterAlertSilence, error) {
+	resp := &ClusterAlertSilence{}
+	err := c.apiClient.Ops.DoUpdate(ClusterAlertSilenceType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertSilenceClient) Replace(obj *ClusterAlertSilence) (*ClusterAlertSilence, error) {
+	resp := &ClusterAlertSilence{}
+	err := c.apiClient.Ops.DoReplace(ClusterAlertSilenceType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertSilenceClient) List(opts *types.ListOpts) (*ClusterAlertSilenceCollection, error) {
+	resp := &ClusterAlertSilenceCollection{}
+	err := c.apiClient.Ops.DoList(ClusterAlertSilenceType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterAlertSilenceClient) ListAll(opts *types.ListOpts) (*ClusterAlertSilenceCollection, error) {
+	resp := &ClusterAlertSilenceCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterAlertSilenceCollection) Next() (*ClusterAlertSilenceCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterAlertSilenceCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterAlertSilenceClient) ByID(id string) (*ClusterAlertSilence, error) {
+	resp := &ClusterAlertSilence{}
+	err := c.apiClient.Ops.DoByID(ClusterAlertSilenceType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterAlertSilenceClient) Delete(container *ClusterAlertSilence) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterAlertSilenceType, &container.Resource)
+}
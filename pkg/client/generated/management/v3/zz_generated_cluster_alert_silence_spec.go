@@ -0,0 +1,20 @@
+package client
+
+const (
+	ClusterAlertSilenceSpecType                 = "clusterAlertSilenceSpec"
+	ClusterAlertSilenceSpecFieldAcknowledgement = "acknowledgement"
+	ClusterAlertSilenceSpecFieldClusterID       = "clusterId"
+	ClusterAlertSilenceSpecFieldComment         = "comment"
+	ClusterAlertSilenceSpecFieldEndsAt          = "endsAt"
+	ClusterAlertSilenceSpecFieldMatchers        = "matchers"
+	ClusterAlertSilenceSpecFieldStartsAt        = "startsAt"
+)
+
+type ClusterAlertSilenceSpec struct {
+	Acknowledgement bool             `json:"acknowledgement,omitempty" yaml:"acknowledgement,omitempty"`
+	ClusterID       string           `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Comment         string           `json:"comment,omitempty" yaml:"comment,omitempty"`
+	EndsAt          string           `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	Matchers        []SilenceMatcher `json:"matchers,omitempty" yaml:"matchers,omitempty"`
+	StartsAt        string           `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+}
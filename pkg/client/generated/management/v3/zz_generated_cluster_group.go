@@ -0,0 +1,154 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterGroupType                      = "clusterGroup"
+	ClusterGroupFieldAnnotations          = "annotations"
+	ClusterGroupFieldClusterSelector      = "clusterSelector"
+	ClusterGroupFieldCreated              = "created"
+	ClusterGroupFieldCreatorID            = "creatorId"
+	ClusterGroupFieldDescription          = "description"
+	ClusterGroupFieldLabels               = "labels"
+	ClusterGroupFieldName                 = "name"
+	ClusterGroupFieldOwnerReferences      = "ownerReferences"
+	ClusterGroupFieldRemoved              = "removed"
+	ClusterGroupFieldState                = "state"
+	ClusterGroupFieldStatus               = "status"
+	ClusterGroupFieldTransitioning        = "transitioning"
+	ClusterGroupFieldTransitioningMessage = "transitioningMessage"
+	ClusterGroupFieldUUID                 = "uuid"
+)
+
+type ClusterGroup struct {
+	types.Resource
+	Annotations          map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterSelector      string              `json:"clusterSelector,omitempty" yaml:"clusterSelector,omitempty"`
+	Created              string              `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string              `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description          string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels               map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string              `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences      []OwnerReference    `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	State                string              `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *ClusterGroupStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning        string              `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string              `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string              `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ClusterGroupCollection struct {
+	types.Collection
+	Data   []ClusterGroup `json:"data,omitempty"`
+	client *ClusterGroupClient
+}
+
+type ClusterGroupClient struct {
+	apiClient *Client
+}
+
+type ClusterGroupOperations interface {
+	List(opts *types.ListOpts) (*ClusterGroupCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterGroupCollection, error)
+	Create(opts *ClusterGroup) (*ClusterGroup, error)
+	Update(existing *ClusterGroup, updates interface{}) (*ClusterGroup, error)
+	Replace(existing *ClusterGroup) (*ClusterGroup, error)
+	ByID(id string) (*ClusterGroup, error)
+	Delete(container *ClusterGroup) error
+
+	ActionBackupEtcd(resource *ClusterGroup) (*ClusterGroupActionOutput, error)
+
+	ActionRotateCertificates(resource *ClusterGroup, input *RotateCertificateInput) (*ClusterGroupActionOutput, error)
+
+	ActionUpgradeKubernetes(resource *ClusterGroup, input *ClusterGroupUpgradeInput) (*ClusterGroupActionOutput, error)
+}
+
+func newClusterGroupClient(apiClient *Client) *ClusterGroupClient {
+	return &ClusterGroupClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterGroupClient) Create(container *ClusterGroup) (*ClusterGroup, error) {
+	resp := &ClusterGroup{}
+	err := c.apiClient.Ops.DoCreate(ClusterGroupType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) Update(existing *ClusterGroup, updates interface{}) (*ClusterGroup, error) {
+	resp := &ClusterGroup{}
+	err := c.apiClient.Ops.DoUpdate(ClusterGroupType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) Replace(obj *ClusterGroup) (*ClusterGroup, error) {
+	resp := &ClusterGroup{}
+	err := c.apiClient.Ops.DoReplace(ClusterGroupType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) List(opts *types.ListOpts) (*ClusterGroupCollection, error) {
+	resp := &ClusterGroupCollection{}
+	err := c.apiClient.Ops.DoList(ClusterGroupType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterGroupClient) ListAll(opts *types.ListOpts) (*ClusterGroupCollection, error) {
+	resp := &ClusterGroupCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterGroupCollection) Next() (*ClusterGroupCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterGroupCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterGroupClient) ByID(id string) (*ClusterGroup, error) {
+	resp := &ClusterGroup{}
+	err := c.apiClient.Ops.DoByID(ClusterGroupType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) Delete(container *ClusterGroup) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterGroupType, &container.Resource)
+}
+
+func (c *ClusterGroupClient) ActionBackupEtcd(resource *ClusterGroup) (*ClusterGroupActionOutput, error) {
+	resp := &ClusterGroupActionOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterGroupType, "backupEtcd", &resource.Resource, nil, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) ActionRotateCertificates(resource *ClusterGroup, input *RotateCertificateInput) (*ClusterGroupActionOutput, error) {
+	resp := &ClusterGroupActionOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterGroupType, "rotateCertificates", &resource.Resource, input, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupClient) ActionUpgradeKubernetes(resource *ClusterGroup, input *ClusterGroupUpgradeInput) (*ClusterGroupActionOutput, error) {
+	resp := &ClusterGroupActionOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterGroupType, "upgradeKubernetes", &resource.Resource, input, resp)
+	return resp, err
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ClusterGroupActionOutputType          = "clusterGroupActionOutput"
+	ClusterGroupActionOutputFieldClusters = "clusters"
+)
+
+type ClusterGroupActionOutput struct {
+	Clusters []ClusterGroupActionResult `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	ClusterGroupActionResultType           = "clusterGroupActionResult"
+	ClusterGroupActionResultFieldClusterID = "clusterId"
+	ClusterGroupActionResultFieldError     = "error"
+	ClusterGroupActionResultFieldMessage   = "message"
+)
+
+type ClusterGroupActionResult struct {
+	ClusterID string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Error     string `json:"error,omitempty" yaml:"error,omitempty"`
+	Message   string `json:"message,omitempty" yaml:"message,omitempty"`
+}
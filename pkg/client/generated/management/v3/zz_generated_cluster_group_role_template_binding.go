@@ -0,0 +1,130 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterGroupRoleTemplateBindingType                  = "clusterGroupRoleTemplateBinding"
+	ClusterGroupRoleTemplateBindingFieldAnnotations      = "annotations"
+	ClusterGroupRoleTemplateBindingFieldClusterGroupID   = "clusterGroupId"
+	ClusterGroupRoleTemplateBindingFieldCreated          = "created"
+	ClusterGroupRoleTemplateBindingFieldCreatorID        = "creatorId"
+	ClusterGroupRoleTemplateBindingFieldGroupID          = "groupId"
+	ClusterGroupRoleTemplateBindingFieldGroupPrincipalID = "groupPrincipalId"
+	ClusterGroupRoleTemplateBindingFieldLabels           = "labels"
+	ClusterGroupRoleTemplateBindingFieldName             = "name"
+	ClusterGroupRoleTemplateBindingFieldOwnerReferences  = "ownerReferences"
+	ClusterGroupRoleTemplateBindingFieldRemoved          = "removed"
+	ClusterGroupRoleTemplateBindingFieldRoleTemplateID   = "roleTemplateId"
+	ClusterGroupRoleTemplateBindingFieldUUID             = "uuid"
+	ClusterGroupRoleTemplateBindingFieldUserID           = "userId"
+	ClusterGroupRoleTemplateBindingFieldUserPrincipalID  = "userPrincipalId"
+)
+
+type ClusterGroupRoleTemplateBinding struct {
+	types.Resource
+	Annotations      map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterGroupID   string            `json:"clusterGroupId,omitempty" yaml:"clusterGroupId,omitempty"`
+	Created          string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID        string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	GroupID          string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	GroupPrincipalID string            `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences  []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed          string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RoleTemplateID   string            `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
+	UUID             string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UserID           string            `json:"userId,omitempty" yaml:"userId,omitempty"`
+	UserPrincipalID  string            `json:"userPrincipalId,omitempty" yaml:"userPrincipalId,omitempty"`
+}
+
+type ClusterGroupRoleTemplateBindingCollection struct {
+	types.Collection
+	Data   []ClusterGroupRoleTemplateBinding `json:"data,omitempty"`
+	client *ClusterGroupRoleTemplateBindingClient
+}
+
+type ClusterGroupRoleTemplateBindingClient struct {
+	apiClient *Client
+}
+
+type ClusterGroupRoleTemplateBindingOperations interface {
+	List(opts *types.ListOpts) (*ClusterGroupRoleTemplateBindingCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterGroupRoleTemplateBindingCollection, error)
+	Create(opts *ClusterGroupRoleTemplateBinding) (*ClusterGroupRoleTemplateBinding, error)
+	Update(existing *ClusterGroupRoleTemplateBinding, updates interface{}) (*ClusterGroupRoleTemplateBinding, error)
+	Replace(existing *ClusterGroupRoleTemplateBinding) (*ClusterGroupRoleTemplateBinding, error)
+	ByID(id string) (*ClusterGroupRoleTemplateBinding, error)
+	Delete(container *ClusterGroupRoleTemplateBinding) error
+}
+
+func newClusterGroupRoleTemplateBindingClient(apiClient *Client) *ClusterGroupRoleTemplateBindingClient {
+	return &ClusterGroupRoleTemplateBindingClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) Create(container *ClusterGroupRoleTemplateBinding) (*ClusterGroupRoleTemplateBinding, error) {
+	resp := &ClusterGroupRoleTemplateBinding{}
+	err := c.apiClient.Ops.DoCreate(ClusterGroupRoleTemplateBindingType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) Update(existing *ClusterGroupRoleTemplateBinding, updates interface{}) (*ClusterGroupRoleTemplateBinding, error) {
+	resp := &ClusterGroupRoleTemplateBinding{}
+	err := c.apiClient.Ops.DoUpdate(ClusterGroupRoleTemplateBindingType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) Replace(obj *ClusterGroupRoleTemplateBinding) (*ClusterGroupRoleTemplateBinding, error) {
+	resp := &ClusterGroupRoleTemplateBinding{}
+	err := c.apiClient.Ops.DoReplace(ClusterGroupRoleTemplateBindingType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) List(opts *types.ListOpts) (*ClusterGroupRoleTemplateBindingCollection, error) {
+	resp := &ClusterGroupRoleTemplateBindingCollection{}
+	err := c.apiClient.Ops.DoList(ClusterGroupRoleTemplateBindingType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) ListAll(opts *types.ListOpts) (*ClusterGroupRoleTemplateBindingCollection, error) {
+	resp := &ClusterGroupRoleTemplateBindingCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterGroupRoleTemplateBindingCollection) Next() (*ClusterGroupRoleTemplateBindingCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterGroupRoleTemplateBindingCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) ByID(id string) (*ClusterGroupRoleTemplateBinding, error) {
+	resp := &ClusterGroupRoleTemplateBinding{}
+	err := c.apiClient.Ops.DoByID(ClusterGroupRoleTemplateBindingType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterGroupRoleTemplateBindingClient) Delete(container *ClusterGroupRoleTemplateBinding) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterGroupRoleTemplateBindingType, &container.Resource)
+}
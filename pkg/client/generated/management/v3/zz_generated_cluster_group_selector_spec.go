@@ -0,0 +1,14 @@
+package client
+
+const (
+	ClusterGroupSelectorSpecType                 = "clusterGroupSelectorSpec"
+	ClusterGroupSelectorSpecFieldClusterSelector = "clusterSelector"
+	ClusterGroupSelectorSpecFieldDescription     = "description"
+	ClusterGroupSelectorSpecFieldDisplayName     = "displayName"
+)
+
+type ClusterGroupSelectorSpec struct {
+	ClusterSelector string `json:"clusterSelector,omitempty" yaml:"clusterSelector,omitempty"`
+	Description     string `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName     string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
@@ -2,6 +2,7 @@
 
 const (
 	ClusterGroupSpecType                       = "clusterGroupSpec"
+	ClusterGroupSpecFieldClusterGroupID        = "clusterGroupId"
 	ClusterGroupSpecFieldClusterID             = "clusterId"
 	ClusterGroupSpecFieldDescription           = "description"
 	ClusterGroupSpecFieldDisplayName           = "displayName"
@@ -9,14 +10,19 @@
 	ClusterGroupSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
 	ClusterGroupSpecFieldRecipients            = "recipients"
 	ClusterGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ClusterGroupSpecFieldRoutes                = "routes"
+	ClusterGroupSpecFieldTemplate              = "template"
 )
 
 type ClusterGroupSpec struct {
-	ClusterID             string      `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Description           string      `json:"description,omitempty" yaml:"description,omitempty"`
-	DisplayName           string      `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	GroupIntervalSeconds  int64       `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64       `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Recipients            []Recipient `json:"recipients,omitempty" yaml:"recipients,omitempty"`
-	RepeatIntervalSeconds int64       `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	ClusterGroupID        string                `json:"clusterGroupId,omitempty" yaml:"clusterGroupId,omitempty"`
+	ClusterID             string                `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Description           string                `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName           string                `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupIntervalSeconds  int64                 `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64                 `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	Recipients            []Recipient           `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	RepeatIntervalSeconds int64                 `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute          `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Template              *NotificationTemplate `json:"template,omitempty" yaml:"template,omitempty"`
 }
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ClusterGroupStatusType          = "clusterGroupStatus"
+	ClusterGroupStatusFieldClusters = "clusters"
+)
+
+type ClusterGroupStatus struct {
+	Clusters []string `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+}
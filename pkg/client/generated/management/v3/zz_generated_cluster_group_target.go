@@ -0,0 +1,12 @@
+package client
+
+const (
+	ClusterGroupTargetType                    = "clusterGroupTarget"
+	ClusterGroupTargetFieldClusterGroupID     = "clusterGroupId"
+	ClusterGroupTargetFieldProjectDisplayName = "projectDisplayName"
+)
+
+type ClusterGroupTarget struct {
+	ClusterGroupID     string `json:"clusterGroupId,omitempty" yaml:"clusterGroupId,omitempty"`
+	ProjectDisplayName string `json:"projectDisplayName,omitempty" yaml:"projectDisplayName,omitempty"`
+}
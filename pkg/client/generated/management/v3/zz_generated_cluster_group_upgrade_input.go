@@ -0,0 +1,10 @@
+package client
+
+const (
+	ClusterGroupUpgradeInputType                   = "clusterGroupUpgradeInput"
+	ClusterGroupUpgradeInputFieldKubernetesVersion = "kubernetesVersion"
+)
+
+type ClusterGroupUpgradeInput struct {
+	KubernetesVersion string `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
+}
@@ -8,14 +8,18 @@
 	ClusterLoggingType                        = "clusterLogging"
 	ClusterLoggingFieldAnnotations            = "annotations"
 	ClusterLoggingFieldAppliedSpec            = "appliedSpec"
+	ClusterLoggingFieldAuditLogSources        = "auditLogSources"
 	ClusterLoggingFieldClusterID              = "clusterId"
 	ClusterLoggingFieldConditions             = "conditions"
 	ClusterLoggingFieldCreated                = "created"
 	ClusterLoggingFieldCreatorID              = "creatorId"
 	ClusterLoggingFieldCustomTargetConfig     = "customTargetConfig"
+	ClusterLoggingFieldDebugSampling          = "debugSampling"
+	ClusterLoggingFieldDroppedLines           = "droppedLines"
 	ClusterLoggingFieldElasticsearchConfig    = "elasticsearchConfig"
 	ClusterLoggingFieldEnableJSONParsing      = "enableJSONParsing"
 	ClusterLoggingFieldFailedSpec             = "failedSpec"
+	ClusterLoggingFieldFieldTransform         = "fieldTransform"
 	ClusterLoggingFieldFluentForwarderConfig  = "fluentForwarderConfig"
 	ClusterLoggingFieldIncludeSystemComponent = "includeSystemComponent"
 	ClusterLoggingFieldKafkaConfig            = "kafkaConfig"
@@ -25,7 +29,9 @@
 	ClusterLoggingFieldOutputFlushInterval    = "outputFlushInterval"
 	ClusterLoggingFieldOutputTags             = "outputTags"
 	ClusterLoggingFieldOwnerReferences        = "ownerReferences"
+	ClusterLoggingFieldParsingRules           = "parsingRules"
 	ClusterLoggingFieldRemoved                = "removed"
+	ClusterLoggingFieldSelector               = "selector"
 	ClusterLoggingFieldSplunkConfig           = "splunkConfig"
 	ClusterLoggingFieldState                  = "state"
 	ClusterLoggingFieldSyslogConfig           = "syslogConfig"
@@ -36,32 +42,38 @@
 
 type ClusterLogging struct {
 	types.Resource
-	Annotations            map[string]string      `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	AppliedSpec            *ClusterLoggingSpec    `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
-	ClusterID              string                 `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Conditions             []LoggingCondition     `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	Created                string                 `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID              string                 `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	CustomTargetConfig     *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
-	ElasticsearchConfig    *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
-	EnableJSONParsing      bool                   `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
-	FailedSpec             *ClusterLoggingSpec    `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
-	FluentForwarderConfig  *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
-	IncludeSystemComponent *bool                  `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
-	KafkaConfig            *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
-	Labels                 map[string]string      `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name                   string                 `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId            string                 `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OutputFlushInterval    int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
-	OutputTags             map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
-	OwnerReferences        []OwnerReference       `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	Removed                string                 `json:"removed,omitempty" yaml:"removed,omitempty"`
-	SplunkConfig           *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
-	State                  string                 `json:"state,omitempty" yaml:"state,omitempty"`
-	SyslogConfig           *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
-	Transitioning          string                 `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage   string                 `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                   string                 `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Annotations            map[string]string       `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	AppliedSpec            *ClusterLoggingSpec     `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
+	AuditLogSources        *LoggingAuditLogSources `json:"auditLogSources,omitempty" yaml:"auditLogSources,omitempty"`
+	ClusterID              string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Conditions             []LoggingCondition      `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Created                string                  `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID              string                  `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	CustomTargetConfig     *CustomTargetConfig     `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
+	DebugSampling          *LoggingDebugSampling   `json:"debugSampling,omitempty" yaml:"debugSampling,omitempty"`
+	DroppedLines           *LoggingDroppedLines    `json:"droppedLines,omitempty" yaml:"droppedLines,omitempty"`
+	ElasticsearchConfig    *ElasticsearchConfig    `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	EnableJSONParsing      bool                    `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
+	FailedSpec             *ClusterLoggingSpec     `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
+	FieldTransform         *LoggingFieldTransform  `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
+	FluentForwarderConfig  *FluentForwarderConfig  `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
+	IncludeSystemComponent *bool                   `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
+	KafkaConfig            *KafkaConfig            `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	Labels                 map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                   string                  `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId            string                  `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OutputFlushInterval    int64                   `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
+	OutputTags             map[string]string       `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
+	OwnerReferences        []OwnerReference        `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ParsingRules           []LoggingParsingRule    `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
+	Removed                string                  `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Selector               *LoggingSelector        `json:"selector,omitempty" yaml:"selector,omitempty"`
+	SplunkConfig           *SplunkConfig           `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
+	State                  string                  `json:"state,omitempty" yaml:"state,omitempty"`
+	SyslogConfig           *SyslogConfig           `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
+	Transitioning          string                  `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage   string                  `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                   string                  `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 
 type ClusterLoggingCollection struct {
@@ -85,7 +97,7 @@ type ClusterLoggingOperations interface {
 
 	CollectionActionDryRun(resource *ClusterLoggingCollection, input *ClusterTestInput) error
 
-	CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) error
+	CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) (*LoggingTestOutput, error)
 }
 
 func newClusterLoggingClient(apiClient *Client) *ClusterLoggingClient {
@@ -162,7 +174,8 @@ func (c *ClusterLoggingClient) CollectionActionDryRun(resource *ClusterLoggingCo
 	return err
 }
 
-func (c *ClusterLoggingClient) CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) error {
-	err := c.apiClient.Ops.DoCollectionAction(ClusterLoggingType, "test", &resource.Collection, input, nil)
-	return err
+func (c *ClusterLoggingClient) CollectionActionTest(resource *ClusterLoggingCollection, input *ClusterTestInput) (*LoggingTestOutput, error) {
+	resp := &LoggingTestOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(ClusterLoggingType, "test", &resource.Collection, input, resp)
+	return resp, err
 }
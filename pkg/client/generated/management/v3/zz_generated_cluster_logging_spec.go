@@ -2,31 +2,41 @@
 
 const (
 	ClusterLoggingSpecType                        = "clusterLoggingSpec"
+	ClusterLoggingSpecFieldAuditLogSources        = "auditLogSources"
 	ClusterLoggingSpecFieldClusterID              = "clusterId"
 	ClusterLoggingSpecFieldCustomTargetConfig     = "customTargetConfig"
+	ClusterLoggingSpecFieldDebugSampling          = "debugSampling"
 	ClusterLoggingSpecFieldDisplayName            = "displayName"
 	ClusterLoggingSpecFieldElasticsearchConfig    = "elasticsearchConfig"
 	ClusterLoggingSpecFieldEnableJSONParsing      = "enableJSONParsing"
+	ClusterLoggingSpecFieldFieldTransform         = "fieldTransform"
 	ClusterLoggingSpecFieldFluentForwarderConfig  = "fluentForwarderConfig"
 	ClusterLoggingSpecFieldIncludeSystemComponent = "includeSystemComponent"
 	ClusterLoggingSpecFieldKafkaConfig            = "kafkaConfig"
 	ClusterLoggingSpecFieldOutputFlushInterval    = "outputFlushInterval"
 	ClusterLoggingSpecFieldOutputTags             = "outputTags"
+	ClusterLoggingSpecFieldParsingRules           = "parsingRules"
+	ClusterLoggingSpecFieldSelector               = "selector"
 	ClusterLoggingSpecFieldSplunkConfig           = "splunkConfig"
 	ClusterLoggingSpecFieldSyslogConfig           = "syslogConfig"
 )
 
 type ClusterLoggingSpec struct {
-	ClusterID              string                 `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	CustomTargetConfig     *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
-	DisplayName            string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	ElasticsearchConfig    *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
-	EnableJSONParsing      bool                   `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
-	FluentForwarderConfig  *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
-	IncludeSystemComponent *bool                  `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
-	KafkaConfig            *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
-	OutputFlushInterval    int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
-	OutputTags             map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
-	SplunkConfig           *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
-	SyslogConfig           *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
+	AuditLogSources        *LoggingAuditLogSources `json:"auditLogSources,omitempty" yaml:"auditLogSources,omitempty"`
+	ClusterID              string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	CustomTargetConfig     *CustomTargetConfig     `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
+	DebugSampling          *LoggingDebugSampling   `json:"debugSampling,omitempty" yaml:"debugSampling,omitempty"`
+	DisplayName            string                  `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	ElasticsearchConfig    *ElasticsearchConfig    `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	EnableJSONParsing      bool                    `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
+	FieldTransform         *LoggingFieldTransform  `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
+	FluentForwarderConfig  *FluentForwarderConfig  `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
+	IncludeSystemComponent *bool                   `json:"includeSystemComponent,omitempty" yaml:"includeSystemComponent,omitempty"`
+	KafkaConfig            *KafkaConfig            `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
+	OutputFlushInterval    int64                   `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
+	OutputTags             map[string]string       `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
+	ParsingRules           []LoggingParsingRule    `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
+	Selector               *LoggingSelector        `json:"selector,omitempty" yaml:"selector,omitempty"`
+	SplunkConfig           *SplunkConfig           `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
+	SyslogConfig           *SyslogConfig           `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
 }
@@ -1,14 +1,16 @@
 package client
 
 const (
-	ClusterLoggingStatusType             = "clusterLoggingStatus"
-	ClusterLoggingStatusFieldAppliedSpec = "appliedSpec"
-	ClusterLoggingStatusFieldConditions  = "conditions"
-	ClusterLoggingStatusFieldFailedSpec  = "failedSpec"
+	ClusterLoggingStatusType              = "clusterLoggingStatus"
+	ClusterLoggingStatusFieldAppliedSpec  = "appliedSpec"
+	ClusterLoggingStatusFieldConditions   = "conditions"
+	ClusterLoggingStatusFieldDroppedLines = "droppedLines"
+	ClusterLoggingStatusFieldFailedSpec   = "failedSpec"
 )
 
 type ClusterLoggingStatus struct {
-	AppliedSpec *ClusterLoggingSpec `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
-	Conditions  []LoggingCondition  `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	FailedSpec  *ClusterLoggingSpec `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
+	AppliedSpec  *ClusterLoggingSpec  `json:"appliedSpec,omitempty" yaml:"appliedSpec,omitempty"`
+	Conditions   []LoggingCondition   `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	DroppedLines *LoggingDroppedLines `json:"droppedLines,omitempty" yaml:"droppedLines,omitempty"`
+	FailedSpec   *ClusterLoggingSpec  `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
 }
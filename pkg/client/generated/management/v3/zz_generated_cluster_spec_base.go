@@ -3,6 +3,7 @@
 const (
 	ClusterSpecBaseType                                     = "clusterSpecBase"
 	ClusterSpecBaseFieldAgentImageOverride                  = "agentImageOverride"
+	ClusterSpecBaseFieldCertificateRotation                 = "certificateRotation"
 	ClusterSpecBaseFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers"
 	ClusterSpecBaseFieldDefaultPodSecurityPolicyTemplateID  = "defaultPodSecurityPolicyTemplateId"
 	ClusterSpecBaseFieldDesiredAgentImage                   = "desiredAgentImage"
@@ -13,6 +14,7 @@
 	ClusterSpecBaseFieldEnableNetworkPolicy                 = "enableNetworkPolicy"
 	ClusterSpecBaseFieldFleetWorkspaceName                  = "fleetWorkspaceName"
 	ClusterSpecBaseFieldLocalClusterAuthEndpoint            = "localClusterAuthEndpoint"
+	ClusterSpecBaseFieldMaintenanceWindow                   = "maintenanceWindow"
 	ClusterSpecBaseFieldRancherKubernetesEngineConfig       = "rancherKubernetesEngineConfig"
 	ClusterSpecBaseFieldScheduledClusterScan                = "scheduledClusterScan"
 	ClusterSpecBaseFieldWindowsPreferedCluster              = "windowsPreferedCluster"
@@ -20,6 +22,7 @@
 
 type ClusterSpecBase struct {
 	AgentImageOverride                  string                         `json:"agentImageOverride,omitempty" yaml:"agentImageOverride,omitempty"`
+	CertificateRotation                 *CertificateRotationConfig     `json:"certificateRotation,omitempty" yaml:"certificateRotation,omitempty"`
 	DefaultClusterRoleForProjectMembers string                         `json:"defaultClusterRoleForProjectMembers,omitempty" yaml:"defaultClusterRoleForProjectMembers,omitempty"`
 	DefaultPodSecurityPolicyTemplateID  string                         `json:"defaultPodSecurityPolicyTemplateId,omitempty" yaml:"defaultPodSecurityPolicyTemplateId,omitempty"`
 	DesiredAgentImage                   string                         `json:"desiredAgentImage,omitempty" yaml:"desiredAgentImage,omitempty"`
@@ -30,6 +33,7 @@ type ClusterSpecBase struct {
 	EnableNetworkPolicy                 *bool                          `json:"enableNetworkPolicy,omitempty" yaml:"enableNetworkPolicy,omitempty"`
 	FleetWorkspaceName                  string                         `json:"fleetWorkspaceName,omitempty" yaml:"fleetWorkspaceName,omitempty"`
 	LocalClusterAuthEndpoint            *LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty" yaml:"localClusterAuthEndpoint,omitempty"`
+	MaintenanceWindow                   *MaintenanceWindow             `json:"maintenanceWindow,omitempty" yaml:"maintenanceWindow,omitempty"`
 	RancherKubernetesEngineConfig       *RancherKubernetesEngineConfig `json:"rancherKubernetesEngineConfig,omitempty" yaml:"rancherKubernetesEngineConfig,omitempty"`
 	ScheduledClusterScan                *ScheduledClusterScan          `json:"scheduledClusterScan,omitempty" yaml:"scheduledClusterScan,omitempty"`
 	WindowsPreferedCluster              bool                           `json:"windowsPreferedCluster,omitempty" yaml:"windowsPreferedCluster,omitempty"`
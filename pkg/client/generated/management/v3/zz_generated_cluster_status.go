@@ -13,15 +13,18 @@
 	ClusterStatusFieldCACert                               = "caCert"
 	ClusterStatusFieldCapabilities                         = "capabilities"
 	ClusterStatusFieldCapacity                             = "capacity"
+	ClusterStatusFieldCertificateRotationStatus            = "certificateRotationStatus"
 	ClusterStatusFieldCertificatesExpiration               = "certificatesExpiration"
 	ClusterStatusFieldComponentStatuses                    = "componentStatuses"
 	ClusterStatusFieldConditions                           = "conditions"
 	ClusterStatusFieldCurrentCisRunName                    = "currentCisRunName"
+	ClusterStatusFieldDecommissionStatus                   = "decommissionStatus"
 	ClusterStatusFieldDriver                               = "driver"
 	ClusterStatusFieldEKSStatus                            = "eksStatus"
 	ClusterStatusFieldFailedSpec                           = "failedSpec"
 	ClusterStatusFieldIstioEnabled                         = "istioEnabled"
 	ClusterStatusFieldLimits                               = "limits"
+	ClusterStatusFieldMaintenanceStatus                    = "maintenanceStatus"
 	ClusterStatusFieldMonitoringStatus                     = "monitoringStatus"
 	ClusterStatusFieldNodeCount                            = "nodeCount"
 	ClusterStatusFieldNodeVersion                          = "nodeVersion"
@@ -43,15 +46,18 @@ type ClusterStatus struct {
 	CACert                               string                      `json:"caCert,omitempty" yaml:"caCert,omitempty"`
 	Capabilities                         *Capabilities               `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
 	Capacity                             map[string]string           `json:"capacity,omitempty" yaml:"capacity,omitempty"`
+	CertificateRotationStatus            *CertificateRotationStatus  `json:"certificateRotationStatus,omitempty" yaml:"certificateRotationStatus,omitempty"`
 	CertificatesExpiration               map[string]CertExpiration   `json:"certificatesExpiration,omitempty" yaml:"certificatesExpiration,omitempty"`
 	ComponentStatuses                    []ClusterComponentStatus    `json:"componentStatuses,omitempty" yaml:"componentStatuses,omitempty"`
 	Conditions                           []ClusterCondition          `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 	CurrentCisRunName                    string                      `json:"currentCisRunName,omitempty" yaml:"currentCisRunName,omitempty"`
+	DecommissionStatus                   *DecommissionStatus         `json:"decommissionStatus,omitempty" yaml:"decommissionStatus,omitempty"`
 	Driver                               string                      `json:"driver,omitempty" yaml:"driver,omitempty"`
 	EKSStatus                            *EKSStatus                  `json:"eksStatus,omitempty" yaml:"eksStatus,omitempty"`
 	FailedSpec                           *ClusterSpec                `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
 	IstioEnabled                         bool                        `json:"istioEnabled,omitempty" yaml:"istioEnabled,omitempty"`
 	Limits                               map[string]string           `json:"limits,omitempty" yaml:"limits,omitempty"`
+	MaintenanceStatus                    *MaintenanceStatus          `json:"maintenanceStatus,omitempty" yaml:"maintenanceStatus,omitempty"`
 	MonitoringStatus                     *MonitoringStatus           `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
 	NodeCount                            int64                       `json:"nodeCount,omitempty" yaml:"nodeCount,omitempty"`
 	NodeVersion                          int64                       `json:"nodeVersion,omitempty" yaml:"nodeVersion,omitempty"`
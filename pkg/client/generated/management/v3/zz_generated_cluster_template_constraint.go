@@ -0,0 +1,26 @@
+package client
+
+const (
+	ClusterTemplateConstraintType               = "clusterTemplateConstraint"
+	ClusterTemplateConstraintFieldAllowedValues = "allowedValues"
+	ClusterTemplateConstraintFieldField         = "field"
+	ClusterTemplateConstraintFieldMax           = "max"
+	ClusterTemplateConstraintFieldMessage       = "message"
+	ClusterTemplateConstraintFieldMin           = "min"
+	ClusterTemplateConstraintFieldPattern       = "pattern"
+	ClusterTemplateConstraintFieldRequired      = "required"
+	ClusterTemplateConstraintFieldVersionRange  = "versionRange"
+	ClusterTemplateConstraintFieldWhen          = "when"
+)
+
+type ClusterTemplateConstraint struct {
+	AllowedValues []string                            `json:"allowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	Field         string                              `json:"field,omitempty" yaml:"field,omitempty"`
+	Max           *int64                              `json:"max,omitempty" yaml:"max,omitempty"`
+	Message       string                              `json:"message,omitempty" yaml:"message,omitempty"`
+	Min           *int64                              `json:"min,omitempty" yaml:"min,omitempty"`
+	Pattern       string                              `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Required      bool                                `json:"required,omitempty" yaml:"required,omitempty"`
+	VersionRange  string                              `json:"versionRange,omitempty" yaml:"versionRange,omitempty"`
+	When          *ClusterTemplateConstraintCondition `json:"when,omitempty" yaml:"when,omitempty"`
+}
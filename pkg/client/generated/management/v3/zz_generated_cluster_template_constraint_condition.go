@@ -0,0 +1,12 @@
+package client
+
+const (
+	ClusterTemplateConstraintConditionType       = "clusterTemplateConstraintCondition"
+	ClusterTemplateConstraintConditionFieldField = "field"
+	ClusterTemplateConstraintConditionFieldValue = "value"
+)
+
+type ClusterTemplateConstraintCondition struct {
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ClusterTemplateDifferenceType               = "clusterTemplateDifference"
+	ClusterTemplateDifferenceFieldAnswered      = "answered"
+	ClusterTemplateDifferenceFieldClusterValue  = "clusterValue"
+	ClusterTemplateDifferenceFieldField         = "field"
+	ClusterTemplateDifferenceFieldRevisionValue = "revisionValue"
+)
+
+type ClusterTemplateDifference struct {
+	Answered      bool   `json:"answered,omitempty" yaml:"answered,omitempty"`
+	ClusterValue  string `json:"clusterValue,omitempty" yaml:"clusterValue,omitempty"`
+	Field         string `json:"field,omitempty" yaml:"field,omitempty"`
+	RevisionValue string `json:"revisionValue,omitempty" yaml:"revisionValue,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	ClusterTemplateDriftType             = "clusterTemplateDrift"
+	ClusterTemplateDriftFieldClusterID   = "clusterId"
+	ClusterTemplateDriftFieldDifferences = "differences"
+	ClusterTemplateDriftFieldDrifted     = "drifted"
+)
+
+type ClusterTemplateDrift struct {
+	ClusterID   string                      `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Differences []ClusterTemplateDifference `json:"differences,omitempty" yaml:"differences,omitempty"`
+	Drifted     bool                        `json:"drifted,omitempty" yaml:"drifted,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ClusterTemplateDriftReportType          = "clusterTemplateDriftReport"
+	ClusterTemplateDriftReportFieldClusters = "clusters"
+)
+
+type ClusterTemplateDriftReport struct {
+	Clusters []ClusterTemplateDrift `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+}
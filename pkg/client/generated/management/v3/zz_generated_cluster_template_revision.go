@@ -9,6 +9,7 @@
 	ClusterTemplateRevisionFieldAnnotations       = "annotations"
 	ClusterTemplateRevisionFieldClusterConfig     = "clusterConfig"
 	ClusterTemplateRevisionFieldClusterTemplateID = "clusterTemplateId"
+	ClusterTemplateRevisionFieldConstraints       = "constraints"
 	ClusterTemplateRevisionFieldCreated           = "created"
 	ClusterTemplateRevisionFieldCreatorID         = "creatorId"
 	ClusterTemplateRevisionFieldEnabled           = "enabled"
@@ -22,18 +23,19 @@
 
 type ClusterTemplateRevision struct {
 	types.Resource
-	Annotations       map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterConfig     *ClusterSpecBase  `json:"clusterConfig,omitempty" yaml:"clusterConfig,omitempty"`
-	ClusterTemplateID string            `json:"clusterTemplateId,omitempty" yaml:"clusterTemplateId,omitempty"`
-	Created           string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID         string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Enabled           *bool             `json:"enabled,omitempty" yaml:"enabled,omitempty"`
-	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name              string            `json:"name,omitempty" yaml:"name,omitempty"`
-	OwnerReferences   []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	Questions         []Question        `json:"questions,omitempty" yaml:"questions,omitempty"`
-	Removed           string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	UUID              string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Annotations       map[string]string           `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterConfig     *ClusterSpecBase            `json:"clusterConfig,omitempty" yaml:"clusterConfig,omitempty"`
+	ClusterTemplateID string                      `json:"clusterTemplateId,omitempty" yaml:"clusterTemplateId,omitempty"`
+	Constraints       []ClusterTemplateConstraint `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+	Created           string                      `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID         string                      `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Enabled           *bool                       `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Labels            map[string]string           `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name              string                      `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences   []OwnerReference            `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Questions         []Question                  `json:"questions,omitempty" yaml:"questions,omitempty"`
+	Removed           string                      `json:"removed,omitempty" yaml:"removed,omitempty"`
+	UUID              string                      `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 
 type ClusterTemplateRevisionCollection struct {
@@ -57,6 +59,8 @@ type ClusterTemplateRevisionOperations interface {
 
 	ActionDisable(resource *ClusterTemplateRevision) error
 
+	ActionDriftreport(resource *ClusterTemplateRevision) (*ClusterTemplateDriftReport, error)
+
 	ActionEnable(resource *ClusterTemplateRevision) error
 
 	CollectionActionListquestions(resource *ClusterTemplateRevisionCollection) (*ClusterTemplateQuestionsOutput, error)
@@ -136,6 +140,12 @@ func (c *ClusterTemplateRevisionClient) ActionDisable(resource *ClusterTemplateR
 	return err
 }
 
+func (c *ClusterTemplateRevisionClient) ActionDriftreport(resource *ClusterTemplateRevision) (*ClusterTemplateDriftReport, error) {
+	resp := &ClusterTemplateDriftReport{}
+	err := c.apiClient.Ops.DoAction(ClusterTemplateRevisionType, "driftreport", &resource.Resource, nil, resp)
+	return resp, err
+}
+
 func (c *ClusterTemplateRevisionClient) ActionEnable(resource *ClusterTemplateRevision) error {
 	err := c.apiClient.Ops.DoAction(ClusterTemplateRevisionType, "enable", &resource.Resource, nil, nil)
 	return err
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterTemplateRolloutType                           = "clusterTemplateRollout"
+	ClusterTemplateRolloutFieldAnnotations               = "annotations"
+	ClusterTemplateRolloutFieldBatchSize                 = "batchSize"
+	ClusterTemplateRolloutFieldClusterIDs                = "clusterIds"
+	ClusterTemplateRolloutFieldClusterTemplateRevisionID = "clusterTemplateRevisionId"
+	ClusterTemplateRolloutFieldCreated                   = "created"
+	ClusterTemplateRolloutFieldCreatorID                 = "creatorId"
+	ClusterTemplateRolloutFieldLabels                    = "labels"
+	ClusterTemplateRolloutFieldMaxFailures               = "maxFailures"
+	ClusterTemplateRolloutFieldName                      = "name"
+	ClusterTemplateRolloutFieldOwnerReferences           = "ownerReferences"
+	ClusterTemplateRolloutFieldPaused                    = "paused"
+	ClusterTemplateRolloutFieldRemoved                   = "removed"
+	ClusterTemplateRolloutFieldState                     = "state"
+	ClusterTemplateRolloutFieldStatus                    = "status"
+	ClusterTemplateRolloutFieldTransitioning             = "transitioning"
+	ClusterTemplateRolloutFieldTransitioningMessage      = "transitioningMessage"
+	ClusterTemplateRolloutFieldUUID                      = "uuid"
+)
+
+type ClusterTemplateRollout struct {
+	types.Resource
+	Annotations               map[string]string             `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	BatchSize                 int64                         `json:"batchSize,omitempty" yaml:"batchSize,omitempty"`
+	ClusterIDs                []string                      `json:"clusterIds,omitempty" yaml:"clusterIds,omitempty"`
+	ClusterTemplateRevisionID string                        `json:"clusterTemplateRevisionId,omitempty" yaml:"clusterTemplateRevisionId,omitempty"`
+	Created                   string                        `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID                 string                        `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Labels                    map[string]string             `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MaxFailures               int64                         `json:"maxFailures,omitempty" yaml:"maxFailures,omitempty"`
+	Name                      string                        `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences           []OwnerReference              `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Paused                    bool                          `json:"paused,omitempty" yaml:"paused,omitempty"`
+	Removed                   string                        `json:"removed,omitempty" yaml:"removed,omitempty"`
+	State                     string                        `json:"state,omitempty" yaml:"state,omitempty"`
+	Status                    *ClusterTemplateRolloutStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning             string                        `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage      string                        `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                      string                        `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ClusterTemplateRolloutCollection struct {
+	types.Collection
+	Data   []ClusterTemplateRollout `json:"data,omitempty"`
+	client *ClusterTemplateRolloutClient
+}
+
+type ClusterTemplateRolloutClient struct {
+	apiClient *Client
+}
+
+type ClusterTemplateRolloutOperations interface {
+	List(opts *types.ListOpts) (*ClusterTemplateRolloutCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterTemplateRolloutCollection, error)
+	Create(opts *ClusterTemplateRollout) (*ClusterTemplateRollout, error)
+	Update(existing *ClusterTemplateRollout, updates interface{}) (*ClusterTemplateRollout, error)
+	Replace(existing *ClusterTemplateRollout) (*ClusterTemplateRollout, error)
+	ByID(id string) (*ClusterTemplateRollout, error)
+	Delete(container *ClusterTemplateRollout) error
+
+	ActionPause(resource *ClusterTemplateRollout) error
+
+	ActionResume(resource *ClusterTemplateRollout) error
+}
+
+func newClusterTemplateRolloutClient(apiClient *Client) *ClusterTemplateRolloutClient {
+	return &ClusterTemplateRolloutClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterTemplateRolloutClient) Create(container *ClusterTemplateRollout) (*ClusterTemplateRollout, error) {
+	resp := &ClusterTemplateRollout{}
+	err := c.apiClient.Ops.DoCreate(ClusterTemplateRolloutType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterTemplateRolloutClient) Update(existing *ClusterTemplateRollout, updates interface{}) (*ClusterTemplateRollout, error) {
+	resp := &ClusterTemplateRollout{}
+	err := c.apiClient.Ops.DoUpdate(ClusterTemplateRolloutType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterTemplateRolloutClient) Replace(obj *ClusterTemplateRollout) (*ClusterTemplateRollout, error) {
+	resp := &ClusterTemplateRollout{}
+	err := c.apiClient.Ops.DoReplace(ClusterTemplateRolloutType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterTemplateRolloutClient) List(opts *types.ListOpts) (*ClusterTemplateRolloutCollection, error) {
+	resp := &ClusterTemplateRolloutCollection{}
+	err := c.apiClient.Ops.DoList(ClusterTemplateRolloutType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterTemplateRolloutClient) ListAll(opts *types.ListOpts) (*ClusterTemplateRolloutCollection, error) {
+	resp := &ClusterTemplateRolloutCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterTemplateRolloutCollection) Next() (*ClusterTemplateRolloutCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterTemplateRolloutCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterTemplateRolloutClient) ByID(id string) (*ClusterTemplateRollout, error) {
+	resp := &ClusterTemplateRollout{}
+	err := c.apiClient.Ops.DoByID(ClusterTemplateRolloutType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterTemplateRolloutClient) Delete(container *ClusterTemplateRollout) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterTemplateRolloutType, &container.Resource)
+}
+
+func (c *ClusterTemplateRolloutClient) ActionPause(resource *ClusterTemplateRollout) error {
+	err := c.apiClient.Ops.DoAction(ClusterTemplateRolloutType, "pause", &resource.Resource, nil, nil)
+	return err
+}
+
+func (c *ClusterTemplateRolloutClient) ActionResume(resource *ClusterTemplateRollout) error {
+	err := c.apiClient.Ops.DoAction(ClusterTemplateRolloutType, "resume", &resource.Resource, nil, nil)
+	return err
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	ClusterTemplateRolloutClusterStatusType                    = "clusterTemplateRolloutClusterStatus"
+	ClusterTemplateRolloutClusterStatusFieldClusterID          = "clusterId"
+	ClusterTemplateRolloutClusterStatusFieldCompletedAt        = "completedAt"
+	ClusterTemplateRolloutClusterStatusFieldMessage            = "message"
+	ClusterTemplateRolloutClusterStatusFieldPreviousRevisionID = "previousRevisionId"
+	ClusterTemplateRolloutClusterStatusFieldStartedAt          = "startedAt"
+	ClusterTemplateRolloutClusterStatusFieldState              = "state"
+)
+
+type ClusterTemplateRolloutClusterStatus struct {
+	ClusterID          string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	CompletedAt        string `json:"completedAt,omitempty" yaml:"completedAt,omitempty"`
+	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
+	PreviousRevisionID string `json:"previousRevisionId,omitempty" yaml:"previousRevisionId,omitempty"`
+	StartedAt          string `json:"startedAt,omitempty" yaml:"startedAt,omitempty"`
+	State              string `json:"state,omitempty" yaml:"state,omitempty"`
+}
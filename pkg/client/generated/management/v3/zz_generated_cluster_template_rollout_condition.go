@@ -0,0 +1,20 @@
+package client
+
+const (
+	ClusterTemplateRolloutConditionType                    = "clusterTemplateRolloutCondition"
+	ClusterTemplateRolloutConditionFieldLastTransitionTime = "lastTransitionTime"
+	ClusterTemplateRolloutConditionFieldLastUpdateTime     = "lastUpdateTime"
+	ClusterTemplateRolloutConditionFieldMessage            = "message"
+	ClusterTemplateRolloutConditionFieldReason             = "reason"
+	ClusterTemplateRolloutConditionFieldStatus             = "status"
+	ClusterTemplateRolloutConditionFieldType               = "type"
+)
+
+type ClusterTemplateRolloutCondition struct {
+	LastTransitionTime string `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	LastUpdateTime     string `json:"lastUpdateTime,omitempty" yaml:"lastUpdateTime,omitempty"`
+	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
+	Reason             string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Status             string `json:"status,omitempty" yaml:"status,omitempty"`
+	Type               string `json:"type,omitempty" yaml:"type,omitempty"`
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	ClusterTemplateRolloutSpecType                           = "clusterTemplateRolloutSpec"
+	ClusterTemplateRolloutSpecFieldBatchSize                 = "batchSize"
+	ClusterTemplateRolloutSpecFieldClusterIDs                = "clusterIds"
+	ClusterTemplateRolloutSpecFieldClusterTemplateRevisionID = "clusterTemplateRevisionId"
+	ClusterTemplateRolloutSpecFieldDisplayName               = "displayName"
+	ClusterTemplateRolloutSpecFieldMaxFailures               = "maxFailures"
+	ClusterTemplateRolloutSpecFieldPaused                    = "paused"
+)
+
+type ClusterTemplateRolloutSpec struct {
+	BatchSize                 int64    `json:"batchSize,omitempty" yaml:"batchSize,omitempty"`
+	ClusterIDs                []string `json:"clusterIds,omitempty" yaml:"clusterIds,omitempty"`
+	ClusterTemplateRevisionID string   `json:"clusterTemplateRevisionId,omitempty" yaml:"clusterTemplateRevisionId,omitempty"`
+	DisplayName               string   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	MaxFailures               int64    `json:"maxFailures,omitempty" yaml:"maxFailures,omitempty"`
+	Paused                    bool     `json:"paused,omitempty" yaml:"paused,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	ClusterTemplateRolloutStatusType            = "clusterTemplateRolloutStatus"
+	ClusterTemplateRolloutStatusFieldClusters   = "clusters"
+	ClusterTemplateRolloutStatusFieldConditions = "conditions"
+	ClusterTemplateRolloutStatusFieldFailed     = "failed"
+	ClusterTemplateRolloutStatusFieldPhase      = "phase"
+	ClusterTemplateRolloutStatusFieldUpdated    = "updated"
+)
+
+type ClusterTemplateRolloutStatus struct {
+	Clusters   []ClusterTemplateRolloutClusterStatus `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+	Conditions []ClusterTemplateRolloutCondition     `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Failed     int64                                 `json:"failed,omitempty" yaml:"failed,omitempty"`
+	Phase      string                                `json:"phase,omitempty" yaml:"phase,omitempty"`
+	Updated    int64                                 `json:"updated,omitempty" yaml:"updated,omitempty"`
+}
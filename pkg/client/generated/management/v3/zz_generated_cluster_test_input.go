@@ -5,9 +5,13 @@
 	ClusterTestInputFieldClusterName           = "clusterId"
 	ClusterTestInputFieldCustomTargetConfig    = "customTargetConfig"
 	ClusterTestInputFieldElasticsearchConfig   = "elasticsearchConfig"
+	ClusterTestInputFieldFieldTransform        = "fieldTransform"
 	ClusterTestInputFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ClusterTestInputFieldKafkaConfig           = "kafkaConfig"
 	ClusterTestInputFieldOutputTags            = "outputTags"
+	ClusterTestInputFieldParsingRules          = "parsingRules"
+	ClusterTestInputFieldSampleLines           = "sampleLines"
+	ClusterTestInputFieldSampleNamespace       = "sampleNamespace"
 	ClusterTestInputFieldSplunkConfig          = "splunkConfig"
 	ClusterTestInputFieldSyslogConfig          = "syslogConfig"
 )
@@ -16,9 +20,13 @@ type ClusterTestInput struct {
 	ClusterName           string                 `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	CustomTargetConfig    *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	FieldTransform        *LoggingFieldTransform `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
+	ParsingRules          []LoggingParsingRule   `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
+	SampleLines           []string               `json:"sampleLines,omitempty" yaml:"sampleLines,omitempty"`
+	SampleNamespace       string                 `json:"sampleNamespace,omitempty" yaml:"sampleNamespace,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
 }
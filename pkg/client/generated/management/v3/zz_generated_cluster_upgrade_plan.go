@@ -0,0 +1,163 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterUpgradePlanType                      = "clusterUpgradePlan"
+	ClusterUpgradePlanFieldAnnotations          = "annotations"
+	ClusterUpgradePlanFieldAutoRollback         = "autoRollback"
+	ClusterUpgradePlanFieldClusterID            = "clusterId"
+	ClusterUpgradePlanFieldCreated              = "created"
+	ClusterUpgradePlanFieldCreatorID            = "creatorId"
+	ClusterUpgradePlanFieldKubernetesVersion    = "kubernetesVersion"
+	ClusterUpgradePlanFieldLabels               = "labels"
+	ClusterUpgradePlanFieldName                 = "name"
+	ClusterUpgradePlanFieldNamespaceId          = "namespaceId"
+	ClusterUpgradePlanFieldOwnerReferences      = "ownerReferences"
+	ClusterUpgradePlanFieldPaused               = "paused"
+	ClusterUpgradePlanFieldPreflightChecks      = "preflightChecks"
+	ClusterUpgradePlanFieldRemoved              = "removed"
+	ClusterUpgradePlanFieldRollback             = "rollback"
+	ClusterUpgradePlanFieldState                = "state"
+	ClusterUpgradePlanFieldStatus               = "status"
+	ClusterUpgradePlanFieldTransitioning        = "transitioning"
+	ClusterUpgradePlanFieldTransitioningMessage = "transitioningMessage"
+	ClusterUpgradePlanFieldUUID                 = "uuid"
+	ClusterUpgradePlanFieldUpgradeStrategy      = "upgradeStrategy"
+)
+
+type ClusterUpgradePlan struct {
+	types.Resource
+	Annotations          map[string]string         `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	AutoRollback         bool                      `json:"autoRollback,omitempty" yaml:"autoRollback,omitempty"`
+	ClusterID            string                    `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string                    `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string                    `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	KubernetesVersion    string                    `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
+	Labels               map[string]string         `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string                    `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string                    `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences      []OwnerReference          `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Paused               bool                      `json:"paused,omitempty" yaml:"paused,omitempty"`
+	PreflightChecks      *UpgradePreflightChecks   `json:"preflightChecks,omitempty" yaml:"preflightChecks,omitempty"`
+	Removed              string                    `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Rollback             bool                      `json:"rollback,omitempty" yaml:"rollback,omitempty"`
+	State                string                    `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *ClusterUpgradePlanStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning        string                    `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string                    `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string                    `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UpgradeStrategy      *NodeUpgradeStrategy      `json:"upgradeStrategy,omitempty" yaml:"upgradeStrategy,omitempty"`
+}
+
+type ClusterUpgradePlanCollection struct {
+	types.Collection
+	Data   []ClusterUpgradePlan `json:"data,omitempty"`
+	client *ClusterUpgradePlanClient
+}
+
+type ClusterUpgradePlanClient struct {
+	apiClient *Client
+}
+
+type ClusterUpgradePlanOperations interface {
+	List(opts *types.ListOpts) (*ClusterUpgradePlanCollection, error)
+	ListAll(opts *types.ListOpts) (*ClusterUpgradePlanCollection, error)
+	Create(opts *ClusterUpgradePlan) (*ClusterUpgradePlan, error)
+	Update(existing *ClusterUpgradePlan, updates interface{}) (*ClusterUpgradePlan, error)
+	Replace(existing *ClusterUpgradePlan) (*ClusterUpgradePlan, error)
+	ByID(id string) (*ClusterUpgradePlan, error)
+	Delete(container *ClusterUpgradePlan) error
+
+	ActionPause(resource *ClusterUpgradePlan) error
+
+	ActionResume(resource *ClusterUpgradePlan) error
+
+	ActionRollback(resource *ClusterUpgradePlan) error
+}
+
+func newClusterUpgradePlanClient(apiClient *Client) *ClusterUpgradePlanClient {
+	return &ClusterUpgradePlanClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterUpgradePlanClient) Create(container *ClusterUpgradePlan) (*ClusterUpgradePlan, error) {
+	resp := &ClusterUpgradePlan{}
+	err := c.apiClient.Ops.DoCreate(ClusterUpgradePlanType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterUpgradePlanClient) Update(existing *ClusterUpgradePlan, updates interface{}) (*ClusterUpgradePlan, error) {
+	resp := &ClusterUpgradePlan{}
+	err := c.apiClient.Ops.DoUpdate(ClusterUpgradePlanType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterUpgradePlanClient) Replace(obj *ClusterUpgradePlan) (*ClusterUpgradePlan, error) {
+	resp := &ClusterUpgradePlan{}
+	err := c.apiClient.Ops.DoReplace(ClusterUpgradePlanType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ClusterUpgradePlanClient) List(opts *types.ListOpts) (*ClusterUpgradePlanCollection, error) {
+	resp := &ClusterUpgradePlanCollection{}
+	err := c.apiClient.Ops.DoList(ClusterUpgradePlanType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ClusterUpgradePlanClient) ListAll(opts *types.ListOpts) (*ClusterUpgradePlanCollection, error) {
+	resp := &ClusterUpgradePlanCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ClusterUpgradePlanCollection) Next() (*ClusterUpgradePlanCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterUpgradePlanCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterUpgradePlanClient) ByID(id string) (*ClusterUpgradePlan, error) {
+	resp := &ClusterUpgradePlan{}
+	err := c.apiClient.Ops.DoByID(ClusterUpgradePlanType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterUpgradePlanClient) Delete(container *ClusterUpgradePlan) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterUpgradePlanType, &container.Resource)
+}
+
+func (c *ClusterUpgradePlanClient) ActionPause(resource *ClusterUpgradePlan) error {
+	err := c.apiClient.Ops.DoAction(ClusterUpgradePlanType, "pause", &resource.Resource, nil, nil)
+	return err
+}
+
+func (c *ClusterUpgradePlanClient) ActionResume(resource *ClusterUpgradePlan) error {
+	err := c.apiClient.Ops.DoAction(ClusterUpgradePlanType, "resume", &resource.Resource, nil, nil)
+	return err
+}
+
+func (c *ClusterUpgradePlanClient) ActionRollback(resource *ClusterUpgradePlan) error {
+	err := c.apiClient.Ops.DoAction(ClusterUpgradePlanType, "rollback", &resource.Resource, nil, nil)
+	return err
+}
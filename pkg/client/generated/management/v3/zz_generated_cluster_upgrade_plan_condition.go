@@ -0,0 +1,20 @@
+package client
+
+const (
+	ClusterUpgradePlanConditionType                    = "clusterUpgradePlanCondition"
+	ClusterUpgradePlanConditionFieldLastTransitionTime = "lastTransitionTime"
+	ClusterUpgradePlanConditionFieldLastUpdateTime     = "lastUpdateTime"
+	ClusterUpgradePlanConditionFieldMessage            = "message"
+	ClusterUpgradePlanConditionFieldReason             = "reason"
+	ClusterUpgradePlanConditionFieldStatus             = "status"
+	ClusterUpgradePlanConditionFieldType               = "type"
+)
+
+type ClusterUpgradePlanCondition struct {
+	LastTransitionTime string `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	LastUpdateTime     string `json:"lastUpdateTime,omitempty" yaml:"lastUpdateTime,omitempty"`
+	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
+	Reason             string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Status             string `json:"status,omitempty" yaml:"status,omitempty"`
+	Type               string `json:"type,omitempty" yaml:"type,omitempty"`
+}
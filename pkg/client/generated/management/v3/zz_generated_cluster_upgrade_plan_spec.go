@@ -0,0 +1,24 @@
+package client
+
+const (
+	ClusterUpgradePlanSpecType                   = "clusterUpgradePlanSpec"
+	ClusterUpgradePlanSpecFieldAutoRollback      = "autoRollback"
+	ClusterUpgradePlanSpecFieldClusterID         = "clusterId"
+	ClusterUpgradePlanSpecFieldDisplayName       = "displayName"
+	ClusterUpgradePlanSpecFieldKubernetesVersion = "kubernetesVersion"
+	ClusterUpgradePlanSpecFieldPaused            = "paused"
+	ClusterUpgradePlanSpecFieldPreflightChecks   = "preflightChecks"
+	ClusterUpgradePlanSpecFieldRollback          = "rollback"
+	ClusterUpgradePlanSpecFieldUpgradeStrategy   = "upgradeStrategy"
+)
+
+type ClusterUpgradePlanSpec struct {
+	AutoRollback      bool                    `json:"autoRollback,omitempty" yaml:"autoRollback,omitempty"`
+	ClusterID         string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	DisplayName       string                  `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	KubernetesVersion string                  `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
+	Paused            bool                    `json:"paused,omitempty" yaml:"paused,omitempty"`
+	PreflightChecks   *UpgradePreflightChecks `json:"preflightChecks,omitempty" yaml:"preflightChecks,omitempty"`
+	Rollback          bool                    `json:"rollback,omitempty" yaml:"rollback,omitempty"`
+	UpgradeStrategy   *NodeUpgradeStrategy    `json:"upgradeStrategy,omitempty" yaml:"upgradeStrategy,omitempty"`
+}
@@ -0,0 +1,24 @@
+package client
+
+const (
+	ClusterUpgradePlanStatusType                           = "clusterUpgradePlanStatus"
+	ClusterUpgradePlanStatusFieldCompletedTime             = "completedTime"
+	ClusterUpgradePlanStatusFieldConditions                = "conditions"
+	ClusterUpgradePlanStatusFieldPhase                     = "phase"
+	ClusterUpgradePlanStatusFieldPreflightResults          = "preflightResults"
+	ClusterUpgradePlanStatusFieldPreviousKubernetesVersion = "previousKubernetesVersion"
+	ClusterUpgradePlanStatusFieldSnapshotID                = "snapshotId"
+	ClusterUpgradePlanStatusFieldTotalNodes                = "totalNodes"
+	ClusterUpgradePlanStatusFieldUpgradedNodes             = "upgradedNodes"
+)
+
+type ClusterUpgradePlanStatus struct {
+	CompletedTime             string                        `json:"completedTime,omitempty" yaml:"completedTime,omitempty"`
+	Conditions                []ClusterUpgradePlanCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Phase                     string                        `json:"phase,omitempty" yaml:"phase,omitempty"`
+	PreflightResults          []UpgradePreflightResult      `json:"preflightResults,omitempty" yaml:"preflightResults,omitempty"`
+	PreviousKubernetesVersion string                        `json:"previousKubernetesVersion,omitempty" yaml:"previousKubernetesVersion,omitempty"`
+	SnapshotID                string                        `json:"snapshotId,omitempty" yaml:"snapshotId,omitempty"`
+	TotalNodes                int64                         `json:"totalNodes,omitempty" yaml:"totalNodes,omitempty"`
+	UpgradedNodes             int64                         `json:"upgradedNodes,omitempty" yaml:"upgradedNodes,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	DecommissionConfigType                = "decommissionConfig"
+	DecommissionConfigFieldS3BackupConfig = "s3BackupConfig"
+	DecommissionConfigFieldSkipExport     = "skipExport"
+)
+
+type DecommissionConfig struct {
+	S3BackupConfig *S3BackupConfig `json:"s3BackupConfig,omitempty" yaml:"s3BackupConfig,omitempty"`
+	SkipExport     bool            `json:"skipExport,omitempty" yaml:"skipExport,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	DecommissionStatusType                = "decommissionStatus"
+	DecommissionStatusFieldArtifacts      = "artifacts"
+	DecommissionStatusFieldCompletedAt    = "completedAt"
+	DecommissionStatusFieldEtcdBackupName = "etcdBackupName"
+	DecommissionStatusFieldStartedAt      = "startedAt"
+)
+
+type DecommissionStatus struct {
+	Artifacts      []string `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
+	CompletedAt    string   `json:"completedAt,omitempty" yaml:"completedAt,omitempty"`
+	EtcdBackupName string   `json:"etcdBackupName,omitempty" yaml:"etcdBackupName,omitempty"`
+	StartedAt      string   `json:"startedAt,omitempty" yaml:"startedAt,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	HPARuleType                 = "hpaRule"
+	HPARuleFieldDurationSeconds = "durationSeconds"
+	HPARuleFieldHPAName         = "hpaName"
+	HPARuleFieldSelector        = "selector"
+)
+
+type HPARule struct {
+	DurationSeconds int64             `json:"durationSeconds,omitempty" yaml:"durationSeconds,omitempty"`
+	HPAName         string            `json:"hpaName,omitempty" yaml:"hpaName,omitempty"`
+	Selector        map[string]string `json:"selector,omitempty" yaml:"selector,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	IncidentEventType           = "incidentEvent"
+	IncidentEventFieldEventType = "eventType"
+	IncidentEventFieldMessage   = "message"
+	IncidentEventFieldTime      = "time"
+)
+
+type IncidentEvent struct {
+	EventType string `json:"eventType,omitempty" yaml:"eventType,omitempty"`
+	Message   string `json:"message,omitempty" yaml:"message,omitempty"`
+	Time      string `json:"time,omitempty" yaml:"time,omitempty"`
+}
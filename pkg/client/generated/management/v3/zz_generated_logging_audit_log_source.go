@@ -0,0 +1,12 @@
+package client
+
+const (
+	LoggingAuditLogSourceType       = "loggingAuditLogSource"
+	LoggingAuditLogSourceFieldIndex = "index"
+	LoggingAuditLogSourceFieldPath  = "path"
+)
+
+type LoggingAuditLogSource struct {
+	Index string `json:"index,omitempty" yaml:"index,omitempty"`
+	Path  string `json:"path,omitempty" yaml:"path,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	LoggingAuditLogSourcesType               = "loggingAuditLogSources"
+	LoggingAuditLogSourcesFieldKubeAPIServer = "kubeApiServer"
+	LoggingAuditLogSourcesFieldRancher       = "rancher"
+)
+
+type LoggingAuditLogSources struct {
+	KubeAPIServer *LoggingAuditLogSource `json:"kubeApiServer,omitempty" yaml:"kubeApiServer,omitempty"`
+	Rancher       *LoggingAuditLogSource `json:"rancher,omitempty" yaml:"rancher,omitempty"`
+}
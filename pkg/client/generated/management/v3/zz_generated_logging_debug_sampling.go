@@ -0,0 +1,12 @@
+package client
+
+const (
+	LoggingDebugSamplingType                = "loggingDebugSampling"
+	LoggingDebugSamplingFieldKeepPercentage = "keepPercentage"
+	LoggingDebugSamplingFieldLevelField     = "levelField"
+)
+
+type LoggingDebugSampling struct {
+	KeepPercentage int64  `json:"keepPercentage,omitempty" yaml:"keepPercentage,omitempty"`
+	LevelField     string `json:"levelField,omitempty" yaml:"levelField,omitempty"`
+}
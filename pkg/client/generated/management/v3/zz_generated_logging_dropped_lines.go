@@ -0,0 +1,12 @@
+package client
+
+const (
+	LoggingDroppedLinesType          = "loggingDroppedLines"
+	LoggingDroppedLinesFieldExcluded = "excluded"
+	LoggingDroppedLinesFieldSampled  = "sampled"
+)
+
+type LoggingDroppedLines struct {
+	Excluded int64 `json:"excluded,omitempty" yaml:"excluded,omitempty"`
+	Sampled  int64 `json:"sampled,omitempty" yaml:"sampled,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	LoggingFieldTransformType              = "loggingFieldTransform"
+	LoggingFieldTransformFieldDropFields   = "dropFields"
+	LoggingFieldTransformFieldRedactFields = "redactFields"
+	LoggingFieldTransformFieldRenameFields = "renameFields"
+)
+
+type LoggingFieldTransform struct {
+	DropFields   []string          `json:"dropFields,omitempty" yaml:"dropFields,omitempty"`
+	RedactFields []string          `json:"redactFields,omitempty" yaml:"redactFields,omitempty"`
+	RenameFields map[string]string `json:"renameFields,omitempty" yaml:"renameFields,omitempty"`
+}
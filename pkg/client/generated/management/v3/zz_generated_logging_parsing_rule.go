@@ -0,0 +1,20 @@
+package client
+
+const (
+	LoggingParsingRuleType                   = "loggingParsingRule"
+	LoggingParsingRuleFieldExpression        = "expression"
+	LoggingParsingRuleFieldFormat            = "format"
+	LoggingParsingRuleFieldKeyValueDelimiter = "keyValueDelimiter"
+	LoggingParsingRuleFieldKeyValueSeparator = "keyValueSeparator"
+	LoggingParsingRuleFieldName              = "name"
+	LoggingParsingRuleFieldNamespaces        = "namespaces"
+)
+
+type LoggingParsingRule struct {
+	Expression        string   `json:"expression,omitempty" yaml:"expression,omitempty"`
+	Format            string   `json:"format,omitempty" yaml:"format,omitempty"`
+	KeyValueDelimiter string   `json:"keyValueDelimiter,omitempty" yaml:"keyValueDelimiter,omitempty"`
+	KeyValueSeparator string   `json:"keyValueSeparator,omitempty" yaml:"keyValueSeparator,omitempty"`
+	Name              string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Namespaces        []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	LoggingSelectorType                   = "loggingSelector"
+	LoggingSelectorFieldExcludeNamespaces = "excludeNamespaces"
+	LoggingSelectorFieldExcludeWorkloads  = "excludeWorkloads"
+	LoggingSelectorFieldIncludeNamespaces = "includeNamespaces"
+	LoggingSelectorFieldIncludeWorkloads  = "includeWorkloads"
+)
+
+type LoggingSelector struct {
+	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty" yaml:"excludeNamespaces,omitempty"`
+	ExcludeWorkloads  []string `json:"excludeWorkloads,omitempty" yaml:"excludeWorkloads,omitempty"`
+	IncludeNamespaces []string `json:"includeNamespaces,omitempty" yaml:"includeNamespaces,omitempty"`
+	IncludeWorkloads  []string `json:"includeWorkloads,omitempty" yaml:"includeWorkloads,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	LoggingTestOutputType         = "loggingTestOutput"
+	LoggingTestOutputFieldRecords = "records"
+)
+
+type LoggingTestOutput struct {
+	Records []LoggingTestRecord `json:"records,omitempty" yaml:"records,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	LoggingTestRecordType        = "loggingTestRecord"
+	LoggingTestRecordFieldErrors = "errors"
+	LoggingTestRecordFieldFields = "fields"
+)
+
+type LoggingTestRecord struct {
+	Errors []string          `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Fields map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
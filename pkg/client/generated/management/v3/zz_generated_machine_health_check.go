@@ -0,0 +1,14 @@
+package client
+
+const (
+	MachineHealthCheckType                     = "machineHealthCheck"
+	MachineHealthCheckFieldMaxUnhealthy        = "maxUnhealthy"
+	MachineHealthCheckFieldRemediation         = "remediation"
+	MachineHealthCheckFieldUnhealthyConditions = "unhealthyConditions"
+)
+
+type MachineHealthCheck struct {
+	MaxUnhealthy        string               `json:"maxUnhealthy,omitempty" yaml:"maxUnhealthy,omitempty"`
+	Remediation         string               `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty" yaml:"unhealthyConditions,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	MaintenanceBlackoutType        = "maintenanceBlackout"
+	MaintenanceBlackoutFieldEnd    = "end"
+	MaintenanceBlackoutFieldReason = "reason"
+	MaintenanceBlackoutFieldStart  = "start"
+)
+
+type MaintenanceBlackout struct {
+	End    string `json:"end,omitempty" yaml:"end,omitempty"`
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Start  string `json:"start,omitempty" yaml:"start,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	MaintenanceStatusType                = "maintenanceStatus"
+	MaintenanceStatusFieldPlannedActions = "plannedActions"
+)
+
+type MaintenanceStatus struct {
+	PlannedActions []PlannedMaintenanceAction `json:"plannedActions,omitempty" yaml:"plannedActions,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	MaintenanceWindowType               = "maintenanceWindow"
+	MaintenanceWindowFieldBlackouts     = "blackouts"
+	MaintenanceWindowFieldOverrideUntil = "overrideUntil"
+	MaintenanceWindowFieldWindows       = "windows"
+)
+
+type MaintenanceWindow struct {
+	Blackouts     []MaintenanceBlackout       `json:"blackouts,omitempty" yaml:"blackouts,omitempty"`
+	OverrideUntil string                      `json:"overrideUntil,omitempty" yaml:"overrideUntil,omitempty"`
+	Windows       []MaintenanceWindowSchedule `json:"windows,omitempty" yaml:"windows,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	MaintenanceWindowScheduleType                 = "maintenanceWindowSchedule"
+	MaintenanceWindowScheduleFieldCronSchedule    = "cronSchedule"
+	MaintenanceWindowScheduleFieldDurationMinutes = "durationMinutes"
+)
+
+type MaintenanceWindowSchedule struct {
+	CronSchedule    string `json:"cronSchedule,omitempty" yaml:"cronSchedule,omitempty"`
+	DurationMinutes int64  `json:"durationMinutes,omitempty" yaml:"durationMinutes,omitempty"`
+}
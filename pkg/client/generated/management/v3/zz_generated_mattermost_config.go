@@ -0,0 +1,14 @@
+package client
+
+const (
+	MattermostConfigType                  = "mattermostConfig"
+	MattermostConfigFieldDefaultRecipient = "defaultRecipient"
+	MattermostConfigFieldProxyURL         = "proxyUrl"
+	MattermostConfigFieldURL              = "url"
+)
+
+type MattermostConfig struct {
+	DefaultRecipient string `json:"defaultRecipient,omitempty" yaml:"defaultRecipient,omitempty"`
+	ProxyURL         string `json:"proxyUrl,omitempty" yaml:"proxyUrl,omitempty"`
+	URL              string `json:"url,omitempty" yaml:"url,omitempty"`
+}
@@ -8,6 +8,7 @@
 	MultiClusterAppType                      = "multiClusterApp"
 	MultiClusterAppFieldAnnotations          = "annotations"
 	MultiClusterAppFieldAnswers              = "answers"
+	MultiClusterAppFieldClusterGroupTargets  = "clusterGroupTargets"
 	MultiClusterAppFieldCreated              = "created"
 	MultiClusterAppFieldCreatorID            = "creatorId"
 	MultiClusterAppFieldLabels               = "labels"
@@ -33,6 +34,7 @@ type MultiClusterApp struct {
 	types.Resource
 	Annotations          map[string]string      `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 	Answers              []Answer               `json:"answers,omitempty" yaml:"answers,omitempty"`
+	ClusterGroupTargets  []ClusterGroupTarget   `json:"clusterGroupTargets,omitempty" yaml:"clusterGroupTargets,omitempty"`
 	Created              string                 `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID            string                 `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Labels               map[string]string      `json:"labels,omitempty" yaml:"labels,omitempty"`
@@ -38,6 +38,7 @@
 	NodeFieldPodCidrs             = "podCidrs"
 	NodeFieldProviderId           = "providerId"
 	NodeFieldPublicEndpoints      = "publicEndpoints"
+	NodeFieldRemediations         = "remediations"
 	NodeFieldRemoved              = "removed"
 	NodeFieldRequested            = "requested"
 	NodeFieldRequestedHostname    = "requestedHostname"
@@ -87,6 +88,7 @@ type Node struct {
 	PodCidrs             []string                  `json:"podCidrs,omitempty" yaml:"podCidrs,omitempty"`
 	ProviderId           string                    `json:"providerId,omitempty" yaml:"providerId,omitempty"`
 	PublicEndpoints      []PublicEndpoint          `json:"publicEndpoints,omitempty" yaml:"publicEndpoints,omitempty"`
+	Remediations         []NodeRemediation         `json:"remediations,omitempty" yaml:"remediations,omitempty"`
 	Removed              string                    `json:"removed,omitempty" yaml:"removed,omitempty"`
 	Requested            map[string]string         `json:"requested,omitempty" yaml:"requested,omitempty"`
 	RequestedHostname    string                    `json:"requestedHostname,omitempty" yaml:"requestedHostname,omitempty"`
@@ -0,0 +1,22 @@
+package client
+
+const (
+	NodePoolRolloutStatusType                       = "nodePoolRolloutStatus"
+	NodePoolRolloutStatusFieldAvailableUpdatedNodes = "availableUpdatedNodes"
+	NodePoolRolloutStatusFieldCompletedAt           = "completedAt"
+	NodePoolRolloutStatusFieldMessage               = "message"
+	NodePoolRolloutStatusFieldOldNodes              = "oldNodes"
+	NodePoolRolloutStatusFieldStartedAt             = "startedAt"
+	NodePoolRolloutStatusFieldTemplateRevision      = "templateRevision"
+	NodePoolRolloutStatusFieldUpdatedNodes          = "updatedNodes"
+)
+
+type NodePoolRolloutStatus struct {
+	AvailableUpdatedNodes int64  `json:"availableUpdatedNodes,omitempty" yaml:"availableUpdatedNodes,omitempty"`
+	CompletedAt           string `json:"completedAt,omitempty" yaml:"completedAt,omitempty"`
+	Message               string `json:"message,omitempty" yaml:"message,omitempty"`
+	OldNodes              int64  `json:"oldNodes,omitempty" yaml:"oldNodes,omitempty"`
+	StartedAt             string `json:"startedAt,omitempty" yaml:"startedAt,omitempty"`
+	TemplateRevision      string `json:"templateRevision,omitempty" yaml:"templateRevision,omitempty"`
+	UpdatedNodes          int64  `json:"updatedNodes,omitempty" yaml:"updatedNodes,omitempty"`
+}
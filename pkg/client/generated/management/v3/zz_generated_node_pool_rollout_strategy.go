@@ -0,0 +1,14 @@
+package client
+
+const (
+	NodePoolRolloutStrategyType                = "nodePoolRolloutStrategy"
+	NodePoolRolloutStrategyFieldMaxSurge       = "maxSurge"
+	NodePoolRolloutStrategyFieldMaxUnavailable = "maxUnavailable"
+	NodePoolRolloutStrategyFieldNodeDrainInput = "nodeDrainInput"
+)
+
+type NodePoolRolloutStrategy struct {
+	MaxSurge       string          `json:"maxSurge,omitempty" yaml:"maxSurge,omitempty"`
+	MaxUnavailable string          `json:"maxUnavailable,omitempty" yaml:"maxUnavailable,omitempty"`
+	NodeDrainInput *NodeDrainInput `json:"nodeDrainInput,omitempty" yaml:"nodeDrainInput,omitempty"`
+}
@@ -7,26 +7,34 @@
 	NodePoolSpecFieldDeleteNotReadyAfterSecs = "deleteNotReadyAfterSecs"
 	NodePoolSpecFieldDisplayName             = "displayName"
 	NodePoolSpecFieldEtcd                    = "etcd"
+	NodePoolSpecFieldHealthCheck             = "healthCheck"
 	NodePoolSpecFieldHostnamePrefix          = "hostnamePrefix"
+	NodePoolSpecFieldMaxQuantity             = "maxQuantity"
+	NodePoolSpecFieldMinQuantity             = "minQuantity"
 	NodePoolSpecFieldNodeAnnotations         = "nodeAnnotations"
 	NodePoolSpecFieldNodeLabels              = "nodeLabels"
 	NodePoolSpecFieldNodeTaints              = "nodeTaints"
 	NodePoolSpecFieldNodeTemplateID          = "nodeTemplateId"
 	NodePoolSpecFieldQuantity                = "quantity"
+	NodePoolSpecFieldRolloutStrategy         = "rolloutStrategy"
 	NodePoolSpecFieldWorker                  = "worker"
 )
 
 type NodePoolSpec struct {
-	ClusterID               string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	ControlPlane            bool              `json:"controlPlane,omitempty" yaml:"controlPlane,omitempty"`
-	DeleteNotReadyAfterSecs int64             `json:"deleteNotReadyAfterSecs,omitempty" yaml:"deleteNotReadyAfterSecs,omitempty"`
-	DisplayName             string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	Etcd                    bool              `json:"etcd,omitempty" yaml:"etcd,omitempty"`
-	HostnamePrefix          string            `json:"hostnamePrefix,omitempty" yaml:"hostnamePrefix,omitempty"`
-	NodeAnnotations         map[string]string `json:"nodeAnnotations,omitempty" yaml:"nodeAnnotations,omitempty"`
-	NodeLabels              map[string]string `json:"nodeLabels,omitempty" yaml:"nodeLabels,omitempty"`
-	NodeTaints              []Taint           `json:"nodeTaints,omitempty" yaml:"nodeTaints,omitempty"`
-	NodeTemplateID          string            `json:"nodeTemplateId,omitempty" yaml:"nodeTemplateId,omitempty"`
-	Quantity                int64             `json:"quantity,omitempty" yaml:"quantity,omitempty"`
-	Worker                  bool              `json:"worker,omitempty" yaml:"worker,omitempty"`
+	ClusterID               string                   `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	ControlPlane            bool                     `json:"controlPlane,omitempty" yaml:"controlPlane,omitempty"`
+	DeleteNotReadyAfterSecs int64                    `json:"deleteNotReadyAfterSecs,omitempty" yaml:"deleteNotReadyAfterSecs,omitempty"`
+	DisplayName             string                   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Etcd                    bool                     `json:"etcd,omitempty" yaml:"etcd,omitempty"`
+	HealthCheck             *MachineHealthCheck      `json:"healthCheck,omitempty" yaml:"healthCheck,omitempty"`
+	HostnamePrefix          string                   `json:"hostnamePrefix,omitempty" yaml:"hostnamePrefix,omitempty"`
+	MaxQuantity             int64                    `json:"maxQuantity,omitempty" yaml:"maxQuantity,omitempty"`
+	MinQuantity             int64                    `json:"minQuantity,omitempty" yaml:"minQuantity,omitempty"`
+	NodeAnnotations         map[string]string        `json:"nodeAnnotations,omitempty" yaml:"nodeAnnotations,omitempty"`
+	NodeLabels              map[string]string        `json:"nodeLabels,omitempty" yaml:"nodeLabels,omitempty"`
+	NodeTaints              []Taint                  `json:"nodeTaints,omitempty" yaml:"nodeTaints,omitempty"`
+	NodeTemplateID          string                   `json:"nodeTemplateId,omitempty" yaml:"nodeTemplateId,omitempty"`
+	Quantity                int64                    `json:"quantity,omitempty" yaml:"quantity,omitempty"`
+	RolloutStrategy         *NodePoolRolloutStrategy `json:"rolloutStrategy,omitempty" yaml:"rolloutStrategy,omitempty"`
+	Worker                  bool                     `json:"worker,omitempty" yaml:"worker,omitempty"`
 }
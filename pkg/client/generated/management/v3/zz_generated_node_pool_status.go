@@ -1,10 +1,14 @@
 package client
 
 const (
-	NodePoolStatusType            = "nodePoolStatus"
-	NodePoolStatusFieldConditions = "conditions"
+	NodePoolStatusType               = "nodePoolStatus"
+	NodePoolStatusFieldConditions    = "conditions"
+	NodePoolStatusFieldLastScaleTime = "lastScaleTime"
+	NodePoolStatusFieldRollout       = "rollout"
 )
 
 type NodePoolStatus struct {
-	Conditions []Condition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Conditions    []Condition            `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	LastScaleTime string                 `json:"lastScaleTime,omitempty" yaml:"lastScaleTime,omitempty"`
+	Rollout       *NodePoolRolloutStatus `json:"rollout,omitempty" yaml:"rollout,omitempty"`
 }
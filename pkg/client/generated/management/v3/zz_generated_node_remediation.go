@@ -0,0 +1,16 @@
+package client
+
+const (
+	NodeRemediationType           = "nodeRemediation"
+	NodeRemediationFieldAction    = "action"
+	NodeRemediationFieldCondition = "condition"
+	NodeRemediationFieldMessage   = "message"
+	NodeRemediationFieldTime      = "time"
+)
+
+type NodeRemediation struct {
+	Action    string `json:"action,omitempty" yaml:"action,omitempty"`
+	Condition string `json:"condition,omitempty" yaml:"condition,omitempty"`
+	Message   string `json:"message,omitempty" yaml:"message,omitempty"`
+	Time      string `json:"time,omitempty" yaml:"time,omitempty"`
+}
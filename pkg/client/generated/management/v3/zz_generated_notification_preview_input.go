@@ -0,0 +1,14 @@
+package client
+
+const (
+	NotificationPreviewInputType           = "notificationPreviewInput"
+	NotificationPreviewInputFieldAlertType = "alertType"
+	NotificationPreviewInputFieldResolved  = "resolved"
+	NotificationPreviewInputFieldTemplate  = "template"
+)
+
+type NotificationPreviewInput struct {
+	AlertType string                `json:"alertType,omitempty" yaml:"alertType,omitempty"`
+	Resolved  bool                  `json:"resolved,omitempty" yaml:"resolved,omitempty"`
+	Template  *NotificationTemplate `json:"template,omitempty" yaml:"template,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	NotificationPreviewOutputType         = "notificationPreviewOutput"
+	NotificationPreviewOutputFieldHTML    = "html"
+	NotificationPreviewOutputFieldPayload = "payload"
+	NotificationPreviewOutputFieldText    = "text"
+	NotificationPreviewOutputFieldTitle   = "title"
+)
+
+type NotificationPreviewOutput struct {
+	HTML    string `json:"html,omitempty" yaml:"html,omitempty"`
+	Payload string `json:"payload,omitempty" yaml:"payload,omitempty"`
+	Text    string `json:"text,omitempty" yaml:"text,omitempty"`
+	Title   string `json:"title,omitempty" yaml:"title,omitempty"`
+}
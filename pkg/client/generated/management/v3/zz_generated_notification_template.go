@@ -0,0 +1,16 @@
+package client
+
+const (
+	NotificationTemplateType         = "notificationTemplate"
+	NotificationTemplateFieldHTML    = "html"
+	NotificationTemplateFieldPayload = "payload"
+	NotificationTemplateFieldText    = "text"
+	NotificationTemplateFieldTitle   = "title"
+)
+
+type NotificationTemplate struct {
+	HTML    string `json:"html,omitempty" yaml:"html,omitempty"`
+	Payload string `json:"payload,omitempty" yaml:"payload,omitempty"`
+	Text    string `json:"text,omitempty" yaml:"text,omitempty"`
+	Title   string `json:"title,omitempty" yaml:"title,omitempty"`
+}
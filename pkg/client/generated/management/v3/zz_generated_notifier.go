@@ -7,15 +7,19 @@
 const (
 	NotifierType                      = "notifier"
 	NotifierFieldAnnotations          = "annotations"
+	NotifierFieldClusterGroupID       = "clusterGroupId"
 	NotifierFieldClusterID            = "clusterId"
 	NotifierFieldCreated              = "created"
 	NotifierFieldCreatorID            = "creatorId"
 	NotifierFieldDescription          = "description"
 	NotifierFieldDingtalkConfig       = "dingtalkConfig"
+	NotifierFieldGoogleChatConfig     = "googleChatConfig"
 	NotifierFieldLabels               = "labels"
 	NotifierFieldMSTeamsConfig        = "msteamsConfig"
+	NotifierFieldMattermostConfig     = "mattermostConfig"
 	NotifierFieldName                 = "name"
 	NotifierFieldNamespaceId          = "namespaceId"
+	NotifierFieldOpsgenieConfig       = "opsgenieConfig"
 	NotifierFieldOwnerReferences      = "ownerReferences"
 	NotifierFieldPagerdutyConfig      = "pagerdutyConfig"
 	NotifierFieldRemoved              = "removed"
@@ -24,6 +28,8 @@
 	NotifierFieldSlackConfig          = "slackConfig"
 	NotifierFieldState                = "state"
 	NotifierFieldStatus               = "status"
+	NotifierFieldTelegramConfig       = "telegramConfig"
+	NotifierFieldTemplate             = "template"
 	NotifierFieldTransitioning        = "transitioning"
 	NotifierFieldTransitioningMessage = "transitioningMessage"
 	NotifierFieldUUID                 = "uuid"
@@ -33,29 +39,35 @@
 
 type Notifier struct {
 	types.Resource
-	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterID            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
-	DingtalkConfig       *DingtalkConfig   `json:"dingtalkConfig,omitempty" yaml:"dingtalkConfig,omitempty"`
-	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	MSTeamsConfig        *MSTeamsConfig    `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
-	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	PagerdutyConfig      *PagerdutyConfig  `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
-	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	SMTPConfig           *SMTPConfig       `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
-	SendResolved         bool              `json:"sendResolved,omitempty" yaml:"sendResolved,omitempty"`
-	SlackConfig          *SlackConfig      `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
-	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
-	Status               *NotifierStatus   `json:"status,omitempty" yaml:"status,omitempty"`
-	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
-	WebhookConfig        *WebhookConfig    `json:"webhookConfig,omitempty" yaml:"webhookConfig,omitempty"`
-	WechatConfig         *WechatConfig     `json:"wechatConfig,omitempty" yaml:"wechatConfig,omitempty"`
+	Annotations          map[string]string     `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterGroupID       string                `json:"clusterGroupId,omitempty" yaml:"clusterGroupId,omitempty"`
+	ClusterID            string                `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string                `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description          string                `json:"description,omitempty" yaml:"description,omitempty"`
+	DingtalkConfig       *DingtalkConfig       `json:"dingtalkConfig,omitempty" yaml:"dingtalkConfig,omitempty"`
+	GoogleChatConfig     *GoogleChatConfig     `json:"googleChatConfig,omitempty" yaml:"googleChatConfig,omitempty"`
+	Labels               map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MSTeamsConfig        *MSTeamsConfig        `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
+	MattermostConfig     *MattermostConfig     `json:"mattermostConfig,omitempty" yaml:"mattermostConfig,omitempty"`
+	Name                 string                `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string                `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OpsgenieConfig       *OpsgenieConfig       `json:"opsgenieConfig,omitempty" yaml:"opsgenieConfig,omitempty"`
+	OwnerReferences      []OwnerReference      `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PagerdutyConfig      *PagerdutyConfig      `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
+	Removed              string                `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SMTPConfig           *SMTPConfig           `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
+	SendResolved         bool                  `json:"sendResolved,omitempty" yaml:"sendResolved,omitempty"`
+	SlackConfig          *SlackConfig          `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
+	State                string                `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *NotifierStatus       `json:"status,omitempty" yaml:"status,omitempty"`
+	TelegramConfig       *TelegramConfig       `json:"telegramConfig,omitempty" yaml:"telegramConfig,omitempty"`
+	Template             *NotificationTemplate `json:"template,omitempty" yaml:"template,omitempty"`
+	Transitioning        string                `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string                `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string                `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	WebhookConfig        *WebhookConfig        `json:"webhookConfig,omitempty" yaml:"webhookConfig,omitempty"`
+	WechatConfig         *WechatConfig         `json:"wechatConfig,omitempty" yaml:"wechatConfig,omitempty"`
 }
 
 type NotifierCollection struct {
@@ -77,8 +89,12 @@ type NotifierOperations interface {
 	ByID(id string) (*Notifier, error)
 	Delete(container *Notifier) error
 
+	ActionPreview(resource *Notifier, input *NotificationPreviewInput) (*NotificationPreviewOutput, error)
+
 	ActionSend(resource *Notifier, input *Notification) error
 
+	CollectionActionPreview(resource *NotifierCollection, input *NotificationPreviewInput) (*NotificationPreviewOutput, error)
+
 	CollectionActionSend(resource *NotifierCollection, input *Notification) error
 }
 
@@ -151,11 +167,23 @@ func (c *NotifierClient) Delete(container *Notifier) error {
 	return c.apiClient.Ops.DoResourceDelete(NotifierType, &container.Resource)
 }
 
+func (c *NotifierClient) ActionPreview(resource *Notifier, input *NotificationPreviewInput) (*NotificationPreviewOutput, error) {
+	resp := &NotificationPreviewOutput{}
+	err := c.apiClient.Ops.DoAction(NotifierType, "preview", &resource.Resource, input, resp)
+	return resp, err
+}
+
 func (c *NotifierClient) ActionSend(resource *Notifier, input *Notification) error {
 	err := c.apiClient.Ops.DoAction(NotifierType, "send", &resource.Resource, input, nil)
 	return err
 }
 
+func (c *NotifierClient) CollectionActionPreview(resource *NotifierCollection, input *NotificationPreviewInput) (*NotificationPreviewOutput, error) {
+	resp := &NotificationPreviewOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(NotifierType, "preview", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *NotifierClient) CollectionActionSend(resource *NotifierCollection, input *Notification) error {
 	err := c.apiClient.Ops.DoCollectionAction(NotifierType, "send", &resource.Collection, input, nil)
 	return err
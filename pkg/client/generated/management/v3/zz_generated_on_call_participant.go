@@ -0,0 +1,14 @@
+package client
+
+const (
+	OnCallParticipantType            = "onCallParticipant"
+	OnCallParticipantFieldName       = "name"
+	OnCallParticipantFieldNotifierID = "notifierId"
+	OnCallParticipantFieldRecipient  = "recipient"
+)
+
+type OnCallParticipant struct {
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	NotifierID string `json:"notifierId,omitempty" yaml:"notifierId,omitempty"`
+	Recipient  string `json:"recipient,omitempty" yaml:"recipient,omitempty"`
+}
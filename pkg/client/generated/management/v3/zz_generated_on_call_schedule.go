@@ -0,0 +1,140 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	OnCallScheduleType                      = "onCallSchedule"
+	OnCallScheduleFieldAnnotations          = "annotations"
+	OnCallScheduleFieldClusterID            = "clusterId"
+	OnCallScheduleFieldCreated              = "created"
+	OnCallScheduleFieldCreatorID            = "creatorId"
+	OnCallScheduleFieldDescription          = "description"
+	OnCallScheduleFieldDisplayName          = "displayName"
+	OnCallScheduleFieldLabels               = "labels"
+	OnCallScheduleFieldName                 = "name"
+	OnCallScheduleFieldNamespaceId          = "namespaceId"
+	OnCallScheduleFieldOwnerReferences      = "ownerReferences"
+	OnCallScheduleFieldParticipants         = "participants"
+	OnCallScheduleFieldRemoved              = "removed"
+	OnCallScheduleFieldRotationHours        = "rotationHours"
+	OnCallScheduleFieldStartsAt             = "startsAt"
+	OnCallScheduleFieldState                = "state"
+	OnCallScheduleFieldStatus               = "status"
+	OnCallScheduleFieldTransitioning        = "transitioning"
+	OnCallScheduleFieldTransitioningMessage = "transitioningMessage"
+	OnCallScheduleFieldUUID                 = "uuid"
+)
+
+type OnCallSchedule struct {
+	types.Resource
+	Annotations          map[string]string     `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string                `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string                `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description          string                `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName          string                `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Labels               map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string                `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string                `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences      []OwnerReference      `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Participants         []OnCallParticipant   `json:"participants,omitempty" yaml:"participants,omitempty"`
+	Removed              string                `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RotationHours        int64                 `json:"rotationHours,omitempty" yaml:"rotationHours,omitempty"`
+	StartsAt             string                `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+	State                string                `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *OnCallScheduleStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning        string                `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string                `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string                `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type OnCallScheduleCollection struct {
+	types.Collection
+	Data   []OnCallSchedule `json:"data,omitempty"`
+	client *OnCallScheduleClient
+}
+
+type OnCallScheduleClient struct {
+	apiClient *Client
+}
+
+type OnCallScheduleOperations interface {
+	List(opts *types.ListOpts) (*OnCallScheduleCollection, error)
+	ListAll(opts *types.ListOpts) (*OnCallScheduleCollection, error)
+	Create(opts *OnCallSchedule) (*OnCallSchedule, error)
+	Update(existing *OnCallSchedule, updates interface{}) (*OnCallSchedule, error)
+	Replace(existing *OnCallSchedule) (*OnCallSchedule, error)
+	ByID(id string) (*OnCallSchedule, error)
+	Delete(container *OnCallSchedule) error
+}
+
+func newOnCallScheduleClient(apiClient *Client) *OnCallScheduleClient {
+	return &OnCallScheduleClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *OnCallScheduleClient) Create(container *OnCallSchedule) (*OnCallSchedule, error) {
+	resp := &OnCallSchedule{}
+	err := c.apiClient.Ops.DoCreate(OnCallScheduleType, container, resp)
+	return resp, err
+}
+
+func (c *OnCallScheduleClient) Update(existing *OnCallSchedule, updates interface{}) (*OnCallSchedule, error) {
+	resp := &OnCallSchedule{}
+	err := c.apiClient.Ops.DoUpdate(OnCallScheduleType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *OnCallScheduleClient) Replace(obj *OnCallSchedule) (*OnCallSchedule, error) {
+	resp := &OnCallSchedule{}
+	err := c.apiClient.Ops.DoReplace(OnCallScheduleType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *OnCallScheduleClient) List(opts *types.ListOpts) (*OnCallScheduleCollection, error) {
+	resp := &OnCallScheduleCollection{}
+	err := c.apiClient.Ops.DoList(OnCallScheduleType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *OnCallScheduleClient) ListAll(opts *types.ListOpts) (*OnCallScheduleCollection, error) {
+	resp := &OnCallScheduleCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *OnCallScheduleCollection) Next() (*OnCallScheduleCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &OnCallScheduleCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *OnCallScheduleClient) ByID(id string) (*OnCallSchedule, error) {
+	resp := &OnCallSchedule{}
+	err := c.apiClient.Ops.DoByID(OnCallScheduleType, id, resp)
+	return resp, err
+}
+
+func (c *OnCallScheduleClient) Delete(container *OnCallSchedule) error {
+	return c.apiClient.Ops.DoResourceDelete(OnCallScheduleType, &container.Resource)
+}
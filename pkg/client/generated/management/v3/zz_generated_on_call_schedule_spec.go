@@ -0,0 +1,20 @@
+package client
+
+const (
+	OnCallScheduleSpecType               = "onCallScheduleSpec"
+	OnCallScheduleSpecFieldClusterID     = "clusterId"
+	OnCallScheduleSpecFieldDescription   = "description"
+	OnCallScheduleSpecFieldDisplayName   = "displayName"
+	OnCallScheduleSpecFieldParticipants  = "participants"
+	OnCallScheduleSpecFieldRotationHours = "rotationHours"
+	OnCallScheduleSpecFieldStartsAt      = "startsAt"
+)
+
+type OnCallScheduleSpec struct {
+	ClusterID     string              `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Description   string              `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName   string              `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Participants  []OnCallParticipant `json:"participants,omitempty" yaml:"participants,omitempty"`
+	RotationHours int64               `json:"rotationHours,omitempty" yaml:"rotationHours,omitempty"`
+	StartsAt      string              `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+}
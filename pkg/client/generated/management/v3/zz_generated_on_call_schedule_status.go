@@ -0,0 +1,12 @@
+package client
+
+const (
+	OnCallScheduleStatusType                    = "onCallScheduleStatus"
+	OnCallScheduleStatusFieldCurrentParticipant = "currentParticipant"
+	OnCallScheduleStatusFieldNextHandoff        = "nextHandoff"
+)
+
+type OnCallScheduleStatus struct {
+	CurrentParticipant string `json:"currentParticipant,omitempty" yaml:"currentParticipant,omitempty"`
+	NextHandoff        string `json:"nextHandoff,omitempty" yaml:"nextHandoff,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	PlannedMaintenanceActionType             = "plannedMaintenanceAction"
+	PlannedMaintenanceActionFieldAction      = "action"
+	PlannedMaintenanceActionFieldMessage     = "message"
+	PlannedMaintenanceActionFieldPlannedTime = "plannedTime"
+)
+
+type PlannedMaintenanceAction struct {
+	Action      string `json:"action,omitempty" yaml:"action,omitempty"`
+	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+	PlannedTime string `json:"plannedTime,omitempty" yaml:"plannedTime,omitempty"`
+}
@@ -21,7 +21,9 @@
 	ProjectAlertGroupFieldRecipients            = "recipients"
 	ProjectAlertGroupFieldRemoved               = "removed"
 	ProjectAlertGroupFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ProjectAlertGroupFieldRoutes                = "routes"
 	ProjectAlertGroupFieldState                 = "state"
+	ProjectAlertGroupFieldTemplate              = "template"
 	ProjectAlertGroupFieldTransitioning         = "transitioning"
 	ProjectAlertGroupFieldTransitioningMessage  = "transitioningMessage"
 	ProjectAlertGroupFieldUUID                  = "uuid"
@@ -29,25 +31,27 @@
 
 type ProjectAlertGroup struct {
 	types.Resource
-	AlertState            string            `json:"alertState,omitempty" yaml:"alertState,omitempty"`
-	Annotations           map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description           string            `json:"description,omitempty" yaml:"description,omitempty"`
-	GroupIntervalSeconds  int64             `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64             `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name                  string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId           string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences       []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	ProjectID             string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
-	Recipients            []Recipient       `json:"recipients,omitempty" yaml:"recipients,omitempty"`
-	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	RepeatIntervalSeconds int64             `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
-	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
-	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage  string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                  string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	AlertState            string                `json:"alertState,omitempty" yaml:"alertState,omitempty"`
+	Annotations           map[string]string     `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created               string                `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID             string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description           string                `json:"description,omitempty" yaml:"description,omitempty"`
+	GroupIntervalSeconds  int64                 `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64                 `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	Labels                map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                  string                `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId           string                `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences       []OwnerReference      `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID             string                `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Recipients            []Recipient           `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Removed               string                `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RepeatIntervalSeconds int64                 `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute          `json:"routes,omitempty" yaml:"routes,omitempty"`
+	State                 string                `json:"state,omitempty" yaml:"state,omitempty"`
+	Template              *NotificationTemplate `json:"template,omitempty" yaml:"template,omitempty"`
+	Transitioning         string                `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage  string                `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                  string                `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 
 type ProjectAlertGroupCollection struct {
@@ -68,6 +72,8 @@ type ProjectAlertGroupOperations interface {
 	Replace(existing *ProjectAlertGroup) (*ProjectAlertGroup, error)
 	ByID(id string) (*ProjectAlertGroup, error)
 	Delete(container *ProjectAlertGroup) error
+
+	ActionPreview(resource *ProjectAlertGroup, input *NotificationPreviewInput) (*NotificationPreviewOutput, error)
 }
 
 func newProjectAlertGroupClient(apiClient *Client) *ProjectAlertGroupClient {
@@ -138,3 +144,9 @@ func (c *ProjectAlertGroupClient) ByID(id string) (*ProjectAlertGroup, error) {
 func (c *ProjectAlertGroupClient) Delete(container *ProjectAlertGroup) error {
 	return c.apiClient.Ops.DoResourceDelete(ProjectAlertGroupType, &container.Resource)
 }
+
+func (c *ProjectAlertGroupClient) ActionPreview(resource *ProjectAlertGroup, input *NotificationPreviewInput) (*NotificationPreviewOutput, error) {
+	resp := &NotificationPreviewOutput{}
+	err := c.apiClient.Ops.DoAction(ProjectAlertGroupType, "preview", &resource.Resource, input, resp)
+	return resp, err
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ProjectAlertIncidentType                      = "projectAlertIncident"
+	ProjectAlertIncidentFieldAlertLabels          = "alertLabels"
+	ProjectAlertIncidentFieldAlertType            = "alertType"
+	ProjectAlertIncidentFieldAnnotations          = "annotations"
+	ProjectAlertIncidentFieldCreated              = "created"
+	ProjectAlertIncidentFieldCreatorID            = "creatorId"
+	ProjectAlertIncidentFieldDisplayName          = "displayName"
+	ProjectAlertIncidentFieldFingerprint          = "fingerprint"
+	ProjectAlertIncidentFieldFiredAt              = "firedAt"
+	ProjectAlertIncidentFieldGroupID              = "groupId"
+	ProjectAlertIncidentFieldIncidentState        = "incidentState"
+	ProjectAlertIncidentFieldLabels               = "labels"
+	ProjectAlertIncidentFieldName                 = "name"
+	ProjectAlertIncidentFieldNamespaceId          = "namespaceId"
+	ProjectAlertIncidentFieldNotifierIDs          = "notifierIds"
+	ProjectAlertIncidentFieldOwnerReferences      = "ownerReferences"
+	ProjectAlertIncidentFieldProjectID            = "projectId"
+	ProjectAlertIncidentFieldRemoved              = "removed"
+	ProjectAlertIncidentFieldResolvedAt           = "resolvedAt"
+	ProjectAlertIncidentFieldRuleID               = "ruleId"
+	ProjectAlertIncidentFieldSeverity             = "severity"
+	ProjectAlertIncidentFieldState                = "state"
+	ProjectAlertIncidentFieldTimeline             = "timeline"
+	ProjectAlertIncidentFieldTransitioning        = "transitioning"
+	ProjectAlertIncidentFieldTransitioningMessage = "transitioningMessage"
+	ProjectAlertIncidentFieldUUID                 = "uuid"
+)
+
+type ProjectAlertIncident struct {
+	types.Resource
+	AlertLabels          map[string]string `json:"alertLabels,omitempty" yaml:"alertLabels,omitempty"`
+	AlertType            string            `json:"alertType,omitempty" yaml:"alertType,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DisplayName          string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Fingerprint          string            `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	FiredAt              string            `json:"firedAt,omitempty" yaml:"firedAt,omitempty"`
+	GroupID              string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	IncidentState        string            `json:"incidentState,omitempty" yaml:"incidentState,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NotifierIDs          []string          `json:"notifierIds,omitempty" yaml:"notifierIds,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID            string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ResolvedAt           string            `json:"resolvedAt,omitempty" yaml:"resolvedAt,omitempty"`
+	RuleID               string            `json:"ruleId,omitempty" yaml:"ruleId,omitempty"`
+	Severity             string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Timeline             []IncidentEvent   `json:"timeline,omitempty" yaml:"timeline,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ProjectAlertIncidentCollection struct {
+	types.Collection
+	Data   []ProjectAlertIncident `json:"data,omitempty"`
+	client *ProjectAlertIncidentClient
+}
+
+type ProjectAlertIncidentClient struct {
+	apiClient *Client
+}
+
+type ProjectAlertIncidentOperations interface {
+	List(opts *types.ListOpts) (*ProjectAlertIncidentCollection, error)
+	ListAll(opts *types.ListOpts) (*ProjectAlertIncidentCollection, error)
+	Create(opts *ProjectAlertIncident) (*ProjectAlertIncident, error)
+	Update(existing *ProjectAlertIncident, updates interface{}) (*ProjectAlertIncident, error)
+	Replace(existing *ProjectAlertIncident) (*ProjectAlertIncident, error)
+	ByID(id string) (*ProjectAlertIncident, error)
+	Delete(container *ProjectAlertIncident) error
+
+	CollectionActionSummary(resource *ProjectAlertIncidentCollection, input *AlertIncidentSummaryInput) (*AlertIncidentSummaryOutput, error)
+}
+
+func newProjectAlertIncidentClient(apiClient *Client) *ProjectAlertIncidentClient {
+	return &ProjectAlertIncidentClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ProjectAlertIncidentClient) Create(container *ProjectAlertIncident) (*ProjectAlertIncident, error) {
+	resp := &ProjectAlertIncident{}
+	err := c.apiClient.Ops.DoCreate(ProjectAlertIncidentType, container, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertIncidentClient) Update(existing *ProjectAlertIncident, updates interface{}) (*ProjectAlertIncident, error) {
+	resp := &ProjectAlertIncident{}
+	err := c.apiClient.Ops.DoUpdate(ProjectAlertIncidentType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertIncidentClient) Replace(obj *ProjectAlertIncident) (*ProjectAlertIncident, error) {
+	resp := &ProjectAlertIncident{}
+	err := c.apiClient.Ops.DoReplace(ProjectAlertIncidentType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertIncidentClient) List(opts *types.ListOpts) (*ProjectAlertIncidentCollection, error) {
+	resp := &ProjectAlertIncidentCollection{}
+	err := c.apiClient.Ops.DoList(ProjectAlertIncidentType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ProjectAlertIncidentClient) ListAll(opts *types.ListOpts) (*ProjectAlertIncidentCollection, error) {
+	resp := &ProjectAlertIncidentCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ProjectAlertIncidentCollection) Next() (*ProjectAlertIncidentCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ProjectAlertIncidentCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ProjectAlertIncidentClient) ByID(id string) (*ProjectAlertIncident, error) {
+	resp := &ProjectAlertIncident{}
+	err := c.apiClient.Ops.DoByID(ProjectAlertIncidentType, id, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertIncidentClient) Delete(container *ProjectAlertIncident) error {
+	return c.apiClient.Ops.DoResourceDelete(ProjectAlertIncidentType, &container.Resource)
+}
+
+func (c *ProjectAlertIncidentClient) CollectionActionSummary(resource *ProjectAlertIncidentCollection, input *AlertIncidentSummaryInput) (*AlertIncidentSummaryOutput, error) {
+	resp := &AlertIncidentSummaryOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(ProjectAlertIncidentType, "summary", &resource.Collection, input, resp)
+	return resp, err
+}
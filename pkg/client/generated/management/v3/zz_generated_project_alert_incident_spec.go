@@ -0,0 +1,26 @@
+package client
+
+const (
+	ProjectAlertIncidentSpecType             = "projectAlertIncidentSpec"
+	ProjectAlertIncidentSpecFieldAlertLabels = "alertLabels"
+	ProjectAlertIncidentSpecFieldAlertType   = "alertType"
+	ProjectAlertIncidentSpecFieldDisplayName = "displayName"
+	ProjectAlertIncidentSpecFieldFingerprint = "fingerprint"
+	ProjectAlertIncidentSpecFieldFiredAt     = "firedAt"
+	ProjectAlertIncidentSpecFieldGroupID     = "groupId"
+	ProjectAlertIncidentSpecFieldProjectID   = "projectId"
+	ProjectAlertIncidentSpecFieldRuleID      = "ruleId"
+	ProjectAlertIncidentSpecFieldSeverity    = "severity"
+)
+
+type ProjectAlertIncidentSpec struct {
+	AlertLabels map[string]string `json:"alertLabels,omitempty" yaml:"alertLabels,omitempty"`
+	AlertType   string            `json:"alertType,omitempty" yaml:"alertType,omitempty"`
+	DisplayName string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Fingerprint string            `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	FiredAt     string            `json:"firedAt,omitempty" yaml:"firedAt,omitempty"`
+	GroupID     string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	ProjectID   string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RuleID      string            `json:"ruleId,omitempty" yaml:"ruleId,omitempty"`
+	Severity    string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+}
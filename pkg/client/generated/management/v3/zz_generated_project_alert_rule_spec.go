@@ -2,29 +2,37 @@
 
 const (
 	ProjectAlertRuleSpecType                       = "projectAlertRuleSpec"
+	ProjectAlertRuleSpecFieldCertificateRule       = "certificateRule"
 	ProjectAlertRuleSpecFieldDisplayName           = "displayName"
 	ProjectAlertRuleSpecFieldGroupID               = "groupId"
 	ProjectAlertRuleSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
 	ProjectAlertRuleSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
+	ProjectAlertRuleSpecFieldHPARule               = "hpaRule"
 	ProjectAlertRuleSpecFieldInherited             = "inherited"
+	ProjectAlertRuleSpecFieldJobRule               = "jobRule"
 	ProjectAlertRuleSpecFieldMetricRule            = "metricRule"
 	ProjectAlertRuleSpecFieldPodRule               = "podRule"
 	ProjectAlertRuleSpecFieldProjectID             = "projectId"
 	ProjectAlertRuleSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
 	ProjectAlertRuleSpecFieldSeverity              = "severity"
+	ProjectAlertRuleSpecFieldVolumeRule            = "volumeRule"
 	ProjectAlertRuleSpecFieldWorkloadRule          = "workloadRule"
 )
 
 type ProjectAlertRuleSpec struct {
-	DisplayName           string        `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	GroupID               string        `json:"groupId,omitempty" yaml:"groupId,omitempty"`
-	GroupIntervalSeconds  int64         `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64         `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Inherited             *bool         `json:"inherited,omitempty" yaml:"inherited,omitempty"`
-	MetricRule            *MetricRule   `json:"metricRule,omitempty" yaml:"metricRule,omitempty"`
-	PodRule               *PodRule      `json:"podRule,omitempty" yaml:"podRule,omitempty"`
-	ProjectID             string        `json:"projectId,omitempty" yaml:"projectId,omitempty"`
-	RepeatIntervalSeconds int64         `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
-	Severity              string        `json:"severity,omitempty" yaml:"severity,omitempty"`
-	WorkloadRule          *WorkloadRule `json:"workloadRule,omitempty" yaml:"workloadRule,omitempty"`
+	CertificateRule       *CertificateRule `json:"certificateRule,omitempty" yaml:"certificateRule,omitempty"`
+	DisplayName           string           `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupID               string           `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	GroupIntervalSeconds  int64            `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64            `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	HPARule               *HPARule         `json:"hpaRule,omitempty" yaml:"hpaRule,omitempty"`
+	Inherited             *bool            `json:"inherited,omitempty" yaml:"inherited,omitempty"`
+	JobRule               *JobRule         `json:"jobRule,omitempty" yaml:"jobRule,omitempty"`
+	MetricRule            *MetricRule      `json:"metricRule,omitempty" yaml:"metricRule,omitempty"`
+	PodRule               *PodRule         `json:"podRule,omitempty" yaml:"podRule,omitempty"`
+	ProjectID             string           `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RepeatIntervalSeconds int64            `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Severity              string           `json:"severity,omitempty" yaml:"severity,omitempty"`
+	VolumeRule            *VolumeRule      `json:"volumeRule,omitempty" yaml:"volumeRule,omitempty"`
+	WorkloadRule          *WorkloadRule    `json:"workloadRule,omitempty" yaml:"workloadRule,omitempty"`
 }
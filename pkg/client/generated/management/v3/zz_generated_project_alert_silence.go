@@ -0,0 +1,144 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ProjectAlertSilenceType                      = "projectAlertSilence"
+	ProjectAlertSilenceFieldAcknowledgement      = "acknowledgement"
+	ProjectAlertSilenceFieldAnnotations          = "annotations"
+	ProjectAlertSilenceFieldComment              = "comment"
+	ProjectAlertSilenceFieldCreated              = "created"
+	ProjectAlertSilenceFieldCreatorID            = "creatorId"
+	ProjectAlertSilenceFieldEndsAt               = "endsAt"
+	ProjectAlertSilenceFieldExpiredAt            = "expiredAt"
+	ProjectAlertSilenceFieldLabels               = "labels"
+	ProjectAlertSilenceFieldMatchers             = "matchers"
+	ProjectAlertSilenceFieldName                 = "name"
+	ProjectAlertSilenceFieldNamespaceId          = "namespaceId"
+	ProjectAlertSilenceFieldOwnerReferences      = "ownerReferences"
+	ProjectAlertSilenceFieldProjectID            = "projectId"
+	ProjectAlertSilenceFieldRemoved              = "removed"
+	ProjectAlertSilenceFieldSilenceID            = "silenceId"
+	ProjectAlertSilenceFieldSilenceState         = "silenceState"
+	ProjectAlertSilenceFieldStartsAt             = "startsAt"
+	ProjectAlertSilenceFieldState                = "state"
+	ProjectAlertSilenceFieldTransitioning        = "transitioning"
+	ProjectAlertSilenceFieldTransitioningMessage = "transitioningMessage"
+	ProjectAlertSilenceFieldUUID                 = "uuid"
+)
+
+type ProjectAlertSilence struct {
+	types.Resource
+	Acknowledgement      bool              `json:"acknowledgement,omitempty" yaml:"acknowledgement,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Comment              string            `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	EndsAt               string            `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	ExpiredAt            string            `json:"expiredAt,omitempty" yaml:"expiredAt,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Matchers             []SilenceMatcher  `json:"matchers,omitempty" yaml:"matchers,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID            string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SilenceID            string            `json:"silenceId,omitempty" yaml:"silenceId,omitempty"`
+	SilenceState         string            `json:"silenceState,omitempty" yaml:"silenceState,omitempty"`
+	StartsAt             string            `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ProjectAlertSilenceCollection struct {
+	types.Collection
+	Data   []ProjectAlertSilence `json:"data,omitempty"`
+	client *ProjectAlertSilenceClient
+}
+
+type ProjectAlertSilenceClient struct {
+	apiClient *Client
+}
+
+type ProjectAlertSilenceOperations interface {
+	List(opts *types.ListOpts) (*ProjectAlertSilenceCollection, error)
+	ListAll(opts *types.ListOpts) (*ProjectAlertSilenceCollection, error)
+	Create(opts *ProjectAlertSilence) (*ProjectAlertSilence, error)
+	Update(existing *ProjectAlertSilence, updates interface{}) (*ProjectAlertSilence, error)
+	Replace(existing *ProjectAlertSilence) (*ProjectAlertSilence, error)
+	ByID(id string) (*ProjectAlertSilence, error)
+	Delete(container *ProjectAlertSilence) error
+}
+
+func newProjectAlertSilenceClient(apiClient *Client) *ProjectAlertSilenceClient {
+	return &ProjectAlertSilenceClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ProjectAlertSilenceClient) Create(container *ProjectAlertSilence) (*ProjectAlertSilence, error) {
+	resp := &ProjectAlertSilence{}
+	err := c.apiClient.Ops.DoCreate(ProjectAlertSilenceType, container, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertSilenceClient) Update(existing *ProjectAlertSilence, updates interface{}) (*ProjectAlertSilence, error) {
+	resp := &ProjectAlertSilence{}
+	err := c.apiClient.Ops.DoUpdate(ProjectAlertSilenceType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertSilenceClient) Replace(obj *ProjectAlertSilence) (*ProjectAlertSilence, error) {
+	resp := &ProjectAlertSilence{}
+	err := c.apiClient.Ops.DoReplace(ProjectAlertSilenceType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertSilenceClient) List(opts *types.ListOpts) (*ProjectAlertSilenceCollection, error) {
+	resp := &ProjectAlertSilenceCollection{}
+	err := c.apiClient.Ops.DoList(ProjectAlertSilenceType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ProjectAlertSilenceClient) ListAll(opts *types.ListOpts) (*ProjectAlertSilenceCollection, error) {
+	resp := &ProjectAlertSilenceCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ProjectAlertSilenceCollection) Next() (*ProjectAlertSilenceCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ProjectAlertSilenceCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ProjectAlertSilenceClient) ByID(id string) (*ProjectAlertSilence, error) {
+	resp := &ProjectAlertSilence{}
+	err := c.apiClient.Ops.DoByID(ProjectAlertSilenceType, id, resp)
+	return resp, err
+}
+
+func (c *ProjectAlertSilenceClient) Delete(container *ProjectAlertSilence) error {
+	return c.apiClient.Ops.DoResourceDelete(ProjectAlertSilenceType, &container.Resource)
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	ProjectAlertSilenceSpecType                 = "projectAlertSilenceSpec"
+	ProjectAlertSilenceSpecFieldAcknowledgement = "acknowledgement"
+	ProjectAlertSilenceSpecFieldComment         = "comment"
+	ProjectAlertSilenceSpecFieldEndsAt          = "endsAt"
+	ProjectAlertSilenceSpecFieldMatchers        = "matchers"
+	ProjectAlertSilenceSpecFieldProjectID       = "projectId"
+	ProjectAlertSilenceSpecFieldStartsAt        = "startsAt"
+)
+
+type ProjectAlertSilenceSpec struct {
+	Acknowledgement bool             `json:"acknowledgement,omitempty" yaml:"acknowledgement,omitempty"`
+	Comment         string           `json:"comment,omitempty" yaml:"comment,omitempty"`
+	EndsAt          string           `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	Matchers        []SilenceMatcher `json:"matchers,omitempty" yaml:"matchers,omitempty"`
+	ProjectID       string           `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	StartsAt        string           `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+}
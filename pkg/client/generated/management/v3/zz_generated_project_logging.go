@@ -10,8 +10,10 @@
 	ProjectLoggingFieldCreated               = "created"
 	ProjectLoggingFieldCreatorID             = "creatorId"
 	ProjectLoggingFieldCustomTargetConfig    = "customTargetConfig"
+	ProjectLoggingFieldDebugSampling         = "debugSampling"
 	ProjectLoggingFieldElasticsearchConfig   = "elasticsearchConfig"
 	ProjectLoggingFieldEnableJSONParsing     = "enableJSONParsing"
+	ProjectLoggingFieldFieldTransform        = "fieldTransform"
 	ProjectLoggingFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectLoggingFieldKafkaConfig           = "kafkaConfig"
 	ProjectLoggingFieldLabels                = "labels"
@@ -20,8 +22,10 @@
 	ProjectLoggingFieldOutputFlushInterval   = "outputFlushInterval"
 	ProjectLoggingFieldOutputTags            = "outputTags"
 	ProjectLoggingFieldOwnerReferences       = "ownerReferences"
+	ProjectLoggingFieldParsingRules          = "parsingRules"
 	ProjectLoggingFieldProjectID             = "projectId"
 	ProjectLoggingFieldRemoved               = "removed"
+	ProjectLoggingFieldSelector              = "selector"
 	ProjectLoggingFieldSplunkConfig          = "splunkConfig"
 	ProjectLoggingFieldState                 = "state"
 	ProjectLoggingFieldStatus                = "status"
@@ -37,8 +41,10 @@ type ProjectLogging struct {
 	Created               string                 `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID             string                 `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	CustomTargetConfig    *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
+	DebugSampling         *LoggingDebugSampling  `json:"debugSampling,omitempty" yaml:"debugSampling,omitempty"`
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
 	EnableJSONParsing     bool                   `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
+	FieldTransform        *LoggingFieldTransform `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	Labels                map[string]string      `json:"labels,omitempty" yaml:"labels,omitempty"`
@@ -47,8 +53,10 @@ type ProjectLogging struct {
 	OutputFlushInterval   int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
 	OwnerReferences       []OwnerReference       `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ParsingRules          []LoggingParsingRule   `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
 	ProjectID             string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	Removed               string                 `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Selector              *LoggingSelector       `json:"selector,omitempty" yaml:"selector,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	State                 string                 `json:"state,omitempty" yaml:"state,omitempty"`
 	Status                *ProjectLoggingStatus  `json:"status,omitempty" yaml:"status,omitempty"`
@@ -79,7 +87,7 @@ type ProjectLoggingOperations interface {
 
 	CollectionActionDryRun(resource *ProjectLoggingCollection, input *ProjectTestInput) error
 
-	CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) error
+	CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) (*LoggingTestOutput, error)
 }
 
 func newProjectLoggingClient(apiClient *Client) *ProjectLoggingClient {
@@ -156,7 +164,8 @@ func (c *ProjectLoggingClient) CollectionActionDryRun(resource *ProjectLoggingCo
 	return err
 }
 
-func (c *ProjectLoggingClient) CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) error {
-	err := c.apiClient.Ops.DoCollectionAction(ProjectLoggingType, "test", &resource.Collection, input, nil)
-	return err
+func (c *ProjectLoggingClient) CollectionActionTest(resource *ProjectLoggingCollection, input *ProjectTestInput) (*LoggingTestOutput, error) {
+	resp := &LoggingTestOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(ProjectLoggingType, "test", &resource.Collection, input, resp)
+	return resp, err
 }
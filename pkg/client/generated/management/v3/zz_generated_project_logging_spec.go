@@ -3,28 +3,36 @@
 const (
 	ProjectLoggingSpecType                       = "projectLoggingSpec"
 	ProjectLoggingSpecFieldCustomTargetConfig    = "customTargetConfig"
+	ProjectLoggingSpecFieldDebugSampling         = "debugSampling"
 	ProjectLoggingSpecFieldDisplayName           = "displayName"
 	ProjectLoggingSpecFieldElasticsearchConfig   = "elasticsearchConfig"
 	ProjectLoggingSpecFieldEnableJSONParsing     = "enableJSONParsing"
+	ProjectLoggingSpecFieldFieldTransform        = "fieldTransform"
 	ProjectLoggingSpecFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectLoggingSpecFieldKafkaConfig           = "kafkaConfig"
 	ProjectLoggingSpecFieldOutputFlushInterval   = "outputFlushInterval"
 	ProjectLoggingSpecFieldOutputTags            = "outputTags"
+	ProjectLoggingSpecFieldParsingRules          = "parsingRules"
 	ProjectLoggingSpecFieldProjectID             = "projectId"
+	ProjectLoggingSpecFieldSelector              = "selector"
 	ProjectLoggingSpecFieldSplunkConfig          = "splunkConfig"
 	ProjectLoggingSpecFieldSyslogConfig          = "syslogConfig"
 )
 
 type ProjectLoggingSpec struct {
 	CustomTargetConfig    *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
+	DebugSampling         *LoggingDebugSampling  `json:"debugSampling,omitempty" yaml:"debugSampling,omitempty"`
 	DisplayName           string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
 	EnableJSONParsing     bool                   `json:"enableJSONParsing,omitempty" yaml:"enableJSONParsing,omitempty"`
+	FieldTransform        *LoggingFieldTransform `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	OutputFlushInterval   int64                  `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
+	ParsingRules          []LoggingParsingRule   `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
 	ProjectID             string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Selector              *LoggingSelector       `json:"selector,omitempty" yaml:"selector,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
 }
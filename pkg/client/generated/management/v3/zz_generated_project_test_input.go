@@ -4,10 +4,14 @@
 	ProjectTestInputType                       = "projectTestInput"
 	ProjectTestInputFieldCustomTargetConfig    = "customTargetConfig"
 	ProjectTestInputFieldElasticsearchConfig   = "elasticsearchConfig"
+	ProjectTestInputFieldFieldTransform        = "fieldTransform"
 	ProjectTestInputFieldFluentForwarderConfig = "fluentForwarderConfig"
 	ProjectTestInputFieldKafkaConfig           = "kafkaConfig"
 	ProjectTestInputFieldOutputTags            = "outputTags"
+	ProjectTestInputFieldParsingRules          = "parsingRules"
 	ProjectTestInputFieldProjectName           = "projectId"
+	ProjectTestInputFieldSampleLines           = "sampleLines"
+	ProjectTestInputFieldSampleNamespace       = "sampleNamespace"
 	ProjectTestInputFieldSplunkConfig          = "splunkConfig"
 	ProjectTestInputFieldSyslogConfig          = "syslogConfig"
 )
@@ -15,10 +19,14 @@
 type ProjectTestInput struct {
 	CustomTargetConfig    *CustomTargetConfig    `json:"customTargetConfig,omitempty" yaml:"customTargetConfig,omitempty"`
 	ElasticsearchConfig   *ElasticsearchConfig   `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	FieldTransform        *LoggingFieldTransform `json:"fieldTransform,omitempty" yaml:"fieldTransform,omitempty"`
 	FluentForwarderConfig *FluentForwarderConfig `json:"fluentForwarderConfig,omitempty" yaml:"fluentForwarderConfig,omitempty"`
 	KafkaConfig           *KafkaConfig           `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	OutputTags            map[string]string      `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
+	ParsingRules          []LoggingParsingRule   `json:"parsingRules,omitempty" yaml:"parsingRules,omitempty"`
 	ProjectName           string                 `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	SampleLines           []string               `json:"sampleLines,omitempty" yaml:"sampleLines,omitempty"`
+	SampleNamespace       string                 `json:"sampleNamespace,omitempty" yaml:"sampleNamespace,omitempty"`
 	SplunkConfig          *SplunkConfig          `json:"splunkConfig,omitempty" yaml:"splunkConfig,omitempty"`
 	SyslogConfig          *SyslogConfig          `json:"syslogConfig,omitempty" yaml:"syslogConfig,omitempty"`
 }
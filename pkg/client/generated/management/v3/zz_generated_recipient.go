@@ -1,14 +1,16 @@
 package client
 
 const (
-	RecipientType              = "recipient"
-	RecipientFieldNotifierID   = "notifierId"
-	RecipientFieldNotifierType = "notifierType"
-	RecipientFieldRecipient    = "recipient"
+	RecipientType                  = "recipient"
+	RecipientFieldNotifierID       = "notifierId"
+	RecipientFieldNotifierType     = "notifierType"
+	RecipientFieldOnCallScheduleID = "onCallScheduleId"
+	RecipientFieldRecipient        = "recipient"
 )
 
 type Recipient struct {
-	NotifierID   string `json:"notifierId,omitempty" yaml:"notifierId,omitempty"`
-	NotifierType string `json:"notifierType,omitempty" yaml:"notifierType,omitempty"`
-	Recipient    string `json:"recipient,omitempty" yaml:"recipient,omitempty"`
+	NotifierID       string `json:"notifierId,omitempty" yaml:"notifierId,omitempty"`
+	NotifierType     string `json:"notifierType,omitempty" yaml:"notifierType,omitempty"`
+	OnCallScheduleID string `json:"onCallScheduleId,omitempty" yaml:"onCallScheduleId,omitempty"`
+	Recipient        string `json:"recipient,omitempty" yaml:"recipient,omitempty"`
 }
@@ -0,0 +1,14 @@
+package client
+
+const (
+	SilenceMatcherType         = "silenceMatcher"
+	SilenceMatcherFieldIsRegex = "isRegex"
+	SilenceMatcherFieldName    = "name"
+	SilenceMatcherFieldValue   = "value"
+)
+
+type SilenceMatcher struct {
+	IsRegex bool   `json:"isRegex,omitempty" yaml:"isRegex,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Value   string `json:"value,omitempty" yaml:"value,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	UnhealthyConditionType                = "unhealthyCondition"
+	UnhealthyConditionFieldStatus         = "status"
+	UnhealthyConditionFieldTimeoutSeconds = "timeoutSeconds"
+	UnhealthyConditionFieldType           = "type"
+)
+
+type UnhealthyCondition struct {
+	Status         string `json:"status,omitempty" yaml:"status,omitempty"`
+	TimeoutSeconds int64  `json:"timeoutSeconds,omitempty" yaml:"timeoutSeconds,omitempty"`
+	Type           string `json:"type,omitempty" yaml:"type,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	UpgradePreflightChecksType                          = "upgradePreflightChecks"
+	UpgradePreflightChecksFieldEtcdBackupMaxAgeMinutes  = "etcdBackupMaxAgeMinutes"
+	UpgradePreflightChecksFieldSkipDeprecatedAPIs       = "skipDeprecatedApis"
+	UpgradePreflightChecksFieldSkipNodePressure         = "skipNodePressure"
+	UpgradePreflightChecksFieldSkipPodDisruptionBudgets = "skipPodDisruptionBudgets"
+)
+
+type UpgradePreflightChecks struct {
+	EtcdBackupMaxAgeMinutes  int64 `json:"etcdBackupMaxAgeMinutes,omitempty" yaml:"etcdBackupMaxAgeMinutes,omitempty"`
+	SkipDeprecatedAPIs       bool  `json:"skipDeprecatedApis,omitempty" yaml:"skipDeprecatedApis,omitempty"`
+	SkipNodePressure         bool  `json:"skipNodePressure,omitempty" yaml:"skipNodePressure,omitempty"`
+	SkipPodDisruptionBudgets bool  `json:"skipPodDisruptionBudgets,omitempty" yaml:"skipPodDisruptionBudgets,omitempty"`
+}
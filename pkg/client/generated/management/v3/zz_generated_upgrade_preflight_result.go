@@ -0,0 +1,14 @@
+package client
+
+const (
+	UpgradePreflightResultType         = "upgradePreflightResult"
+	UpgradePreflightResultFieldMessage = "message"
+	UpgradePreflightResultFieldName    = "name"
+	UpgradePreflightResultFieldPassed  = "passed"
+)
+
+type UpgradePreflightResult struct {
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Passed  bool   `json:"passed,omitempty" yaml:"passed,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	VolumeRuleType                 = "volumeRule"
+	VolumeRuleFieldSelector        = "selector"
+	VolumeRuleFieldUsedPercentage  = "usedPercentage"
+	VolumeRuleFieldVolumeClaimName = "volumeClaimName"
+)
+
+type VolumeRule struct {
+	Selector        map[string]string `json:"selector,omitempty" yaml:"selector,omitempty"`
+	UsedPercentage  int64             `json:"usedPercentage,omitempty" yaml:"usedPercentage,omitempty"`
+	VolumeClaimName string            `json:"volumeClaimName,omitempty" yaml:"volumeClaimName,omitempty"`
+}
@@ -41,17 +41,21 @@
 	"catalogtemplateversions":     "management.cattle.io",
 	"clusteralertrules":           "management.cattle.io",
 	"clusteralertgroups":          "management.cattle.io",
+	"clusteralertsilences":        "management.cattle.io",
 	"clustercatalogs":             "management.cattle.io",
 	"clusterloggings":             "management.cattle.io",
 	"clustermonitorgraphs":        "management.cattle.io",
 	"clusterregistrationtokens":   "management.cattle.io",
 	"clusterroletemplatebindings": "management.cattle.io",
+	"clusterupgradeplans":         "management.cattle.io",
 	"etcdbackups":                 "management.cattle.io",
 	"nodes":                       "management.cattle.io",
 	"nodepools":                   "management.cattle.io",
 	"notifiers":                   "management.cattle.io",
+	"oncallschedules":             "management.cattle.io",
 	"podsecuritypolicytemplateprojectbindings": "management.cattle.io",
-	"projects": "management.cattle.io",
+	"projects":              "management.cattle.io",
+	"clusteralertincidents": "management.cattle.io",
 }
 
 type crtbLifecycle struct {
@@ -32,6 +32,8 @@
 	"projectloggings":             "management.cattle.io",
 	"projectalertrules":           "management.cattle.io",
 	"projectalertgroups":          "management.cattle.io",
+	"projectalertsilences":        "management.cattle.io",
+	"projectalertincidents":       "management.cattle.io",
 	"projectcatalogs":             "management.cattle.io",
 	"projectmonitorgraphs":        "management.cattle.io",
 	"projectroletemplatebindings": "management.cattle.io",
@@ -39,6 +41,7 @@
 }
 var prtbClusterManagmentPlaneResources = map[string]string{
 	"notifiers":               "management.cattle.io",
+	"oncallschedules":         "management.cattle.io",
 	"clustercatalogs":         "management.cattle.io",
 	"catalogtemplates":        "management.cattle.io",
 	"catalogtemplateversions": "management.cattle.io",
@@ -0,0 +1,286 @@
+package certsrotation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
+	"github.com/rancher/rancher/pkg/notifiers"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	controllerName   = "certificate-rotation"
+	checkInterval    = time.Hour
+	progressInterval = time.Minute
+	retryBackoff     = 30 * time.Minute
+	maxWindowSearch  = 100
+)
+
+const (
+	eventStarted   = "started"
+	eventSucceeded = "succeeded"
+	eventFailed    = "failed"
+	eventGaveUp    = "gave up"
+)
+
+// This controller rotates the certificates of RKE clusters with a certificate rotation policy when they get close to
+// their expiration, the expiration dates come from the certificate-expiration user controller
+func Register(ctx context.Context, management *config.ManagementContext) {
+	c := &controller{
+		ctx:               ctx,
+		clusters:          management.Management.Clusters(""),
+		clusterController: management.Management.Clusters("").Controller(),
+		notifierLister:    management.Management.Notifiers("").Controller().Lister(),
+		dialerFactory:     management.Dialer,
+	}
+	c.clusters.AddHandler(ctx, controllerName, c.sync)
+}
+
+type controller struct {
+	ctx               context.Context
+	clusters          v3.ClusterInterface
+	clusterController v3.ClusterController
+	notifierLister    v3.NotifierLister
+	dialerFactory     dialer.Factory
+}
+
+func (c *controller) sync(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		return cluster, nil
+	}
+	policy := cluster.Spec.CertificateRotation
+	if policy == nil || !policy.Enabled || cluster.Spec.RancherKubernetesEngineConfig == nil ||
+		cluster.Status.AppliedSpec.RancherKubernetesEngineConfig == nil || !v32.ClusterConditionProvisioned.IsTrue(cluster) {
+		return cluster, nil
+	}
+
+	toUpdate := cluster.DeepCopy()
+	event, requeue, err := rotate(toUpdate, time.Now().UTC())
+	if err != nil {
+		return cluster, err
+	}
+	if !reflect.DeepEqual(cluster.Spec, toUpdate.Spec) || !reflect.DeepEqual(cluster.Status, toUpdate.Status) {
+		if cluster, err = c.clusters.Update(toUpdate); err != nil {
+			return cluster, err
+		}
+	}
+	if event != "" {
+		c.notify(cluster, event)
+	}
+	c.clusterController.EnqueueAfter("", cluster.Name, requeue)
+	return cluster, nil
+}
+
+// rotate moves the certificate rotation of a cluster forward and returns the event to notify, if any, and when to
+// check the cluster again
+func rotate(cluster *v3.Cluster, now time.Time) (string, time.Duration, error) {
+	policy := cluster.Spec.CertificateRotation
+	rkeConfig := cluster.Spec.RancherKubernetesEngineConfig
+	if cluster.Status.CertificateRotationStatus == nil {
+		cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{}
+	}
+	status := cluster.Status.CertificateRotationStatus
+
+	if status.RotationStarted != "" {
+		failedSpec := cluster.Status.FailedSpec
+		switch {
+		case failedSpec != nil && failedSpec.RancherKubernetesEngineConfig != nil && failedSpec.RancherKubernetesEngineConfig.RotateCertificates != nil:
+			message := v32.ClusterConditionUpdated.GetMessage(cluster)
+			if message == "" {
+				message = "failed to rotate certificates"
+			}
+			// the provisioner retries failed specs on its own, retries are left to the policy instead
+			rkeConfig.RotateCertificates = nil
+			status.RotationStarted = ""
+			status.FailedAttempts++
+			status.LastFailure = message
+			status.LastFailureTime = now.Format(time.RFC3339)
+			v32.ClusterConditionCertificatesRotated.False(cluster)
+			v32.ClusterConditionCertificatesRotated.Message(cluster, message)
+			if status.FailedAttempts > policy.MaxRetries {
+				return eventGaveUp, checkInterval, nil
+			}
+			return eventFailed, retryBackoff, nil
+		case rkeConfig.RotateCertificates == nil:
+			status.RotationStarted = ""
+			status.LastRotation = now.Format(time.RFC3339)
+			status.FailedAttempts = 0
+			status.LastFailure = ""
+			status.LastFailureTime = ""
+			v32.ClusterConditionCertificatesRotated.True(cluster)
+			v32.ClusterConditionCertificatesRotated.Message(cluster, "")
+			return eventSucceeded, checkInterval, nil
+		}
+		return "", progressInterval, nil
+	}
+	if rkeConfig.RotateCertificates != nil {
+		// a rotation was requested through the API
+		return "", progressInterval, nil
+	}
+
+	expiration := earliestExpiration(cluster.Status.CertificatesExpiration)
+	if expiration.IsZero() {
+		return "", checkInterval, nil
+	}
+	status.EarliestExpiration = expiration.Format(time.RFC3339)
+
+	due := expiration.AddDate(0, 0, -policy.DaysBeforeExpiration)
+	if now.Before(due) {
+		// certificates were rotated since the last failures
+		status.FailedAttempts = 0
+		maintenance.Done(cluster, v32.MaintenanceActionCertificateRotation)
+		next, err := rotationStart(cluster, due)
+		if err != nil {
+			return "", checkInterval, err
+		}
+		status.NextRotation = next.Format(time.RFC3339)
+		return "", requeueAt(now, next), nil
+	}
+	if status.FailedAttempts > policy.MaxRetries {
+		status.NextRotation = ""
+		maintenance.Done(cluster, v32.MaintenanceActionCertificateRotation)
+		return "", checkInterval, nil
+	}
+
+	start := now
+	if lastFailure, err := time.Parse(time.RFC3339, status.LastFailureTime); err == nil && now.Before(lastFailure.Add(retryBackoff)) {
+		start = lastFailure.Add(retryBackoff)
+	}
+	next, err := rotationStart(cluster, start)
+	if err != nil {
+		return "", checkInterval, err
+	}
+	if next.After(now) {
+		status.NextRotation = next.Format(time.RFC3339)
+		maintenance.Plan(cluster, v32.MaintenanceActionCertificateRotation, fmt.Sprintf("certificates expire on %s", status.EarliestExpiration), next)
+		return "", requeueAt(now, next), nil
+	}
+
+	logrus.Infof("[%s] rotating certificates of cluster [%s] expiring on %s", controllerName, cluster.Name, status.EarliestExpiration)
+	rkeConfig.RotateCertificates = &rketypes.RotateCertificates{CACertificates: policy.CACertificates}
+	status.RotationStarted = now.Format(time.RFC3339)
+	status.NextRotation = ""
+	maintenance.Done(cluster, v32.MaintenanceActionCertificateRotation)
+	v32.ClusterConditionCertificatesRotated.Unknown(cluster)
+	v32.ClusterConditionCertificatesRotated.Message(cluster, fmt.Sprintf("rotating certificates expiring on %s", status.EarliestExpiration))
+	return eventStarted, progressInterval, nil
+}
+
+// earliestExpiration returns the expiration date of the first certificate to expire, certificates with a corrupted
+// date are skipped
+func earliestExpiration(certs map[string]v32.CertExpiration) time.Time {
+	var earliest time.Time
+	for name, cert := range certs {
+		date, err := time.Parse(time.RFC3339, cert.ExpirationDate)
+		if err != nil {
+			logrus.Debugf("[%s] invalid expiration date for certificate [%s]: %v", controllerName, name, err)
+			continue
+		}
+		if earliest.IsZero() || date.Before(earliest) {
+			earliest = date
+		}
+	}
+	return earliest
+}
+
+// rotationStart returns the first time from t on that is inside both the window of the rotation policy and the
+// maintenance window of the cluster
+func rotationStart(cluster *v3.Cluster, t time.Time) (time.Time, error) {
+	window := cluster.Spec.MaintenanceWindow
+	for i := 0; i < maxWindowSearch; i++ {
+		if maintenance.Overridden(window, t) {
+			return t, nil
+		}
+		next, err := windowStart(cluster.Spec.CertificateRotation.Window, t)
+		if err != nil {
+			return t, err
+		}
+		if t, err = maintenance.Next(window, next); err != nil {
+			return t, err
+		}
+		if t.Equal(next) {
+			return t, nil
+		}
+	}
+	return t, fmt.Errorf("no rotation window inside the maintenance window of the cluster after %s", t.Format(time.RFC3339))
+}
+
+// windowStart returns the first time from t on that is inside the window
+func windowStart(window *v32.CertificateRotationWindow, t time.Time) (time.Time, error) {
+	if window == nil {
+		return t, nil
+	}
+	schedule, err := cron.ParseStandard(window.CronSchedule)
+	if err != nil {
+		return t, fmt.Errorf("invalid window schedule %q: %v", window.CronSchedule, err)
+	}
+	duration := time.Duration(window.DurationMinutes) * time.Minute
+	// a window opening after t-duration and before t is still open at t
+	next := schedule.Next(t.Add(-duration))
+	if !next.After(t) {
+		return t, nil
+	}
+	return next, nil
+}
+
+func requeueAt(now, t time.Time) time.Duration {
+	if wait := t.Sub(now); wait < checkInterval {
+		return wait
+	}
+	return checkInterval
+}
+
+func (c *controller) notify(cluster *v3.Cluster, event string) {
+	policy := cluster.Spec.CertificateRotation
+	if len(policy.Recipients) == 0 {
+		return
+	}
+	status := cluster.Status.CertificateRotationStatus
+	message := &notifiers.Message{
+		Title: fmt.Sprintf("Certificate rotation %s for cluster %s", event, cluster.Spec.DisplayName),
+	}
+	switch event {
+	case eventStarted:
+		message.Content = fmt.Sprintf("Rotating the certificates of cluster %s (%s), the first certificate expires on %s.",
+			cluster.Spec.DisplayName, cluster.Name, status.EarliestExpiration)
+	case eventSucceeded:
+		message.Content = fmt.Sprintf("Rotated the certificates of cluster %s (%s).", cluster.Spec.DisplayName, cluster.Name)
+	case eventFailed:
+		message.Content = fmt.Sprintf("Failed to rotate the certificates of cluster %s (%s), retrying in %v: %s",
+			cluster.Spec.DisplayName, cluster.Name, retryBackoff, status.LastFailure)
+	case eventGaveUp:
+		message.Content = fmt.Sprintf("Failed to rotate the certificates of cluster %s (%s) %d times, the first certificate expires on %s: %s",
+			cluster.Spec.DisplayName, cluster.Name, status.FailedAttempts, status.EarliestExpiration, status.LastFailure)
+	}
+
+	clusterDialer, err := c.dialerFactory.ClusterDialer(cluster.Name)
+	if err != nil {
+		logrus.Warnf("[%s] failed to get dialer for cluster [%s]: %v", controllerName, cluster.Name, err)
+		return
+	}
+	for _, recipient := range policy.Recipients {
+		ns, name := ref.Parse(recipient.NotifierName)
+		if ns == "" {
+			ns = cluster.Name
+		}
+		notifier, err := c.notifierLister.Get(ns, name)
+		if err != nil {
+			logrus.Warnf("[%s] failed to get notifier [%s] for cluster [%s]: %v", controllerName, recipient.NotifierName, cluster.Name, err)
+			continue
+		}
+		if err := notifiers.SendMessage(c.ctx, notifier, recipient.Recipient, message, clusterDialer); err != nil {
+			logrus.Warnf("[%s] failed to notify [%s] of cluster [%s]: %v", controllerName, recipient.NotifierName, cluster.Name, err)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package certsrotation
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC) // a Wednesday
+
+func testCluster(expiresIn time.Duration, window *v32.CertificateRotationWindow) *v3.Cluster {
+	return &v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+		Spec: v32.ClusterSpec{
+			ClusterSpecBase: v32.ClusterSpecBase{
+				RancherKubernetesEngineConfig: &rketypes.RancherKubernetesEngineConfig{},
+				CertificateRotation: &v32.CertificateRotationConfig{
+					Enabled:              true,
+					DaysBeforeExpiration: 30,
+					MaxRetries:           1,
+					Window:               window,
+				},
+			},
+		},
+		Status: v32.ClusterStatus{
+			CertificatesExpiration: map[string]v32.CertExpiration{
+				"kube-apiserver": {ExpirationDate: now.Add(expiresIn).Format(time.RFC3339)},
+				"kube-node":      {ExpirationDate: now.Add(expiresIn + 24*time.Hour).Format(time.RFC3339)},
+				"kube-proxy":     {ExpirationDate: "corrupted"},
+			},
+		},
+	}
+}
+
+func TestRotate(t *testing.T) {
+	day := 24 * time.Hour
+	nightly := &v32.CertificateRotationWindow{CronSchedule: "0 2 * * *", DurationMinutes: 120}
+
+	tests := []struct {
+		name             string
+		cluster          func() *v3.Cluster
+		wantEvent        string
+		wantRequeue      time.Duration
+		wantRotating     bool
+		wantNextRotation time.Time
+		wantFailures     int
+	}{
+		{
+			name:             "certificates are not due",
+			cluster:          func() *v3.Cluster { return testCluster(60*day, nil) },
+			wantRequeue:      checkInterval,
+			wantNextRotation: now.Add(30 * day),
+		},
+		{
+			name:         "certificates are due",
+			cluster:      func() *v3.Cluster { return testCluster(10*day, nil) },
+			wantEvent:    eventStarted,
+			wantRequeue:  progressInterval,
+			wantRotating: true,
+		},
+		{
+			name:             "wait for the window",
+			cluster:          func() *v3.Cluster { return testCluster(10*day, nightly) },
+			wantRequeue:      checkInterval,
+			wantNextRotation: time.Date(2020, time.October, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "inside the window",
+			cluster: func() *v3.Cluster {
+				return testCluster(10*day, &v32.CertificateRotationWindow{CronSchedule: "0 11 * * 3", DurationMinutes: 120})
+			},
+			wantEvent:    eventStarted,
+			wantRequeue:  progressInterval,
+			wantRotating: true,
+		},
+		{
+			name: "maintenance blackout",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Spec.MaintenanceWindow = &v32.MaintenanceWindow{
+					Blackouts: []v32.MaintenanceBlackout{{Start: now.Format(time.RFC3339), End: now.Add(2 * time.Hour).Format(time.RFC3339)}},
+				}
+				return cluster
+			},
+			wantRequeue:      checkInterval,
+			wantNextRotation: now.Add(2 * time.Hour),
+		},
+		{
+			name: "rotation in progress",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates = &rketypes.RotateCertificates{}
+				cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{RotationStarted: now.Format(time.RFC3339)}
+				return cluster
+			},
+			wantRequeue:  progressInterval,
+			wantRotating: true,
+		},
+		{
+			name: "rotation succeeded",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{RotationStarted: now.Format(time.RFC3339), FailedAttempts: 1}
+				return cluster
+			},
+			wantEvent:   eventSucceeded,
+			wantRequeue: checkInterval,
+		},
+		{
+			name: "rotation failed",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates = &rketypes.RotateCertificates{}
+				cluster.Status.FailedSpec = cluster.Spec.DeepCopy()
+				cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{RotationStarted: now.Format(time.RFC3339)}
+				return cluster
+			},
+			wantEvent:    eventFailed,
+			wantRequeue:  retryBackoff,
+			wantFailures: 1,
+		},
+		{
+			name: "retry after the backoff",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{
+					FailedAttempts:  1,
+					LastFailureTime: now.Add(-10 * time.Minute).Format(time.RFC3339),
+				}
+				return cluster
+			},
+			wantRequeue:      20 * time.Minute,
+			wantNextRotation: now.Add(20 * time.Minute),
+			wantFailures:     1,
+		},
+		{
+			name: "too many failures",
+			cluster: func() *v3.Cluster {
+				cluster := testCluster(10*day, nil)
+				cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates = &rketypes.RotateCertificates{}
+				cluster.Status.FailedSpec = cluster.Spec.DeepCopy()
+				cluster.Status.CertificateRotationStatus = &v32.CertificateRotationStatus{RotationStarted: now.Format(time.RFC3339), FailedAttempts: 1}
+				return cluster
+			},
+			wantEvent:    eventGaveUp,
+			wantRequeue:  checkInterval,
+			wantFailures: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := tt.cluster()
+			event, requeue, err := rotate(cluster, now)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantEvent, event)
+			assert.Equal(t, tt.wantRequeue, requeue)
+			assert.Equal(t, tt.wantRotating, cluster.Spec.RancherKubernetesEngineConfig.RotateCertificates != nil)
+
+			status := cluster.Status.CertificateRotationStatus
+			assert.Equal(t, tt.wantFailures, status.FailedAttempts)
+			if tt.wantNextRotation.IsZero() {
+				assert.Empty(t, status.NextRotation)
+			} else {
+				assert.Equal(t, tt.wantNextRotation.Format(time.RFC3339), status.NextRotation)
+			}
+		})
+	}
+}
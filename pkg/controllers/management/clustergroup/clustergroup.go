@@ -0,0 +1,225 @@
+package clustergroup
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	membershipController = "mgmt-cluster-group-membership-controller"
+	clusterController    = "mgmt-cluster-group-cluster-controller"
+	bindingController    = "mgmt-cluster-group-binding-controller"
+	targetsController    = "mgmt-cluster-group-targets-controller"
+	alertGroupController = "mgmt-cluster-group-alert-group-controller"
+	alertRuleController  = "mgmt-cluster-group-alert-rule-controller"
+	notifierController   = "mgmt-cluster-group-notifier-controller"
+)
+
+type controller struct {
+	clusterGroups      v3.ClusterGroupInterface
+	clusterGroupLister v3.ClusterGroupLister
+	clusterLister      v3.ClusterLister
+	bindings           v3.ClusterGroupRoleTemplateBindingInterface
+	bindingLister      v3.ClusterGroupRoleTemplateBindingLister
+	crtbs              v3.ClusterRoleTemplateBindingInterface
+	crtbLister         v3.ClusterRoleTemplateBindingLister
+	mcApps             v3.MultiClusterAppInterface
+	mcAppLister        v3.MultiClusterAppLister
+	projectLister      v3.ProjectLister
+	alertGroups        v3.ClusterAlertGroupInterface
+	alertGroupLister   v3.ClusterAlertGroupLister
+	alertRules         v3.ClusterAlertRuleInterface
+	alertRuleLister    v3.ClusterAlertRuleLister
+	notifiers          v3.NotifierInterface
+	notifierLister     v3.NotifierLister
+	roleTemplateLister v3.RoleTemplateLister
+	escalation         *Escalation
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	c := &controller{
+		clusterGroups:      management.Management.ClusterGroups(""),
+		clusterGroupLister: management.Management.ClusterGroups("").Controller().Lister(),
+		clusterLister:      management.Management.Clusters("").Controller().Lister(),
+		bindings:           management.Management.ClusterGroupRoleTemplateBindings(""),
+		bindingLister:      management.Management.ClusterGroupRoleTemplateBindings("").Controller().Lister(),
+		crtbs:              management.Management.ClusterRoleTemplateBindings(""),
+		crtbLister:         management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+		mcApps:             management.Management.MultiClusterApps(""),
+		mcAppLister:        management.Management.MultiClusterApps("").Controller().Lister(),
+		projectLister:      management.Management.Projects("").Controller().Lister(),
+		alertGroups:        management.Management.ClusterAlertGroups(""),
+		alertGroupLister:   management.Management.ClusterAlertGroups("").Controller().Lister(),
+		alertRules:         management.Management.ClusterAlertRules(""),
+		alertRuleLister:    management.Management.ClusterAlertRules("").Controller().Lister(),
+		notifiers:          management.Management.Notifiers(""),
+		notifierLister:     management.Management.Notifiers("").Controller().Lister(),
+		roleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		escalation: &Escalation{
+			GrbLister:          management.Management.GlobalRoleBindings("").Controller().Lister(),
+			GrLister:           management.Management.GlobalRoles("").Controller().Lister(),
+			CrtbLister:         management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+			RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		},
+	}
+
+	c.clusterGroups.AddHandler(ctx, membershipController, c.sync)
+	management.Management.Clusters("").AddHandler(ctx, clusterController, c.clusterChanged)
+	c.bindings.AddLifecycle(ctx, bindingController, &bindingLifecycle{c})
+	c.mcApps.AddHandler(ctx, targetsController, c.syncTargets)
+	c.alertGroups.AddHandler(ctx, alertGroupController, c.syncAlertGroup)
+	c.alertRules.AddHandler(ctx, alertRuleController, c.syncAlertRule)
+	c.notifiers.AddHandler(ctx, notifierController, c.syncNotifier)
+}
+
+// sync recomputes the members of a group, the objects created for the members are updated when they change
+func (c *controller) sync(key string, group *v3.ClusterGroup) (runtime.Object, error) {
+	if group == nil || group.DeletionTimestamp != nil {
+		c.enqueueConsumers(key)
+		return nil, nil
+	}
+
+	clusters, err := c.selectClusters(group.Spec.ClusterSelector)
+	if err != nil {
+		return group, errors.Wrapf(err, "failed to select the members of cluster group %s", group.Name)
+	}
+	if reflect.DeepEqual(clusters, group.Status.Clusters) {
+		return group, nil
+	}
+
+	group = group.DeepCopy()
+	group.Status.Clusters = clusters
+	group, err = c.clusterGroups.Update(group)
+	if err != nil {
+		return nil, err
+	}
+	c.enqueueConsumers(group.Name)
+	return group, nil
+}
+
+// clusterChanged enqueues the groups, the labels of the cluster may have changed
+func (c *controller) clusterChanged(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	groups, err := c.clusterGroupLister.List("", labels.Everything())
+	if err != nil {
+		return cluster, err
+	}
+	for _, group := range groups {
+		c.clusterGroups.Controller().Enqueue("", group.Name)
+	}
+	return cluster, nil
+}
+
+func (c *controller) selectClusters(clusterSelector string) ([]string, error) {
+	selector, err := labels.Parse(clusterSelector)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := c.clusterLister.List("", selector)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, cluster := range clusters {
+		if cluster.DeletionTimestamp == nil {
+			names = append(names, cluster.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// members returns the members of the group, a group that does not exist has none
+func (c *controller) members(groupName string) ([]string, error) {
+	_, groupName = ref.Parse(groupName)
+	group, err := c.clusterGroupLister.Get("", groupName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return group.Status.Clusters, nil
+}
+
+// enqueueConsumers enqueues the objects that reference the group
+func (c *controller) enqueueConsumers(groupName string) {
+	if bindings, err := c.bindingLister.List("", labels.Everything()); err == nil {
+		for _, binding := range bindings {
+			if sameGroup(binding.ClusterGroupName, groupName) {
+				c.bindings.Controller().Enqueue("", binding.Name)
+			}
+		}
+	}
+	if mcApps, err := c.mcAppLister.List("", labels.Everything()); err == nil {
+		for _, mcApp := range mcApps {
+			for _, target := range mcApp.Spec.ClusterGroupTargets {
+				if sameGroup(target.ClusterGroupName, groupName) {
+					c.mcApps.Controller().Enqueue(mcApp.Namespace, mcApp.Name)
+					break
+				}
+			}
+		}
+	}
+	if alertGroups, err := c.alertGroupLister.List("", labels.Everything()); err == nil {
+		for _, alertGroup := range alertGroups {
+			if sameGroup(alertGroup.Spec.ClusterGroupName, groupName) {
+				c.alertGroups.Controller().Enqueue(alertGroup.Namespace, alertGroup.Name)
+			}
+		}
+	}
+	if notifiers, err := c.notifierLister.List("", labels.Everything()); err == nil {
+		for _, notifier := range notifiers {
+			if sameGroup(notifier.Spec.ClusterGroupName, groupName) {
+				c.notifiers.Controller().Enqueue(notifier.Namespace, notifier.Name)
+			}
+		}
+	}
+}
+
+// sameGroup compares a reference to a group, which may be an ID with an empty namespace, with the name of the group
+func sameGroup(reference, groupName string) bool {
+	_, name := ref.Parse(reference)
+	return reference != "" && name == groupName
+}
+
+// copyNamespaces returns the namespaces copies of an object scoped to a group go in, the namespace of each member
+// but the one of the object
+func copyNamespaces(members []string, source string) map[string]bool {
+	namespaces := map[string]bool{}
+	for _, member := range members {
+		if member != source {
+			namespaces[member] = true
+		}
+	}
+	return namespaces
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func copyLabels(group, source string) map[string]string {
+	_, group = ref.Parse(group)
+	return map[string]string{
+		v32.ClusterGroupLabel:       group,
+		v32.ClusterGroupSourceLabel: source,
+	}
+}
+
+func copySelector(source string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{v32.ClusterGroupSourceLabel: source})
+}
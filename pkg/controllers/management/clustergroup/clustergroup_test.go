@@ -0,0 +1,174 @@
+package clustergroup
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/rbac"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestGroupTargets(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []v32.Target
+		wanted   map[string]string
+		expected []v32.Target
+	}{
+		{
+			name:   "adds the projects of the members",
+			wanted: map[string]string{"c-2:p-2": "edge", "c-1:p-1": "edge"},
+			expected: []v32.Target{
+				{ProjectName: "c-1:p-1", ClusterGroupName: "edge"},
+				{ProjectName: "c-2:p-2", ClusterGroupName: "edge"},
+			},
+		},
+		{
+			name: "keeps explicit targets",
+			existing: []v32.Target{
+				{ProjectName: "c-1:p-1", AppName: "app-1"},
+			},
+			wanted: map[string]string{"c-1:p-1": "edge", "c-2:p-2": "edge"},
+			expected: []v32.Target{
+				{ProjectName: "c-1:p-1", AppName: "app-1"},
+				{ProjectName: "c-2:p-2", ClusterGroupName: "edge"},
+			},
+		},
+		{
+			name: "keeps the state of existing group targets",
+			existing: []v32.Target{
+				{ProjectName: "c-1:p-1", AppName: "app-1", Healthstate: "healthy", ClusterGroupName: "edge"},
+			},
+			wanted: map[string]string{"c-1:p-1": "edge"},
+			expected: []v32.Target{
+				{ProjectName: "c-1:p-1", AppName: "app-1", Healthstate: "healthy", ClusterGroupName: "edge"},
+			},
+		},
+		{
+			name: "removes the projects of clusters that left the group",
+			existing: []v32.Target{
+				{ProjectName: "c-3:p-3", AppName: "app-3"},
+				{ProjectName: "c-1:p-1", AppName: "app-1", ClusterGroupName: "edge"},
+				{ProjectName: "c-2:p-2", AppName: "app-2", ClusterGroupName: "edge"},
+			},
+			wanted: map[string]string{"c-2:p-2": "edge"},
+			expected: []v32.Target{
+				{ProjectName: "c-3:p-3", AppName: "app-3"},
+				{ProjectName: "c-2:p-2", AppName: "app-2", ClusterGroupName: "edge"},
+			},
+		},
+		{
+			name: "removes all group targets when no group is targeted",
+			existing: []v32.Target{
+				{ProjectName: "c-1:p-1", AppName: "app-1", ClusterGroupName: "edge"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, groupTargets(test.existing, test.wanted))
+		})
+	}
+}
+
+func TestCopyNamespaces(t *testing.T) {
+	assert.Equal(t, map[string]bool{"c-1": true, "c-3": true}, copyNamespaces([]string{"c-1", "c-2", "c-3"}, "c-2"))
+	assert.Equal(t, map[string]bool{}, copyNamespaces(nil, "c-2"))
+}
+
+func TestSameGroup(t *testing.T) {
+	assert.True(t, sameGroup("edge", "edge"))
+	assert.True(t, sameGroup(":edge", "edge"))
+	assert.False(t, sameGroup("", ""))
+	assert.False(t, sameGroup("core", "edge"))
+}
+
+func TestDropEscalations(t *testing.T) {
+	roleTemplates := map[string]*v3.RoleTemplate{
+		"cluster-owner":  {ObjectMeta: metav1.ObjectMeta{Name: "cluster-owner"}, Builtin: true, ClusterCreatorDefault: true},
+		"cluster-member": {ObjectMeta: metav1.ObjectMeta{Name: "cluster-member"}, Builtin: true},
+		"custom":         {ObjectMeta: metav1.ObjectMeta{Name: "custom"}},
+	}
+	crtbs := map[string][]*v3.ClusterRoleTemplateBinding{
+		"c-owned":  {{UserName: "u-1", RoleTemplateName: "cluster-owner"}},
+		"c-member": {{UserName: "u-1", RoleTemplateName: "cluster-member"}, {UserName: "u-2", RoleTemplateName: "custom"}},
+	}
+	roleTemplateLister := &fakes.RoleTemplateListerMock{
+		GetFunc: func(namespace, name string) (*v3.RoleTemplate, error) {
+			return roleTemplates[name], nil
+		},
+	}
+	c := &controller{
+		roleTemplateLister: roleTemplateLister,
+		escalation: &Escalation{
+			GrbLister: &fakes.GlobalRoleBindingListerMock{
+				ListFunc: func(namespace string, selector labels.Selector) ([]*v3.GlobalRoleBinding, error) {
+					return []*v3.GlobalRoleBinding{{UserName: "admin", GlobalRoleName: "admin"}, {UserName: "u-1", GlobalRoleName: "user"}}, nil
+				},
+			},
+			GrLister: &fakes.GlobalRoleListerMock{
+				GetFunc: func(namespace, name string) (*v3.GlobalRole, error) {
+					if name == "admin" {
+						return &v3.GlobalRole{Rules: []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}}}, nil
+					}
+					return &v3.GlobalRole{}, nil
+				},
+			},
+			CrtbLister: &fakes.ClusterRoleTemplateBindingListerMock{
+				ListFunc: func(namespace string, selector labels.Selector) ([]*v3.ClusterRoleTemplateBinding, error) {
+					return crtbs[namespace], nil
+				},
+			},
+			RoleTemplateLister: roleTemplateLister,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		creator      string
+		roleTemplate string
+		expected     map[string]bool
+	}{
+		{
+			name:         "administrators grant everywhere",
+			creator:      "admin",
+			roleTemplate: "custom",
+			expected:     map[string]bool{"c-owned": true, "c-member": true, "c-other": true},
+		},
+		{
+			name:         "owners and holders grant builtin roles",
+			creator:      "u-1",
+			roleTemplate: "cluster-member",
+			expected:     map[string]bool{"c-owned": true, "c-member": true},
+		},
+		{
+			name:         "custom roles are granted by their holders only",
+			creator:      "u-2",
+			roleTemplate: "custom",
+			expected:     map[string]bool{"c-member": true},
+		},
+		{
+			name:         "bindings without a creator are kept",
+			roleTemplate: "custom",
+			expected:     map[string]bool{"c-owned": true, "c-member": true, "c-other": true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			binding := &v3.ClusterGroupRoleTemplateBinding{RoleTemplateName: test.roleTemplate}
+			if test.creator != "" {
+				binding.Annotations = map[string]string{rbac.CreatorIDAnn: test.creator}
+			}
+			clusters := map[string]bool{"c-owned": true, "c-member": true, "c-other": true}
+			assert.NoError(t, c.dropEscalations(binding, clusters))
+			assert.Equal(t, test.expected, clusters)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package clustergroup
+
+import (
+	"github.com/rancher/norman/types/slice"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Escalation checks a user can grant a cluster role in a cluster, the user must be an administrator, hold the role
+// in the cluster, or own the cluster when the role is builtin
+type Escalation struct {
+	GrbLister          v3.GlobalRoleBindingLister
+	GrLister           v3.GlobalRoleLister
+	CrtbLister         v3.ClusterRoleTemplateBindingLister
+	RoleTemplateLister v3.RoleTemplateLister
+}
+
+func (e *Escalation) IsAdmin(userID string) (bool, error) {
+	grbs, err := e.GrbLister.List("", labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, grb := range grbs {
+		if grb.UserName != userID {
+			continue
+		}
+		gr, err := e.GrLister.Get("", grb.GlobalRoleName)
+		if err != nil {
+			return false, err
+		}
+		for _, rule := range gr.Rules {
+			if slice.ContainsString(rule.Resources, "*") && slice.ContainsString(rule.APIGroups, "*") && slice.ContainsString(rule.Verbs, "*") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// CanGrant returns whether the user, who is not an administrator, can grant the role template in the cluster
+func (e *Escalation) CanGrant(userID, clusterName string, roleTemplate *v3.RoleTemplate) (bool, error) {
+	crtbs, err := e.CrtbLister.List(clusterName, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, crtb := range crtbs {
+		if crtb.UserName != userID {
+			continue
+		}
+		if crtb.RoleTemplateName == roleTemplate.Name {
+			return true, nil
+		}
+		if !roleTemplate.Builtin {
+			continue
+		}
+		rt, err := e.RoleTemplateLister.Get("", crtb.RoleTemplateName)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		if rt.ClusterCreatorDefault && rt.Builtin {
+			return true, nil
+		}
+	}
+	return false, nil
+}
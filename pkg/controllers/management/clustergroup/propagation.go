@@ -0,0 +1,259 @@
+package clustergroup
+
+import (
+	"fmt"
+	"reflect"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// syncAlertGroup copies an alert group scoped to a cluster group to every member, its rules are copied along
+func (c *controller) syncAlertGroup(key string, alertGroup *v3.ClusterAlertGroup) (runtime.Object, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return alertGroup, err
+	}
+	if alertGroup != nil && alertGroup.Labels[v32.ClusterGroupSourceLabel] != "" {
+		return alertGroup, nil
+	}
+
+	var members []string
+	if alertGroup != nil && alertGroup.DeletionTimestamp == nil && alertGroup.Spec.ClusterGroupName != "" {
+		if members, err = c.members(alertGroup.Spec.ClusterGroupName); err != nil {
+			return alertGroup, err
+		}
+	}
+	wanted := copyNamespaces(members, namespace)
+
+	copies, err := c.alertGroupLister.List("", copySelector(namespace))
+	if err != nil {
+		return alertGroup, err
+	}
+	for _, existing := range copies {
+		if existing.Name != name {
+			continue
+		}
+		if !wanted[existing.Namespace] {
+			if err := c.alertGroups.DeleteNamespaced(existing.Namespace, existing.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return alertGroup, err
+			}
+			continue
+		}
+		delete(wanted, existing.Namespace)
+		spec := c.alertGroupSpec(alertGroup, existing.Namespace)
+		if !reflect.DeepEqual(spec, existing.Spec) {
+			existing = existing.DeepCopy()
+			existing.Spec = spec
+			if _, err := c.alertGroups.Update(existing); err != nil {
+				return alertGroup, err
+			}
+		}
+	}
+	for clusterName := range wanted {
+		if _, err := c.alertGroups.Create(&v3.ClusterAlertGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: clusterName,
+				Labels:    copyLabels(alertGroup.Spec.ClusterGroupName, namespace),
+			},
+			Spec: c.alertGroupSpec(alertGroup, clusterName),
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return alertGroup, err
+		}
+	}
+
+	// the rules follow the members of their group
+	rules, err := c.alertRuleLister.List(namespace, labels.Everything())
+	if err != nil {
+		return alertGroup, err
+	}
+	for _, rule := range rules {
+		if rule.Spec.GroupName == fmt.Sprintf("%s:%s", namespace, name) {
+			c.alertRules.Controller().Enqueue(rule.Namespace, rule.Name)
+		}
+	}
+	return alertGroup, nil
+}
+
+func (c *controller) alertGroupSpec(source *v3.ClusterAlertGroup, clusterName string) v32.ClusterGroupSpec {
+	spec := *source.Spec.DeepCopy()
+	spec.ClusterName = clusterName
+	spec.ClusterGroupName = ""
+	for i, recipient := range spec.Recipients {
+		spec.Recipients[i].NotifierName = c.notifierName(recipient.NotifierName, source.Namespace, source.Spec.ClusterGroupName, clusterName)
+	}
+	return spec
+}
+
+// notifierName returns the notifier of the copy of an alert group in a member, the copy of the notifier in the
+// member if it is scoped to the same group
+func (c *controller) notifierName(notifierName, namespace, groupName, clusterName string) string {
+	notifierNamespace, name := ref.Parse(notifierName)
+	if notifierNamespace != namespace {
+		return notifierName
+	}
+	_, group := ref.Parse(groupName)
+	notifier, err := c.notifierLister.Get(namespace, name)
+	if err != nil || !sameGroup(notifier.Spec.ClusterGroupName, group) {
+		return notifierName
+	}
+	return fmt.Sprintf("%s:%s", clusterName, name)
+}
+
+// syncAlertRule copies the rules of an alert group scoped to a cluster group to the copies of the alert group
+func (c *controller) syncAlertRule(key string, rule *v3.ClusterAlertRule) (runtime.Object, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return rule, err
+	}
+	if rule != nil && rule.Labels[v32.ClusterGroupSourceLabel] != "" {
+		return rule, nil
+	}
+
+	var (
+		members    []string
+		groupName  string
+		alertGroup string
+	)
+	if rule != nil && rule.DeletionTimestamp == nil {
+		groupNamespace, groupID := ref.Parse(rule.Spec.GroupName)
+		if parent, err := c.alertGroupLister.Get(groupNamespace, groupID); err == nil && parent.Spec.ClusterGroupName != "" {
+			groupName, alertGroup = parent.Spec.ClusterGroupName, groupID
+			if members, err = c.members(groupName); err != nil {
+				return rule, err
+			}
+		} else if err != nil && !apierrors.IsNotFound(err) {
+			return rule, err
+		}
+	}
+	wanted := copyNamespaces(members, namespace)
+
+	copies, err := c.alertRuleLister.List("", copySelector(namespace))
+	if err != nil {
+		return rule, err
+	}
+	for _, existing := range copies {
+		if existing.Name != name {
+			continue
+		}
+		if !wanted[existing.Namespace] {
+			if err := c.alertRules.DeleteNamespaced(existing.Namespace, existing.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return rule, err
+			}
+			continue
+		}
+		delete(wanted, existing.Namespace)
+		spec := alertRuleSpec(rule, existing.Namespace, alertGroup)
+		if !reflect.DeepEqual(spec, existing.Spec) {
+			existing = existing.DeepCopy()
+			existing.Spec = spec
+			if _, err := c.alertRules.Update(existing); err != nil {
+				return rule, err
+			}
+		}
+	}
+	for clusterName := range wanted {
+		if _, err := c.alertRules.Create(&v3.ClusterAlertRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: clusterName,
+				Labels:    copyLabels(groupName, namespace),
+			},
+			Spec: alertRuleSpec(rule, clusterName, alertGroup),
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return rule, err
+		}
+	}
+	return rule, nil
+}
+
+func alertRuleSpec(source *v3.ClusterAlertRule, clusterName, alertGroup string) v32.ClusterAlertRuleSpec {
+	spec := *source.Spec.DeepCopy()
+	spec.ClusterName = clusterName
+	spec.GroupName = fmt.Sprintf("%s:%s", clusterName, alertGroup)
+	return spec
+}
+
+// syncNotifier copies a notifier scoped to a cluster group to every member
+func (c *controller) syncNotifier(key string, notifier *v3.Notifier) (runtime.Object, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return notifier, err
+	}
+	if notifier != nil && notifier.Labels[v32.ClusterGroupSourceLabel] != "" {
+		return notifier, nil
+	}
+
+	var members []string
+	if notifier != nil && notifier.DeletionTimestamp == nil && notifier.Spec.ClusterGroupName != "" {
+		if members, err = c.members(notifier.Spec.ClusterGroupName); err != nil {
+			return notifier, err
+		}
+	}
+	wanted := copyNamespaces(members, namespace)
+
+	copies, err := c.notifierLister.List("", copySelector(namespace))
+	if err != nil {
+		return notifier, err
+	}
+	for _, existing := range copies {
+		if existing.Name != name {
+			continue
+		}
+		if !wanted[existing.Namespace] {
+			if err := c.notifiers.DeleteNamespaced(existing.Namespace, existing.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return notifier, err
+			}
+			continue
+		}
+		delete(wanted, existing.Namespace)
+		spec := notifierSpec(notifier, existing.Namespace)
+		if !reflect.DeepEqual(spec, existing.Spec) {
+			existing = existing.DeepCopy()
+			existing.Spec = spec
+			if _, err := c.notifiers.Update(existing); err != nil {
+				return notifier, err
+			}
+		}
+	}
+	for clusterName := range wanted {
+		if _, err := c.notifiers.Create(&v3.Notifier{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: clusterName,
+				Labels:    copyLabels(notifier.Spec.ClusterGroupName, namespace),
+			},
+			Spec: notifierSpec(notifier, clusterName),
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return notifier, err
+		}
+	}
+
+	// the alert groups of the same cluster group send to the copies of the notifier
+	if notifier != nil {
+		alertGroups, err := c.alertGroupLister.List(namespace, labels.Everything())
+		if err != nil {
+			return notifier, err
+		}
+		for _, alertGroup := range alertGroups {
+			if alertGroup.Spec.ClusterGroupName != "" {
+				c.alertGroups.Controller().Enqueue(alertGroup.Namespace, alertGroup.Name)
+			}
+		}
+	}
+	return notifier, nil
+}
+
+func notifierSpec(source *v3.Notifier, clusterName string) v32.NotifierSpec {
+	spec := *source.Spec.DeepCopy()
+	spec.ClusterName = clusterName
+	spec.ClusterGroupName = ""
+	return spec
+}
@@ -0,0 +1,135 @@
+package clustergroup
+
+import (
+	"fmt"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/rbac"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// bindingLifecycle keeps a cluster role template binding in the namespace of every member of the group of a
+// ClusterGroupRoleTemplateBinding
+type bindingLifecycle struct {
+	c *controller
+}
+
+func (l *bindingLifecycle) Create(binding *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error) {
+	return binding, l.c.syncBinding(binding)
+}
+
+func (l *bindingLifecycle) Updated(binding *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error) {
+	return binding, l.c.syncBinding(binding)
+}
+
+func (l *bindingLifecycle) Remove(binding *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error) {
+	crtbs, err := l.c.boundCRTBs(binding.Name)
+	if err != nil {
+		return binding, err
+	}
+	for _, crtb := range crtbs {
+		if err := l.c.crtbs.DeleteNamespaced(crtb.Namespace, crtb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return binding, err
+		}
+	}
+	return binding, nil
+}
+
+func (c *controller) syncBinding(binding *v3.ClusterGroupRoleTemplateBinding) error {
+	members, err := c.members(binding.ClusterGroupName)
+	if err != nil {
+		return err
+	}
+	crtbs, err := c.boundCRTBs(binding.Name)
+	if err != nil {
+		return err
+	}
+
+	wanted := copyNamespaces(members, "")
+	for _, crtb := range crtbs {
+		if wanted[crtb.Namespace] {
+			delete(wanted, crtb.Namespace)
+			continue
+		}
+		logrus.Infof("Removing cluster role template binding %s from cluster %s, it left cluster group %s", crtb.Name, crtb.Namespace, binding.ClusterGroupName)
+		if err := c.crtbs.DeleteNamespaced(crtb.Namespace, crtb.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if err := c.dropEscalations(binding, wanted); err != nil {
+		return err
+	}
+	for clusterName := range wanted {
+		crtb := newCRTB(binding, clusterName)
+		if _, err := c.crtbs.Create(crtb); apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("cluster role template binding %s:%s already exists and was not created for the cluster group", clusterName, crtb.Name)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropEscalations removes the clusters that joined the group where the creator of the binding can not grant its role,
+// the API only checked the members of the group when the binding was created
+func (c *controller) dropEscalations(binding *v3.ClusterGroupRoleTemplateBinding, clusters map[string]bool) error {
+	creatorID := binding.Annotations[rbac.CreatorIDAnn]
+	if creatorID == "" || len(clusters) == 0 {
+		return nil
+	}
+	if isAdmin, err := c.escalation.IsAdmin(creatorID); err != nil || isAdmin {
+		return err
+	}
+	roleTemplate, err := c.roleTemplateLister.Get("", binding.RoleTemplateName)
+	if err != nil {
+		return err
+	}
+	for clusterName := range clusters {
+		canGrant, err := c.escalation.CanGrant(creatorID, clusterName, roleTemplate)
+		if err != nil {
+			return err
+		}
+		if !canGrant {
+			logrus.Warnf("Not binding role template %s in cluster %s for cluster group binding %s, its creator %s can not grant it there",
+				roleTemplate.Name, clusterName, binding.Name, creatorID)
+			delete(clusters, clusterName)
+		}
+	}
+	return nil
+}
+
+func (c *controller) boundCRTBs(bindingName string) ([]*v3.ClusterRoleTemplateBinding, error) {
+	return c.crtbLister.List("", labels.SelectorFromSet(labels.Set{v32.ClusterGroupBindingLabel: bindingName}))
+}
+
+func newCRTB(binding *v3.ClusterGroupRoleTemplateBinding, clusterName string) *v3.ClusterRoleTemplateBinding {
+	_, group := ref.Parse(binding.ClusterGroupName)
+	annotations := map[string]string{}
+	if creatorID, ok := binding.Annotations[rbac.CreatorIDAnn]; ok {
+		annotations[rbac.CreatorIDAnn] = creatorID
+	}
+	return &v3.ClusterRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        binding.Name,
+			Namespace:   clusterName,
+			Annotations: annotations,
+			Labels: map[string]string{
+				v32.ClusterGroupLabel:        group,
+				v32.ClusterGroupBindingLabel: binding.Name,
+			},
+		},
+		UserName:           binding.UserName,
+		UserPrincipalName:  binding.UserPrincipalName,
+		GroupName:          binding.GroupName,
+		GroupPrincipalName: binding.GroupPrincipalName,
+		ClusterName:        clusterName,
+		RoleTemplateName:   binding.RoleTemplateName,
+	}
+}
@@ -0,0 +1,94 @@
+package clustergroup
+
+import (
+	"fmt"
+	"reflect"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultProject = "Default"
+
+// syncTargets keeps a target in the multi cluster app for the project of every member of its cluster group targets
+func (c *controller) syncTargets(key string, mcApp *v3.MultiClusterApp) (runtime.Object, error) {
+	if mcApp == nil || mcApp.DeletionTimestamp != nil {
+		return mcApp, nil
+	}
+
+	wanted := map[string]string{}
+	for _, target := range mcApp.Spec.ClusterGroupTargets {
+		members, err := c.members(target.ClusterGroupName)
+		if err != nil {
+			return mcApp, err
+		}
+		_, groupName := ref.Parse(target.ClusterGroupName)
+		for _, clusterName := range members {
+			projectName, err := c.projectByDisplayName(clusterName, target.ProjectDisplayName)
+			if err != nil {
+				return mcApp, err
+			}
+			if projectName != "" {
+				wanted[projectName] = groupName
+			}
+		}
+	}
+
+	targets := groupTargets(mcApp.Spec.Targets, wanted)
+	if reflect.DeepEqual(targets, mcApp.Spec.Targets) {
+		return mcApp, nil
+	}
+	mcApp = mcApp.DeepCopy()
+	mcApp.Spec.Targets = targets
+	return c.mcApps.Update(mcApp)
+}
+
+// projectByDisplayName returns the ID of the project of the cluster with the display name, or an empty string if the
+// cluster has no such project
+func (c *controller) projectByDisplayName(clusterName, displayName string) (string, error) {
+	if displayName == "" {
+		displayName = defaultProject
+	}
+	projects, err := c.projectLister.List(clusterName, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	for _, project := range projects {
+		if project.Spec.DisplayName == displayName && project.DeletionTimestamp == nil {
+			return fmt.Sprintf("%s:%s", clusterName, project.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// groupTargets returns the targets of the app with the targets of its cluster groups replaced by the wanted ones,
+// by project. The targets added explicitly are kept and take precedence, the state of the existing ones is kept.
+func groupTargets(existing []v32.Target, wanted map[string]string) []v32.Target {
+	var targets []v32.Target
+	added := map[string]bool{}
+	for _, target := range existing {
+		if target.ClusterGroupName == "" {
+			targets = append(targets, target)
+			added[target.ProjectName] = true
+		}
+	}
+	for _, target := range existing {
+		if target.ClusterGroupName == "" || added[target.ProjectName] {
+			continue
+		}
+		if group, ok := wanted[target.ProjectName]; ok {
+			target.ClusterGroupName = group
+			targets = append(targets, target)
+			added[target.ProjectName] = true
+		}
+	}
+	for _, projectName := range sortedKeys(wanted) {
+		if !added[projectName] {
+			targets = append(targets, v32.Target{ProjectName: projectName, ClusterGroupName: wanted[projectName]})
+		}
+	}
+	return targets
+}
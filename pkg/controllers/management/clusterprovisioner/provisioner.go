@@ -22,6 +22,7 @@
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/rancher/pkg/rkedialerfactory"
 	"github.com/rancher/rancher/pkg/settings"
@@ -422,6 +423,39 @@ func (p *Provisioner) backoffFailure(cluster *v3.Cluster, spec *apimgmtv3.Cluste
 	return false, 0
 }
 
+// deferUpdate holds back changes to the rke config of a cluster until its maintenance window opens, changes to the
+// nodes only are applied right away so clusters can still scale
+func (p *Provisioner) deferUpdate(cluster *v3.Cluster, spec *apimgmtv3.ClusterSpec) (*v3.Cluster, bool, error) {
+	newConfig := spec.RancherKubernetesEngineConfig
+	oldConfig := cluster.Status.AppliedSpec.RancherKubernetesEngineConfig
+	if newConfig == nil || oldConfig == nil || newConfig.Restore.Restore || newConfig.RotateCertificates != nil {
+		return cluster, false, nil
+	}
+	if !rkeConfigChanged(oldConfig, newConfig) {
+		return cluster, false, nil
+	}
+
+	open, next, err := maintenance.IsOpen(cluster.Spec.MaintenanceWindow, time.Now())
+	if err != nil || open {
+		return cluster, false, err
+	}
+	logrus.Infof("Update of cluster [%s] is waiting for the maintenance window at %s", cluster.Name, next)
+	if maintenance.Plan(cluster, apimgmtv3.MaintenanceActionRKEUpdate, "rke config changed", next) {
+		if cluster, err = p.Clusters.Update(cluster); err != nil {
+			return cluster, true, err
+		}
+	}
+	p.ClusterController.EnqueueAfter("", cluster.Name, time.Until(next))
+	return cluster, true, nil
+}
+
+// rkeConfigChanged compares rke configs without their nodes
+func rkeConfigChanged(oldConfig, newConfig *rketypes.RancherKubernetesEngineConfig) bool {
+	oldCopy, newCopy := *oldConfig, *newConfig
+	oldCopy.Nodes, newCopy.Nodes = nil, nil
+	return !reflect.DeepEqual(oldCopy, newCopy)
+}
+
 func (p *Provisioner) reconcileCluster(cluster *v3.Cluster, create bool) (*v3.Cluster, error) {
 	if skipLocalK3sImported(cluster) {
 		return cluster, nil
@@ -475,6 +509,13 @@ func (p *Provisioner) reconcileCluster(cluster *v3.Cluster, create bool) (*v3.Cl
 		return cluster, &controller.ForgetError{Err: fmt.Errorf("backing off failure, delay: %v", delay)}
 	}
 
+	if !create {
+		var deferred bool
+		if cluster, deferred, err = p.deferUpdate(cluster, spec); err != nil || deferred {
+			return cluster, err
+		}
+	}
+
 	logrus.Infof("Provisioning cluster [%s]", cluster.Name)
 	var updateTriggered bool
 	if create {
@@ -544,6 +585,7 @@ func (p *Provisioner) reconcileCluster(cluster *v3.Cluster, create bool) (*v3.Cl
 		cluster.Status.ServiceAccountToken = serviceAccountToken
 		cluster.Status.CACert = caCert
 		resetRkeConfigFlags(cluster, updateTriggered)
+		maintenance.Done(cluster, apimgmtv3.MaintenanceActionRKEUpdate)
 
 		// initialize on first rke up
 		if cluster.Status.AppliedSpec.RancherKubernetesEngineConfig != nil && cluster.Status.NodeVersion == 0 {
@@ -0,0 +1,387 @@
+package clusterstats
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// HistoryConfigMapName is the config map in the cluster namespace holding the capacity history of the cluster,
+	// the history of each node pool is in its own config map so that none of them outgrows the size limit of objects
+	HistoryConfigMapName = "capacity-history"
+	historyLabel         = "management.cattle.io/capacity-history"
+	historyDataKey       = "samples"
+
+	// samples are recorded at most every sampleInterval and averaged per coarseInterval once older than
+	// fineRetention, the averages are kept for coarseRetention
+	sampleInterval  = 5 * time.Minute
+	fineRetention   = 24 * time.Hour
+	coarseInterval  = time.Hour
+	coarseRetention = 30 * 24 * time.Hour
+	// maxQuerySamples bounds the samples returned by a query
+	maxQuerySamples = 2000
+)
+
+// sample is the stored form of v32.CapacitySample, cpu is in millicores and memory in bytes
+type sample struct {
+	Time              int64 `json:"t"`
+	CPUAllocatable    int64 `json:"ca"`
+	CPURequested      int64 `json:"cr"`
+	CPULimits         int64 `json:"cl"`
+	MemoryAllocatable int64 `json:"ma"`
+	MemoryRequested   int64 `json:"mr"`
+	MemoryLimits      int64 `json:"ml"`
+	PodsAllocatable   int64 `json:"pa"`
+	PodsRequested     int64 `json:"pr"`
+}
+
+func nodesSample(machines []*v3.Node, now time.Time) sample {
+	s := sample{Time: now.Unix()}
+	for _, machine := range machines {
+		if allocatable := machine.Status.InternalNodeStatus.Allocatable; allocatable != nil {
+			s.CPUAllocatable += allocatable.Cpu().MilliValue()
+			s.MemoryAllocatable += allocatable.Memory().Value()
+			s.PodsAllocatable += allocatable.Pods().Value()
+		}
+		if requested := machine.Status.Requested; requested != nil {
+			s.CPURequested += requested.Cpu().MilliValue()
+			s.MemoryRequested += requested.Memory().Value()
+			s.PodsRequested += requested.Pods().Value()
+		}
+		if limits := machine.Status.Limits; limits != nil {
+			s.CPULimits += limits.Cpu().MilliValue()
+			s.MemoryLimits += limits.Memory().Value()
+		}
+	}
+	return s
+}
+
+// recordHistory adds a sample of the cluster and of each of its node pools to the capacity history
+func (s *StatsAggregator) recordHistory(clusterName string, machines []*v3.Node, now time.Time) error {
+	// stats are aggregated on every node change, skip decoding the history when it already has a sample for now
+	bucket := now.Unix() / int64(sampleInterval/time.Second)
+	if last, ok := s.recorded.Load(clusterName); ok && last.(int64) == bucket {
+		return nil
+	}
+
+	samples := map[string]sample{HistoryConfigMapName: nodesSample(machines, now)}
+	pools := map[string][]*v3.Node{}
+	for _, machine := range machines {
+		if machine.Spec.NodePoolName != "" {
+			pools[machine.Spec.NodePoolName] = append(pools[machine.Spec.NodePoolName], machine)
+		}
+	}
+	for pool, poolMachines := range pools {
+		samples[historyConfigMapName(pool)] = nodesSample(poolMachines, now)
+	}
+
+	// node pools that are gone age out of the history
+	existing, err := s.ConfigMapLister.List(clusterName, labels.SelectorFromSet(labels.Set{historyLabel: "true"}))
+	if err != nil {
+		return err
+	}
+	for _, cm := range existing {
+		if _, ok := samples[cm.Name]; ok {
+			continue
+		}
+		series, err := decodeSeries(cm.Data[historyDataKey])
+		if err != nil {
+			return err
+		}
+		if err := s.saveSeries(clusterName, cm.Name, cm, compact(series, now)); err != nil {
+			return err
+		}
+	}
+
+	for name, next := range samples {
+		cm, err := s.ConfigMapLister.Get(clusterName, name)
+		if apierrors.IsNotFound(err) {
+			cm = nil
+		} else if err != nil {
+			return err
+		}
+		var series []sample
+		if cm != nil {
+			if series, err = decodeSeries(cm.Data[historyDataKey]); err != nil {
+				return err
+			}
+		}
+		series, ok := record(series, next, now)
+		if !ok {
+			continue
+		}
+		if err := s.saveSeries(clusterName, name, cm, series); err != nil {
+			return err
+		}
+	}
+
+	s.recorded.Store(clusterName, bucket)
+	return nil
+}
+
+// saveSeries writes a series to its config map, cm is nil when it does not exist yet, a config map left without
+// samples is removed
+func (s *StatsAggregator) saveSeries(clusterName, name string, cm *v1.ConfigMap, series []sample) error {
+	if len(series) == 0 {
+		if cm == nil {
+			return nil
+		}
+		err := s.ConfigMaps.DeleteNamespaced(clusterName, name, &metav1.DeleteOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	encoded, err := encodeSeries(series)
+	if err != nil {
+		return err
+	}
+	if cm == nil {
+		_, err = s.ConfigMaps.Create(&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: clusterName,
+				Labels:    map[string]string{historyLabel: "true"},
+			},
+			Data: map[string]string{historyDataKey: encoded},
+		})
+		return err
+	}
+	if cm.Data[historyDataKey] == encoded {
+		return nil
+	}
+	updated := cm.DeepCopy()
+	if updated.Data == nil {
+		updated.Data = map[string]string{}
+	}
+	updated.Data[historyDataKey] = encoded
+	_, err = s.ConfigMaps.Update(updated)
+	return err
+}
+
+// record appends a sample to a series unless one was recorded in the same interval, and compacts the series
+func record(series []sample, s sample, now time.Time) ([]sample, bool) {
+	bucket := s.Time / int64(sampleInterval/time.Second)
+	if len(series) > 0 && series[len(series)-1].Time/int64(sampleInterval/time.Second) >= bucket {
+		return series, false
+	}
+	return compact(append(series, s), now), true
+}
+
+// compact averages the samples older than fineRetention per coarseInterval, only hours that are entirely older are
+// averaged so that they are averaged once, and drops the samples older than coarseRetention
+func compact(series []sample, now time.Time) []sample {
+	fineCutoff := now.Add(-fineRetention).Unix()
+	coarseCutoff := now.Add(-coarseRetention).Unix()
+	hour := int64(coarseInterval / time.Second)
+
+	var result []sample
+	for i := 0; i < len(series); {
+		start := series[i].Time - series[i].Time%hour
+		if start+hour > fineCutoff {
+			if series[i].Time >= coarseCutoff {
+				result = append(result, series[i])
+			}
+			i++
+			continue
+		}
+		j := i
+		for j < len(series) && series[j].Time < start+hour {
+			j++
+		}
+		if start >= coarseCutoff {
+			result = append(result, average(series[i:j], start))
+		}
+		i = j
+	}
+	return result
+}
+
+func average(samples []sample, t int64) sample {
+	if len(samples) == 1 {
+		samples[0].Time = t
+		return samples[0]
+	}
+	avg := sample{Time: t}
+	for _, s := range samples {
+		avg.CPUAllocatable += s.CPUAllocatable
+		avg.CPURequested += s.CPURequested
+		avg.CPULimits += s.CPULimits
+		avg.MemoryAllocatable += s.MemoryAllocatable
+		avg.MemoryRequested += s.MemoryRequested
+		avg.MemoryLimits += s.MemoryLimits
+		avg.PodsAllocatable += s.PodsAllocatable
+		avg.PodsRequested += s.PodsRequested
+	}
+	n := int64(len(samples))
+	avg.CPUAllocatable /= n
+	avg.CPURequested /= n
+	avg.CPULimits /= n
+	avg.MemoryAllocatable /= n
+	avg.MemoryRequested /= n
+	avg.MemoryLimits /= n
+	avg.PodsAllocatable /= n
+	avg.PodsRequested /= n
+	return avg
+}
+
+// CapacityHistory returns the capacity of a cluster, or of one of its node pools, at every step from start to end.
+// The figures only change when nodes do, so each step gets the last sample recorded before it.
+func CapacityHistory(configMapLister corev1.ConfigMapLister, clusterName, nodePoolName string, start, end time.Time, step time.Duration) ([]v32.CapacitySample, error) {
+	if step < sampleInterval {
+		return nil, fmt.Errorf("step has to be at least %v", sampleInterval)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("end has to be after start")
+	}
+	if end.Sub(start)/step > maxQuerySamples {
+		return nil, fmt.Errorf("the range covers more than %d steps", maxQuerySamples)
+	}
+
+	cm, err := configMapLister.Get(clusterName, historyConfigMapName(nodePoolName))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	series, err := decodeSeries(cm.Data[historyDataKey])
+	if err != nil {
+		return nil, err
+	}
+	return query(series, start, end, step), nil
+}
+
+func query(series []sample, start, end time.Time, step time.Duration) []v32.CapacitySample {
+	var result []v32.CapacitySample
+	for t := start; !t.After(end); t = t.Add(step) {
+		i := sort.Search(len(series), func(i int) bool { return series[i].Time > t.Unix() })
+		if i == 0 {
+			continue
+		}
+		s := series[i-1]
+		result = append(result, v32.CapacitySample{
+			Timestamp:         t.UTC().Format(time.RFC3339),
+			CPUAllocatable:    s.CPUAllocatable,
+			CPURequested:      s.CPURequested,
+			CPULimits:         s.CPULimits,
+			MemoryAllocatable: s.MemoryAllocatable,
+			MemoryRequested:   s.MemoryRequested,
+			MemoryLimits:      s.MemoryLimits,
+			PodsAllocatable:   s.PodsAllocatable,
+			PodsRequested:     s.PodsRequested,
+		})
+	}
+	return result
+}
+
+// ForecastCapacity fits the cpu and memory requests of the samples to a line and projects when they reach the last
+// allocatable resources, it returns nil without enough samples
+func ForecastCapacity(samples []v32.CapacitySample) *v32.CapacityForecast {
+	if len(samples) < 2 {
+		return nil
+	}
+	var times, cpu, memory []float64
+	for _, s := range samples {
+		t, err := time.Parse(time.RFC3339, s.Timestamp)
+		if err != nil {
+			continue
+		}
+		times = append(times, float64(t.Unix()))
+		cpu = append(cpu, float64(s.CPURequested))
+		memory = append(memory, float64(s.MemoryRequested))
+	}
+	if len(times) < 2 {
+		return nil
+	}
+
+	last := samples[len(samples)-1]
+	now := time.Unix(int64(times[len(times)-1]), 0).UTC()
+	day := (24 * time.Hour).Seconds()
+	forecast := &v32.CapacityForecast{}
+
+	cpuSlope, cpuIntercept := fit(times, cpu)
+	forecast.CPURequestsPerDay = int64(math.Round(cpuSlope * day))
+	forecast.CPUExhaustion = exhaustion(cpuSlope, cpuIntercept, float64(last.CPUAllocatable), now)
+
+	memorySlope, memoryIntercept := fit(times, memory)
+	forecast.MemoryRequestsPerDay = int64(math.Round(memorySlope * day))
+	forecast.MemoryExhaustion = exhaustion(memorySlope, memoryIntercept, float64(last.MemoryAllocatable), now)
+	return forecast
+}
+
+// fit returns the slope and intercept of the least squares line through the points
+func fit(x, y []float64) (float64, float64) {
+	n := float64(len(x))
+	var sumX, sumY float64
+	for i := range x {
+		sumX += x[i]
+		sumY += y[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var covariance, variance float64
+	for i := range x {
+		covariance += (x[i] - meanX) * (y[i] - meanY)
+		variance += (x[i] - meanX) * (x[i] - meanX)
+	}
+	if variance == 0 {
+		return 0, meanY
+	}
+	slope := covariance / variance
+	return slope, meanY - slope*meanX
+}
+
+// exhaustion returns when the line reaches the allocatable resources, now if it already did and nothing if it does
+// not grow
+func exhaustion(slope, intercept, allocatable float64, now time.Time) string {
+	current := slope*float64(now.Unix()) + intercept
+	if current >= allocatable {
+		return now.Format(time.RFC3339)
+	}
+	if slope <= 0 {
+		return ""
+	}
+	seconds := (allocatable - current) / slope
+	if seconds > float64(math.MaxInt64/int64(time.Second)) {
+		return ""
+	}
+	return now.Add(time.Duration(seconds) * time.Second).Format(time.RFC3339)
+}
+
+// historyConfigMapName is the config map of the history of a node pool, or of the cluster when nodePoolName is empty.
+// It accepts both node pool names and namespaced references, config map names can not hold the latter.
+func historyConfigMapName(nodePoolName string) string {
+	if nodePoolName == "" {
+		return HistoryConfigMapName
+	}
+	_, name := ref.Parse(nodePoolName)
+	return HistoryConfigMapName + "-" + name
+}
+
+func decodeSeries(value string) ([]sample, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var series []sample
+	if err := json.Unmarshal([]byte(value), &series); err != nil {
+		return nil, fmt.Errorf("invalid capacity history: %v", err)
+	}
+	return series, nil
+}
+
+func encodeSeries(series []sample) (string, error) {
+	data, err := json.Marshal(series)
+	return string(data), err
+}
@@ -0,0 +1,240 @@
+package clusterstats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var now = time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC)
+
+func TestRecord(t *testing.T) {
+	series, ok := record(nil, sample{Time: now.Unix(), CPURequested: 100}, now)
+	assert.True(t, ok)
+	assert.Len(t, series, 1)
+
+	// a sample in the same interval is dropped
+	series, ok = record(series, sample{Time: now.Add(time.Minute).Unix(), CPURequested: 200}, now.Add(time.Minute))
+	assert.False(t, ok)
+	assert.Len(t, series, 1)
+
+	series, ok = record(series, sample{Time: now.Add(sampleInterval).Unix(), CPURequested: 200}, now.Add(sampleInterval))
+	assert.True(t, ok)
+	assert.Len(t, series, 2)
+}
+
+func TestCompact(t *testing.T) {
+	var series []sample
+	// an old sample that ages out, two samples in an hour older than a day and a recent one
+	for _, s := range []struct {
+		age time.Duration
+		cpu int64
+	}{
+		{age: 31 * 24 * time.Hour, cpu: 1},
+		{age: 26 * time.Hour, cpu: 100},
+		{age: 26*time.Hour - 30*time.Minute, cpu: 300},
+		{age: 24*time.Hour - 5*time.Minute, cpu: 500},
+		{age: time.Hour, cpu: 700},
+	} {
+		series = append(series, sample{Time: now.Add(-s.age).Unix(), CPURequested: s.cpu})
+	}
+
+	compacted := compact(series, now)
+	assert.Equal(t, []sample{
+		{Time: now.Add(-26 * time.Hour).Unix(), CPURequested: 200},
+		{Time: now.Add(-24*time.Hour + 5*time.Minute).Unix(), CPURequested: 500},
+		{Time: now.Add(-time.Hour).Unix(), CPURequested: 700},
+	}, compacted)
+
+	// compacting again does not change the averages
+	assert.Equal(t, compacted, compact(compacted, now))
+}
+
+func TestQuery(t *testing.T) {
+	series := []sample{
+		{Time: now.Add(-3 * time.Hour).Unix(), CPURequested: 100},
+		{Time: now.Add(-90 * time.Minute).Unix(), CPURequested: 200},
+	}
+
+	samples := query(series, now.Add(-4*time.Hour), now, time.Hour)
+	var cpu []int64
+	for _, s := range samples {
+		cpu = append(cpu, s.CPURequested)
+	}
+	// nothing was recorded 4 hours ago, later steps carry the last sample forward
+	assert.Equal(t, []int64{100, 100, 200, 200}, cpu)
+	assert.Equal(t, now.Add(-3*time.Hour).Format(time.RFC3339), samples[0].Timestamp)
+}
+
+func TestForecastCapacity(t *testing.T) {
+	capacitySample := func(age time.Duration, cpu, memory int64) v32.CapacitySample {
+		return v32.CapacitySample{
+			Timestamp:         now.Add(-age).Format(time.RFC3339),
+			CPUAllocatable:    4000,
+			CPURequested:      cpu,
+			MemoryAllocatable: 1000,
+			MemoryRequested:   memory,
+		}
+	}
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		samples []v32.CapacitySample
+		want    *v32.CapacityForecast
+	}{
+		{
+			name:    "not enough samples",
+			samples: []v32.CapacitySample{capacitySample(0, 1000, 100)},
+		},
+		{
+			name: "growing cpu requests",
+			samples: []v32.CapacitySample{
+				capacitySample(2*day, 1000, 100),
+				capacitySample(day, 1500, 100),
+				capacitySample(0, 2000, 100),
+			},
+			want: &v32.CapacityForecast{
+				CPURequestsPerDay: 500,
+				CPUExhaustion:     now.Add(4 * day).Format(time.RFC3339),
+			},
+		},
+		{
+			name: "exhausted memory",
+			samples: []v32.CapacitySample{
+				capacitySample(day, 1000, 800),
+				capacitySample(0, 1000, 1200),
+			},
+			want: &v32.CapacityForecast{
+				MemoryRequestsPerDay: 400,
+				MemoryExhaustion:     now.Format(time.RFC3339),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ForecastCapacity(tt.samples))
+		})
+	}
+}
+
+// configMapStore keeps the config maps written by the stats aggregator
+type configMapStore map[string]*v1.ConfigMap
+
+func (c configMapStore) aggregator() *StatsAggregator {
+	return &StatsAggregator{
+		ConfigMaps: &fakes.ConfigMapInterfaceMock{
+			CreateFunc: func(cm *v1.ConfigMap) (*v1.ConfigMap, error) {
+				cm.ResourceVersion = "1"
+				c[cm.Name] = cm
+				return cm, nil
+			},
+			UpdateFunc: func(cm *v1.ConfigMap) (*v1.ConfigMap, error) {
+				c[cm.Name] = cm
+				return cm, nil
+			},
+			DeleteNamespacedFunc: func(namespace, name string, options *metav1.DeleteOptions) error {
+				delete(c, name)
+				return nil
+			},
+		},
+		ConfigMapLister: c.lister(),
+	}
+}
+
+func (c configMapStore) lister() *fakes.ConfigMapListerMock {
+	return &fakes.ConfigMapListerMock{
+		GetFunc: func(namespace, name string) (*v1.ConfigMap, error) {
+			if cm, ok := c[name]; ok {
+				return cm, nil
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+		},
+		ListFunc: func(namespace string, selector labels.Selector) ([]*v1.ConfigMap, error) {
+			var result []*v1.ConfigMap
+			for _, cm := range c {
+				if selector.Matches(labels.Set(cm.Labels)) {
+					result = append(result, cm)
+				}
+			}
+			return result, nil
+		},
+	}
+}
+
+// fullSeries is a series with samples over the whole retention and figures as long as they get
+func fullSeries() []sample {
+	var series []sample
+	for t := now.Add(-coarseRetention); t.Before(now); t = t.Add(sampleInterval) {
+		series = append(series, sample{
+			Time:              t.Unix(),
+			CPUAllocatable:    math.MaxInt64,
+			CPURequested:      math.MaxInt64,
+			CPULimits:         math.MaxInt64,
+			MemoryAllocatable: math.MaxInt64,
+			MemoryRequested:   math.MaxInt64,
+			MemoryLimits:      math.MaxInt64,
+			PodsAllocatable:   math.MaxInt64,
+			PodsRequested:     math.MaxInt64,
+		})
+	}
+	return compact(series, now)
+}
+
+func TestRecordHistoryManyPools(t *testing.T) {
+	const pools = 50
+	store := configMapStore{}
+	full, err := encodeSeries(fullSeries())
+	require.NoError(t, err)
+
+	var machines []*v3.Node
+	for i := 0; i < pools; i++ {
+		pool := fmt.Sprintf("np-%d", i)
+		store[historyConfigMapName(pool)] = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            historyConfigMapName(pool),
+				ResourceVersion: "1",
+				Labels:          map[string]string{historyLabel: "true"},
+			},
+			Data: map[string]string{historyDataKey: full},
+		}
+		machine := &v3.Node{}
+		machine.Spec.NodePoolName = "c-1:" + pool
+		machines = append(machines, machine)
+	}
+	// the history of a removed pool only has samples past the retention
+	store[historyConfigMapName("np-removed")] = &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   historyConfigMapName("np-removed"),
+			Labels: map[string]string{historyLabel: "true"},
+		},
+		Data: map[string]string{historyDataKey: fmt.Sprintf(`[{"t":%d}]`, now.Add(-2*coarseRetention).Unix())},
+	}
+
+	require.NoError(t, store.aggregator().recordHistory("c-1", machines, now))
+
+	assert.Len(t, store, pools+1, "the cluster and every pool have a config map, the removed pool has none")
+	for name, cm := range store {
+		// objects are limited to 1MiB, the rest of the config map is left some room
+		assert.Less(t, len(cm.Data[historyDataKey]), 900*1024, "history %s is too large", name)
+	}
+
+	samples, err := CapacityHistory(store.lister(), "c-1", "c-1:np-7", now.Add(-time.Hour), now, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, samples, 2)
+	samples, err = CapacityHistory(store.lister(), "c-1", "", now.Add(-time.Hour), now, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, samples, 1, "the cluster history starts now")
+}
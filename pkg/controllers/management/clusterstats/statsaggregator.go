@@ -3,6 +3,7 @@
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -10,17 +11,24 @@
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/rancher/rancher/pkg/clustermanager"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
 type StatsAggregator struct {
-	NodesLister    v3.NodeLister
-	Clusters       v3.ClusterInterface
-	ClusterManager *clustermanager.Manager
+	NodesLister     v3.NodeLister
+	Clusters        v3.ClusterInterface
+	ClusterManager  *clustermanager.Manager
+	ConfigMaps      corev1.ConfigMapInterface
+	ConfigMapLister corev1.ConfigMapLister
+
+	// recorded is the last sample interval recorded in the capacity history of each cluster
+	recorded sync.Map
 }
 
 type ClusterNodeData struct {
@@ -37,9 +45,11 @@ func Register(ctx context.Context, management *config.ManagementContext, cluster
 	machinesClient := management.Management.Nodes("")
 
 	s := &StatsAggregator{
-		NodesLister:    machinesClient.Controller().Lister(),
-		Clusters:       clustersClient,
-		ClusterManager: clusterManager,
+		NodesLister:     machinesClient.Controller().Lister(),
+		Clusters:        clustersClient,
+		ClusterManager:  clusterManager,
+		ConfigMaps:      management.Core.ConfigMaps(""),
+		ConfigMapLister: management.Core.ConfigMaps("").Controller().Lister(),
 	}
 
 	clustersClient.AddHandler(ctx, "cluster-stats", s.sync)
@@ -132,6 +142,10 @@ func (s *StatsAggregator) aggregate(cluster *v3.Cluster, clusterName string) err
 		v32.ClusterConditionNoMemoryPressure.False(cluster)
 	}
 
+	if err := s.recordHistory(cluster.Name, machines, time.Now()); err != nil {
+		logrus.Warnf("[cluster-stats] failed to record the capacity history of cluster [%s]: %v", cluster.Name, err)
+	}
+
 	versionChanged := s.updateVersion(cluster)
 
 	if statsChanged(origStatus, &cluster.Status) || versionChanged {
@@ -0,0 +1,169 @@
+package clustertemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+)
+
+// ConstraintViolation is a field of a cluster spec that does not meet a constraint of its revision
+type ConstraintViolation struct {
+	Field   string
+	Message string
+}
+
+func (c ConstraintViolation) String() string {
+	return fmt.Sprintf("%s: %s", c.Field, c.Message)
+}
+
+// ValidateConstraints checks the constraints of a revision can be evaluated
+func ValidateConstraints(constraints []v32.ClusterTemplateConstraint) error {
+	for _, constraint := range constraints {
+		if constraint.Field == "" {
+			return fmt.Errorf("constraints require a field")
+		}
+		if constraint.Pattern != "" {
+			if _, err := regexp.Compile(constraint.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern for %s: %v", constraint.Field, err)
+			}
+		}
+		if constraint.VersionRange != "" {
+			if _, err := semver.ParseRange(constraint.VersionRange); err != nil {
+				return fmt.Errorf("invalid versionRange for %s: %v", constraint.Field, err)
+			}
+		}
+		if constraint.Min != nil && constraint.Max != nil && *constraint.Min > *constraint.Max {
+			return fmt.Errorf("min of %s is greater than its max", constraint.Field)
+		}
+		if constraint.When != nil && constraint.When.Field == "" {
+			return fmt.Errorf("the condition of the constraint on %s requires a field", constraint.Field)
+		}
+	}
+	return nil
+}
+
+// CheckConstraints evaluates the limits of the revision questions on the answers and the revision constraints on the
+// spec built from them
+func CheckConstraints(revision *v3.ClusterTemplateRevision, spec *v32.ClusterSpecBase, answers map[string]string) ([]ConstraintViolation, error) {
+	var violations []ConstraintViolation
+	for _, question := range revision.Spec.Questions {
+		answer, ok := answers[question.Variable]
+		if !ok {
+			continue
+		}
+		if message := checkQuestion(question, answer); message != "" {
+			violations = append(violations, ConstraintViolation{Field: question.Variable, Message: message})
+		}
+	}
+
+	if len(revision.Spec.Constraints) == 0 {
+		return violations, nil
+	}
+	data, err := convert.EncodeToMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	for _, constraint := range revision.Spec.Constraints {
+		if constraint.When != nil && toString(fieldValue(data, constraint.When.Field)) != constraint.When.Value {
+			continue
+		}
+		message := checkConstraint(constraint, fieldValue(data, constraint.Field))
+		if message == "" {
+			continue
+		}
+		if constraint.Message != "" {
+			message = constraint.Message
+		}
+		violations = append(violations, ConstraintViolation{Field: constraint.Field, Message: message})
+	}
+	return violations, nil
+}
+
+func checkQuestion(question v32.Question, answer string) string {
+	if len(question.Options) > 0 && !contains(question.Options, answer) {
+		return fmt.Sprintf("must be one of %s", strings.Join(question.Options, ", "))
+	}
+	switch question.Type {
+	case "int":
+		n, err := strconv.Atoi(answer)
+		if err != nil {
+			return "must be a number"
+		}
+		if (question.Min != 0 || question.Max != 0) && n < question.Min {
+			return fmt.Sprintf("must be at least %d", question.Min)
+		}
+		if question.Max != 0 && n > question.Max {
+			return fmt.Sprintf("must be at most %d", question.Max)
+		}
+	case "string", "password", "multiline":
+		if question.MinLength != 0 && len(answer) < question.MinLength {
+			return fmt.Sprintf("must be at least %d characters", question.MinLength)
+		}
+		if question.MaxLength != 0 && len(answer) > question.MaxLength {
+			return fmt.Sprintf("must be at most %d characters", question.MaxLength)
+		}
+	}
+	return ""
+}
+
+func checkConstraint(constraint v32.ClusterTemplateConstraint, value interface{}) string {
+	if isEmpty(value) {
+		if constraint.Required {
+			return "is required"
+		}
+		return ""
+	}
+	str := toString(value)
+
+	if len(constraint.AllowedValues) > 0 && !contains(constraint.AllowedValues, str) {
+		return fmt.Sprintf("must be one of %s", strings.Join(constraint.AllowedValues, ", "))
+	}
+	if constraint.Min != nil || constraint.Max != nil {
+		n, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return "must be a number"
+		}
+		if constraint.Min != nil && n < *constraint.Min {
+			return fmt.Sprintf("must be at least %d", *constraint.Min)
+		}
+		if constraint.Max != nil && n > *constraint.Max {
+			return fmt.Sprintf("must be at most %d", *constraint.Max)
+		}
+	}
+	if constraint.Pattern != "" {
+		if matched, _ := regexp.MatchString("^(?:"+constraint.Pattern+")$", str); !matched {
+			return fmt.Sprintf("must match %s", constraint.Pattern)
+		}
+	}
+	if constraint.VersionRange != "" {
+		versionRange, err := semver.ParseRange(constraint.VersionRange)
+		if err != nil {
+			return fmt.Sprintf("invalid versionRange %s", constraint.VersionRange)
+		}
+		version, err := semver.ParseTolerant(strings.Split(str, "-rancher")[0])
+		if err != nil || !versionRange(version) {
+			return fmt.Sprintf("must be a version in %s", constraint.VersionRange)
+		}
+	}
+	return ""
+}
+
+func fieldValue(data map[string]interface{}, field string) interface{} {
+	return values.GetValueN(data, strings.Split(fieldPath(field), ".")...)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package clustertemplate
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestCheckConstraints(t *testing.T) {
+	backupEnabled := &v32.ClusterTemplateConstraintCondition{Field: "rancherKubernetesEngineConfig.services.etcd.backupConfig.enabled", Value: "true"}
+
+	tests := []struct {
+		name        string
+		questions   []v32.Question
+		constraints []v32.ClusterTemplateConstraint
+		answers     map[string]string
+		spec        func(*v32.ClusterSpecBase)
+		want        []string
+	}{
+		{
+			name:        "allowed values",
+			constraints: []v32.ClusterTemplateConstraint{{Field: "rancherKubernetesEngineConfig.network.plugin", AllowedValues: []string{"canal", "calico"}}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				spec.RancherKubernetesEngineConfig.Network.Plugin = "flannel"
+			},
+			want: []string{"rancherKubernetesEngineConfig.network.plugin: must be one of canal, calico"},
+		},
+		{
+			name:        "numeric range",
+			constraints: []v32.ClusterTemplateConstraint{{Field: "rancherKubernetesEngineConfig.services.etcd.backupConfig.retention", Min: int64Ptr(3), Max: int64Ptr(12)}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig = &rketypes.BackupConfig{Retention: 24}
+			},
+			want: []string{"rancherKubernetesEngineConfig.services.etcd.backupConfig.retention: must be at most 12"},
+		},
+		{
+			name:        "pattern matches the whole value",
+			constraints: []v32.ClusterTemplateConstraint{{Field: "dockerRootDir", Pattern: "/var/lib/[a-z]+"}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				spec.DockerRootDir = "/var/lib/docker/extra"
+			},
+			want: []string{"dockerRootDir: must match /var/lib/[a-z]+"},
+		},
+		{
+			name:        "kubernetes version range",
+			constraints: []v32.ClusterTemplateConstraint{{Field: k8sVersionField, VersionRange: ">=1.17.0 <1.19.0", Message: "only 1.17 and 1.18 are supported"}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				spec.RancherKubernetesEngineConfig.Version = "v1.19.2-rancher1-1"
+			},
+			want: []string{k8sVersionField + ": only 1.17 and 1.18 are supported"},
+		},
+		{
+			name:        "version in range",
+			constraints: []v32.ClusterTemplateConstraint{{Field: k8sVersionField, VersionRange: ">=1.17.0 <1.19.0"}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				spec.RancherKubernetesEngineConfig.Version = "v1.18.8-rancher1-1"
+			},
+		},
+		{
+			name:        "cross field rule",
+			constraints: []v32.ClusterTemplateConstraint{{Field: "rancherKubernetesEngineConfig.services.etcd.backupConfig.s3BackupConfig", Required: true, When: backupEnabled}},
+			spec: func(spec *v32.ClusterSpecBase) {
+				enabled := true
+				spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig = &rketypes.BackupConfig{Enabled: &enabled}
+			},
+			want: []string{"rancherKubernetesEngineConfig.services.etcd.backupConfig.s3BackupConfig: is required"},
+		},
+		{
+			name:        "cross field rule does not apply",
+			constraints: []v32.ClusterTemplateConstraint{{Field: "rancherKubernetesEngineConfig.services.etcd.backupConfig.s3BackupConfig", Required: true, When: backupEnabled}},
+		},
+		{
+			name: "question limits",
+			questions: []v32.Question{
+				{Variable: "rancherKubernetesEngineConfig.network.plugin", Type: "enum", Options: []string{"canal", "calico"}},
+				{Variable: "rancherKubernetesEngineConfig.addonJobTimeout", Type: "int", Min: 30, Max: 300},
+			},
+			answers: map[string]string{
+				"rancherKubernetesEngineConfig.network.plugin":  "flannel",
+				"rancherKubernetesEngineConfig.addonJobTimeout": "10",
+			},
+			want: []string{
+				"rancherKubernetesEngineConfig.network.plugin: must be one of canal, calico",
+				"rancherKubernetesEngineConfig.addonJobTimeout: must be at least 30",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision := testRevision("v1.18.8-rancher1-1", "canal", tt.questions...)
+			revision.Spec.Constraints = tt.constraints
+			spec := revision.Spec.ClusterConfig.DeepCopy()
+			if tt.spec != nil {
+				tt.spec(spec)
+			}
+
+			violations, err := CheckConstraints(revision, spec, tt.answers)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var got []string
+			for _, violation := range violations {
+				got = append(got, violation.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateConstraints(t *testing.T) {
+	assert.NoError(t, ValidateConstraints([]v32.ClusterTemplateConstraint{{Field: "dockerRootDir", Pattern: "/var/.*", VersionRange: ">=1.17.0"}}))
+	assert.Error(t, ValidateConstraints([]v32.ClusterTemplateConstraint{{Field: "dockerRootDir", Pattern: "("}}))
+	assert.Error(t, ValidateConstraints([]v32.ClusterTemplateConstraint{{Field: k8sVersionField, VersionRange: "latest"}}))
+	assert.Error(t, ValidateConstraints([]v32.ClusterTemplateConstraint{{Field: "dockerRootDir", Min: int64Ptr(2), Max: int64Ptr(1)}}))
+	assert.Error(t, ValidateConstraints([]v32.ClusterTemplateConstraint{{Pattern: ".*"}}))
+}
@@ -0,0 +1,256 @@
+package clustertemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/rancher/norman/parse/builder"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+)
+
+const k8sVersionField = "rancherKubernetesEngineConfig.kubernetesVersion"
+
+// Drift compares the spec of a cluster with the clusterConfig of a revision. Fields the revision leaves empty are
+// not compared as they are defaulted when the cluster is created, fields answered through the questions of the
+// revision are reported but do not count as drift.
+func Drift(revision *v3.ClusterTemplateRevision, cluster *v3.Cluster) (v32.ClusterTemplateDrift, error) {
+	drift := v32.ClusterTemplateDrift{ClusterName: cluster.Name}
+	if revision.Spec.ClusterConfig == nil {
+		return drift, nil
+	}
+
+	expected, err := convert.EncodeToMap(revision.Spec.ClusterConfig)
+	if err != nil {
+		return drift, err
+	}
+	actual, err := convert.EncodeToMap(cluster.Spec.ClusterSpecBase)
+	if err != nil {
+		return drift, err
+	}
+
+	expectedLeaves := map[string]interface{}{}
+	flatten("", expected, expectedLeaves)
+	// the cluster keeps monitoring and alerting when a revision does not turn them on
+	if !revision.Spec.ClusterConfig.EnableClusterMonitoring {
+		delete(expectedLeaves, "enableClusterMonitoring")
+	}
+	if !revision.Spec.ClusterConfig.EnableClusterAlerting {
+		delete(expectedLeaves, "enableClusterAlerting")
+	}
+
+	answers := map[string]string{}
+	for _, question := range revision.Spec.Questions {
+		field := fieldPath(question.Variable)
+		if answer, ok := cluster.Spec.ClusterTemplateAnswers.Values[question.Variable]; ok {
+			answers[field] = answer
+		}
+		if _, ok := expectedLeaves[field]; !ok {
+			expectedLeaves[field] = question.Default
+		}
+	}
+
+	fields := make([]string, 0, len(expectedLeaves))
+	for field := range expectedLeaves {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		want := expectedLeaves[field]
+		if isEmpty(want) {
+			if _, answered := answers[field]; !answered {
+				continue
+			}
+		}
+		got := values.GetValueN(actual, strings.Split(field, ".")...)
+		if sameValue(field, want, got) {
+			continue
+		}
+
+		difference := v32.ClusterTemplateDifference{
+			Field:         field,
+			RevisionValue: toString(want),
+			ClusterValue:  toString(got),
+		}
+		if answer, ok := answers[field]; ok && sameValue(field, answer, got) {
+			difference.Answered = true
+		} else {
+			drift.Drifted = true
+		}
+		drift.Differences = append(drift.Differences, difference)
+	}
+	return drift, nil
+}
+
+// RevisionSpec is the spec a cluster gets from a revision with its answers, as the cluster store builds it when a
+// cluster is created or updated from a revision
+func RevisionSpec(revision *v3.ClusterTemplateRevision, cluster *v3.Cluster) (*v32.ClusterSpecBase, error) {
+	if revision.Spec.ClusterConfig == nil {
+		return nil, fmt.Errorf("clusterTemplateRevision %s has no clusterConfig", revision.Name)
+	}
+	data, err := convert.EncodeToMap(revision.Spec.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, question := range revision.Spec.Questions {
+		answer, ok := cluster.Spec.ClusterTemplateAnswers.Values[question.Variable]
+		if !ok {
+			if question.Required && question.Default == "" {
+				return nil, fmt.Errorf("missing answer for required question %s", question.Variable)
+			}
+			answer = question.Default
+		}
+		val, err := builder.ConvertSimple(question.Type, answer, builder.Create)
+		if err != nil {
+			return nil, fmt.Errorf("invalid answer for question %s: %v", question.Variable, err)
+		}
+		values.PutValue(data, val, strings.Split(fieldPath(question.Variable), ".")...)
+	}
+
+	spec := &v32.ClusterSpecBase{}
+	if err := convert.ToObj(data, spec); err != nil {
+		return nil, err
+	}
+	preserveClusterFields(cluster.Spec.ClusterSpecBase, spec)
+
+	if rke := spec.RancherKubernetesEngineConfig; rke != nil && strings.HasSuffix(rke.Version, ".x") {
+		// the cluster keeps the patch release it runs while it matches the requested minor version
+		if existing := cluster.Spec.RancherKubernetesEngineConfig; existing != nil && versionMatches(rke.Version, existing.Version) {
+			rke.Version = existing.Version
+		} else if rke.Version, err = supportedKubernetesVersion(rke.Version); err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
+// supportedKubernetesVersion picks the current Kubernetes version matching a v1.x.x request
+func supportedKubernetesVersion(requested string) (string, error) {
+	versionRange, err := semver.ParseRange("=" + requested)
+	if err != nil {
+		return "", fmt.Errorf("requested kubernetesVersion %v is not of valid semver [major.minor.patch] format", requested)
+	}
+	for _, v := range strings.Split(settings.KubernetesVersionsCurrent.Get(), ",") {
+		version, err := semver.ParseTolerant(strings.Split(v, "-rancher")[0])
+		if err != nil {
+			continue
+		}
+		if versionRange(version) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("requested kubernetesVersion %v is not supported currently", requested)
+}
+
+// preserveClusterFields keeps what the cluster store keeps from the existing cluster on update, the settings a
+// revision cannot turn off and the secrets it does not carry
+func preserveClusterFields(existing v32.ClusterSpecBase, spec *v32.ClusterSpecBase) {
+	if !spec.EnableClusterMonitoring {
+		spec.EnableClusterMonitoring = existing.EnableClusterMonitoring
+	}
+	if !spec.EnableClusterAlerting {
+		spec.EnableClusterAlerting = existing.EnableClusterAlerting
+	}
+
+	oldRKE, newRKE := existing.RancherKubernetesEngineConfig, spec.RancherKubernetesEngineConfig
+	if oldRKE == nil || newRKE == nil {
+		return
+	}
+	if newRKE.UpgradeStrategy == nil {
+		newRKE.UpgradeStrategy = oldRKE.UpgradeStrategy
+	}
+	if oldBackup, newBackup := oldRKE.Services.Etcd.BackupConfig, newRKE.Services.Etcd.BackupConfig; oldBackup != nil && newBackup != nil &&
+		oldBackup.S3BackupConfig != nil && newBackup.S3BackupConfig != nil && newBackup.S3BackupConfig.SecretKey == "" {
+		newBackup.S3BackupConfig.SecretKey = oldBackup.S3BackupConfig.SecretKey
+	}
+	if oldRKE.Network.WeaveNetworkProvider != nil && newRKE.Network.WeaveNetworkProvider != nil && newRKE.Network.WeaveNetworkProvider.Password == "" {
+		newRKE.Network.WeaveNetworkProvider.Password = oldRKE.Network.WeaveNetworkProvider.Password
+	}
+	for i, registry := range newRKE.PrivateRegistries {
+		if registry.Password != "" {
+			continue
+		}
+		for _, old := range oldRKE.PrivateRegistries {
+			if old.URL == registry.URL && old.User == registry.User {
+				newRKE.PrivateRegistries[i].Password = old.Password
+				break
+			}
+		}
+	}
+}
+
+// fieldPath turns a question variable into the path of the field in the cluster spec, the API names references
+// with an Id suffix while the spec uses Name
+func fieldPath(variable string) string {
+	parts := strings.SplitN(variable, ".", 2)
+	if strings.HasSuffix(parts[0], "Id") {
+		parts[0] = strings.TrimSuffix(parts[0], "Id") + "Name"
+	}
+	return strings.Join(parts, ".")
+}
+
+func flatten(prefix string, data map[string]interface{}, leaves map[string]interface{}) {
+	for k, v := range data {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flatten(path, nested, leaves)
+			continue
+		}
+		leaves[path] = v
+	}
+}
+
+func isEmpty(value interface{}) bool {
+	if value == nil || value == "" {
+		return true
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func sameValue(field string, want, got interface{}) bool {
+	if field == k8sVersionField {
+		return versionMatches(toString(want), toString(got))
+	}
+	return toString(want) == toString(got) || reflect.DeepEqual(want, got)
+}
+
+// versionMatches is true when version is the requested Kubernetes version or a patch release of a v1.x.x request
+func versionMatches(requested, version string) bool {
+	if requested == version {
+		return true
+	}
+	if !strings.HasSuffix(requested, ".x") {
+		return false
+	}
+	return strings.HasPrefix(version, strings.TrimSuffix(requested, "x"))
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
@@ -0,0 +1,207 @@
+package clustertemplate
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testRevision(version, plugin string, questions ...v32.Question) *v3.ClusterTemplateRevision {
+	return &v3.ClusterTemplateRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "ctr-2"},
+		Spec: v32.ClusterTemplateRevisionSpec{
+			ClusterTemplateName: "cattle-global-data:ct-1",
+			Questions:           questions,
+			ClusterConfig: &v32.ClusterSpecBase{
+				RancherKubernetesEngineConfig: &rketypes.RancherKubernetesEngineConfig{
+					Version: version,
+					Network: rketypes.NetworkConfig{Plugin: plugin},
+				},
+			},
+		},
+	}
+}
+
+func testCluster(version, plugin string, answers map[string]string) *v3.Cluster {
+	return &v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+		Spec: v32.ClusterSpec{
+			ClusterSpecBase: v32.ClusterSpecBase{
+				RancherKubernetesEngineConfig: &rketypes.RancherKubernetesEngineConfig{
+					Version: version,
+					Network: rketypes.NetworkConfig{Plugin: plugin},
+					UpgradeStrategy: &rketypes.NodeUpgradeStrategy{
+						MaxUnavailableWorker: "10%",
+					},
+					PrivateRegistries: []rketypes.PrivateRegistry{{URL: "registry.example.com", User: "admin", Password: "secret"}},
+				},
+				EnableClusterMonitoring: true,
+			},
+			ClusterTemplateName:         "cattle-global-data:ct-1",
+			ClusterTemplateRevisionName: "cattle-global-data:ctr-1",
+			ClusterTemplateAnswers:      v32.Answer{Values: answers},
+		},
+	}
+}
+
+func TestDrift(t *testing.T) {
+	versionQuestion := v32.Question{Variable: k8sVersionField, Type: "string", Default: "v1.18.x"}
+
+	tests := []struct {
+		name            string
+		revision        *v3.ClusterTemplateRevision
+		cluster         *v3.Cluster
+		wantDrifted     bool
+		wantDifferences []v32.ClusterTemplateDifference
+	}{
+		{
+			name:     "cluster matches the revision",
+			revision: testRevision("v1.18.x", "canal"),
+			cluster:  testCluster("v1.18.8-rancher1-1", "canal", nil),
+		},
+		{
+			name:        "edited field",
+			revision:    testRevision("v1.18.x", "canal"),
+			cluster:     testCluster("v1.18.8-rancher1-1", "flannel", nil),
+			wantDrifted: true,
+			wantDifferences: []v32.ClusterTemplateDifference{
+				{Field: "rancherKubernetesEngineConfig.network.plugin", RevisionValue: "canal", ClusterValue: "flannel"},
+			},
+		},
+		{
+			name:        "kubernetes version outside of the requested minor",
+			revision:    testRevision("v1.18.x", "canal"),
+			cluster:     testCluster("v1.17.11-rancher1-1", "canal", nil),
+			wantDrifted: true,
+			wantDifferences: []v32.ClusterTemplateDifference{
+				{Field: k8sVersionField, RevisionValue: "v1.18.x", ClusterValue: "v1.17.11-rancher1-1"},
+			},
+		},
+		{
+			name:     "answered question",
+			revision: testRevision("v1.18.x", "canal", versionQuestion),
+			cluster:  testCluster("v1.17.11-rancher1-1", "canal", map[string]string{k8sVersionField: "v1.17.x"}),
+			wantDifferences: []v32.ClusterTemplateDifference{
+				{Field: k8sVersionField, RevisionValue: "v1.18.x", ClusterValue: "v1.17.11-rancher1-1", Answered: true},
+			},
+		},
+		{
+			name:        "field differs from its answer",
+			revision:    testRevision("v1.18.x", "canal", versionQuestion),
+			cluster:     testCluster("v1.16.15-rancher1-1", "canal", map[string]string{k8sVersionField: "v1.17.x"}),
+			wantDrifted: true,
+			wantDifferences: []v32.ClusterTemplateDifference{
+				{Field: k8sVersionField, RevisionValue: "v1.18.x", ClusterValue: "v1.16.15-rancher1-1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift, err := Drift(tt.revision, tt.cluster)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "c-1", drift.ClusterName)
+			assert.Equal(t, tt.wantDrifted, drift.Drifted)
+			assert.Equal(t, tt.wantDifferences, drift.Differences)
+		})
+	}
+}
+
+func TestRevisionSpec(t *testing.T) {
+	revision := testRevision("v1.18.x", "canal",
+		v32.Question{Variable: "rancherKubernetesEngineConfig.network.plugin", Type: "string", Default: "canal"},
+		v32.Question{Variable: "enableNetworkPolicy", Type: "boolean", Default: "false"},
+	)
+	revision.Spec.ClusterConfig.RancherKubernetesEngineConfig.PrivateRegistries = []rketypes.PrivateRegistry{{URL: "registry.example.com", User: "admin"}}
+	cluster := testCluster("v1.18.8-rancher1-1", "canal", map[string]string{
+		"rancherKubernetesEngineConfig.network.plugin": "flannel",
+		"enableNetworkPolicy":                          "true",
+	})
+
+	spec, err := RevisionSpec(revision, cluster)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rke := spec.RancherKubernetesEngineConfig
+	assert.Equal(t, "flannel", rke.Network.Plugin)
+	if assert.NotNil(t, spec.EnableNetworkPolicy) {
+		assert.True(t, *spec.EnableNetworkPolicy)
+	}
+	assert.Equal(t, "v1.18.8-rancher1-1", rke.Version, "the patch release of the cluster is kept")
+	assert.Equal(t, "secret", rke.PrivateRegistries[0].Password)
+	assert.Equal(t, cluster.Spec.RancherKubernetesEngineConfig.UpgradeStrategy, rke.UpgradeStrategy)
+	assert.True(t, spec.EnableClusterMonitoring)
+
+	revision.Spec.Questions = append(revision.Spec.Questions, v32.Question{Variable: "dockerRootDir", Type: "string", Required: true})
+	_, err = RevisionSpec(revision, cluster)
+	assert.Error(t, err)
+}
+
+func testRollout(batchSize, maxFailures int, states ...string) *v3.ClusterTemplateRollout {
+	rollout := &v3.ClusterTemplateRollout{
+		Spec: v32.ClusterTemplateRolloutSpec{BatchSize: batchSize, MaxFailures: maxFailures},
+	}
+	for i, state := range states {
+		rollout.Status.Clusters = append(rollout.Status.Clusters, v32.ClusterTemplateRolloutClusterStatus{
+			ClusterName: "c-" + string(rune('a'+i)),
+			State:       state,
+		})
+	}
+	return rollout
+}
+
+func TestRolloutBatches(t *testing.T) {
+	pending, updating, updated, failed := v32.ClusterTemplateRolloutClusterPending, v32.ClusterTemplateRolloutClusterUpdating,
+		v32.ClusterTemplateRolloutClusterUpdated, v32.ClusterTemplateRolloutClusterFailed
+
+	tests := []struct {
+		name      string
+		rollout   *v3.ClusterTemplateRollout
+		wantBatch []string
+		wantPhase v32.ClusterTemplateRolloutPhase
+	}{
+		{
+			name:      "first batch",
+			rollout:   testRollout(2, 0, pending, pending, pending),
+			wantBatch: []string{"c-a", "c-b"},
+			wantPhase: v32.ClusterTemplateRolloutPhaseInProgress,
+		},
+		{
+			name:      "wait for the batch to finish",
+			rollout:   testRollout(2, 0, updated, updating, pending),
+			wantPhase: v32.ClusterTemplateRolloutPhaseInProgress,
+		},
+		{
+			name:      "next batch",
+			rollout:   testRollout(2, 1, updated, failed, pending),
+			wantBatch: []string{"c-c"},
+			wantPhase: v32.ClusterTemplateRolloutPhaseInProgress,
+		},
+		{
+			name:      "too many failures",
+			rollout:   testRollout(2, 0, updated, failed, pending),
+			wantPhase: v32.ClusterTemplateRolloutPhaseFailed,
+		},
+		{
+			name:      "completed",
+			rollout:   testRollout(1, 1, updated, failed, v32.ClusterTemplateRolloutClusterSkipped),
+			wantPhase: v32.ClusterTemplateRolloutPhaseCompleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batch []string
+			for _, status := range nextBatch(tt.rollout) {
+				batch = append(batch, status.ClusterName)
+			}
+			assert.Equal(t, tt.wantBatch, batch)
+			phase, _ := rolloutPhase(tt.rollout)
+			assert.Equal(t, tt.wantPhase, phase)
+		})
+	}
+}
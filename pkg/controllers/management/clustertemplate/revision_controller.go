@@ -42,6 +42,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		management.Management.ClusterTemplateRevisions("").AddHandler(ctx, RevisionController, n.sync)
 	}
 	registerRbacControllers(ctx, management)
+	registerRolloutController(ctx, management)
 }
 
 //sync is called periodically and on real updates
@@ -0,0 +1,265 @@
+package clustertemplate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	RolloutController = "mgmt-cluster-template-rollout-controller"
+	rolloutInterval   = 15 * time.Second
+)
+
+type rolloutController struct {
+	rollouts       v3.ClusterTemplateRolloutInterface
+	revisionLister v3.ClusterTemplateRevisionLister
+	clusters       v3.ClusterInterface
+	clusterLister  v3.ClusterLister
+}
+
+func registerRolloutController(ctx context.Context, management *config.ManagementContext) {
+	r := &rolloutController{
+		rollouts:       management.Management.ClusterTemplateRollouts(""),
+		revisionLister: management.Management.ClusterTemplateRevisions("").Controller().Lister(),
+		clusters:       management.Management.Clusters(""),
+		clusterLister:  management.Management.Clusters("").Controller().Lister(),
+	}
+	r.rollouts.AddHandler(ctx, RolloutController, r.sync)
+}
+
+func (r *rolloutController) sync(key string, obj *v3.ClusterTemplateRollout) (runtime.Object, error) {
+	if obj == nil || obj.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	switch obj.Status.Phase {
+	case v32.ClusterTemplateRolloutPhaseCompleted, v32.ClusterTemplateRolloutPhaseFailed:
+		return obj, nil
+	}
+
+	rollout := obj.DeepCopy()
+	err := r.progress(rollout)
+	if !reflect.DeepEqual(obj.Status, rollout.Status) {
+		if _, updateErr := r.rollouts.Update(rollout); updateErr != nil {
+			return obj, updateErr
+		}
+	}
+	if err != nil {
+		return obj, err
+	}
+
+	if rollout.Status.Phase == v32.ClusterTemplateRolloutPhaseInProgress {
+		r.rollouts.Controller().EnqueueAfter(rollout.Namespace, rollout.Name, rolloutInterval)
+	}
+	return obj, nil
+}
+
+// progress checks the clusters being updated and starts the next batch once they are all done
+func (r *rolloutController) progress(rollout *v3.ClusterTemplateRollout) error {
+	if len(rollout.Status.Clusters) == 0 {
+		for _, clusterName := range rollout.Spec.ClusterNames {
+			rollout.Status.Clusters = append(rollout.Status.Clusters, v32.ClusterTemplateRolloutClusterStatus{
+				ClusterName: clusterName,
+				State:       v32.ClusterTemplateRolloutClusterPending,
+			})
+		}
+	}
+
+	_, revisionName := ref.Parse(rollout.Spec.ClusterTemplateRevisionName)
+	revision, err := r.revisionLister.Get(namespace.GlobalNamespace, revisionName)
+	if apierrors.IsNotFound(err) {
+		// the revision is not coming back, the rollout would otherwise be retried forever
+		setRolloutPhase(rollout, v32.ClusterTemplateRolloutPhaseFailed, fmt.Sprintf("clusterTemplateRevision %s was not found", rollout.Spec.ClusterTemplateRevisionName))
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for i := range rollout.Status.Clusters {
+		status := &rollout.Status.Clusters[i]
+		if status.State != v32.ClusterTemplateRolloutClusterUpdating {
+			continue
+		}
+		cluster, err := r.clusterLister.Get("", status.ClusterName)
+		if apierrors.IsNotFound(err) {
+			finishCluster(status, v32.ClusterTemplateRolloutClusterFailed, "cluster was removed")
+			continue
+		} else if err != nil {
+			return err
+		}
+		state, message := clusterUpdateState(cluster, rollout.Spec.ClusterTemplateRevisionName)
+		if state != v32.ClusterTemplateRolloutClusterUpdating {
+			finishCluster(status, state, message)
+		}
+	}
+
+	if rollout.Spec.Paused {
+		setRolloutPhase(rollout, v32.ClusterTemplateRolloutPhasePaused, "")
+		return nil
+	}
+
+	for _, cluster := range nextBatch(rollout) {
+		if err := r.startCluster(rollout, revision, cluster); err != nil {
+			return err
+		}
+	}
+
+	phase, message := rolloutPhase(rollout)
+	setRolloutPhase(rollout, phase, message)
+	return nil
+}
+
+// startCluster moves a cluster to the revision of the rollout
+func (r *rolloutController) startCluster(rollout *v3.ClusterTemplateRollout, revision *v3.ClusterTemplateRevision, status *v32.ClusterTemplateRolloutClusterStatus) error {
+	cluster, err := r.clusterLister.Get("", status.ClusterName)
+	if apierrors.IsNotFound(err) {
+		finishCluster(status, v32.ClusterTemplateRolloutClusterFailed, "cluster was not found")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	switch {
+	case cluster.Spec.ClusterTemplateName != revision.Spec.ClusterTemplateName:
+		finishCluster(status, v32.ClusterTemplateRolloutClusterSkipped, "cluster is not created from the cluster template of the revision")
+		return nil
+	case cluster.Spec.ClusterTemplateRevisionName == rollout.Spec.ClusterTemplateRevisionName:
+		finishCluster(status, v32.ClusterTemplateRolloutClusterSkipped, "cluster already uses the revision")
+		return nil
+	}
+
+	spec, err := RevisionSpec(revision, cluster)
+	if err != nil {
+		finishCluster(status, v32.ClusterTemplateRolloutClusterFailed, err.Error())
+		return nil
+	}
+	violations, err := CheckConstraints(revision, spec, cluster.Spec.ClusterTemplateAnswers.Values)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		finishCluster(status, v32.ClusterTemplateRolloutClusterFailed, fmt.Sprintf("cluster does not meet the constraints of the revision: %s", violations[0]))
+		return nil
+	}
+
+	clusterCopy := cluster.DeepCopy()
+	clusterCopy.Spec.ClusterSpecBase = *spec
+	clusterCopy.Spec.ClusterTemplateRevisionName = rollout.Spec.ClusterTemplateRevisionName
+	clusterCopy.Spec.ClusterTemplateQuestions = revision.Spec.Questions
+	if _, err := r.clusters.Update(clusterCopy); err != nil {
+		if apierrors.IsConflict(err) {
+			return err
+		}
+		finishCluster(status, v32.ClusterTemplateRolloutClusterFailed, err.Error())
+		return nil
+	}
+
+	logrus.Infof("[%s] moving cluster [%s] from clusterTemplateRevision [%s] to [%s]", RolloutController, cluster.Name,
+		cluster.Spec.ClusterTemplateRevisionName, rollout.Spec.ClusterTemplateRevisionName)
+	status.State = v32.ClusterTemplateRolloutClusterUpdating
+	status.PreviousRevisionName = cluster.Spec.ClusterTemplateRevisionName
+	status.StartedAt = time.Now().UTC().Format(time.RFC3339)
+	status.Message = ""
+	return nil
+}
+
+// clusterUpdateState is the state of a cluster that was moved to revisionName
+func clusterUpdateState(cluster *v3.Cluster, revisionName string) (string, string) {
+	if cluster.Spec.ClusterTemplateRevisionName != revisionName {
+		return v32.ClusterTemplateRolloutClusterFailed, fmt.Sprintf("cluster was moved to clusterTemplateRevision %s", cluster.Spec.ClusterTemplateRevisionName)
+	}
+	if v32.ClusterConditionUpdated.IsFalse(cluster) {
+		return v32.ClusterTemplateRolloutClusterFailed, v32.ClusterConditionUpdated.GetMessage(cluster)
+	}
+	if cluster.Status.AppliedSpec.ClusterTemplateRevisionName == revisionName && v32.ClusterConditionReady.IsTrue(cluster) {
+		return v32.ClusterTemplateRolloutClusterUpdated, ""
+	}
+	return v32.ClusterTemplateRolloutClusterUpdating, ""
+}
+
+// nextBatch returns the pending clusters to start, none while clusters of the previous batch are still updating or
+// once more clusters failed than the rollout allows
+func nextBatch(rollout *v3.ClusterTemplateRollout) []*v32.ClusterTemplateRolloutClusterStatus {
+	batchSize := rollout.Spec.BatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	var pending []*v32.ClusterTemplateRolloutClusterStatus
+	failed := 0
+	for i := range rollout.Status.Clusters {
+		switch rollout.Status.Clusters[i].State {
+		case v32.ClusterTemplateRolloutClusterUpdating:
+			return nil
+		case v32.ClusterTemplateRolloutClusterFailed:
+			failed++
+		case v32.ClusterTemplateRolloutClusterPending:
+			pending = append(pending, &rollout.Status.Clusters[i])
+		}
+	}
+	if failed > rollout.Spec.MaxFailures {
+		return nil
+	}
+	if len(pending) > batchSize {
+		pending = pending[:batchSize]
+	}
+	return pending
+}
+
+// rolloutPhase counts the clusters of a rollout and decides whether it is done
+func rolloutPhase(rollout *v3.ClusterTemplateRollout) (v32.ClusterTemplateRolloutPhase, string) {
+	rollout.Status.Updated, rollout.Status.Failed = 0, 0
+	unfinished := 0
+	for _, status := range rollout.Status.Clusters {
+		switch status.State {
+		case v32.ClusterTemplateRolloutClusterUpdated:
+			rollout.Status.Updated++
+		case v32.ClusterTemplateRolloutClusterFailed:
+			rollout.Status.Failed++
+		case v32.ClusterTemplateRolloutClusterPending, v32.ClusterTemplateRolloutClusterUpdating:
+			unfinished++
+		}
+	}
+
+	if rollout.Status.Failed > rollout.Spec.MaxFailures {
+		for _, status := range rollout.Status.Clusters {
+			if status.State == v32.ClusterTemplateRolloutClusterUpdating {
+				return v32.ClusterTemplateRolloutPhaseInProgress, ""
+			}
+		}
+		return v32.ClusterTemplateRolloutPhaseFailed, fmt.Sprintf("%d clusters failed to update, more than the %d allowed", rollout.Status.Failed, rollout.Spec.MaxFailures)
+	}
+	if unfinished > 0 {
+		return v32.ClusterTemplateRolloutPhaseInProgress, ""
+	}
+	return v32.ClusterTemplateRolloutPhaseCompleted, fmt.Sprintf("%d clusters updated, %d failed", rollout.Status.Updated, rollout.Status.Failed)
+}
+
+func setRolloutPhase(rollout *v3.ClusterTemplateRollout, phase v32.ClusterTemplateRolloutPhase, message string) {
+	rollout.Status.Phase = phase
+	switch phase {
+	case v32.ClusterTemplateRolloutPhaseCompleted:
+		v32.ClusterTemplateRolloutConditionCompleted.True(rollout)
+	case v32.ClusterTemplateRolloutPhaseFailed:
+		v32.ClusterTemplateRolloutConditionCompleted.False(rollout)
+	default:
+		v32.ClusterTemplateRolloutConditionCompleted.Unknown(rollout)
+	}
+	v32.ClusterTemplateRolloutConditionCompleted.Message(rollout, message)
+}
+
+func finishCluster(status *v32.ClusterTemplateRolloutClusterStatus, state, message string) {
+	status.State = state
+	status.Message = message
+	status.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,55 @@
+package clustertemplate
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRolloutFailsWithoutRevision(t *testing.T) {
+	var updated []*v3.ClusterTemplateRollout
+	var requeued int
+	r := &rolloutController{
+		rollouts: &fakes.ClusterTemplateRolloutInterfaceMock{
+			UpdateFunc: func(rollout *v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error) {
+				updated = append(updated, rollout)
+				return rollout, nil
+			},
+			ControllerFunc: func() v3.ClusterTemplateRolloutController {
+				return &fakes.ClusterTemplateRolloutControllerMock{
+					EnqueueAfterFunc: func(namespace, name string, after time.Duration) {
+						requeued++
+					},
+				}
+			},
+		},
+		revisionLister: &fakes.ClusterTemplateRevisionListerMock{
+			GetFunc: func(namespace, name string) (*v3.ClusterTemplateRevision, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "clustertemplaterevisions"}, name)
+			},
+		},
+	}
+	rollout := &v3.ClusterTemplateRollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollout", Namespace: "cattle-global-data"},
+		Spec: v32.ClusterTemplateRolloutSpec{
+			ClusterTemplateRevisionName: "cattle-global-data:ctr-2",
+			ClusterNames:                []string{"c-1"},
+		},
+	}
+
+	_, err := r.sync("cattle-global-data/rollout", rollout)
+	assert.NoError(t, err)
+	if assert.Len(t, updated, 1) {
+		assert.Equal(t, v32.ClusterTemplateRolloutPhaseFailed, updated[0].Status.Phase)
+		assert.True(t, v32.ClusterTemplateRolloutConditionCompleted.IsFalse(updated[0]))
+		assert.Equal(t, "clusterTemplateRevision cattle-global-data:ctr-2 was not found", v32.ClusterTemplateRolloutConditionCompleted.GetMessage(updated[0]))
+	}
+	assert.Equal(t, 0, requeued, "a failed rollout is not checked again")
+}
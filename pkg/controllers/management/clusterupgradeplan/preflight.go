@@ -0,0 +1,215 @@
+package clusterupgradeplan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/prometheus/common/expfmt"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// deprecatedAPIMetric is exposed by kube-apiserver 1.19+ for every deprecated API that received a request
+const deprecatedAPIMetric = "apiserver_requested_deprecated_apis"
+
+// checkDeprecatedAPIs reports the deprecated APIs the downstream cluster still serves requests for and
+// that are removed in the target version.
+func checkDeprecatedAPIs(ctx context.Context, client kubernetes.Interface, targetVersion string) v32.UpgradePreflightResult {
+	result := v32.UpgradePreflightResult{Name: v32.PreflightCheckDeprecatedAPIs}
+	metrics, err := client.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to read apiserver metrics: %v", err)
+		return result
+	}
+	inUse, err := deprecatedAPIsInUse(bytes.NewReader(metrics), targetVersion)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+	if len(inUse) > 0 {
+		result.Message = fmt.Sprintf("APIs removed in %s are still in use: %s", targetVersion, strings.Join(inUse, ", "))
+		return result
+	}
+	result.Passed = true
+	return result
+}
+
+// deprecatedAPIsInUse parses the apiserver metrics and returns the group/version/resource of every
+// requested API whose removed_release is at or below the target version.
+func deprecatedAPIsInUse(metrics io.Reader, targetVersion string) ([]string, error) {
+	target, err := parseKubernetesVersion(targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse apiserver metrics: %v", err)
+	}
+	family, ok := families[deprecatedAPIMetric]
+	if !ok {
+		return nil, nil
+	}
+
+	var inUse []string
+	for _, m := range family.GetMetric() {
+		if m.GetGauge().GetValue() == 0 {
+			continue
+		}
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		if labels["removed_release"] == "" {
+			continue
+		}
+		removed, err := parseKubernetesVersion(labels["removed_release"])
+		if err != nil {
+			continue
+		}
+		if removed.Major != target.Major || removed.Minor > target.Minor {
+			continue
+		}
+		gv := labels["version"]
+		if labels["group"] != "" {
+			gv = labels["group"] + "/" + gv
+		}
+		inUse = append(inUse, fmt.Sprintf("%s %s", gv, labels["resource"]))
+	}
+	sort.Strings(inUse)
+	return inUse, nil
+}
+
+// checkPodDisruptionBudgets fails if a node drain would be blocked by a PodDisruptionBudget that allows no disruption.
+func checkPodDisruptionBudgets(ctx context.Context, client kubernetes.Interface, strategy *rketypes.NodeUpgradeStrategy) v32.UpgradePreflightResult {
+	result := v32.UpgradePreflightResult{Name: v32.PreflightCheckPodDisruptionBudgets}
+	pdbs, err := client.PolicyV1beta1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to list pod disruption budgets: %v", err)
+		return result
+	}
+	blocking := blockingPodDisruptionBudgets(pdbs.Items)
+	if len(blocking) == 0 {
+		result.Passed = true
+		return result
+	}
+	if strategy == nil || !strategy.Drain {
+		result.Passed = true
+		result.Message = fmt.Sprintf("drain is disabled, ignoring pod disruption budgets that allow no disruption: %s", strings.Join(blocking, ", "))
+		return result
+	}
+	result.Message = fmt.Sprintf("pod disruption budgets allow no disruption and would block node drain: %s", strings.Join(blocking, ", "))
+	return result
+}
+
+func blockingPodDisruptionBudgets(pdbs []policyv1beta1.PodDisruptionBudget) []string {
+	var blocking []string
+	for _, pdb := range pdbs {
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed < 1 {
+			blocking = append(blocking, pdb.Namespace+"/"+pdb.Name)
+		}
+	}
+	sort.Strings(blocking)
+	return blocking
+}
+
+// checkNodePressure fails if any node of the cluster is not ready or reports resource pressure.
+func checkNodePressure(nodes []*v3.Node) v32.UpgradePreflightResult {
+	result := v32.UpgradePreflightResult{Name: v32.PreflightCheckNodePressure}
+	unhealthy := unhealthyNodes(nodes)
+	if len(unhealthy) > 0 {
+		result.Message = fmt.Sprintf("nodes are not ready or under pressure: %s", strings.Join(unhealthy, ", "))
+		return result
+	}
+	result.Passed = true
+	return result
+}
+
+func unhealthyNodes(nodes []*v3.Node) []string {
+	var unhealthy []string
+	for _, node := range nodes {
+		if node.DeletionTimestamp != nil {
+			continue
+		}
+		if reasons := nodeProblems(node); len(reasons) > 0 {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", nodeName(node), strings.Join(reasons, ", ")))
+		}
+	}
+	sort.Strings(unhealthy)
+	return unhealthy
+}
+
+func nodeProblems(node *v3.Node) []string {
+	var problems []string
+	ready := false
+	for _, cond := range node.Status.InternalNodeStatus.Conditions {
+		switch cond.Type {
+		case v1.NodeReady:
+			ready = cond.Status == v1.ConditionTrue
+		case v1.NodeMemoryPressure, v1.NodeDiskPressure, v1.NodePIDPressure:
+			if cond.Status == v1.ConditionTrue {
+				problems = append(problems, string(cond.Type))
+			}
+		}
+	}
+	if !ready {
+		problems = append([]string{"NotReady"}, problems...)
+	}
+	return problems
+}
+
+// recentBackup returns the newest completed backup taken within maxAge.
+func recentBackup(backups []*v3.EtcdBackup, maxAge time.Duration, now time.Time) *v3.EtcdBackup {
+	var newest *v3.EtcdBackup
+	var newestTime time.Time
+	for _, backup := range backups {
+		if !rketypes.BackupConditionCompleted.IsTrue(backup) {
+			continue
+		}
+		completed, err := time.Parse(time.RFC3339, rketypes.BackupConditionCompleted.GetLastUpdated(backup))
+		if err != nil || now.Sub(completed) > maxAge {
+			continue
+		}
+		if newest == nil || completed.After(newestTime) {
+			newest = backup
+			newestTime = completed
+		}
+	}
+	return newest
+}
+
+// parseKubernetesVersion accepts RKE versions such as v1.19.3-rancher1-1 as well as release strings such as 1.22.
+func parseKubernetesVersion(version string) (semver.Version, error) {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("invalid kubernetes version %s: %v", version, err)
+	}
+	return v, nil
+}
+
+// kubeletVersionMatches reports if a kubelet version such as v1.19.3 belongs to the RKE version v1.19.3-rancher1-1.
+func kubeletVersionMatches(kubeletVersion, rkeVersion string) bool {
+	if kubeletVersion == "" {
+		return false
+	}
+	return kubeletVersion == rkeVersion || strings.HasPrefix(rkeVersion, kubeletVersion+"-")
+}
+
+func nodeName(node *v3.Node) string {
+	if node.Status.NodeName != "" {
+		return node.Status.NodeName
+	}
+	return node.Name
+}
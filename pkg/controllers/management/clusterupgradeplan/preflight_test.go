@@ -0,0 +1,145 @@
+package clusterupgradeplan
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const deprecatedAPIMetrics = `# HELP apiserver_requested_deprecated_apis [ALPHA] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="extensions",removed_release="1.22",resource="ingresses",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="rbac.authorization.k8s.io",removed_release="1.22",resource="roles",subresource="",version="v1beta1"} 0
+`
+
+func TestDeprecatedAPIsInUse(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		metrics string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "no api removed in target",
+			target:  "v1.21.5-rancher1-1",
+			metrics: deprecatedAPIMetrics,
+		},
+		{
+			name:    "api removed in target",
+			target:  "v1.22.2-rancher1-1",
+			metrics: deprecatedAPIMetrics,
+			want:    []string{"extensions/v1beta1 ingresses"},
+		},
+		{
+			name:    "apis removed before target",
+			target:  "v1.25.0-rancher1-1",
+			metrics: deprecatedAPIMetrics,
+			want:    []string{"extensions/v1beta1 ingresses", "policy/v1beta1 podsecuritypolicies"},
+		},
+		{
+			name:    "metric not exposed",
+			target:  "v1.22.2-rancher1-1",
+			metrics: "# TYPE up gauge\nup 1\n",
+		},
+		{
+			name:    "invalid target",
+			target:  "latest",
+			metrics: deprecatedAPIMetrics,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deprecatedAPIsInUse(strings.NewReader(tt.metrics), tt.target)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBlockingPodDisruptionBudgets(t *testing.T) {
+	pdb := func(name string, expected, allowed int32) policyv1beta1.PodDisruptionBudget {
+		return policyv1beta1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Status:     policyv1beta1.PodDisruptionBudgetStatus{ExpectedPods: expected, DisruptionsAllowed: allowed},
+		}
+	}
+	got := blockingPodDisruptionBudgets([]policyv1beta1.PodDisruptionBudget{
+		pdb("web", 3, 1),
+		pdb("db", 1, 0),
+		pdb("empty", 0, 0),
+		pdb("cache", 2, 0),
+	})
+	assert.Equal(t, []string{"default/cache", "default/db"}, got)
+}
+
+func TestUnhealthyNodes(t *testing.T) {
+	node := func(name string, conditions ...v1.NodeCondition) *v3.Node {
+		return &v3.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v32.NodeStatus{
+				NodeName:           name,
+				InternalNodeStatus: v1.NodeStatus{Conditions: conditions},
+			},
+		}
+	}
+	ready := v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionTrue}
+	notReady := v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionFalse}
+	diskPressure := v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue}
+	noMemoryPressure := v1.NodeCondition{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse}
+
+	got := unhealthyNodes([]*v3.Node{
+		node("healthy", ready, noMemoryPressure),
+		node("full", ready, diskPressure),
+		node("down", notReady, diskPressure),
+		node("unknown"),
+	})
+	assert.Equal(t, []string{"down (NotReady, DiskPressure)", "full (DiskPressure)", "unknown (NotReady)"}, got)
+}
+
+func TestRecentBackup(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	backup := func(name string, age time.Duration, completed bool) *v3.EtcdBackup {
+		b := &v3.EtcdBackup{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if completed {
+			b.Status.Conditions = []rketypes.EtcdBackupCondition{{
+				Type:           string(rketypes.BackupConditionCompleted),
+				Status:         v1.ConditionTrue,
+				LastUpdateTime: now.Add(-age).Format(time.RFC3339),
+			}}
+		}
+		return b
+	}
+
+	backups := []*v3.EtcdBackup{
+		backup("old", 2*time.Hour, true),
+		backup("recent", 30*time.Minute, true),
+		backup("newest", 10*time.Minute, true),
+		backup("running", 0, false),
+	}
+	assert.Equal(t, "newest", recentBackup(backups, time.Hour, now).Name)
+	assert.Nil(t, recentBackup(backups, 5*time.Minute, now))
+}
+
+func TestKubeletVersionMatches(t *testing.T) {
+	assert.True(t, kubeletVersionMatches("v1.19.3", "v1.19.3-rancher1-1"))
+	assert.True(t, kubeletVersionMatches("v1.19.3", "v1.19.3"))
+	assert.False(t, kubeletVersionMatches("v1.19.3", "v1.19.30-rancher1-1"))
+	assert.False(t, kubeletVersionMatches("v1.18.10", "v1.19.3-rancher1-1"))
+	assert.False(t, kubeletVersionMatches("", "v1.19.3-rancher1-1"))
+}
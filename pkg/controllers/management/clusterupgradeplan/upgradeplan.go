@@ -0,0 +1,592 @@
+package clusterupgradeplan
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	preflightRetryInterval = time.Minute
+	progressCheckInterval  = 30 * time.Second
+	snapshotCheckInterval  = 15 * time.Second
+)
+
+type controller struct {
+	ctx            context.Context
+	plans          v3.ClusterUpgradePlanInterface
+	planLister     v3.ClusterUpgradePlanLister
+	clusters       v3.ClusterInterface
+	clusterLister  v3.ClusterLister
+	nodeLister     v3.NodeLister
+	backups        v3.EtcdBackupInterface
+	backupLister   v3.EtcdBackupLister
+	clusterManager *clustermanager.Manager
+}
+
+func Register(ctx context.Context, management *config.ManagementContext, manager *clustermanager.Manager) {
+	c := &controller{
+		ctx:            ctx,
+		plans:          management.Management.ClusterUpgradePlans(""),
+		planLister:     management.Management.ClusterUpgradePlans("").Controller().Lister(),
+		clusters:       management.Management.Clusters(""),
+		clusterLister:  management.Management.Clusters("").Controller().Lister(),
+		nodeLister:     management.Management.Nodes("").Controller().Lister(),
+		backups:        management.Management.EtcdBackups(""),
+		backupLister:   management.Management.EtcdBackups("").Controller().Lister(),
+		clusterManager: manager,
+	}
+
+	c.plans.AddHandler(ctx, "cluster-upgrade-plan-controller", c.sync)
+	c.clusters.AddHandler(ctx, "cluster-upgrade-plan-cluster-watcher", c.clusterChanged)
+}
+
+// clusterChanged re-evaluates the active upgrade plans of a cluster as soon as its provisioning state changes
+func (c *controller) clusterChanged(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		return cluster, nil
+	}
+	plans, err := c.planLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return cluster, err
+	}
+	for _, plan := range plans {
+		if !isFinished(plan) {
+			c.plans.Controller().Enqueue(plan.Namespace, plan.Name)
+		}
+	}
+	return cluster, nil
+}
+
+func (c *controller) sync(key string, plan *v3.ClusterUpgradePlan) (runtime.Object, error) {
+	if plan == nil || plan.DeletionTimestamp != nil || isFinished(plan) {
+		return plan, nil
+	}
+
+	planCopy := plan.DeepCopy()
+	requeue, syncErr := c.reconcile(planCopy)
+
+	if !reflect.DeepEqual(plan.Spec, planCopy.Spec) || !reflect.DeepEqual(plan.Status, planCopy.Status) {
+		updated, err := c.plans.Update(planCopy)
+		if err != nil {
+			return plan, err
+		}
+		plan = updated
+	}
+	if syncErr != nil {
+		return plan, syncErr
+	}
+	if requeue > 0 {
+		c.plans.Controller().EnqueueAfter(plan.Namespace, plan.Name, requeue)
+	}
+	return plan, nil
+}
+
+func (c *controller) reconcile(plan *v3.ClusterUpgradePlan) (time.Duration, error) {
+	cluster, err := c.clusterLister.Get("", plan.Spec.ClusterName)
+	if apierrors.IsNotFound(err) {
+		fail(plan, fmt.Sprintf("cluster %s not found", plan.Spec.ClusterName))
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	if plan.Spec.Rollback {
+		return c.rollback(plan, cluster)
+	}
+
+	switch plan.Status.Phase {
+	case "", v32.ClusterUpgradePhasePreflight:
+		return c.preflight(plan, cluster)
+	case v32.ClusterUpgradePhaseControlPlane:
+		return c.upgradeControlPlane(plan, cluster)
+	case v32.ClusterUpgradePhaseWorkers:
+		return c.upgradeWorkers(plan, cluster)
+	case v32.ClusterUpgradePhasePaused:
+		return c.paused(plan, cluster)
+	case v32.ClusterUpgradePhaseRollingBack:
+		return c.rollingBack(plan, cluster)
+	}
+	return 0, nil
+}
+
+func (c *controller) preflight(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	plan.Status.Phase = v32.ClusterUpgradePhasePreflight
+
+	rkeConfig := cluster.Spec.RancherKubernetesEngineConfig
+	if rkeConfig == nil {
+		fail(plan, "upgrade plans are only supported for RKE clusters")
+		return 0, nil
+	}
+	if rkeConfig.Version == plan.Spec.KubernetesVersion {
+		fail(plan, fmt.Sprintf("cluster already runs kubernetes version %s", plan.Spec.KubernetesVersion))
+		return 0, nil
+	}
+	if _, err := parseKubernetesVersion(plan.Spec.KubernetesVersion); err != nil {
+		fail(plan, err.Error())
+		return 0, nil
+	}
+	active, err := c.otherActivePlan(plan)
+	if err != nil {
+		return 0, err
+	}
+	if active != "" {
+		fail(plan, fmt.Sprintf("cluster is already being upgraded by plan %s", active))
+		return 0, nil
+	}
+	if !v32.ClusterConditionReady.IsTrue(cluster) {
+		v32.ClusterUpgradePlanConditionPreflightPassed.False(plan)
+		v32.ClusterUpgradePlanConditionPreflightPassed.Message(plan, "waiting for cluster to become ready")
+		return preflightRetryInterval, nil
+	}
+
+	results, err := c.runPreflightChecks(plan, cluster)
+	if err != nil {
+		return 0, err
+	}
+	backupResult, snapshotPending, err := c.ensureSnapshot(plan, cluster)
+	if err != nil {
+		return 0, err
+	}
+	plan.Status.PreflightResults = append(results, backupResult)
+
+	var failed []string
+	for _, result := range plan.Status.PreflightResults {
+		if !result.Passed {
+			failed = append(failed, result.Name)
+		}
+	}
+	if len(failed) > 0 {
+		v32.ClusterUpgradePlanConditionPreflightPassed.False(plan)
+		v32.ClusterUpgradePlanConditionPreflightPassed.Message(plan, fmt.Sprintf("failed checks: %s", strings.Join(failed, ", ")))
+		if snapshotPending {
+			return snapshotCheckInterval, nil
+		}
+		return preflightRetryInterval, nil
+	}
+	v32.ClusterUpgradePlanConditionPreflightPassed.True(plan)
+	v32.ClusterUpgradePlanConditionPreflightPassed.Message(plan, "")
+
+	return c.startUpgrade(plan, cluster)
+}
+
+func (c *controller) runPreflightChecks(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) ([]v32.UpgradePreflightResult, error) {
+	var results []v32.UpgradePreflightResult
+	checks := plan.Spec.PreflightChecks
+
+	if !checks.SkipNodePressure {
+		nodes, err := c.nodeLister.List(cluster.Name, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, checkNodePressure(nodes))
+	}
+
+	if checks.SkipDeprecatedAPIs && checks.SkipPodDisruptionBudgets {
+		return results, nil
+	}
+
+	userContext, err := c.clusterManager.UserContext(cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !checks.SkipDeprecatedAPIs {
+		results = append(results, checkDeprecatedAPIs(c.ctx, userContext.K8sClient, plan.Spec.KubernetesVersion))
+	}
+	if !checks.SkipPodDisruptionBudgets {
+		strategy := plan.Spec.UpgradeStrategy
+		if strategy == nil {
+			strategy = cluster.Spec.RancherKubernetesEngineConfig.UpgradeStrategy
+		}
+		results = append(results, checkPodDisruptionBudgets(c.ctx, userContext.K8sClient, strategy))
+	}
+	return results, nil
+}
+
+// ensureSnapshot selects a recent etcd backup as the rollback point of the upgrade, or takes a new one.
+// The returned bool is true while a backup taken by the plan is still running.
+func (c *controller) ensureSnapshot(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (v32.UpgradePreflightResult, bool, error) {
+	result := v32.UpgradePreflightResult{Name: v32.PreflightCheckEtcdBackup}
+
+	if plan.Status.SnapshotName != "" {
+		ns, name := ref.Parse(plan.Status.SnapshotName)
+		backup, err := c.backupLister.Get(ns, name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return result, false, err
+		}
+		switch {
+		case backup == nil || apierrors.IsNotFound(err):
+			result.Message = fmt.Sprintf("etcd backup %s no longer exists", plan.Status.SnapshotName)
+			plan.Status.SnapshotName = ""
+			v32.ClusterUpgradePlanConditionSnapshotTaken.False(plan)
+			return result, false, nil
+		case rketypes.BackupConditionCompleted.IsTrue(backup):
+			result.Passed = true
+			result.Message = fmt.Sprintf("using etcd backup %s", plan.Status.SnapshotName)
+			v32.ClusterUpgradePlanConditionSnapshotTaken.True(plan)
+			return result, false, nil
+		case rketypes.BackupConditionCompleted.IsFalse(backup):
+			result.Message = fmt.Sprintf("etcd backup %s failed: %s", plan.Status.SnapshotName,
+				rketypes.BackupConditionCompleted.GetMessage(backup))
+			plan.Status.SnapshotName = ""
+			v32.ClusterUpgradePlanConditionSnapshotTaken.False(plan)
+			return result, false, nil
+		default:
+			result.Message = fmt.Sprintf("waiting for etcd backup %s to complete", plan.Status.SnapshotName)
+			return result, true, nil
+		}
+	}
+
+	backups, err := c.backupLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return result, false, err
+	}
+	maxAge := time.Duration(plan.Spec.PreflightChecks.EtcdBackupMaxAgeMinutes) * time.Minute
+	if maxAge == 0 {
+		maxAge = time.Hour
+	}
+	if backup := recentBackup(backups, maxAge, time.Now()); backup != nil {
+		plan.Status.SnapshotName = ref.Ref(backup)
+		v32.ClusterUpgradePlanConditionSnapshotTaken.True(plan)
+		result.Passed = true
+		result.Message = fmt.Sprintf("using etcd backup %s", plan.Status.SnapshotName)
+		return result, false, nil
+	}
+
+	if cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig == nil {
+		result.Message = "cluster has no etcd backup configuration"
+		return result, false, nil
+	}
+	newBackup, err := etcdbackup.NewBackupObject(cluster, true)
+	if err != nil {
+		return result, false, err
+	}
+	backup, err := c.backups.Create(newBackup)
+	if err != nil {
+		return result, false, err
+	}
+	logrus.Infof("[cluster-upgrade-plan] taking etcd backup %s before upgrading cluster %s", backup.Name, cluster.Name)
+	plan.Status.SnapshotName = ref.Ref(backup)
+	v32.ClusterUpgradePlanConditionSnapshotTaken.Unknown(plan)
+	result.Message = fmt.Sprintf("waiting for etcd backup %s to complete", plan.Status.SnapshotName)
+	return result, true, nil
+}
+
+func (c *controller) startUpgrade(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	clusterCopy := cluster.DeepCopy()
+	plan.Status.PreviousKubernetesVersion = cluster.Spec.RancherKubernetesEngineConfig.Version
+	clusterCopy.Spec.RancherKubernetesEngineConfig.Version = plan.Spec.KubernetesVersion
+	if plan.Spec.UpgradeStrategy != nil {
+		clusterCopy.Spec.RancherKubernetesEngineConfig.UpgradeStrategy = plan.Spec.UpgradeStrategy.DeepCopy()
+	}
+	// workers are held back until the control plane passed its health check
+	setWorkerGate(clusterCopy, true)
+	if _, err := c.clusters.Update(clusterCopy); err != nil {
+		return 0, err
+	}
+	logrus.Infof("[cluster-upgrade-plan] upgrading cluster %s from %s to %s", cluster.Name,
+		plan.Status.PreviousKubernetesVersion, plan.Spec.KubernetesVersion)
+
+	plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+	v32.ClusterUpgradePlanConditionControlPlaneUpgraded.Unknown(plan)
+	v32.ClusterUpgradePlanConditionControlPlaneUpgraded.Message(plan, "upgrading etcd and control plane nodes")
+	return progressCheckInterval, nil
+}
+
+func (c *controller) upgradeControlPlane(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	nodes, err := c.nodeLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	updateProgress(plan, nodes)
+
+	if v32.ClusterConditionUpdated.IsFalse(cluster) {
+		return c.unhealthy(plan, cluster, fmt.Sprintf("control plane upgrade failed: %s", v32.ClusterConditionUpdated.GetMessage(cluster)))
+	}
+	if !controlPlaneUpgraded(cluster, nodes, plan.Spec.KubernetesVersion) {
+		return progressCheckInterval, nil
+	}
+	if problems := healthProblems(cluster, controlPlaneNodes(nodes)); len(problems) > 0 {
+		return c.unhealthy(plan, cluster, strings.Join(problems, "; "))
+	}
+	v32.ClusterUpgradePlanConditionHealthy.True(plan)
+	v32.ClusterUpgradePlanConditionHealthy.Message(plan, "")
+	v32.ClusterUpgradePlanConditionControlPlaneUpgraded.True(plan)
+	v32.ClusterUpgradePlanConditionControlPlaneUpgraded.Message(plan, "")
+
+	if plan.Spec.Paused {
+		plan.Status.Phase = v32.ClusterUpgradePhasePaused
+		return 0, nil
+	}
+
+	plan.Status.Phase = v32.ClusterUpgradePhaseWorkers
+	v32.ClusterUpgradePlanConditionWorkersUpgraded.Unknown(plan)
+	v32.ClusterUpgradePlanConditionWorkersUpgraded.Message(plan, "upgrading worker nodes")
+	return c.upgradeWorkers(plan, cluster)
+}
+
+func (c *controller) upgradeWorkers(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	nodes, err := c.nodeLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	updateProgress(plan, nodes)
+
+	if v32.ClusterConditionUpdated.IsFalse(cluster) {
+		return c.unhealthy(plan, cluster, fmt.Sprintf("cluster update failed: %s", v32.ClusterConditionUpdated.GetMessage(cluster)))
+	}
+	if problems := healthProblems(cluster, upgradedNodes(nodes, plan.Spec.KubernetesVersion)); len(problems) > 0 {
+		return c.unhealthy(plan, cluster, strings.Join(problems, "; "))
+	}
+	v32.ClusterUpgradePlanConditionHealthy.True(plan)
+	v32.ClusterUpgradePlanConditionHealthy.Message(plan, "")
+
+	if plan.Spec.Paused {
+		if err := c.updateWorkerGate(cluster, true); err != nil {
+			return 0, err
+		}
+		plan.Status.Phase = v32.ClusterUpgradePhasePaused
+		return 0, nil
+	}
+	if err := c.updateWorkerGate(cluster, false); err != nil {
+		return 0, err
+	}
+
+	if !workersUpgraded(cluster, nodes, plan.Spec.KubernetesVersion) {
+		return progressCheckInterval, nil
+	}
+	v32.ClusterUpgradePlanConditionWorkersUpgraded.True(plan)
+	v32.ClusterUpgradePlanConditionWorkersUpgraded.Message(plan, "")
+	plan.Status.Phase = v32.ClusterUpgradePhaseCompleted
+	plan.Status.CompletedTime = time.Now().UTC().Format(time.RFC3339)
+	logrus.Infof("[cluster-upgrade-plan] cluster %s upgraded to %s", cluster.Name, plan.Spec.KubernetesVersion)
+	return 0, nil
+}
+
+func (c *controller) paused(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	if plan.Spec.Paused {
+		return 0, nil
+	}
+	if v32.ClusterUpgradePlanConditionControlPlaneUpgraded.IsTrue(plan) {
+		plan.Status.Phase = v32.ClusterUpgradePhaseWorkers
+		return c.upgradeWorkers(plan, cluster)
+	}
+	plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+	return c.upgradeControlPlane(plan, cluster)
+}
+
+// unhealthy stops the rollout after a failed health check, it either rolls back or pauses the plan until it is resumed
+func (c *controller) unhealthy(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster, message string) (time.Duration, error) {
+	logrus.Warnf("[cluster-upgrade-plan] health check failed for cluster %s: %s", cluster.Name, message)
+	v32.ClusterUpgradePlanConditionHealthy.False(plan)
+	v32.ClusterUpgradePlanConditionHealthy.Message(plan, message)
+
+	if plan.Spec.AutoRollback {
+		plan.Spec.Rollback = true
+		return c.rollback(plan, cluster)
+	}
+
+	if err := c.updateWorkerGate(cluster, true); err != nil {
+		return 0, err
+	}
+	plan.Spec.Paused = true
+	plan.Status.Phase = v32.ClusterUpgradePhasePaused
+	return 0, nil
+}
+
+func (c *controller) rollback(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	plan.Spec.Rollback = false
+	if plan.Status.PreviousKubernetesVersion == "" || plan.Status.SnapshotName == "" {
+		fail(plan, "upgrade was cancelled before it started")
+		return 0, nil
+	}
+
+	clusterCopy := cluster.DeepCopy()
+	clusterCopy.Spec.RancherKubernetesEngineConfig.Version = plan.Status.PreviousKubernetesVersion
+	clusterCopy.Spec.RancherKubernetesEngineConfig.Restore = rketypes.RestoreConfig{
+		Restore:      true,
+		SnapshotName: plan.Status.SnapshotName,
+	}
+	setWorkerGate(clusterCopy, false)
+	if _, err := c.clusters.Update(clusterCopy); err != nil {
+		return 0, err
+	}
+	logrus.Infof("[cluster-upgrade-plan] rolling back cluster %s to %s from etcd backup %s", cluster.Name,
+		plan.Status.PreviousKubernetesVersion, plan.Status.SnapshotName)
+
+	plan.Spec.Paused = false
+	plan.Status.Phase = v32.ClusterUpgradePhaseRollingBack
+	v32.ClusterUpgradePlanConditionRolledBack.Unknown(plan)
+	v32.ClusterUpgradePlanConditionRolledBack.Message(plan, fmt.Sprintf("restoring etcd backup %s", plan.Status.SnapshotName))
+	return progressCheckInterval, nil
+}
+
+func (c *controller) rollingBack(plan *v3.ClusterUpgradePlan, cluster *v3.Cluster) (time.Duration, error) {
+	if cluster.Spec.RancherKubernetesEngineConfig.Restore.Restore ||
+		cluster.Annotations[clusterprovisioner.RkeRestoreAnnotation] == "true" {
+		return progressCheckInterval, nil
+	}
+	if v32.ClusterConditionUpdated.IsFalse(cluster) {
+		v32.ClusterUpgradePlanConditionRolledBack.False(plan)
+		v32.ClusterUpgradePlanConditionRolledBack.Message(plan, v32.ClusterConditionUpdated.GetMessage(cluster))
+		plan.Status.Phase = v32.ClusterUpgradePhaseFailed
+		return 0, nil
+	}
+	if !v32.ClusterConditionUpdated.IsTrue(cluster) {
+		return progressCheckInterval, nil
+	}
+	v32.ClusterUpgradePlanConditionRolledBack.True(plan)
+	v32.ClusterUpgradePlanConditionRolledBack.Message(plan, "")
+	plan.Status.Phase = v32.ClusterUpgradePhaseRolledBack
+	plan.Status.CompletedTime = time.Now().UTC().Format(time.RFC3339)
+	return 0, nil
+}
+
+func (c *controller) otherActivePlan(plan *v3.ClusterUpgradePlan) (string, error) {
+	plans, err := c.planLister.List(plan.Namespace, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	for _, other := range plans {
+		if other.Name == plan.Name || isFinished(other) {
+			continue
+		}
+		switch other.Status.Phase {
+		case "", v32.ClusterUpgradePhasePreflight:
+			continue
+		}
+		return other.Name, nil
+	}
+	return "", nil
+}
+
+func (c *controller) updateWorkerGate(cluster *v3.Cluster, closed bool) error {
+	if (cluster.Annotations[v32.ClusterUpgradeWorkerGateAnnotation] == "true") == closed {
+		return nil
+	}
+	clusterCopy := cluster.DeepCopy()
+	setWorkerGate(clusterCopy, closed)
+	_, err := c.clusters.Update(clusterCopy)
+	return err
+}
+
+func setWorkerGate(cluster *v3.Cluster, closed bool) {
+	if !closed {
+		delete(cluster.Annotations, v32.ClusterUpgradeWorkerGateAnnotation)
+		return
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[v32.ClusterUpgradeWorkerGateAnnotation] = "true"
+}
+
+func fail(plan *v3.ClusterUpgradePlan, message string) {
+	plan.Status.Phase = v32.ClusterUpgradePhaseFailed
+	v32.ClusterUpgradePlanConditionPreflightPassed.False(plan)
+	v32.ClusterUpgradePlanConditionPreflightPassed.Message(plan, message)
+}
+
+// isFinished reports if the plan reached a phase it can only leave through an explicit rollback request
+func isFinished(plan *v3.ClusterUpgradePlan) bool {
+	switch plan.Status.Phase {
+	case v32.ClusterUpgradePhaseCompleted, v32.ClusterUpgradePhaseFailed:
+		return !plan.Spec.Rollback
+	case v32.ClusterUpgradePhaseRolledBack:
+		return true
+	}
+	return false
+}
+
+func controlPlaneUpgraded(cluster *v3.Cluster, nodes []*v3.Node, version string) bool {
+	applied := cluster.Status.AppliedSpec.RancherKubernetesEngineConfig
+	if applied == nil || applied.Version != version || !v32.ClusterConditionUpdated.IsTrue(cluster) {
+		return false
+	}
+	for _, node := range controlPlaneNodes(nodes) {
+		if !kubeletVersionMatches(node.Status.InternalNodeStatus.NodeInfo.KubeletVersion, version) {
+			return false
+		}
+	}
+	return true
+}
+
+func workersUpgraded(cluster *v3.Cluster, nodes []*v3.Node, version string) bool {
+	if !v32.ClusterConditionUpgraded.IsTrue(cluster) {
+		return false
+	}
+	for _, node := range nodes {
+		if node.DeletionTimestamp != nil {
+			continue
+		}
+		if !kubeletVersionMatches(node.Status.InternalNodeStatus.NodeInfo.KubeletVersion, version) {
+			return false
+		}
+	}
+	return true
+}
+
+func healthProblems(cluster *v3.Cluster, nodes []*v3.Node) []string {
+	var problems []string
+	if !v32.ClusterConditionReady.IsTrue(cluster) {
+		problems = append(problems, "cluster is not ready")
+	}
+	if unhealthy := unhealthyNodes(nodes); len(unhealthy) > 0 {
+		problems = append(problems, fmt.Sprintf("unhealthy nodes: %s", strings.Join(unhealthy, ", ")))
+	}
+	return problems
+}
+
+func controlPlaneNodes(nodes []*v3.Node) []*v3.Node {
+	var result []*v3.Node
+	for _, node := range nodes {
+		if node.Spec.Etcd || node.Spec.ControlPlane {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// upgradedNodes returns the nodes the worker upgrader is done with, nodes still being drained or restarted are left out
+func upgradedNodes(nodes []*v3.Node, version string) []*v3.Node {
+	var result []*v3.Node
+	for _, node := range nodes {
+		if !kubeletVersionMatches(node.Status.InternalNodeStatus.NodeInfo.KubeletVersion, version) {
+			continue
+		}
+		if node.Spec.Worker && !node.Spec.Etcd && !node.Spec.ControlPlane && !v32.NodeConditionUpgraded.IsTrue(node) {
+			continue
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+func updateProgress(plan *v3.ClusterUpgradePlan, nodes []*v3.Node) {
+	plan.Status.TotalNodes = 0
+	plan.Status.UpgradedNodes = 0
+	for _, node := range nodes {
+		if node.DeletionTimestamp != nil {
+			continue
+		}
+		plan.Status.TotalNodes++
+		if kubeletVersionMatches(node.Status.InternalNodeStatus.NodeInfo.KubeletVersion, plan.Spec.KubernetesVersion) {
+			plan.Status.UpgradedNodes++
+		}
+	}
+}
@@ -0,0 +1,549 @@
+package clusterupgradeplan
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	currentVersion = "v1.18.12-rancher1-1"
+	targetVersion  = "v1.19.4-rancher1-1"
+)
+
+type fakeEnv struct {
+	cluster  *v3.Cluster
+	nodes    []*v3.Node
+	backups  []*v3.EtcdBackup
+	plans    []*v3.ClusterUpgradePlan
+	nodesErr error
+
+	updatedPlans    []*v3.ClusterUpgradePlan
+	updatedClusters []*v3.Cluster
+	createdBackups  []*v3.EtcdBackup
+	requeues        []time.Duration
+}
+
+func (f *fakeEnv) controller() *controller {
+	return &controller{
+		plans: &fakes.ClusterUpgradePlanInterfaceMock{
+			UpdateFunc: func(plan *v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error) {
+				f.updatedPlans = append(f.updatedPlans, plan)
+				return plan, nil
+			},
+			ControllerFunc: func() v3.ClusterUpgradePlanController {
+				return &fakes.ClusterUpgradePlanControllerMock{
+					EnqueueAfterFunc: func(namespace, name string, after time.Duration) {
+						f.requeues = append(f.requeues, after)
+					},
+				}
+			},
+		},
+		planLister: &fakes.ClusterUpgradePlanListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.ClusterUpgradePlan, error) {
+				return f.plans, nil
+			},
+		},
+		clusters: &fakes.ClusterInterfaceMock{
+			UpdateFunc: func(cluster *v3.Cluster) (*v3.Cluster, error) {
+				f.updatedClusters = append(f.updatedClusters, cluster)
+				return cluster, nil
+			},
+		},
+		clusterLister: &fakes.ClusterListerMock{
+			GetFunc: func(namespace, name string) (*v3.Cluster, error) {
+				if f.cluster == nil || f.cluster.Name != name {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, name)
+				}
+				return f.cluster, nil
+			},
+		},
+		nodeLister: &fakes.NodeListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.Node, error) {
+				return f.nodes, f.nodesErr
+			},
+		},
+		backups: &fakes.EtcdBackupInterfaceMock{
+			CreateFunc: func(backup *v3.EtcdBackup) (*v3.EtcdBackup, error) {
+				backup = backup.DeepCopy()
+				backup.Name = backup.GenerateName + "abcde"
+				f.createdBackups = append(f.createdBackups, backup)
+				return backup, nil
+			},
+		},
+		backupLister: &fakes.EtcdBackupListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.EtcdBackup, error) {
+				return f.backups, nil
+			},
+			GetFunc: func(namespace, name string) (*v3.EtcdBackup, error) {
+				for _, backup := range f.backups {
+					if backup.Namespace == namespace && backup.Name == name {
+						return backup, nil
+					}
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "etcdbackups"}, name)
+			},
+		},
+	}
+}
+
+// lastPlan is the plan the controller wrote last, the plan it was given when it wrote nothing
+func (f *fakeEnv) lastPlan(plan *v3.ClusterUpgradePlan) *v3.ClusterUpgradePlan {
+	if len(f.updatedPlans) == 0 {
+		return plan
+	}
+	return f.updatedPlans[len(f.updatedPlans)-1]
+}
+
+func newCluster() *v3.Cluster {
+	cluster := &v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}
+	cluster.Spec.RancherKubernetesEngineConfig = &rketypes.RancherKubernetesEngineConfig{
+		Version: currentVersion,
+		Services: rketypes.RKEConfigServices{
+			Etcd: rketypes.ETCDService{BackupConfig: &rketypes.BackupConfig{}},
+		},
+	}
+	v32.ClusterConditionReady.True(cluster)
+	return cluster
+}
+
+func newPlan() *v3.ClusterUpgradePlan {
+	return &v3.ClusterUpgradePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan", Namespace: "c-1"},
+		Spec: v32.ClusterUpgradePlanSpec{
+			ClusterName:       "c-1",
+			KubernetesVersion: targetVersion,
+			PreflightChecks: v32.UpgradePreflightChecks{
+				SkipDeprecatedAPIs:       true,
+				SkipPodDisruptionBudgets: true,
+			},
+		},
+	}
+}
+
+func newNode(name, kubeletVersion string, controlPlane bool) *v3.Node {
+	node := &v3.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-1"}}
+	node.Spec.ControlPlane = controlPlane
+	node.Spec.Etcd = controlPlane
+	node.Spec.Worker = !controlPlane
+	node.Status.NodeName = name
+	node.Status.InternalNodeStatus.NodeInfo.KubeletVersion = kubeletVersion
+	node.Status.InternalNodeStatus.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}
+	if !controlPlane && kubeletVersion == "v1.19.4" {
+		v32.NodeConditionUpgraded.True(node)
+	}
+	return node
+}
+
+func completedBackup(name string, age time.Duration) *v3.EtcdBackup {
+	return &v3.EtcdBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-1"},
+		Status: rketypes.EtcdBackupStatus{Conditions: []rketypes.EtcdBackupCondition{{
+			Type:           string(rketypes.BackupConditionCompleted),
+			Status:         v1.ConditionTrue,
+			LastUpdateTime: time.Now().Add(-age).UTC().Format(time.RFC3339),
+		}}},
+	}
+}
+
+// upgradedCluster is the cluster once RKE applied the target version to the control plane
+func upgradedCluster() *v3.Cluster {
+	cluster := newCluster()
+	cluster.Spec.RancherKubernetesEngineConfig.Version = targetVersion
+	cluster.Status.AppliedSpec.RancherKubernetesEngineConfig = &rketypes.RancherKubernetesEngineConfig{Version: targetVersion}
+	v32.ClusterConditionUpdated.True(cluster)
+	return cluster
+}
+
+func TestSyncSkipsFinishedPlans(t *testing.T) {
+	for _, phase := range []v32.ClusterUpgradePhase{v32.ClusterUpgradePhaseCompleted, v32.ClusterUpgradePhaseFailed, v32.ClusterUpgradePhaseRolledBack} {
+		env := &fakeEnv{cluster: newCluster()}
+		plan := newPlan()
+		plan.Status.Phase = phase
+
+		if _, err := env.controller().sync("c-1/plan", plan); err != nil {
+			t.Errorf("phase %s: unexpected error %v", phase, err)
+		}
+		if len(env.updatedPlans) != 0 || len(env.updatedClusters) != 0 || len(env.requeues) != 0 {
+			t.Errorf("phase %s: finished plan was reconciled", phase)
+		}
+	}
+}
+
+func TestSyncFailsWithoutCluster(t *testing.T) {
+	env := &fakeEnv{}
+	plan := newPlan()
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseFailed, got.Status.Phase)
+	assert.Equal(t, "cluster c-1 not found", v32.ClusterUpgradePlanConditionPreflightPassed.GetMessage(got))
+	assert.Empty(t, env.requeues, "a failed plan isn't requeued")
+}
+
+func TestPreflightFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster func() *v3.Cluster
+		plans   []*v3.ClusterUpgradePlan
+		message string
+	}{
+		{
+			name: "not an rke cluster",
+			cluster: func() *v3.Cluster {
+				cluster := newCluster()
+				cluster.Spec.RancherKubernetesEngineConfig = nil
+				return cluster
+			},
+			message: "upgrade plans are only supported for RKE clusters",
+		},
+		{
+			name: "already on the target version",
+			cluster: func() *v3.Cluster {
+				cluster := newCluster()
+				cluster.Spec.RancherKubernetesEngineConfig.Version = targetVersion
+				return cluster
+			},
+			message: "cluster already runs kubernetes version " + targetVersion,
+		},
+		{
+			name:    "another plan is upgrading the cluster",
+			cluster: newCluster,
+			plans: []*v3.ClusterUpgradePlan{{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "c-1"},
+				Status:     v32.ClusterUpgradePlanStatus{Phase: v32.ClusterUpgradePhaseWorkers},
+			}},
+			message: "cluster is already being upgraded by plan other",
+		},
+	}
+
+	for _, tt := range tests {
+		env := &fakeEnv{cluster: tt.cluster(), plans: tt.plans}
+		plan := newPlan()
+
+		if _, err := env.controller().sync("c-1/plan", plan); err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		got := env.lastPlan(plan)
+		if got.Status.Phase != v32.ClusterUpgradePhaseFailed {
+			t.Errorf("%s: expected phase %s, got %s", tt.name, v32.ClusterUpgradePhaseFailed, got.Status.Phase)
+		}
+		if message := v32.ClusterUpgradePlanConditionPreflightPassed.GetMessage(got); message != tt.message {
+			t.Errorf("%s: expected message %q, got %q", tt.name, tt.message, message)
+		}
+		if len(env.updatedClusters) != 0 {
+			t.Errorf("%s: cluster was updated by a failed preflight", tt.name)
+		}
+	}
+}
+
+func TestPreflightWaitsForReadyCluster(t *testing.T) {
+	cluster := newCluster()
+	v32.ClusterConditionReady.False(cluster)
+	env := &fakeEnv{cluster: cluster}
+	plan := newPlan()
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhasePreflight, got.Status.Phase)
+	assert.True(t, v32.ClusterUpgradePlanConditionPreflightPassed.IsFalse(got))
+	assert.Equal(t, []time.Duration{preflightRetryInterval}, env.requeues)
+	assert.Empty(t, env.updatedClusters)
+}
+
+func TestPreflightTakesSnapshot(t *testing.T) {
+	env := &fakeEnv{
+		cluster: newCluster(),
+		// the backup is older than the default maximum age of an hour
+		backups: []*v3.EtcdBackup{completedBackup("old", 2*time.Hour)},
+	}
+	plan := newPlan()
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	if assert.Len(t, env.createdBackups, 1) {
+		got := env.lastPlan(plan)
+		assert.Equal(t, "c-1:"+env.createdBackups[0].Name, got.Status.SnapshotName)
+		assert.True(t, v32.ClusterUpgradePlanConditionSnapshotTaken.IsUnknown(got))
+		assert.True(t, v32.ClusterUpgradePlanConditionPreflightPassed.IsFalse(got))
+	}
+	assert.Equal(t, []time.Duration{snapshotCheckInterval}, env.requeues)
+	assert.Empty(t, env.updatedClusters, "the upgrade waits for the backup")
+}
+
+func TestPreflightRetriesFailedBackup(t *testing.T) {
+	backup := completedBackup("failed", 0)
+	rketypes.BackupConditionCompleted.False(backup)
+	env := &fakeEnv{cluster: newCluster(), backups: []*v3.EtcdBackup{backup}}
+	plan := newPlan()
+	plan.Status.SnapshotName = "c-1:failed"
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Empty(t, got.Status.SnapshotName, "the failed backup is dropped so the next preflight takes a new one")
+	assert.True(t, v32.ClusterUpgradePlanConditionSnapshotTaken.IsFalse(got))
+	assert.Equal(t, []time.Duration{preflightRetryInterval}, env.requeues)
+}
+
+func TestPreflightStartsUpgrade(t *testing.T) {
+	env := &fakeEnv{
+		cluster: newCluster(),
+		nodes:   []*v3.Node{newNode("cp", "v1.18.12", true), newNode("worker", "v1.18.12", false)},
+		backups: []*v3.EtcdBackup{completedBackup("recent", 10*time.Minute)},
+	}
+	plan := newPlan()
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseControlPlane, got.Status.Phase)
+	assert.Equal(t, currentVersion, got.Status.PreviousKubernetesVersion)
+	assert.Equal(t, "c-1:recent", got.Status.SnapshotName)
+	assert.True(t, v32.ClusterUpgradePlanConditionPreflightPassed.IsTrue(got))
+	if assert.Len(t, env.updatedClusters, 1) {
+		cluster := env.updatedClusters[0]
+		assert.Equal(t, targetVersion, cluster.Spec.RancherKubernetesEngineConfig.Version)
+		assert.Equal(t, "true", cluster.Annotations[v32.ClusterUpgradeWorkerGateAnnotation], "workers wait for the control plane")
+	}
+	assert.Equal(t, []time.Duration{progressCheckInterval}, env.requeues)
+}
+
+func TestUpgradeControlPlane(t *testing.T) {
+	inProgress := upgradedCluster()
+	inProgress.Status.AppliedSpec.RancherKubernetesEngineConfig.Version = currentVersion
+
+	tests := []struct {
+		name    string
+		cluster *v3.Cluster
+		nodes   []*v3.Node
+		paused  bool
+		phase   v32.ClusterUpgradePhase
+		requeue []time.Duration
+		gate    string
+	}{
+		{
+			name:    "control plane still upgrading",
+			cluster: inProgress,
+			nodes:   []*v3.Node{newNode("cp", "v1.18.12", true)},
+			phase:   v32.ClusterUpgradePhaseControlPlane,
+			requeue: []time.Duration{progressCheckInterval},
+		},
+		{
+			name:    "control plane upgraded, workers start",
+			cluster: upgradedCluster(),
+			nodes:   []*v3.Node{newNode("cp", "v1.19.4", true), newNode("worker", "v1.18.12", false)},
+			phase:   v32.ClusterUpgradePhaseWorkers,
+			requeue: []time.Duration{progressCheckInterval},
+		},
+		{
+			name:    "control plane upgraded, plan paused before the workers",
+			cluster: upgradedCluster(),
+			nodes:   []*v3.Node{newNode("cp", "v1.19.4", true), newNode("worker", "v1.18.12", false)},
+			paused:  true,
+			phase:   v32.ClusterUpgradePhasePaused,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.cluster.Annotations = map[string]string{v32.ClusterUpgradeWorkerGateAnnotation: "true"}
+		env := &fakeEnv{cluster: tt.cluster, nodes: tt.nodes}
+		plan := newPlan()
+		plan.Spec.Paused = tt.paused
+		plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+
+		if _, err := env.controller().sync("c-1/plan", plan); err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		got := env.lastPlan(plan)
+		if got.Status.Phase != tt.phase {
+			t.Errorf("%s: expected phase %s, got %s", tt.name, tt.phase, got.Status.Phase)
+		}
+		if !assert.ObjectsAreEqual(tt.requeue, env.requeues) {
+			t.Errorf("%s: expected requeues %v, got %v", tt.name, tt.requeue, env.requeues)
+		}
+		workersStarted := len(env.updatedClusters) == 1 && env.updatedClusters[0].Annotations[v32.ClusterUpgradeWorkerGateAnnotation] == ""
+		if workersStarted != (tt.phase == v32.ClusterUpgradePhaseWorkers) {
+			t.Errorf("%s: expected the worker gate to be opened only when the workers start", tt.name)
+		}
+	}
+}
+
+func TestUnhealthyUpgrade(t *testing.T) {
+	failed := func() *v3.Cluster {
+		cluster := upgradedCluster()
+		v32.ClusterConditionUpdated.False(cluster)
+		v32.ClusterConditionUpdated.Message(cluster, "etcd is unhealthy")
+		return cluster
+	}
+
+	// without auto rollback the plan pauses and keeps the workers back
+	env := &fakeEnv{cluster: failed(), nodes: []*v3.Node{newNode("cp", "v1.18.12", true)}}
+	plan := newPlan()
+	plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhasePaused, got.Status.Phase)
+	assert.True(t, got.Spec.Paused)
+	assert.True(t, v32.ClusterUpgradePlanConditionHealthy.IsFalse(got))
+	assert.Equal(t, "control plane upgrade failed: etcd is unhealthy", v32.ClusterUpgradePlanConditionHealthy.GetMessage(got))
+	if assert.Len(t, env.updatedClusters, 1) {
+		assert.Equal(t, "true", env.updatedClusters[0].Annotations[v32.ClusterUpgradeWorkerGateAnnotation])
+	}
+	assert.Empty(t, env.requeues)
+
+	// with auto rollback the snapshot is restored with the previous version
+	env = &fakeEnv{cluster: failed(), nodes: []*v3.Node{newNode("cp", "v1.18.12", true)}}
+	plan = newPlan()
+	plan.Spec.AutoRollback = true
+	plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+	plan.Status.PreviousKubernetesVersion = currentVersion
+	plan.Status.SnapshotName = "c-1:recent"
+	_, err = env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got = env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseRollingBack, got.Status.Phase)
+	assert.False(t, got.Spec.Rollback, "the rollback request is consumed")
+	if assert.Len(t, env.updatedClusters, 1) {
+		rkeConfig := env.updatedClusters[0].Spec.RancherKubernetesEngineConfig
+		assert.Equal(t, currentVersion, rkeConfig.Version)
+		assert.Equal(t, rketypes.RestoreConfig{Restore: true, SnapshotName: "c-1:recent"}, rkeConfig.Restore)
+	}
+	assert.Equal(t, []time.Duration{progressCheckInterval}, env.requeues)
+}
+
+func TestRollbackBeforeUpgradeStarted(t *testing.T) {
+	env := &fakeEnv{cluster: newCluster()}
+	plan := newPlan()
+	plan.Spec.Rollback = true
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseFailed, got.Status.Phase)
+	assert.Equal(t, "upgrade was cancelled before it started", v32.ClusterUpgradePlanConditionPreflightPassed.GetMessage(got))
+	assert.Empty(t, env.updatedClusters)
+}
+
+func TestUpgradeWorkers(t *testing.T) {
+	cluster := upgradedCluster()
+	cluster.Annotations = map[string]string{v32.ClusterUpgradeWorkerGateAnnotation: "true"}
+	v32.ClusterConditionUpgraded.True(cluster)
+	env := &fakeEnv{
+		cluster: cluster,
+		nodes:   []*v3.Node{newNode("cp", "v1.19.4", true), newNode("worker", "v1.19.4", false)},
+	}
+	plan := newPlan()
+	plan.Status.Phase = v32.ClusterUpgradePhaseWorkers
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseCompleted, got.Status.Phase)
+	assert.NotEmpty(t, got.Status.CompletedTime)
+	assert.Equal(t, 2, got.Status.UpgradedNodes)
+	assert.True(t, v32.ClusterUpgradePlanConditionWorkersUpgraded.IsTrue(got))
+	if assert.Len(t, env.updatedClusters, 1) {
+		assert.Empty(t, env.updatedClusters[0].Annotations[v32.ClusterUpgradeWorkerGateAnnotation], "the worker gate is opened")
+	}
+	assert.Empty(t, env.requeues, "a completed plan isn't requeued")
+}
+
+func TestResumePausedPlan(t *testing.T) {
+	cluster := upgradedCluster()
+	cluster.Annotations = map[string]string{v32.ClusterUpgradeWorkerGateAnnotation: "true"}
+	env := &fakeEnv{
+		cluster: cluster,
+		nodes:   []*v3.Node{newNode("cp", "v1.19.4", true), newNode("worker", "v1.18.12", false)},
+	}
+	plan := newPlan()
+	plan.Status.Phase = v32.ClusterUpgradePhasePaused
+	v32.ClusterUpgradePlanConditionControlPlaneUpgraded.True(plan)
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.NoError(t, err)
+	got := env.lastPlan(plan)
+	assert.Equal(t, v32.ClusterUpgradePhaseWorkers, got.Status.Phase, "a resumed plan continues with the workers")
+	assert.Equal(t, []time.Duration{progressCheckInterval}, env.requeues)
+}
+
+func TestRollingBack(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster func() *v3.Cluster
+		phase   v32.ClusterUpgradePhase
+		requeue []time.Duration
+	}{
+		{
+			name: "restore running",
+			cluster: func() *v3.Cluster {
+				cluster := newCluster()
+				cluster.Spec.RancherKubernetesEngineConfig.Restore.Restore = true
+				return cluster
+			},
+			phase:   v32.ClusterUpgradePhaseRollingBack,
+			requeue: []time.Duration{progressCheckInterval},
+		},
+		{
+			name: "restore failed",
+			cluster: func() *v3.Cluster {
+				cluster := newCluster()
+				v32.ClusterConditionUpdated.False(cluster)
+				return cluster
+			},
+			phase: v32.ClusterUpgradePhaseFailed,
+		},
+		{
+			name: "restore completed",
+			cluster: func() *v3.Cluster {
+				cluster := newCluster()
+				v32.ClusterConditionUpdated.True(cluster)
+				return cluster
+			},
+			phase: v32.ClusterUpgradePhaseRolledBack,
+		},
+	}
+
+	for _, tt := range tests {
+		env := &fakeEnv{cluster: tt.cluster()}
+		plan := newPlan()
+		plan.Status.Phase = v32.ClusterUpgradePhaseRollingBack
+
+		if _, err := env.controller().sync("c-1/plan", plan); err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if got := env.lastPlan(plan); got.Status.Phase != tt.phase {
+			t.Errorf("%s: expected phase %s, got %s", tt.name, tt.phase, got.Status.Phase)
+		}
+		if !assert.ObjectsAreEqual(tt.requeue, env.requeues) {
+			t.Errorf("%s: expected requeues %v, got %v", tt.name, tt.requeue, env.requeues)
+		}
+	}
+}
+
+func TestSyncReturnsReconcileErrors(t *testing.T) {
+	env := &fakeEnv{cluster: upgradedCluster(), nodesErr: errors.New("cache not synced")}
+	plan := newPlan()
+	plan.Status.Phase = v32.ClusterUpgradePhaseControlPlane
+
+	_, err := env.controller().sync("c-1/plan", plan)
+	assert.EqualError(t, err, "cache not synced")
+	assert.Empty(t, env.requeues, "errors are retried by the work queue")
+}
@@ -7,17 +7,21 @@
 	"github.com/rancher/rancher/pkg/controllers/management/auth"
 	"github.com/rancher/rancher/pkg/controllers/management/catalog"
 	"github.com/rancher/rancher/pkg/controllers/management/certsexpiration"
+	"github.com/rancher/rancher/pkg/controllers/management/certsrotation"
 	"github.com/rancher/rancher/pkg/controllers/management/cis"
 	"github.com/rancher/rancher/pkg/controllers/management/cloudcredential"
 	"github.com/rancher/rancher/pkg/controllers/management/cluster"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterdeploy"
 	"github.com/rancher/rancher/pkg/controllers/management/clustergc"
+	"github.com/rancher/rancher/pkg/controllers/management/clustergroup"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterregistrationtoken"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterstats"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterstatus"
 	"github.com/rancher/rancher/pkg/controllers/management/clustertemplate"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterupgradeplan"
 	"github.com/rancher/rancher/pkg/controllers/management/compose"
+	"github.com/rancher/rancher/pkg/controllers/management/decommission"
 	"github.com/rancher/rancher/pkg/controllers/management/drivers/kontainerdriver"
 	"github.com/rancher/rancher/pkg/controllers/management/drivers/nodedriver"
 	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
@@ -43,18 +47,22 @@ func Register(ctx context.Context, management *config.ManagementContext, manager
 	// a-z
 	catalog.Register(ctx, management)
 	certsexpiration.Register(ctx, management)
+	certsrotation.Register(ctx, management)
 	cluster.Register(ctx, management)
 	clusterdeploy.Register(ctx, management, manager)
 	clustergc.Register(ctx, management)
+	clustergroup.Register(ctx, management)
 	clusterprovisioner.Register(ctx, management)
 	clusterstats.Register(ctx, management, manager)
 	clusterstatus.Register(ctx, management)
 	clusterregistrationtoken.Register(ctx, management)
+	clusterupgradeplan.Register(ctx, management, manager)
 	compose.Register(ctx, management, manager)
+	decommission.Register(ctx, management, manager)
 	kontainerdriver.Register(ctx, management)
 	kontainerdrivermetadata.Register(ctx, management)
 	nodedriver.Register(ctx, management)
-	nodepool.Register(ctx, management)
+	nodepool.Register(ctx, management, manager)
 	cloudcredential.Register(ctx, management)
 	node.Register(ctx, management)
 	podsecuritypolicy.Register(ctx, management)
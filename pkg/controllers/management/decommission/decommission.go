@@ -0,0 +1,255 @@
+package decommission
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/rancher/norman/condition"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	decommissionController = "mgmt-cluster-decommission-controller"
+	backupCheckInterval    = 15 * time.Second
+)
+
+// Conditions are the decommission conditions of a cluster, the action resets them to start a new decommission
+var Conditions = []condition.Cond{
+	v32.ClusterConditionDecommissionEtcdBackedUp,
+	v32.ClusterConditionDecommissionResourcesExported,
+	v32.ClusterConditionDecommissionConfigExported,
+	v32.ClusterConditionDecommissioned,
+}
+
+type controller struct {
+	ctx            context.Context
+	clusters       v3.ClusterInterface
+	backups        v3.EtcdBackupInterface
+	backupLister   v3.EtcdBackupLister
+	projectLister  v3.ProjectLister
+	prtbLister     v3.ProjectRoleTemplateBindingLister
+	crtbLister     v3.ClusterRoleTemplateBindingLister
+	clusterManager *clustermanager.Manager
+}
+
+func Register(ctx context.Context, management *config.ManagementContext, manager *clustermanager.Manager) {
+	c := &controller{
+		ctx:            ctx,
+		clusters:       management.Management.Clusters(""),
+		backups:        management.Management.EtcdBackups(""),
+		backupLister:   management.Management.EtcdBackups("").Controller().Lister(),
+		projectLister:  management.Management.Projects("").Controller().Lister(),
+		prtbLister:     management.Management.ProjectRoleTemplateBindings("").Controller().Lister(),
+		crtbLister:     management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+		clusterManager: manager,
+	}
+
+	c.clusters.AddHandler(ctx, decommissionController, c.sync)
+}
+
+// sync runs the decommission steps of a cluster in order, the cluster is deleted once they all stored their artifact
+func (c *controller) sync(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil || cluster.Spec.Decommission == nil ||
+		v32.ClusterConditionDecommissioned.IsFalse(cluster) {
+		return cluster, nil
+	}
+	if v32.ClusterConditionDecommissioned.IsTrue(cluster) {
+		return cluster, c.delete(cluster)
+	}
+
+	clusterCopy := cluster.DeepCopy()
+	requeue, syncErr := c.reconcile(clusterCopy)
+
+	if !reflect.DeepEqual(cluster.Status, clusterCopy.Status) {
+		updated, err := c.clusters.Update(clusterCopy)
+		if err != nil {
+			return cluster, err
+		}
+		cluster = updated
+	}
+	if syncErr != nil {
+		return cluster, syncErr
+	}
+	if requeue > 0 {
+		c.clusters.Controller().EnqueueAfter("", cluster.Name, requeue)
+		return cluster, nil
+	}
+	if v32.ClusterConditionDecommissioned.IsTrue(cluster) {
+		return cluster, c.delete(cluster)
+	}
+	return cluster, nil
+}
+
+func (c *controller) delete(cluster *v3.Cluster) error {
+	logrus.Infof("[decommission] cluster %s is decommissioned, deleting it", cluster.Name)
+	if err := c.clusters.Delete(cluster.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcile runs the next step of the decommission. A step that fails is retried, but a cluster that has no valid
+// backup target fails the decommission until the action is run again.
+func (c *controller) reconcile(cluster *v3.Cluster) (time.Duration, error) {
+	status := cluster.Status.DecommissionStatus
+	if status == nil {
+		status = &v32.DecommissionStatus{StartedAt: time.Now().UTC().Format(time.RFC3339)}
+		cluster.Status.DecommissionStatus = status
+	}
+
+	config := backupTarget(cluster)
+	if config == nil {
+		v32.ClusterConditionDecommissioned.False(cluster)
+		v32.ClusterConditionDecommissioned.Message(cluster, "no backup target, the cluster has no etcd S3 backup configuration")
+		return 0, nil
+	}
+	started, err := time.Parse(time.RFC3339, status.StartedAt)
+	if err != nil {
+		started = time.Now().UTC()
+	}
+	target, err := newTarget(config, cluster.Name, started)
+	if err != nil {
+		v32.ClusterConditionDecommissioned.False(cluster)
+		v32.ClusterConditionDecommissioned.Message(cluster, fmt.Sprintf("invalid backup target: %v", err))
+		return 0, nil
+	}
+
+	skipExport := cluster.Spec.Decommission.SkipExport
+	if !v32.ClusterConditionDecommissionEtcdBackedUp.IsTrue(cluster) {
+		if skipExport || cluster.Spec.RancherKubernetesEngineConfig == nil {
+			v32.ClusterConditionDecommissionEtcdBackedUp.True(cluster)
+			v32.ClusterConditionDecommissionEtcdBackedUp.Message(cluster, "skipped, the cluster has no etcd managed by rancher")
+		} else if requeue, err := c.backupEtcd(cluster, target); requeue > 0 || err != nil {
+			return requeue, err
+		}
+	}
+
+	if !v32.ClusterConditionDecommissionResourcesExported.IsTrue(cluster) {
+		if skipExport {
+			v32.ClusterConditionDecommissionResourcesExported.True(cluster)
+			v32.ClusterConditionDecommissionResourcesExported.Message(cluster, "skipped")
+		} else if err := step(cluster, v32.ClusterConditionDecommissionResourcesExported, func() (string, error) {
+			return target.putFile(resourcesArtifact, func(w io.Writer) error {
+				return c.exportResources(cluster, w)
+			})
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	if !v32.ClusterConditionDecommissionConfigExported.IsTrue(cluster) {
+		if err := step(cluster, v32.ClusterConditionDecommissionConfigExported, func() (string, error) {
+			data, err := c.exportConfig(cluster)
+			if err != nil {
+				return "", err
+			}
+			return target.put(configArtifact, data)
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	status.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+	v32.ClusterConditionDecommissioned.True(cluster)
+	v32.ClusterConditionDecommissioned.Message(cluster, "")
+	return 0, nil
+}
+
+// step records the artifact of a step and its condition, the error is returned to retry the step
+func step(cluster *v3.Cluster, cond condition.Cond, f func() (string, error)) error {
+	artifact, err := f()
+	if err != nil {
+		cond.False(cluster)
+		cond.ReasonAndMessageFromError(cluster, err)
+		return err
+	}
+	addArtifact(cluster.Status.DecommissionStatus, artifact)
+	cond.True(cluster)
+	cond.Reason(cluster, "")
+	cond.Message(cluster, "")
+	return nil
+}
+
+// backupEtcd takes the final etcd backup of the cluster and copies the snapshot to the target, the backups of the
+// cluster are deleted along with it
+func (c *controller) backupEtcd(cluster *v3.Cluster, target *target) (time.Duration, error) {
+	status := cluster.Status.DecommissionStatus
+	etcdConfig := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig
+	if etcdConfig == nil || etcdConfig.S3BackupConfig == nil {
+		v32.ClusterConditionDecommissionEtcdBackedUp.False(cluster)
+		v32.ClusterConditionDecommissioned.False(cluster)
+		v32.ClusterConditionDecommissioned.Message(cluster, "the etcd backups of the cluster are not stored in S3 and would be deleted with its nodes")
+		return 0, nil
+	}
+
+	if status.EtcdBackupName == "" {
+		newBackup, err := etcdbackup.NewBackupObject(cluster, true)
+		if err != nil {
+			return 0, err
+		}
+		backup, err := c.backups.Create(newBackup)
+		if err != nil {
+			return 0, err
+		}
+		logrus.Infof("[decommission] taking final etcd backup %s of cluster %s", backup.Name, cluster.Name)
+		status.EtcdBackupName = ref.Ref(backup)
+		v32.ClusterConditionDecommissionEtcdBackedUp.Unknown(cluster)
+		v32.ClusterConditionDecommissionEtcdBackedUp.Message(cluster, fmt.Sprintf("waiting for etcd backup %s", status.EtcdBackupName))
+		return backupCheckInterval, nil
+	}
+
+	ns, name := ref.Parse(status.EtcdBackupName)
+	backup, err := c.backupLister.Get(ns, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		status.EtcdBackupName = ""
+		return backupCheckInterval, nil
+	case err != nil:
+		return 0, err
+	case rketypes.BackupConditionCompleted.IsFalse(backup):
+		// a new backup is taken on the retry
+		status.EtcdBackupName = ""
+		return 0, step(cluster, v32.ClusterConditionDecommissionEtcdBackedUp, func() (string, error) {
+			return "", fmt.Errorf("etcd backup %s failed: %s", name, rketypes.BackupConditionCompleted.GetMessage(backup))
+		})
+	case !rketypes.BackupConditionCompleted.IsTrue(backup):
+		return backupCheckInterval, nil
+	}
+
+	return 0, step(cluster, v32.ClusterConditionDecommissionEtcdBackedUp, func() (string, error) {
+		return target.copySnapshot(backup)
+	})
+}
+
+// backupTarget is the S3 target of the decommission, or the etcd S3 backup target of the cluster
+func backupTarget(cluster *v3.Cluster) *rketypes.S3BackupConfig {
+	if cluster.Spec.Decommission.S3BackupConfig != nil {
+		return cluster.Spec.Decommission.S3BackupConfig
+	}
+	if rkeConfig := cluster.Spec.RancherKubernetesEngineConfig; rkeConfig != nil && rkeConfig.Services.Etcd.BackupConfig != nil {
+		return rkeConfig.Services.Etcd.BackupConfig.S3BackupConfig
+	}
+	return nil
+}
+
+func addArtifact(status *v32.DecommissionStatus, artifact string) {
+	for _, existing := range status.Artifacts {
+		if existing == artifact {
+			return
+		}
+	}
+	status.Artifacts = append(status.Artifacts, artifact)
+}
@@ -0,0 +1,31 @@
+package decommission
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileInvalidTarget(t *testing.T) {
+	cluster := &v3.Cluster{
+		Spec: v32.ClusterSpec{
+			Decommission: &v32.DecommissionConfig{
+				S3BackupConfig: &rketypes.S3BackupConfig{
+					Endpoint:   "s3.example.com/backups",
+					BucketName: "backups",
+					AccessKey:  "access",
+					SecretKey:  "secret",
+				},
+			},
+		},
+	}
+
+	requeue, err := (&controller{}).reconcile(cluster)
+	assert.Nil(t, err)
+	assert.Zero(t, requeue)
+	assert.True(t, v32.ClusterConditionDecommissioned.IsFalse(cluster))
+	assert.Contains(t, v32.ClusterConditionDecommissioned.GetMessage(cluster), "invalid backup target")
+}
@@ -0,0 +1,240 @@
+package decommission
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+// exportResources writes a gzipped tar of the namespaced resources of the downstream cluster, one YAML file per
+// namespace and resource. Events are left out, and so are secrets which would be stored in the clear in the bucket. The
+// resources are listed one at a time and written as they are listed.
+func (c *controller) exportResources(cluster *v3.Cluster, w io.Writer) error {
+	restConfig, err := c.clusterManager.RESTConfig(cluster)
+	if err != nil {
+		return err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(&restConfig)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(&restConfig)
+	if err != nil {
+		return err
+	}
+
+	// the groups of aggregated apiservers that are down are left out of the export rather than failing it
+	resourceLists, err := discoveryClient.ServerPreferredNamespacedResources()
+	if discovery.IsGroupDiscoveryFailedError(err) {
+		logrus.Warnf("[decommission] exporting the resources of cluster %s without the groups that could not be discovered: %v", cluster.Name, err)
+	} else if err != nil {
+		return errors.Wrap(err, "failed to discover the resources of the cluster")
+	}
+
+	archive := newArchive(w, time.Now())
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return err
+		}
+		for _, resource := range resourceList.APIResources {
+			if !exportable(gv.Group, resource) {
+				continue
+			}
+			objects, err := dynamicClient.Resource(gv.WithResource(resource.Name)).List(c.ctx, metav1.ListOptions{})
+			if err != nil {
+				return errors.Wrapf(err, "failed to list %s", gv.WithResource(resource.Name))
+			}
+			files := map[string][]*unstructured.Unstructured{}
+			for i := range objects.Items {
+				object := &objects.Items[i]
+				name := fileName(object.GetNamespace(), resource.Name, gv.Group)
+				files[name] = append(files[name], cleanObject(object))
+			}
+			if err := archive.add(files); err != nil {
+				return err
+			}
+		}
+	}
+	return archive.close()
+}
+
+func exportable(group string, resource metav1.APIResource) bool {
+	if strings.Contains(resource.Name, "/") || resource.Name == "events" && (group == "" || group == "events.k8s.io") ||
+		resource.Name == "secrets" && group == "" {
+		return false
+	}
+	for _, verb := range resource.Verbs {
+		if verb == "list" {
+			return true
+		}
+	}
+	return false
+}
+
+func fileName(namespace, resource, group string) string {
+	if group != "" {
+		resource = resource + "." + group
+	}
+	return fmt.Sprintf("%s/%s.yaml", namespace, resource)
+}
+
+// cleanObject removes the fields set by the apiserver, the object can be created again from the export
+func cleanObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+	object = object.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "selfLink", "generation", "creationTimestamp"} {
+		unstructured.RemoveNestedField(object.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(object.Object, "status")
+	return object
+}
+
+// archive writes the objects of each file as a multi document YAML in a gzipped tar
+type archive struct {
+	gz      *gzip.Writer
+	tw      *tar.Writer
+	modTime time.Time
+}
+
+func newArchive(w io.Writer, modTime time.Time) *archive {
+	gz := gzip.NewWriter(w)
+	return &archive{
+		gz:      gz,
+		tw:      tar.NewWriter(gz),
+		modTime: modTime,
+	}
+}
+
+// add writes the files sorted by name
+func (a *archive) add(files map[string][]*unstructured.Unstructured) error {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var content []byte
+		for _, object := range files[name] {
+			data, err := yaml.Marshal(object.Object)
+			if err != nil {
+				return err
+			}
+			content = append(content, "---\n"...)
+			content = append(content, data...)
+		}
+		if err := a.tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(content)),
+			ModTime: a.modTime,
+		}); err != nil {
+			return err
+		}
+		if _, err := a.tw.Write(content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *archive) close() error {
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+	return a.gz.Close()
+}
+
+// exportConfig returns the cluster, its projects and their role bindings as a multi document YAML
+func (c *controller) exportConfig(cluster *v3.Cluster) ([]byte, error) {
+	spec := *cluster.Spec.DeepCopy()
+	spec.Decommission = nil
+	spec.ImportedConfig = nil
+	objects := []interface{}{
+		&v3.Cluster{
+			TypeMeta:   typeMeta(v3.ClusterGroupVersionKind.Kind),
+			ObjectMeta: cleanMeta(cluster.ObjectMeta),
+			Spec:       spec,
+		},
+	}
+
+	crtbs, err := c.crtbLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(crtbs, func(i, j int) bool { return crtbs[i].Name < crtbs[j].Name })
+	for _, crtb := range crtbs {
+		crtb = crtb.DeepCopy()
+		crtb.TypeMeta = typeMeta(v3.ClusterRoleTemplateBindingGroupVersionKind.Kind)
+		crtb.ObjectMeta = cleanMeta(crtb.ObjectMeta)
+		objects = append(objects, crtb)
+	}
+
+	projects, err := c.projectLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+	for _, project := range projects {
+		prtbs, err := c.prtbLister.List(project.Name, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		project = project.DeepCopy()
+		project.TypeMeta = typeMeta(v3.ProjectGroupVersionKind.Kind)
+		project.ObjectMeta = cleanMeta(project.ObjectMeta)
+		project.Status = v32.ProjectStatus{}
+		objects = append(objects, project)
+
+		sort.Slice(prtbs, func(i, j int) bool { return prtbs[i].Name < prtbs[j].Name })
+		for _, prtb := range prtbs {
+			prtb = prtb.DeepCopy()
+			prtb.TypeMeta = typeMeta(v3.ProjectRoleTemplateBindingGroupVersionKind.Kind)
+			prtb.ObjectMeta = cleanMeta(prtb.ObjectMeta)
+			objects = append(objects, prtb)
+		}
+	}
+
+	var content []byte
+	for _, object := range objects {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, "---\n"...)
+		content = append(content, data...)
+	}
+	return content, nil
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: v3.SchemeGroupVersion.String(),
+		Kind:       kind,
+	}
+}
+
+func cleanMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
@@ -0,0 +1,146 @@
+package decommission
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExportable(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    string
+		resource metav1.APIResource
+		expected bool
+	}{
+		{
+			name:     "listable resource",
+			resource: metav1.APIResource{Name: "configmaps", Verbs: []string{"get", "list"}},
+			expected: true,
+		},
+		{
+			name:     "subresource",
+			resource: metav1.APIResource{Name: "pods/log", Verbs: []string{"get", "list"}},
+		},
+		{
+			name:     "core events",
+			resource: metav1.APIResource{Name: "events", Verbs: []string{"list"}},
+		},
+		{
+			name:     "events.k8s.io events",
+			group:    "events.k8s.io",
+			resource: metav1.APIResource{Name: "events", Verbs: []string{"list"}},
+		},
+		{
+			name:     "custom events resource",
+			group:    "example.com",
+			resource: metav1.APIResource{Name: "events", Verbs: []string{"list"}},
+			expected: true,
+		},
+		{
+			name:     "secrets",
+			resource: metav1.APIResource{Name: "secrets", Verbs: []string{"list"}},
+		},
+		{
+			name:     "resource that can not be listed",
+			resource: metav1.APIResource{Name: "bindings", Verbs: []string{"create"}},
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, exportable(tt.group, tt.resource), tt.name)
+	}
+}
+
+func TestCleanObject(t *testing.T) {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":              "settings",
+			"namespace":         "default",
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2020-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "web"},
+		},
+		"data":   map[string]interface{}{"key": "value"},
+		"status": map[string]interface{}{"phase": "Active"},
+	}}
+
+	cleaned := cleanObject(object)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":      "settings",
+		"namespace": "default",
+		"labels":    map[string]interface{}{"app": "web"},
+	}, cleaned.Object["metadata"])
+	assert.NotContains(t, cleaned.Object, "status")
+	assert.Equal(t, map[string]interface{}{"key": "value"}, cleaned.Object["data"])
+	// the listed object is left untouched
+	assert.Contains(t, object.Object, "status")
+}
+
+func TestArchive(t *testing.T) {
+	configMap := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		}}
+	}
+	files := map[string][]*unstructured.Unstructured{
+		fileName("kube-system", "configmaps", ""):                      {configMap("c")},
+		fileName("default", "configmaps", ""):                          {configMap("a"), configMap("b")},
+		fileName("default", "deployments", "apps"):                     nil,
+		fileName("default", "certificates", "cert-manager.io"):         nil,
+		fileName("default", "ingresses", "networking.k8s.io"):          nil,
+		fileName("default", "horizontalpodautoscalers", "autoscaling"): nil,
+	}
+
+	var buf bytes.Buffer
+	archive := newArchive(&buf, time.Unix(0, 0))
+	assert.Nil(t, archive.add(files))
+	assert.Nil(t, archive.close())
+
+	gz, err := gzip.NewReader(&buf)
+	assert.Nil(t, err)
+	tr := tar.NewReader(gz)
+	contents := map[string]string{}
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		content, err := ioutil.ReadAll(tr)
+		assert.Nil(t, err)
+		names = append(names, header.Name)
+		contents[header.Name] = string(content)
+	}
+
+	assert.Equal(t, []string{
+		"default/certificates.cert-manager.io.yaml",
+		"default/configmaps.yaml",
+		"default/deployments.apps.yaml",
+		"default/horizontalpodautoscalers.autoscaling.yaml",
+		"default/ingresses.networking.k8s.io.yaml",
+		"kube-system/configmaps.yaml",
+	}, names)
+	assert.Equal(t, "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n  namespace: default\n"+
+		"---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n  namespace: default\n", contents["default/configmaps.yaml"])
+	assert.Equal(t, "", contents["default/deployments.apps.yaml"])
+}
+
+func TestObjectPrefix(t *testing.T) {
+	started := time.Date(2020, 10, 1, 12, 30, 5, 0, time.UTC)
+	assert.Equal(t, "decommission/c-abcde/20201001T123005Z", objectPrefix("", "c-abcde", started))
+	assert.Equal(t, "backups/decommission/c-abcde/20201001T123005Z", objectPrefix("backups", "c-abcde", started))
+}
@@ -0,0 +1,119 @@
+package decommission
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	minio "github.com/minio/minio-go"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+)
+
+const (
+	s3TransportTimeout = 30
+	defaultS3Endpoint  = "s3.amazonaws.com"
+
+	resourcesArtifact = "resources.tar.gz"
+	configArtifact    = "rancher-config.yaml"
+)
+
+// target stores the artifacts of a decommission under <folder>/decommission/<cluster>/<start time>/ in a bucket
+type target struct {
+	config *rketypes.S3BackupConfig
+	client *minio.Client
+	prefix string
+}
+
+func newTarget(config *rketypes.S3BackupConfig, clusterName string, started time.Time) (*target, error) {
+	client, err := etcdbackup.GetS3Client(config, s3TransportTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &target{
+		config: config,
+		client: client,
+		prefix: objectPrefix(config.Folder, clusterName, started),
+	}, nil
+}
+
+func objectPrefix(folder, clusterName string, started time.Time) string {
+	return path.Join(folder, "decommission", clusterName, started.UTC().Format("20060102T150405Z"))
+}
+
+// putFile writes an artifact to a temporary file before storing it, the export of a large cluster is not held in memory
+func (t *target) putFile(name string, write func(w io.Writer) error) (string, error) {
+	file, err := ioutil.TempFile("", "decommission-")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if err := write(file); err != nil {
+		return "", err
+	}
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	key := path.Join(t.prefix, name)
+	if _, err := t.client.PutObject(t.config.BucketName, key, file, size, minio.PutObjectOptions{}); err != nil {
+		return "", fmt.Errorf("failed to store %s in bucket %s: %v", key, t.config.BucketName, err)
+	}
+	return t.url(key), nil
+}
+
+func (t *target) put(name string, data []byte) (string, error) {
+	key := path.Join(t.prefix, name)
+	if _, err := t.client.PutObject(t.config.BucketName, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{}); err != nil {
+		return "", fmt.Errorf("failed to store %s in bucket %s: %v", key, t.config.BucketName, err)
+	}
+	return t.url(key), nil
+}
+
+// copySnapshot copies the snapshot of an etcd backup stored in S3 to the target
+func (t *target) copySnapshot(backup *v3.EtcdBackup) (string, error) {
+	source := backup.Spec.BackupConfig.S3BackupConfig
+	if source == nil {
+		return "", fmt.Errorf("etcd backup %s is not stored in S3", backup.Name)
+	}
+	sourceClient, err := etcdbackup.GetS3Client(source, s3TransportTimeout)
+	if err != nil {
+		return "", err
+	}
+	filename := path.Base(backup.Spec.Filename)
+	sourceKey := path.Join(source.Folder, filename)
+	object, err := sourceClient.GetObject(source.BucketName, sourceKey, minio.GetObjectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to read etcd snapshot %s: %v", sourceKey, err)
+	}
+	defer object.Close()
+	info, err := object.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to read etcd snapshot %s: %v", sourceKey, err)
+	}
+
+	key := path.Join(t.prefix, "etcd-"+filename)
+	if _, err := t.client.PutObject(t.config.BucketName, key, object, info.Size, minio.PutObjectOptions{}); err != nil {
+		return "", fmt.Errorf("failed to store %s in bucket %s: %v", key, t.config.BucketName, err)
+	}
+	return t.url(key), nil
+}
+
+func (t *target) url(key string) string {
+	endpoint := t.config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+	return fmt.Sprintf("https://%s/%s/%s", endpoint, t.config.BucketName, key)
+}
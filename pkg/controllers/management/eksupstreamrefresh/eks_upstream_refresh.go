@@ -1,6 +1,7 @@
 package eksupstreamrefresh
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,6 +11,7 @@
 	apimgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
 	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/peermanager"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/wrangler"
 	wranglerv1 "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
@@ -40,9 +42,13 @@ type eksRefreshController struct {
 	secretsCache   wranglerv1.SecretCache
 	clusterClient  v3.ClusterClient
 	clusterCache   v3.ClusterCache
+	peerManager    peermanager.PeerManager
 }
 
-func StartEKSUpstreamCronJob(wContext *wrangler.Context) {
+// StartEKSUpstreamCronJob refreshes the upstream state of the EKS clusters on every server, each server refreshes the
+// clusters it owns, see peermanager.IsOwner
+func StartEKSUpstreamCronJob(ctx context.Context, wContext *wrangler.Context, peerManager peermanager.PeerManager) {
+	eksUpstreamRefresher.peerManager = peerManager
 	eksUpstreamRefresher.secretsCache = wContext.Core.Secret().Cache()
 	eksUpstreamRefresher.clusterClient = wContext.Mgmt.Cluster()
 	eksUpstreamRefresher.clusterCache = wContext.Mgmt.Cluster().Cache()
@@ -61,6 +67,9 @@ func StartEKSUpstreamCronJob(wContext *wrangler.Context) {
 	}
 	eksUpstreamRefresher.refreshCronJob.Schedule(schedule, cron.FuncJob(eksUpstreamRefresher.refreshAllUpstreamStates))
 	eksUpstreamRefresher.refreshCronJob.Start()
+
+	// every server runs the cron job, they all follow the changes of its schedule
+	Register(ctx, wContext)
 }
 
 func (e *eksRefreshController) refreshAllUpstreamStates() {
@@ -72,6 +81,9 @@ func (e *eksRefreshController) refreshAllUpstreamStates() {
 	}
 
 	for _, cluster := range clusters {
+		if !peermanager.IsOwner(e.peerManager, cluster.Name) {
+			continue
+		}
 		if _, err := e.refreshClusterUpstreamSpec(cluster); err != nil {
 			logrus.Errorf("error refreshing EKS cluster [%s] upstream state", cluster.Name)
 		}
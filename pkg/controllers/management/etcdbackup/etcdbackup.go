@@ -25,6 +25,7 @@
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/rkedialerfactory"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/wrangler/pkg/ticker"
@@ -165,12 +166,7 @@ func (c *Controller) doClusterBackupSync(cluster *v3.Cluster) error {
 	// cluster has no backups, we need to kick a new one.
 	if len(clusterBackups) == 0 {
 		logrus.Infof("[etcd-backup] Cluster [%s] has no backups, creating first backup", cluster.Name)
-		newBackup, err := c.createNewBackup(cluster)
-		if err != nil {
-			return fmt.Errorf("[etcd-backup] Backup create failed:%v", err)
-		}
-		logrus.Infof("[etcd-backup] Cluster [%s] new backup is created: %s", cluster.Name, newBackup.Name)
-		return nil
+		return c.createRecurringBackup(cluster, "first backup")
 	}
 
 	newestBackup := clusterBackups[0]
@@ -186,17 +182,42 @@ func (c *Controller) doClusterBackupSync(cluster *v3.Cluster) error {
 	backupIntervalHours := time.Duration(intervalHours) * time.Hour
 
 	if time.Since(getBackupCompletedTime(newestBackup)) > backupIntervalHours {
-		newBackup, err := c.createNewBackup(cluster)
-		if err != nil {
-			return fmt.Errorf("[etcd-backup] Backup create failed:%v", err)
+		if err := c.createRecurringBackup(cluster, fmt.Sprintf("backup every %d hours", intervalHours)); err != nil {
+			return err
 		}
-		logrus.Infof("[etcd-backup] New backup created: %s", newBackup.Name)
 	}
 
 	// rotate old backups
 	return c.rotateExpiredBackups(cluster, clusterBackups)
 }
 
+// createRecurringBackup creates a backup when the maintenance window of the cluster is open and plans it for the next
+// window otherwise
+func (c *Controller) createRecurringBackup(cluster *v3.Cluster, reason string) error {
+	open, next, err := maintenance.IsOpen(cluster.Spec.MaintenanceWindow, time.Now())
+	if err != nil {
+		return fmt.Errorf("[etcd-backup] invalid maintenance window for cluster [%s]: %v", cluster.Name, err)
+	}
+	toUpdate := cluster.DeepCopy()
+	if !open {
+		logrus.Debugf("[etcd-backup] Cluster [%s] backup is waiting for the maintenance window at %s", cluster.Name, next)
+		if maintenance.Plan(toUpdate, v32.MaintenanceActionEtcdBackup, reason, next) {
+			_, err = c.clusterClient.Update(toUpdate)
+		}
+		return err
+	}
+
+	newBackup, err := c.createNewBackup(cluster)
+	if err != nil {
+		return fmt.Errorf("[etcd-backup] Backup create failed:%v", err)
+	}
+	logrus.Infof("[etcd-backup] Cluster [%s] new backup is created: %s", cluster.Name, newBackup.Name)
+	if maintenance.Done(toUpdate, v32.MaintenanceActionEtcdBackup) {
+		_, err = c.clusterClient.Update(toUpdate)
+	}
+	return err
+}
+
 func (c *Controller) createNewBackup(cluster *v3.Cluster) (*v3.EtcdBackup, error) {
 	newBackup, err := NewBackupObject(cluster, false)
 	if err != nil {
@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	app2 "github.com/rancher/rancher/pkg/app"
 
@@ -12,6 +13,7 @@
 	"github.com/coreos/go-semver/semver"
 	"github.com/rancher/rancher/pkg/catalog/utils"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/project"
 	"github.com/rancher/rancher/pkg/ref"
@@ -73,6 +75,30 @@ func (h *handler) onClusterChange(key string, cluster *v3.Cluster) (*v3.Cluster,
 		}
 
 	}
+
+	// upgrades wait for the maintenance window, those in progress are finished
+	if !v32.ClusterConditionUpgraded.IsUnknown(cluster) {
+		open, next, err := maintenance.IsOpen(cluster.Spec.MaintenanceWindow, time.Now())
+		if err != nil {
+			return cluster, err
+		}
+		if !open {
+			cluster = cluster.DeepCopy()
+			if maintenance.Plan(cluster, v32.MaintenanceActionK3sBasedUpgrade, fmt.Sprintf("upgrade to %s", updateVersion), next) {
+				if cluster, err = h.clusterClient.Update(cluster); err != nil {
+					return cluster, err
+				}
+			}
+			h.clusterEnqueueAfter(cluster.Name, time.Until(next))
+			return cluster, nil
+		}
+	}
+	if toUpdate := cluster.DeepCopy(); maintenance.Done(toUpdate, v32.MaintenanceActionK3sBasedUpgrade) {
+		if cluster, err = h.clusterClient.Update(toUpdate); err != nil {
+			return cluster, err
+		}
+	}
+
 	// set cluster upgrading status
 	cluster, err = h.modifyClusterCondition(cluster, planv1.Plan{}, planv1.Plan{}, strategy)
 	if err != nil {
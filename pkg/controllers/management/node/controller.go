@@ -464,9 +464,61 @@ func (m *Lifecycle) Updated(obj *v3.Node) (runtime.Object, error) {
 		}
 		return obj, err
 	})
+	if err == nil && newObj.(*v3.Node).Annotations[v32.NodeRebootAnnotation] != "" {
+		return m.reboot(newObj.(*v3.Node))
+	}
 	return newObj.(*v3.Node), err
 }
 
+// reboot restarts the machine of a node through its node driver when the node pool health check asks for it, the
+// outcome is added to the remediation the health check recorded on the node
+func (m *Lifecycle) reboot(obj *v3.Node) (*v3.Node, error) {
+	if obj.Status.NodeTemplateSpec == nil {
+		delete(obj.Annotations, v32.NodeRebootAnnotation)
+		return obj, nil
+	}
+
+	if !m.devMode {
+		err := jailer.CreateJail(obj.Namespace)
+		if err != nil {
+			return obj, errors.WithMessage(err, "node reboot jail error")
+		}
+	}
+
+	config, err := nodeconfig.NewNodeConfig(m.secretStore, obj)
+	if err != nil {
+		return obj, err
+	}
+	if err := config.Restore(); err != nil {
+		return obj, err
+	}
+	defer config.Remove()
+
+	if err := m.refreshNodeConfig(config, obj); err != nil {
+		return obj, errors.WithMessagef(err, "unable to refresh config for node %v", obj.Name)
+	}
+
+	logrus.Infof("Rebooting node %s", obj.Spec.RequestedHostname)
+	result := "rebooted"
+	if err := restartNode(config.Dir(), obj); err != nil {
+		logrus.Errorf("Rebooting node %s failed: %v", obj.Spec.RequestedHostname, err)
+		result = "reboot failed: " + err.Error()
+	} else {
+		logrus.Infof("Rebooting node %s done", obj.Spec.RequestedHostname)
+	}
+
+	if n := len(obj.Status.Remediations); n > 0 && obj.Status.Remediations[n-1].Action == v32.RemediationReboot {
+		remediation := &obj.Status.Remediations[n-1]
+		if remediation.Message == "" {
+			remediation.Message = result
+		} else {
+			remediation.Message += ", " + result
+		}
+	}
+	delete(obj.Annotations, v32.NodeRebootAnnotation)
+	return obj, nil
+}
+
 func (m *Lifecycle) saveConfig(config *nodeconfig.NodeConfig, nodeDir string, obj *v3.Node) (*v3.Node, error) {
 	logrus.Infof("Generating and uploading node config %s", obj.Spec.RequestedHostname)
 	if err := config.Save(); err != nil {
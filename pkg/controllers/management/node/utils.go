@@ -251,7 +251,15 @@ func nodeExists(nodeDir string, node *v3.Node) (bool, error) {
 }
 
 func deleteNode(nodeDir string, node *v3.Node) error {
-	command, err := buildCommand(nodeDir, node, []string{"rm", "-f", node.Spec.RequestedHostname})
+	return runMachineCommand(nodeDir, node, "rm", "-f", node.Spec.RequestedHostname)
+}
+
+func restartNode(nodeDir string, node *v3.Node) error {
+	return runMachineCommand(nodeDir, node, "restart", node.Spec.RequestedHostname)
+}
+
+func runMachineCommand(nodeDir string, node *v3.Node, args ...string) error {
+	command, err := buildCommand(nodeDir, node, args)
 	if err != nil {
 		return err
 	}
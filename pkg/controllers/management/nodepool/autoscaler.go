@@ -0,0 +1,541 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	nodehelper "github.com/rancher/rancher/pkg/node"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	autoscaleInterval = 30 * time.Second
+	// scaleDownDelay is how long a pool has to be left alone after it was scaled before it is considered for scale down
+	scaleDownDelay = 10 * time.Minute
+	// scaleDownUtilization is the share of allocatable cpu and memory requested on a node below which it can be removed
+	scaleDownUtilization = 0.5
+	// scaleDownAnnotation marks a node the autoscaler drains for removal, the value is when the drain was requested
+	scaleDownAnnotation = "nodepool.cattle.io/scale-down"
+
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+type scaleAction int
+
+const (
+	scaleNone scaleAction = iota
+	scaleUp
+	scaleDown
+	scaleDownDrain
+	scaleDownCancel
+)
+
+// scalePlan is the autoscaler decision for a node pool
+type scalePlan struct {
+	action   scaleAction
+	quantity int
+	node     *v3.Node
+	reason   string
+}
+
+type autoscaler struct {
+	ctx            context.Context
+	nodePools      v3.NodePoolInterface
+	nodePoolLister v3.NodePoolLister
+	nodes          v3.NodeInterface
+	nodeLister     v3.NodeLister
+	clusterLister  v3.ClusterLister
+	events         corev1.EventInterface
+	clusterManager *clustermanager.Manager
+}
+
+func registerAutoscaler(ctx context.Context, management *config.ManagementContext, manager *clustermanager.Manager) {
+	a := &autoscaler{
+		ctx:            ctx,
+		nodePools:      management.Management.NodePools(""),
+		nodePoolLister: management.Management.NodePools("").Controller().Lister(),
+		nodes:          management.Management.Nodes(""),
+		nodeLister:     management.Management.Nodes("").Controller().Lister(),
+		clusterLister:  management.Management.Clusters("").Controller().Lister(),
+		events:         management.Core.Events(""),
+		clusterManager: manager,
+	}
+	a.nodePools.AddHandler(ctx, "nodepool-autoscaler", a.sync)
+}
+
+func (a *autoscaler) sync(key string, nodePool *v3.NodePool) (runtime.Object, error) {
+	if nodePool == nil || nodePool.DeletionTimestamp != nil || !nodePool.Spec.AutoscalingEnabled() || !isWorkerOnly(nodePool) {
+		return nodePool, nil
+	}
+	// the rollout replaces nodes above and below the quantity on its own
+	if rollingOut(nodePool) {
+		a.nodePools.Controller().EnqueueAfter(nodePool.Namespace, nodePool.Name, autoscaleInterval)
+		return nodePool, nil
+	}
+
+	if err := a.autoscale(nodePool); err != nil {
+		return nodePool, err
+	}
+
+	a.nodePools.Controller().EnqueueAfter(nodePool.Namespace, nodePool.Name, autoscaleInterval)
+	return nodePool, nil
+}
+
+func (a *autoscaler) autoscale(nodePool *v3.NodePool) error {
+	cluster, err := a.clusterLister.Get("", nodePool.Spec.ClusterName)
+	if err != nil {
+		return err
+	}
+	if !v32.ClusterConditionReady.IsTrue(cluster) {
+		return nil
+	}
+
+	nodes, err := a.poolNodes(nodePool)
+	if err != nil {
+		return err
+	}
+	// wait for the node pool controller to settle the pool on its quantity before deciding again
+	if len(nodes) != nodePool.Spec.Quantity {
+		return nil
+	}
+
+	userContext, err := a.clusterManager.UserContext(cluster.Name)
+	if err != nil {
+		return err
+	}
+	podList, err := userContext.K8sClient.CoreV1().Pods(metav1.NamespaceAll).List(a.ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return fmt.Errorf("listing pods of cluster [%s]: %v", cluster.Name, err)
+	}
+
+	scaleUpAllowed, err := a.scaleUpAllowed(nodePool, podList.Items)
+	if err != nil {
+		return err
+	}
+
+	plan := planScale(nodePool, nodes, podList.Items, scaleUpAllowed, time.Now())
+	return a.apply(nodePool, plan)
+}
+
+// scaleUpAllowed picks the first autoscaled pool by name that has room for the unschedulable pods so a cluster
+// with several autoscaled pools adds nodes to one pool at a time
+func (a *autoscaler) scaleUpAllowed(nodePool *v3.NodePool, pods []v1.Pod) (bool, error) {
+	pools, err := a.nodePoolLister.List(nodePool.Namespace, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	var candidates []string
+	for _, pool := range pools {
+		if pool.DeletionTimestamp != nil || !pool.Spec.AutoscalingEnabled() || !isWorkerOnly(pool) ||
+			pool.Spec.Quantity >= pool.Spec.MaxQuantity || len(pendingPods(pods, pool)) == 0 {
+			continue
+		}
+		candidates = append(candidates, pool.Name)
+	}
+	sort.Strings(candidates)
+	return len(candidates) > 0 && candidates[0] == nodePool.Name, nil
+}
+
+func (a *autoscaler) poolNodes(nodePool *v3.NodePool) ([]*v3.Node, error) {
+	return poolNodes(a.nodeLister, nodePool)
+}
+
+// poolNodes lists the nodes of a pool that are not being deleted
+func poolNodes(nodeLister v3.NodeLister, nodePool *v3.NodePool) ([]*v3.Node, error) {
+	allNodes, err := nodeLister.List(nodePool.Namespace, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*v3.Node
+	for _, node := range allNodes {
+		_, nodePoolName := ref.Parse(node.Spec.NodePoolName)
+		if nodePoolName != nodePool.Name || node.DeletionTimestamp != nil {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (a *autoscaler) apply(nodePool *v3.NodePool, plan scalePlan) error {
+	switch plan.action {
+	case scaleUp, scaleDown:
+		poolCopy := nodePool.DeepCopy()
+		poolCopy.Spec.Quantity = plan.quantity
+		poolCopy.Status.LastScaleTime = time.Now().UTC().Format(time.RFC3339)
+		if _, err := a.nodePools.Update(poolCopy); err != nil {
+			return err
+		}
+		reason := "ScaledUp"
+		if plan.action == scaleDown {
+			reason = "ScaledDown"
+		}
+		a.recordEvent(nodePool, v1.EventTypeNormal, reason, fmt.Sprintf("Scaled from %d to %d nodes: %s", nodePool.Spec.Quantity, plan.quantity, plan.reason))
+	case scaleDownDrain:
+		if err := drainNode(a.nodes, plan.node, scaleDownAnnotation, nil); err != nil {
+			return err
+		}
+		a.recordEvent(nodePool, v1.EventTypeNormal, "ScaleDownStarted", fmt.Sprintf("Draining node %s: %s", nodeName(plan.node), plan.reason))
+	case scaleDownCancel:
+		nodeCopy := plan.node.DeepCopy()
+		delete(nodeCopy.Annotations, scaleDownAnnotation)
+		nodeCopy.Spec.DesiredNodeUnschedulable = "false"
+		if _, err := a.nodes.Update(nodeCopy); err != nil {
+			return err
+		}
+		a.recordEvent(nodePool, v1.EventTypeWarning, "ScaleDownCanceled", fmt.Sprintf("Stopped removing node %s: %s", nodeName(plan.node), plan.reason))
+	default:
+		if plan.reason != "" {
+			logrus.Debugf("[nodepool-autoscaler] not scaling nodepool [%s:%s]: %s", nodePool.Namespace, nodePool.Name, plan.reason)
+		}
+	}
+	return nil
+}
+
+func (a *autoscaler) recordEvent(nodePool *v3.NodePool, eventType, reason, message string) {
+	recordPoolEvent(a.events, "nodepool-autoscaler", nodePool, eventType, reason, message)
+}
+
+func recordPoolEvent(events corev1.EventInterface, component string, nodePool *v3.NodePool, eventType, reason, message string) {
+	apiVersion, kind := v3.NodePoolGroupVersionKind.ToAPIVersionAndKind()
+	now := metav1.Now()
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: nodePool.Name + "-",
+			Namespace:    nodePool.Namespace,
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion:      apiVersion,
+			Kind:            kind,
+			Namespace:       nodePool.Namespace,
+			Name:            nodePool.Name,
+			UID:             nodePool.UID,
+			ResourceVersion: nodePool.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Count:          1,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Source:         v1.EventSource{Component: component},
+	}
+	if _, err := events.Create(event); err != nil {
+		logrus.Warnf("[%s] failed to record event for nodepool [%s:%s]: %v", component, nodePool.Namespace, nodePool.Name, err)
+	}
+}
+
+// planScale decides how to scale a node pool from its live nodes and the pods of the downstream cluster
+func planScale(nodePool *v3.NodePool, nodes []*v3.Node, pods []v1.Pod, scaleUpAllowed bool, now time.Time) scalePlan {
+	spec := nodePool.Spec
+	if spec.Quantity < spec.MinQuantity {
+		return scalePlan{action: scaleUp, quantity: spec.MinQuantity, reason: "quantity is below the minimum"}
+	}
+	if spec.Quantity > spec.MaxQuantity {
+		return scalePlan{action: scaleDown, quantity: spec.MaxQuantity, reason: "quantity is above the maximum"}
+	}
+
+	pending := pendingPods(pods, nodePool)
+
+	for _, node := range nodes {
+		if node.Annotations[scaleDownAnnotation] == "" {
+			continue
+		}
+		done, failure := drainResult(node, scaleDownAnnotation)
+		switch {
+		case failure != "":
+			return scalePlan{action: scaleDownCancel, node: node, reason: "drain failed: " + failure}
+		case len(pending) > 0:
+			return scalePlan{action: scaleDownCancel, node: node, reason: fmt.Sprintf("%d pods are unschedulable", len(pending))}
+		case spec.Quantity <= spec.MinQuantity:
+			return scalePlan{action: scaleDownCancel, node: node, reason: "pool is at its minimum quantity"}
+		case done:
+			return scalePlan{action: scaleDown, quantity: spec.Quantity - 1, node: node, reason: fmt.Sprintf("node %s was drained", nodeName(node))}
+		}
+		return scalePlan{reason: fmt.Sprintf("waiting for node %s to drain", nodeName(node))}
+	}
+
+	var ready, notReady []*v3.Node
+	for _, node := range nodes {
+		if isNodeAvailable(node) {
+			ready = append(ready, node)
+			continue
+		}
+		// a node that never registered is still coming up, and an unreachable one gets replaced by the node pool
+		// controller when deleteNotReadyAfterSecs is set; either way the pool capacity is still changing
+		if !v32.NodeConditionRegistered.IsTrue(node) || spec.DeleteNotReadyAfterSecs > 0 {
+			return scalePlan{reason: fmt.Sprintf("waiting for node %s to become ready", nodeName(node))}
+		}
+		notReady = append(notReady, node)
+	}
+
+	if len(pending) > 0 {
+		if !scaleUpAllowed || spec.Quantity >= spec.MaxQuantity {
+			return scalePlan{reason: fmt.Sprintf("%d pods are unschedulable but the pool can not grow", len(pending))}
+		}
+		var allocatable v1.ResourceList
+		if len(ready) > 0 {
+			allocatable = ready[0].Status.InternalNodeStatus.Allocatable
+		}
+		quantity := spec.Quantity + nodesNeeded(pending, allocatable)
+		if quantity > spec.MaxQuantity {
+			quantity = spec.MaxQuantity
+		}
+		return scalePlan{action: scaleUp, quantity: quantity, reason: fmt.Sprintf("%d pods are unschedulable", len(pending))}
+	}
+
+	if spec.Quantity <= spec.MinQuantity || len(notReady) > 0 {
+		return scalePlan{}
+	}
+	lastScale := nodePool.CreationTimestamp.Time
+	if t, err := time.Parse(time.RFC3339, nodePool.Status.LastScaleTime); err == nil {
+		lastScale = t
+	}
+	if now.Sub(lastScale) < scaleDownDelay {
+		return scalePlan{}
+	}
+
+	if node, utilization := scaleDownCandidate(ready, pods); node != nil {
+		return scalePlan{action: scaleDownDrain, node: node, reason: fmt.Sprintf("%.0f%% of the node is requested", utilization*100)}
+	}
+	return scalePlan{}
+}
+
+// pendingPods returns the pods the scheduler could not place that would fit the labels and taints of the pool nodes
+func pendingPods(pods []v1.Pod, nodePool *v3.NodePool) []*v1.Pod {
+	var result []*v1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != v1.PodPending || pod.Spec.NodeName != "" || !isUnschedulable(pod) {
+			continue
+		}
+		if !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(nodePool.Spec.NodeLabels)) {
+			continue
+		}
+		if !toleratesTaints(pod, nodePool.Spec.NodeTaints) {
+			continue
+		}
+		result = append(result, pod)
+	}
+	return result
+}
+
+func isUnschedulable(pod *v1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == v1.PodScheduled {
+			return cond.Status == v1.ConditionFalse && cond.Reason == v1.PodReasonUnschedulable
+		}
+	}
+	return false
+}
+
+func toleratesTaints(pod *v1.Pod, taints []v1.Taint) bool {
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range pod.Spec.Tolerations {
+			if toleration.ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// nodesNeeded estimates how many nodes with the given allocatable resources the pods need
+func nodesNeeded(pods []*v1.Pod, allocatable v1.ResourceList) int {
+	var cpu, memory int64
+	for _, pod := range pods {
+		podCPU, podMemory := podRequests(pod)
+		cpu += podCPU
+		memory += podMemory
+	}
+
+	needed := 1
+	if allocCPU := allocatable.Cpu().MilliValue(); allocCPU > 0 {
+		if n := int(math.Ceil(float64(cpu) / float64(allocCPU))); n > needed {
+			needed = n
+		}
+	}
+	if allocMemory := allocatable.Memory().Value(); allocMemory > 0 {
+		if n := int(math.Ceil(float64(memory) / float64(allocMemory))); n > needed {
+			needed = n
+		}
+	}
+	return needed
+}
+
+// scaleDownCandidate returns the least utilized node below the scale down threshold whose pods fit on the other nodes
+func scaleDownCandidate(nodes []*v3.Node, pods []v1.Pod) (*v3.Node, float64) {
+	type usage struct {
+		cpu, memory               int64
+		movableCPU, movableMemory int64
+		unmovable                 bool
+	}
+	byNode := map[string]*usage{}
+	for _, node := range nodes {
+		byNode[node.Status.NodeName] = &usage{}
+	}
+	for i := range pods {
+		pod := &pods[i]
+		u, ok := byNode[pod.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		cpu, memory := podRequests(pod)
+		u.cpu += cpu
+		u.memory += memory
+		if isDaemonSetPod(pod) || pod.Annotations[mirrorPodAnnotation] != "" {
+			continue
+		}
+		if len(pod.OwnerReferences) == 0 {
+			// drain does not evict pods without a controller
+			u.unmovable = true
+		}
+		u.movableCPU += cpu
+		u.movableMemory += memory
+	}
+
+	var (
+		candidate   *v3.Node
+		lowest      = math.MaxFloat64
+		freeCPU     int64
+		freeMemory  int64
+		utilization = map[string]float64{}
+	)
+	for _, node := range nodes {
+		u := byNode[node.Status.NodeName]
+		allocatable := node.Status.InternalNodeStatus.Allocatable
+		freeCPU += allocatable.Cpu().MilliValue() - u.cpu
+		freeMemory += allocatable.Memory().Value() - u.memory
+		utilization[node.Name] = math.Max(ratio(u.cpu, allocatable.Cpu().MilliValue()), ratio(u.memory, allocatable.Memory().Value()))
+	}
+
+	for _, node := range nodes {
+		u := byNode[node.Status.NodeName]
+		allocatable := node.Status.InternalNodeStatus.Allocatable
+		if u.unmovable || utilization[node.Name] >= scaleDownUtilization || utilization[node.Name] >= lowest {
+			continue
+		}
+		// the free capacity left on the other nodes has to hold the pods of this node
+		otherCPU := freeCPU - (allocatable.Cpu().MilliValue() - u.cpu)
+		otherMemory := freeMemory - (allocatable.Memory().Value() - u.memory)
+		if u.movableCPU > otherCPU || u.movableMemory > otherMemory {
+			continue
+		}
+		candidate, lowest = node, utilization[node.Name]
+	}
+	return candidate, lowest
+}
+
+func ratio(used, allocatable int64) float64 {
+	if allocatable <= 0 {
+		return 1
+	}
+	return float64(used) / float64(allocatable)
+}
+
+// podRequests returns the cpu in millicores and memory in bytes requested by the containers of a pod
+func podRequests(pod *v1.Pod) (cpu, memory int64) {
+	for _, container := range pod.Spec.Containers {
+		cpu += container.Resources.Requests.Cpu().MilliValue()
+		memory += container.Resources.Requests.Memory().Value()
+	}
+	return cpu, memory
+}
+
+func isDaemonSetPod(pod *v1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
+
+// drainNode cordons and drains a node for removal and marks it with annotation
+func drainNode(nodes v3.NodeInterface, node *v3.Node, annotation string, drainInput *v32.NodeDrainInput) error {
+	if drainInput == nil {
+		ignoreDaemonSets := true
+		drainInput = &v32.NodeDrainInput{
+			IgnoreDaemonSets: &ignoreDaemonSets,
+			DeleteLocalData:  true,
+			GracePeriod:      -1,
+			Timeout:          120,
+		}
+	}
+
+	nodeCopy := node.DeepCopy()
+	if nodeCopy.Annotations == nil {
+		nodeCopy.Annotations = map[string]string{}
+	}
+	nodeCopy.Annotations[annotation] = time.Now().UTC().Format(time.RFC3339)
+	nodeCopy.Spec.DesiredNodeUnschedulable = "drain"
+	nodeCopy.Spec.NodeDrainInput = drainInput
+	_, err := nodes.Update(nodeCopy)
+	return err
+}
+
+// drainResult reports whether the drain marked by annotation on a node finished or why it failed
+func drainResult(node *v3.Node, annotation string) (bool, string) {
+	requested, err := time.Parse(time.RFC3339, node.Annotations[annotation])
+	if err != nil {
+		return false, ""
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type != v32.NodeConditionDrained {
+			continue
+		}
+		// ignore the outcome of drains that happened before this one was requested
+		updated, err := time.Parse(time.RFC3339, cond.LastUpdateTime)
+		if err != nil || updated.Before(requested) {
+			return false, ""
+		}
+		switch cond.Status {
+		case v1.ConditionTrue:
+			return true, ""
+		case v1.ConditionFalse:
+			return false, cond.Message
+		}
+	}
+	return false, ""
+}
+
+func isNodeAvailable(node *v3.Node) bool {
+	return v32.NodeConditionRegistered.IsTrue(node) && nodehelper.IsMachineReady(node) && node.Status.NodeName != ""
+}
+
+func isWorkerOnly(nodePool *v3.NodePool) bool {
+	return nodePool.Spec.Worker && !nodePool.Spec.Etcd && !nodePool.Spec.ControlPlane
+}
+
+func nodeName(node *v3.Node) string {
+	if node.Spec.RequestedHostname != "" {
+		return node.Spec.RequestedHostname
+	}
+	return node.Name
+}
@@ -0,0 +1,282 @@
+package nodepool
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func testPool(quantity, min, max int) *v3.NodePool {
+	return &v3.NodePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "np-1",
+			Namespace:         "c-1",
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+		},
+		Spec: v32.NodePoolSpec{
+			Worker:      true,
+			Quantity:    quantity,
+			MinQuantity: min,
+			MaxQuantity: max,
+			NodeLabels:  map[string]string{"pool": "workers"},
+		},
+	}
+}
+
+func testNode(name string, ready bool) *v3.Node {
+	node := &v3.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c-1"},
+		Spec:       v32.NodeSpec{RequestedHostname: name},
+		Status: v32.NodeStatus{
+			NodeName: name,
+			InternalNodeStatus: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("2"),
+					v1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		},
+	}
+	v32.NodeConditionRegistered.True(node)
+	status := v1.ConditionTrue
+	if !ready {
+		status = v1.ConditionUnknown
+	}
+	node.Status.InternalNodeStatus.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: status}}
+	return node
+}
+
+func testPod(name, nodeName, cpu string) v1.Pod {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs"}},
+		},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	if nodeName == "" {
+		pod.Status.Phase = v1.PodPending
+		pod.Status.Conditions = []v1.PodCondition{{
+			Type:   v1.PodScheduled,
+			Status: v1.ConditionFalse,
+			Reason: v1.PodReasonUnschedulable,
+		}}
+	}
+	return pod
+}
+
+func drainingNode(name string, status v1.ConditionStatus, updated time.Time) *v3.Node {
+	node := testNode(name, true)
+	node.Annotations = map[string]string{scaleDownAnnotation: now.Add(-time.Minute).Format(time.RFC3339)}
+	node.Status.Conditions = append(node.Status.Conditions, v32.NodeCondition{
+		Type:           v32.NodeConditionDrained,
+		Status:         status,
+		LastUpdateTime: updated.Format(time.RFC3339),
+		Message:        "cannot evict pod",
+	})
+	return node
+}
+
+func TestPlanScale(t *testing.T) {
+	tests := []struct {
+		name           string
+		pool           *v3.NodePool
+		nodes          []*v3.Node
+		pods           []v1.Pod
+		scaleUpAllowed bool
+		wantAction     scaleAction
+		wantQuantity   int
+		wantNode       string
+	}{
+		{
+			name:         "below minimum",
+			pool:         testPool(1, 2, 5),
+			nodes:        []*v3.Node{testNode("n1", true)},
+			wantAction:   scaleUp,
+			wantQuantity: 2,
+		},
+		{
+			name:         "above maximum",
+			pool:         testPool(6, 2, 5),
+			wantAction:   scaleDown,
+			wantQuantity: 5,
+		},
+		{
+			name:           "unschedulable pods add enough nodes for their requests",
+			pool:           testPool(2, 1, 5),
+			nodes:          []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			pods:           []v1.Pod{testPod("p1", "", "1500m"), testPod("p2", "", "1500m")},
+			scaleUpAllowed: true,
+			wantAction:     scaleUp,
+			wantQuantity:   4,
+		},
+		{
+			name:           "scale up is capped at the maximum",
+			pool:           testPool(2, 1, 3),
+			nodes:          []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			pods:           []v1.Pod{testPod("p1", "", "1500m"), testPod("p2", "", "1500m")},
+			scaleUpAllowed: true,
+			wantAction:     scaleUp,
+			wantQuantity:   3,
+		},
+		{
+			name:       "another pool scales up",
+			pool:       testPool(2, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			pods:       []v1.Pod{testPod("p1", "", "1")},
+			wantAction: scaleNone,
+		},
+		{
+			name: "wait for unreachable nodes replaced by the pool",
+			pool: func() *v3.NodePool {
+				p := testPool(2, 1, 5)
+				p.Spec.DeleteNotReadyAfterSecs = 300
+				return p
+			}(),
+			nodes:          []*v3.Node{testNode("n1", true), testNode("n2", false)},
+			pods:           []v1.Pod{testPod("p1", "", "1")},
+			scaleUpAllowed: true,
+			wantAction:     scaleNone,
+		},
+		{
+			name:           "unreachable nodes are not capacity without deleteNotReadyAfterSecs",
+			pool:           testPool(2, 1, 5),
+			nodes:          []*v3.Node{testNode("n1", true), testNode("n2", false)},
+			pods:           []v1.Pod{testPod("p1", "", "1")},
+			scaleUpAllowed: true,
+			wantAction:     scaleUp,
+			wantQuantity:   3,
+		},
+		{
+			name:       "drain the least utilized node",
+			pool:       testPool(3, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), testNode("n2", true), testNode("n3", true)},
+			pods:       []v1.Pod{testPod("p1", "n1", "1200m"), testPod("p2", "n2", "200m"), testPod("p3", "n3", "500m")},
+			wantAction: scaleDownDrain,
+			wantNode:   "n2",
+		},
+		{
+			name:       "no scale down while at the minimum",
+			pool:       testPool(1, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true)},
+			wantAction: scaleNone,
+		},
+		{
+			name: "no scale down right after scaling",
+			pool: func() *v3.NodePool {
+				p := testPool(2, 1, 5)
+				p.Status.LastScaleTime = now.Add(-time.Minute).Format(time.RFC3339)
+				return p
+			}(),
+			nodes:      []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			wantAction: scaleNone,
+		},
+		{
+			name:       "no scale down when the pods do not fit elsewhere",
+			pool:       testPool(2, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			pods:       []v1.Pod{testPod("p1", "n1", "1800m"), testPod("p2", "n2", "900m")},
+			wantAction: scaleNone,
+		},
+		{
+			name:         "drained node is removed",
+			pool:         testPool(2, 1, 5),
+			nodes:        []*v3.Node{testNode("n1", true), drainingNode("n2", v1.ConditionTrue, now)},
+			wantAction:   scaleDown,
+			wantQuantity: 1,
+			wantNode:     "n2",
+		},
+		{
+			name:       "drained condition from an earlier drain is ignored",
+			pool:       testPool(2, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), drainingNode("n2", v1.ConditionTrue, now.Add(-time.Hour))},
+			wantAction: scaleNone,
+		},
+		{
+			name:       "failed drain is canceled",
+			pool:       testPool(2, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), drainingNode("n2", v1.ConditionFalse, now)},
+			wantAction: scaleDownCancel,
+			wantNode:   "n2",
+		},
+		{
+			name:       "drain is canceled for unschedulable pods",
+			pool:       testPool(2, 1, 5),
+			nodes:      []*v3.Node{testNode("n1", true), drainingNode("n2", v1.ConditionUnknown, now)},
+			pods:       []v1.Pod{testPod("p1", "", "1")},
+			wantAction: scaleDownCancel,
+			wantNode:   "n2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planScale(tt.pool, tt.nodes, tt.pods, tt.scaleUpAllowed, now)
+			assert.Equal(t, tt.wantAction, plan.action, plan.reason)
+			if tt.wantQuantity != 0 {
+				assert.Equal(t, tt.wantQuantity, plan.quantity)
+			}
+			if tt.wantNode != "" && assert.NotNil(t, plan.node) {
+				assert.Equal(t, tt.wantNode, plan.node.Name)
+			}
+		})
+	}
+}
+
+func TestPendingPods(t *testing.T) {
+	pool := testPool(1, 1, 3)
+	pool.Spec.NodeTaints = []v1.Taint{{Key: "dedicated", Value: "batch", Effect: v1.TaintEffectNoSchedule}}
+
+	tolerating := testPod("tolerating", "", "1")
+	tolerating.Spec.Tolerations = []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "batch"}}
+	selecting := tolerating
+	selecting.Name = "selecting"
+	selecting.Spec.NodeSelector = map[string]string{"pool": "workers"}
+	otherSelector := tolerating
+	otherSelector.Name = "other-selector"
+	otherSelector.Spec.NodeSelector = map[string]string{"pool": "gpu"}
+	running := testPod("running", "n1", "1")
+
+	pods := pendingPods([]v1.Pod{testPod("intolerant", "", "1"), tolerating, selecting, otherSelector, running}, pool)
+
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	assert.Equal(t, []string{"tolerating", "selecting"}, names)
+}
+
+func TestScaleDownCandidateSkipsBarePods(t *testing.T) {
+	bare := testPod("bare", "n2", "100m")
+	bare.OwnerReferences = nil
+	daemon := testPod("daemon", "n1", "100m")
+	daemon.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds"}}
+
+	node, _ := scaleDownCandidate([]*v3.Node{testNode("n1", true), testNode("n2", true)}, []v1.Pod{bare, daemon})
+	if assert.NotNil(t, node) {
+		assert.Equal(t, "n1", node.Name)
+	}
+}
+
+func TestScaleDownIndex(t *testing.T) {
+	nodes := []*v3.Node{testNode("n1", true), testNode("n2", true), testNode("n3", true)}
+	assert.Equal(t, 2, scaleDownIndex(nodes))
+
+	nodes[0].Annotations = map[string]string{scaleDownAnnotation: now.Format(time.RFC3339)}
+	assert.Equal(t, 0, scaleDownIndex(nodes))
+}
@@ -0,0 +1,287 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	defaultMaxUnhealthy    = "40%"
+	defaultUnhealthyWindow = 300
+	// maxRemediationHistory is how many remediations are kept on a node
+	maxRemediationHistory = 10
+)
+
+// defaultUnhealthyConditions are checked when a health check does not list any
+var defaultUnhealthyConditions = []v32.UnhealthyCondition{
+	{Type: string(v1.NodeReady), Status: v1.ConditionFalse, TimeoutSeconds: defaultUnhealthyWindow},
+	{Type: string(v1.NodeReady), Status: v1.ConditionUnknown, TimeoutSeconds: defaultUnhealthyWindow},
+}
+
+// unhealthyNode is a node matching one of the unhealthy conditions of its pool
+type unhealthyNode struct {
+	node      *v3.Node
+	condition string
+	message   string
+}
+
+// healthPlan is the health check decision for a node pool
+type healthPlan struct {
+	unhealthy    int
+	maxUnhealthy int
+	remediate    []unhealthyNode
+	// requeue is when the next node times out, zero when no node is waiting to
+	requeue time.Duration
+}
+
+type healthChecker struct {
+	nodePools  v3.NodePoolInterface
+	nodes      v3.NodeInterface
+	nodeLister v3.NodeLister
+	events     corev1.EventInterface
+}
+
+func registerHealthCheck(ctx context.Context, management *config.ManagementContext) {
+	h := &healthChecker{
+		nodePools:  management.Management.NodePools(""),
+		nodes:      management.Management.Nodes(""),
+		nodeLister: management.Management.Nodes("").Controller().Lister(),
+		events:     management.Core.Events(""),
+	}
+	h.nodePools.AddHandler(ctx, "nodepool-health-check", h.sync)
+}
+
+func (h *healthChecker) sync(key string, nodePool *v3.NodePool) (runtime.Object, error) {
+	if nodePool == nil || nodePool.DeletionTimestamp != nil || nodePool.Spec.HealthCheck == nil {
+		return nodePool, nil
+	}
+
+	nodes, err := poolNodes(h.nodeLister, nodePool)
+	if err != nil {
+		return nodePool, err
+	}
+	plan, err := planHealthCheck(nodePool.Spec.HealthCheck, nodes, time.Now())
+	if err != nil {
+		return nodePool, err
+	}
+
+	if nodePool, err = h.setRemediationAllowed(nodePool, plan); err != nil {
+		return nodePool, err
+	}
+	if plan.unhealthy <= plan.maxUnhealthy {
+		for _, unhealthy := range plan.remediate {
+			if err := h.remediate(nodePool, unhealthy); err != nil {
+				return nodePool, err
+			}
+		}
+	}
+
+	if plan.requeue > 0 {
+		h.nodePools.Controller().EnqueueAfter(nodePool.Namespace, nodePool.Name, plan.requeue)
+	}
+	return nodePool, nil
+}
+
+// setRemediationAllowed opens the circuit breaker of the pool when more nodes are unhealthy than it tolerates
+func (h *healthChecker) setRemediationAllowed(nodePool *v3.NodePool, plan *healthPlan) (*v3.NodePool, error) {
+	poolCopy := nodePool.DeepCopy()
+	if plan.unhealthy > plan.maxUnhealthy {
+		message := fmt.Sprintf("%d nodes are unhealthy, more than the %d allowed by maxUnhealthy", plan.unhealthy, plan.maxUnhealthy)
+		if !v32.NodePoolConditionRemediationAllowed.IsFalse(nodePool) {
+			recordPoolEvent(h.events, "nodepool-health-check", nodePool, v1.EventTypeWarning, "RemediationPaused", message)
+		}
+		v32.NodePoolConditionRemediationAllowed.False(poolCopy)
+		v32.NodePoolConditionRemediationAllowed.Message(poolCopy, message)
+	} else {
+		v32.NodePoolConditionRemediationAllowed.True(poolCopy)
+		v32.NodePoolConditionRemediationAllowed.Message(poolCopy, "")
+	}
+
+	if reflect.DeepEqual(nodePool.Status, poolCopy.Status) {
+		return nodePool, nil
+	}
+	return h.nodePools.Update(poolCopy)
+}
+
+func (h *healthChecker) remediate(nodePool *v3.NodePool, unhealthy unhealthyNode) error {
+	action := nodePool.Spec.HealthCheck.Remediation
+	if action == "" {
+		action = v32.RemediationReplace
+	}
+
+	nodeCopy := unhealthy.node.DeepCopy()
+	addRemediation(nodeCopy, v32.NodeRemediation{
+		Time:      time.Now().UTC().Format(time.RFC3339),
+		Condition: unhealthy.condition,
+		Action:    action,
+		Message:   unhealthy.message,
+	})
+	if action == v32.RemediationReboot {
+		if nodeCopy.Annotations == nil {
+			nodeCopy.Annotations = map[string]string{}
+		}
+		nodeCopy.Annotations[v32.NodeRebootAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	}
+	node, err := h.nodes.Update(nodeCopy)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case v32.RemediationReplace:
+		f := metav1.DeletePropagationBackground
+		if err := h.nodes.DeleteNamespaced(node.Namespace, node.Name, &metav1.DeleteOptions{PropagationPolicy: &f}); err != nil {
+			return err
+		}
+		recordPoolEvent(h.events, "nodepool-health-check", nodePool, v1.EventTypeWarning, "NodeReplaced",
+			fmt.Sprintf("Replacing node %s as %s", nodeName(node), unhealthy.message))
+	case v32.RemediationReboot:
+		recordPoolEvent(h.events, "nodepool-health-check", nodePool, v1.EventTypeWarning, "NodeRebooted",
+			fmt.Sprintf("Rebooting node %s as %s", nodeName(node), unhealthy.message))
+	default:
+		recordPoolEvent(h.events, "nodepool-health-check", nodePool, v1.EventTypeWarning, "NodeUnhealthy",
+			fmt.Sprintf("Node %s is unhealthy as %s", nodeName(node), unhealthy.message))
+	}
+	logrus.Infof("[nodepool-health-check] %s node [%s:%s]: %s", action, node.Namespace, node.Name, unhealthy.message)
+	return nil
+}
+
+// planHealthCheck finds the unhealthy nodes of a pool and the ones that stayed unhealthy past their timeout
+func planHealthCheck(healthCheck *v32.MachineHealthCheck, nodes []*v3.Node, now time.Time) (*healthPlan, error) {
+	maxUnhealthy := healthCheck.MaxUnhealthy
+	if maxUnhealthy == "" {
+		maxUnhealthy = defaultMaxUnhealthy
+	}
+	parsed := intstr.Parse(maxUnhealthy)
+	max, err := intstr.GetValueFromIntOrPercent(&parsed, len(nodes), true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxUnhealthy %q: %v", maxUnhealthy, err)
+	}
+
+	rules := healthCheck.UnhealthyConditions
+	if len(rules) == 0 {
+		rules = defaultUnhealthyConditions
+	}
+
+	plan := &healthPlan{maxUnhealthy: max}
+	for _, node := range nodes {
+		// nodes still provisioning or rebooting have no conditions worth checking yet
+		if !v32.NodeConditionRegistered.IsTrue(node) || node.Annotations[v32.NodeRebootAnnotation] != "" {
+			continue
+		}
+
+		var (
+			matched   *v32.UnhealthyCondition
+			remaining time.Duration
+		)
+		for i, rule := range rules {
+			since, ok := matchCondition(node, rule)
+			if !ok {
+				continue
+			}
+			// a remediation restarts the clock so a node is not remediated again before it had time to recover
+			if last := lastRemediation(node); last.After(since) {
+				since = last
+			}
+			left := since.Add(time.Duration(rule.TimeoutSeconds) * time.Second).Sub(now)
+			if matched == nil || left < remaining {
+				matched, remaining = &rules[i], left
+			}
+		}
+		if matched == nil {
+			continue
+		}
+
+		plan.unhealthy++
+		if remaining > 0 {
+			if plan.requeue == 0 || remaining < plan.requeue {
+				plan.requeue = remaining
+			}
+			continue
+		}
+		plan.remediate = append(plan.remediate, unhealthyNode{
+			node:      node,
+			condition: matched.Type,
+			message:   describeCondition(*matched),
+		})
+	}
+	return plan, nil
+}
+
+// matchCondition reports whether the node matches rule and since when
+func matchCondition(node *v3.Node, rule v32.UnhealthyCondition) (time.Time, bool) {
+	condType, status := rule.Type, conditionStatus(rule)
+	var messageMatch func(string) bool
+	switch rule.Type {
+	case v32.UnhealthyConditionKubeletStopped:
+		condType, status = string(v1.NodeReady), v1.ConditionUnknown
+	case v32.UnhealthyConditionDockerUnhealthy:
+		condType, status = string(v1.NodeReady), v1.ConditionFalse
+		messageMatch = func(message string) bool {
+			message = strings.ToLower(message)
+			return strings.Contains(message, "container runtime") || strings.Contains(message, "pleg")
+		}
+	}
+
+	for _, cond := range node.Status.InternalNodeStatus.Conditions {
+		if string(cond.Type) != condType || cond.Status != status {
+			continue
+		}
+		if messageMatch != nil && !messageMatch(cond.Message) {
+			return time.Time{}, false
+		}
+		return cond.LastTransitionTime.Time, true
+	}
+	return time.Time{}, false
+}
+
+// conditionStatus is the status a rule matches, conditions other than Ready are unhealthy when True
+func conditionStatus(rule v32.UnhealthyCondition) v1.ConditionStatus {
+	switch {
+	case rule.Status != "":
+		return rule.Status
+	case rule.Type == string(v1.NodeReady):
+		return v1.ConditionFalse
+	default:
+		return v1.ConditionTrue
+	}
+}
+
+func describeCondition(rule v32.UnhealthyCondition) string {
+	switch rule.Type {
+	case v32.UnhealthyConditionKubeletStopped:
+		return fmt.Sprintf("the kubelet stopped posting status for more than %ds", rule.TimeoutSeconds)
+	case v32.UnhealthyConditionDockerUnhealthy:
+		return fmt.Sprintf("the container runtime has been unhealthy for more than %ds", rule.TimeoutSeconds)
+	}
+	return fmt.Sprintf("%s has been %s for more than %ds", rule.Type, conditionStatus(rule), rule.TimeoutSeconds)
+}
+
+func lastRemediation(node *v3.Node) time.Time {
+	remediations := node.Status.Remediations
+	if len(remediations) == 0 {
+		return time.Time{}
+	}
+	t, _ := time.Parse(time.RFC3339, remediations[len(remediations)-1].Time)
+	return t
+}
+
+func addRemediation(node *v3.Node, remediation v32.NodeRemediation) {
+	node.Status.Remediations = append(node.Status.Remediations, remediation)
+	if extra := len(node.Status.Remediations) - maxRemediationHistory; extra > 0 {
+		node.Status.Remediations = node.Status.Remediations[extra:]
+	}
+}
@@ -0,0 +1,169 @@
+package nodepool
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func conditionNode(name string, condType v1.NodeConditionType, status v1.ConditionStatus, message string, since time.Time) *v3.Node {
+	node := testNode(name, true)
+	if condType == v1.NodeReady {
+		node.Status.InternalNodeStatus.Conditions = nil
+	}
+	node.Status.InternalNodeStatus.Conditions = append(node.Status.InternalNodeStatus.Conditions, v1.NodeCondition{
+		Type:               condType,
+		Status:             status,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(since),
+	})
+	return node
+}
+
+func TestPlanHealthCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		healthCheck   v32.MachineHealthCheck
+		nodes         []*v3.Node
+		wantUnhealthy int
+		wantMax       int
+		wantRemediate []string
+		wantRequeue   time.Duration
+	}{
+		{
+			name:        "healthy nodes",
+			healthCheck: v32.MachineHealthCheck{},
+			nodes:       []*v3.Node{testNode("n1", true), testNode("n2", true)},
+			wantMax:     1,
+		},
+		{
+			name:        "not ready past the default timeout",
+			healthCheck: v32.MachineHealthCheck{},
+			nodes: []*v3.Node{
+				testNode("n1", true),
+				conditionNode("n2", v1.NodeReady, v1.ConditionFalse, "", now.Add(-10*time.Minute)),
+			},
+			wantUnhealthy: 1,
+			wantMax:       1,
+			wantRemediate: []string{"n2"},
+		},
+		{
+			name:        "not ready within the timeout is requeued",
+			healthCheck: v32.MachineHealthCheck{},
+			nodes: []*v3.Node{
+				testNode("n1", true),
+				conditionNode("n2", v1.NodeReady, v1.ConditionUnknown, "", now.Add(-2*time.Minute)),
+			},
+			wantUnhealthy: 1,
+			wantMax:       1,
+			wantRequeue:   3 * time.Minute,
+		},
+		{
+			name: "disk pressure",
+			healthCheck: v32.MachineHealthCheck{
+				UnhealthyConditions: []v32.UnhealthyCondition{{Type: string(v1.NodeDiskPressure), TimeoutSeconds: 60}},
+			},
+			nodes: []*v3.Node{
+				testNode("n1", true),
+				conditionNode("n2", v1.NodeDiskPressure, v1.ConditionTrue, "", now.Add(-2*time.Minute)),
+				conditionNode("n3", v1.NodeDiskPressure, v1.ConditionFalse, "", now.Add(-2*time.Minute)),
+			},
+			wantUnhealthy: 1,
+			wantMax:       2,
+			wantRemediate: []string{"n2"},
+		},
+		{
+			name: "docker unhealthy only matches container runtime failures",
+			healthCheck: v32.MachineHealthCheck{
+				UnhealthyConditions: []v32.UnhealthyCondition{{Type: v32.UnhealthyConditionDockerUnhealthy, TimeoutSeconds: 60}},
+			},
+			nodes: []*v3.Node{
+				conditionNode("n1", v1.NodeReady, v1.ConditionFalse, "container runtime is down", now.Add(-2*time.Minute)),
+				conditionNode("n2", v1.NodeReady, v1.ConditionFalse, "network plugin is not ready", now.Add(-2*time.Minute)),
+				conditionNode("n3", v1.NodeReady, v1.ConditionUnknown, "Kubelet stopped posting node status.", now.Add(-2*time.Minute)),
+			},
+			wantUnhealthy: 1,
+			wantMax:       2,
+			wantRemediate: []string{"n1"},
+		},
+		{
+			name: "kubelet stopped",
+			healthCheck: v32.MachineHealthCheck{
+				UnhealthyConditions: []v32.UnhealthyCondition{{Type: v32.UnhealthyConditionKubeletStopped, TimeoutSeconds: 60}},
+			},
+			nodes: []*v3.Node{
+				conditionNode("n1", v1.NodeReady, v1.ConditionUnknown, "Kubelet stopped posting node status.", now.Add(-2*time.Minute)),
+				testNode("n2", true),
+			},
+			wantUnhealthy: 1,
+			wantMax:       1,
+			wantRemediate: []string{"n1"},
+		},
+		{
+			name:        "too many unhealthy nodes",
+			healthCheck: v32.MachineHealthCheck{MaxUnhealthy: "1"},
+			nodes: []*v3.Node{
+				conditionNode("n1", v1.NodeReady, v1.ConditionFalse, "", now.Add(-10*time.Minute)),
+				conditionNode("n2", v1.NodeReady, v1.ConditionFalse, "", now.Add(-10*time.Minute)),
+				testNode("n3", true),
+			},
+			wantUnhealthy: 2,
+			wantMax:       1,
+			wantRemediate: []string{"n1", "n2"},
+		},
+		{
+			name:        "a recent remediation restarts the timeout",
+			healthCheck: v32.MachineHealthCheck{},
+			nodes: func() []*v3.Node {
+				node := conditionNode("n1", v1.NodeReady, v1.ConditionFalse, "", now.Add(-time.Hour))
+				addRemediation(node, v32.NodeRemediation{Time: now.Add(-time.Minute).Format(time.RFC3339), Action: v32.RemediationReboot})
+				return []*v3.Node{node}
+			}(),
+			wantUnhealthy: 1,
+			wantMax:       1,
+			wantRequeue:   4 * time.Minute,
+		},
+		{
+			name:        "rebooting and unregistered nodes are skipped",
+			healthCheck: v32.MachineHealthCheck{},
+			nodes: func() []*v3.Node {
+				rebooting := conditionNode("n1", v1.NodeReady, v1.ConditionFalse, "", now.Add(-time.Hour))
+				rebooting.Annotations = map[string]string{v32.NodeRebootAnnotation: now.Format(time.RFC3339)}
+				unregistered := conditionNode("n2", v1.NodeReady, v1.ConditionFalse, "", now.Add(-time.Hour))
+				v32.NodeConditionRegistered.Unknown(unregistered)
+				return []*v3.Node{rebooting, unregistered}
+			}(),
+			wantMax: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planHealthCheck(&tt.healthCheck, tt.nodes, now)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var remediate []string
+			for _, unhealthy := range plan.remediate {
+				remediate = append(remediate, unhealthy.node.Name)
+			}
+			assert.Equal(t, tt.wantUnhealthy, plan.unhealthy)
+			assert.Equal(t, tt.wantMax, plan.maxUnhealthy)
+			assert.Equal(t, tt.wantRemediate, remediate)
+			assert.Equal(t, tt.wantRequeue, plan.requeue)
+		})
+	}
+}
+
+func TestAddRemediationKeepsLatest(t *testing.T) {
+	node := testNode("n1", true)
+	for i := 0; i < maxRemediationHistory+3; i++ {
+		addRemediation(node, v32.NodeRemediation{Time: now.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)})
+	}
+	assert.Len(t, node.Status.Remediations, maxRemediationHistory)
+	assert.Equal(t, now.Add(time.Duration(maxRemediationHistory+2)*time.Minute), lastRemediation(node))
+}
@@ -11,13 +11,16 @@
 	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
 
+	"github.com/rancher/norman/objectclient"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rke/services"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,28 +36,39 @@
 )
 
 type Controller struct {
-	NodePoolController v3.NodePoolController
-	NodePoolLister     v3.NodePoolLister
-	NodePools          v3.NodePoolInterface
-	NodeLister         v3.NodeLister
-	Nodes              v3.NodeInterface
-	mutex              sync.RWMutex
-	syncmap            map[string]bool
+	NodePoolController        v3.NodePoolController
+	NodePoolLister            v3.NodePoolLister
+	NodePools                 v3.NodePoolInterface
+	NodeLister                v3.NodeLister
+	Nodes                     v3.NodeInterface
+	NodeTemplateLister        v3.NodeTemplateLister
+	NodeTemplateGenericClient objectclient.GenericClient
+	mutex                     sync.RWMutex
+	syncmap                   map[string]bool
+	revisionMutex             sync.Mutex
+	revisions                 map[string]templateRevision
 }
 
-func Register(ctx context.Context, management *config.ManagementContext) {
+func Register(ctx context.Context, management *config.ManagementContext, manager *clustermanager.Manager) {
 	p := &Controller{
-		NodePoolController: management.Management.NodePools("").Controller(),
-		NodePoolLister:     management.Management.NodePools("").Controller().Lister(),
-		NodePools:          management.Management.NodePools(""),
-		NodeLister:         management.Management.Nodes("").Controller().Lister(),
-		Nodes:              management.Management.Nodes(""),
-		syncmap:            make(map[string]bool),
+		NodePoolController:        management.Management.NodePools("").Controller(),
+		NodePoolLister:            management.Management.NodePools("").Controller().Lister(),
+		NodePools:                 management.Management.NodePools(""),
+		NodeLister:                management.Management.Nodes("").Controller().Lister(),
+		Nodes:                     management.Management.Nodes(""),
+		NodeTemplateLister:        management.Management.NodeTemplates("").Controller().Lister(),
+		NodeTemplateGenericClient: management.Management.NodeTemplates("").ObjectClient().UnstructuredClient(),
+		syncmap:                   make(map[string]bool),
+		revisions:                 make(map[string]templateRevision),
 	}
 
 	// Add handlers
 	p.NodePools.AddLifecycle(ctx, "nodepool-provisioner", p)
 	management.Management.Nodes("").AddHandler(ctx, "nodepool-provisioner", p.machineChanged)
+	management.Management.NodeTemplates("").AddHandler(ctx, "nodepool-template-rollout", p.templateChanged)
+
+	registerAutoscaler(ctx, management, manager)
+	registerHealthCheck(ctx, management)
 }
 
 func (c *Controller) Create(nodePool *v3.NodePool) (runtime.Object, error) {
@@ -108,13 +122,21 @@ func (c *Controller) machineChanged(key string, machine *v3.Node) (runtime.Objec
 	return nil, nil
 }
 
-func (c *Controller) createNode(name string, nodePool *v3.NodePool, simulate bool) (*v3.Node, error) {
+func (c *Controller) createNode(name string, nodePool *v3.NodePool, revision string, simulate bool) (*v3.Node, error) {
+	annotations := map[string]string{}
+	for k, v := range nodePool.Annotations {
+		annotations[k] = v
+	}
+	if revision != "" {
+		annotations[templateRevisionAnnotation] = revision
+	}
+
 	newNode := &v3.Node{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "m-",
 			Namespace:    nodePool.Namespace,
 			Labels:       nodePool.Labels,
-			Annotations:  nodePool.Annotations,
+			Annotations:  annotations,
 		},
 		Spec: v32.NodeSpec{
 			Etcd:              nodePool.Spec.Etcd,
@@ -152,13 +174,19 @@ func (c *Controller) deleteNode(node *v3.Node, duration time.Duration) error {
 }
 
 func (c *Controller) reconcile(nodePool *v3.NodePool) error {
-	changed, err := c.createOrCheckNodes(nodePool, true)
+	// a missing template only stops the rollout, the pool can still scale down or remove failed nodes
+	revision, err := c.rolloutRevision(nodePool)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	changed, err := c.createOrCheckNodes(nodePool, revision, true)
 	if err != nil {
 		return err
 	}
 
 	if changed {
-		_, err = c.createOrCheckNodes(nodePool, false)
+		_, err = c.createOrCheckNodes(nodePool, revision, false)
 	}
 
 	return err
@@ -192,7 +220,7 @@ func (c *Controller) nodes(nodePool *v3.NodePool, simulate bool) ([]*v3.Node, er
 	return nodes, nil
 }
 
-func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (bool, error) {
+func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, revision string, simulate bool) (bool, error) {
 	var (
 		err                 error
 		byName              = map[string]*v3.Node{}
@@ -242,6 +270,16 @@ func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (b
 				}
 			}
 		}
+		// nodes created before template revisions were tracked are taken as created from the current one
+		if revision != "" && node.Annotations[templateRevisionAnnotation] == "" {
+			changed = true
+			if !simulate {
+				node, err = c.setTemplateRevision(node, revision)
+				if err != nil {
+					return false, err
+				}
+			}
+		}
 		nodes = append(nodes, node)
 	}
 
@@ -250,9 +288,29 @@ func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (b
 		quantity = 0
 	}
 
+	var rollout *rolloutPlan
+	if nodePool.Spec.RolloutStrategy != nil && revision != "" {
+		rollout, err = planRollout(nodePool, revision, nodes)
+		if err != nil {
+			return false, err
+		}
+		updatedNodes := 0
+		for _, node := range nodes {
+			if node.Annotations[templateRevisionAnnotation] == revision {
+				updatedNodes++
+			}
+		}
+		setRolloutStatus(nodePool, revision, rollout, updatedNodes)
+	}
+
+	target := quantity
+	if rollout != nil {
+		target = len(nodes) + rollout.create
+	}
+
 	prefix, minLength, start := parsePrefix(nodePool.Spec.HostnamePrefix)
 
-	for i := start; len(nodes) < quantity; i++ {
+	for i := start; len(nodes) < target; i++ {
 		ia := strconv.Itoa(i)
 		name := prefix + ia
 		if len(ia) < minLength {
@@ -264,7 +322,7 @@ func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (b
 		}
 
 		changed = true
-		newNode, err := c.createNode(name, nodePool, simulate)
+		newNode, err := c.createNode(name, nodePool, revision, simulate)
 		if err != nil {
 			return false, err
 		}
@@ -273,17 +331,30 @@ func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (b
 		nodes = append(nodes, newNode)
 	}
 
-	for len(nodes) > quantity {
+	if rollout != nil {
+		removed, err := c.applyRollout(nodePool, rollout, simulate)
+		if err != nil {
+			return false, err
+		}
+		if removed || len(rollout.drain) > 0 {
+			changed = true
+		}
+		nodes = removeNodes(nodes, rollout.remove)
+	}
+
+	// the rollout surges above the quantity and removes old nodes itself
+	for rollout == nil && len(nodes) > quantity {
 		sort.Sort(byHostname(nodes))
 
-		toDelete := nodes[len(nodes)-1]
+		i := scaleDownIndex(nodes)
+		toDelete := nodes[i]
 
 		changed = true
 		if !simulate {
 			c.deleteNode(toDelete, 0)
 		}
 
-		nodes = nodes[:len(nodes)-1]
+		nodes = append(nodes[:i], nodes[i+1:]...)
 		delete(byName, toDelete.Spec.RequestedHostname)
 	}
 
@@ -300,6 +371,62 @@ func (c *Controller) createOrCheckNodes(nodePool *v3.NodePool, simulate bool) (b
 	return changed, nil
 }
 
+func (c *Controller) setTemplateRevision(node *v3.Node, revision string) (*v3.Node, error) {
+	nodeCopy := node.DeepCopy()
+	if nodeCopy.Annotations == nil {
+		nodeCopy.Annotations = map[string]string{}
+	}
+	nodeCopy.Annotations[templateRevisionAnnotation] = revision
+	return c.Nodes.Update(nodeCopy)
+}
+
+// applyRollout drains and deletes the old nodes of the rollout plan
+func (c *Controller) applyRollout(nodePool *v3.NodePool, plan *rolloutPlan, simulate bool) (bool, error) {
+	if simulate {
+		return len(plan.remove) > 0, nil
+	}
+
+	for _, node := range plan.drain {
+		logrus.Infof("Draining node [%s] of nodepool [%s] for rollout", node.Name, nodePool.Name)
+		if err := drainNode(c.Nodes, node, rolloutDrainAnnotation, nodePool.Spec.RolloutStrategy.NodeDrainInput); err != nil {
+			return false, err
+		}
+	}
+	for _, node := range plan.remove {
+		logrus.Infof("Deleting node [%s] of nodepool [%s] for rollout", node.Name, nodePool.Name)
+		if err := c.deleteNode(node, 0); err != nil {
+			return false, err
+		}
+	}
+	return len(plan.remove) > 0, nil
+}
+
+func removeNodes(nodes, toRemove []*v3.Node) []*v3.Node {
+	removed := map[string]bool{}
+	for _, node := range toRemove {
+		removed[node.Name] = true
+	}
+
+	var result []*v3.Node
+	for _, node := range nodes {
+		if !removed[node.Name] {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// scaleDownIndex returns the node to remove when the pool shrinks, nodes drained by the autoscaler go first and
+// otherwise the node with the highest hostname
+func scaleDownIndex(nodes []*v3.Node) int {
+	for i, node := range nodes {
+		if node.Annotations[scaleDownAnnotation] != "" {
+			return i
+		}
+	}
+	return len(nodes) - 1
+}
+
 func needRoleUpdate(node *v3.Node, nodePool *v3.NodePool) bool {
 	if node.Status.NodeConfig == nil {
 		return false
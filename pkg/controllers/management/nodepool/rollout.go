@@ -0,0 +1,273 @@
+package nodepool
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// templateRevisionAnnotation is the revision of the node template a node was created from
+	templateRevisionAnnotation = "nodepool.cattle.io/template-revision"
+	// rolloutDrainAnnotation marks a node the rollout drains for removal, the value is when the drain was requested
+	rolloutDrainAnnotation = "nodepool.cattle.io/rollout-drain"
+)
+
+// templateRevision is the revision computed for a resource version of a node template
+type templateRevision struct {
+	resourceVersion string
+	revision        string
+}
+
+// rolloutPlan is the next step of replacing the nodes of a pool that are not on the current template revision
+type rolloutPlan struct {
+	create int
+	drain  []*v3.Node
+	remove []*v3.Node
+	status v32.NodePoolRolloutStatus
+}
+
+func (c *Controller) templateChanged(key string, template *v3.NodeTemplate) (runtime.Object, error) {
+	if template == nil {
+		c.revisionMutex.Lock()
+		delete(c.revisions, strings.Replace(key, "/", ":", 1))
+		c.revisionMutex.Unlock()
+		return nil, nil
+	}
+
+	nodePools, err := c.NodePoolLister.List("", labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, nodePool := range nodePools {
+		if nodePool.Spec.RolloutStrategy != nil && nodePool.Spec.NodeTemplateName == ref.Ref(template) {
+			c.NodePoolController.Enqueue(nodePool.Namespace, nodePool.Name)
+		}
+	}
+	return template, nil
+}
+
+// rolloutRevision identifies the node template content the pool creates nodes from, it is empty for pools without a
+// rollout strategy as their nodes are never replaced on template changes
+func (c *Controller) rolloutRevision(nodePool *v3.NodePool) (string, error) {
+	if nodePool.Spec.RolloutStrategy == nil {
+		return "", nil
+	}
+
+	templateName := nodePool.Spec.NodeTemplateName
+	ns, name := ref.Parse(templateName)
+	template, err := c.NodeTemplateLister.Get(ns, name)
+	if err != nil {
+		return "", err
+	}
+
+	c.revisionMutex.Lock()
+	cached, ok := c.revisions[templateName]
+	c.revisionMutex.Unlock()
+	if ok && cached.resourceVersion == template.ResourceVersion {
+		return cached.revision, nil
+	}
+
+	// the cached template does not have the driver config, it is only read from the API when the template changed
+	obj, err := c.NodeTemplateGenericClient.GetNamespaced(ns, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	data := obj.(*unstructured.Unstructured)
+	revision, err := revisionOf(templateName, data.Object)
+	if err != nil {
+		return "", err
+	}
+
+	c.revisionMutex.Lock()
+	c.revisions[templateName] = templateRevision{resourceVersion: data.GetResourceVersion(), revision: revision}
+	c.revisionMutex.Unlock()
+	return revision, nil
+}
+
+// revisionOf hashes the template fields that end up on the machines, names, descriptions and credentials are left out
+// so editing them does not replace nodes
+func revisionOf(templateName string, data map[string]interface{}) (string, error) {
+	spec := map[string]interface{}{}
+	for k, v := range convert.ToMapInterface(data["spec"]) {
+		switch k {
+		case "displayName", "description", "cloudCredentialName":
+		default:
+			spec[k] = v
+		}
+	}
+	driver := convert.ToString(spec["driver"])
+
+	content, err := json.Marshal(map[string]interface{}{
+		"name":   templateName,
+		"spec":   spec,
+		"config": data[driver+"Config"],
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// rolloutLimits resolves maxSurge and maxUnavailable of the strategy against the pool quantity
+func rolloutLimits(strategy *v32.NodePoolRolloutStrategy, quantity int) (int, int, error) {
+	maxSurge, maxUnavailable := strategy.MaxSurge, strategy.MaxUnavailable
+	if maxSurge == "" {
+		maxSurge = "1"
+	}
+	if maxUnavailable == "" {
+		maxUnavailable = "0"
+	}
+
+	parsedSurge := intstr.Parse(maxSurge)
+	surge, err := intstr.GetValueFromIntOrPercent(&parsedSurge, quantity, true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxSurge %q: %v", maxSurge, err)
+	}
+	parsedUnavailable := intstr.Parse(maxUnavailable)
+	unavailable, err := intstr.GetValueFromIntOrPercent(&parsedUnavailable, quantity, false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxUnavailable %q: %v", maxUnavailable, err)
+	}
+	// a rollout that can neither add nor take away a node would never progress
+	if surge <= 0 && unavailable <= 0 {
+		surge = 1
+	}
+	return surge, unavailable, nil
+}
+
+// planRollout decides which nodes to create, drain and delete to move the pool to revision, it returns nil once no
+// node of an older revision is left
+func planRollout(nodePool *v3.NodePool, revision string, nodes []*v3.Node) (*rolloutPlan, error) {
+	var updated, old []*v3.Node
+	for _, node := range nodes {
+		if node.Annotations[templateRevisionAnnotation] == revision {
+			updated = append(updated, node)
+		} else {
+			old = append(old, node)
+		}
+	}
+	if len(old) == 0 {
+		return nil, nil
+	}
+
+	quantity := nodePool.Spec.Quantity
+	if quantity < 0 {
+		quantity = 0
+	}
+	surge, unavailable, err := rolloutLimits(nodePool.Spec.RolloutStrategy, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &rolloutPlan{
+		status: v32.NodePoolRolloutStatus{
+			TemplateRevision: revision,
+			UpdatedNodes:     len(updated),
+			OldNodes:         len(old),
+		},
+	}
+	// create up to the quantity of updated nodes without going above the surge
+	plan.create = quantity - len(updated)
+	if room := quantity + surge - len(nodes); room < plan.create {
+		plan.create = room
+	}
+	if plan.create < 0 {
+		plan.create = 0
+	}
+
+	available := 0
+	for _, node := range nodes {
+		if isNodeAvailable(node) && node.Annotations[rolloutDrainAnnotation] == "" {
+			available++
+		}
+	}
+	for _, node := range updated {
+		if isNodeAvailable(node) {
+			plan.status.AvailableUpdatedNodes++
+		}
+	}
+
+	// unavailable nodes go first as removing them does not lower the capacity of the pool
+	sort.SliceStable(old, func(i, j int) bool {
+		return !isNodeAvailable(old[i]) && isNodeAvailable(old[j])
+	})
+	minAvailable := quantity - unavailable
+	for _, node := range old {
+		if node.Annotations[rolloutDrainAnnotation] != "" {
+			done, failure := drainResult(node, rolloutDrainAnnotation)
+			if done {
+				plan.remove = append(plan.remove, node)
+			} else if failure != "" {
+				plan.status.Message = fmt.Sprintf("draining node %s failed: %s", nodeName(node), failure)
+			}
+			continue
+		}
+		if !isNodeAvailable(node) {
+			plan.remove = append(plan.remove, node)
+			continue
+		}
+		if available-1 >= minAvailable {
+			plan.drain = append(plan.drain, node)
+			available--
+		}
+	}
+
+	return plan, nil
+}
+
+// setRolloutStatus records the rollout progress on the pool, plan is nil when all nodes are on revision
+func setRolloutStatus(nodePool *v3.NodePool, revision string, plan *rolloutPlan, updatedNodes int) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	current := nodePool.Status.Rollout
+
+	if plan == nil {
+		if current == nil || current.CompletedAt != "" {
+			return
+		}
+		nodePool.Status.Rollout = &v32.NodePoolRolloutStatus{
+			TemplateRevision:      revision,
+			UpdatedNodes:          updatedNodes,
+			AvailableUpdatedNodes: updatedNodes,
+			StartedAt:             current.StartedAt,
+			CompletedAt:           now,
+		}
+		v32.NodePoolConditionRolledOut.True(nodePool)
+		v32.NodePoolConditionRolledOut.Message(nodePool, "")
+		return
+	}
+
+	status := plan.status
+	status.StartedAt = now
+	if current != nil && current.TemplateRevision == revision && current.CompletedAt == "" {
+		status.StartedAt = current.StartedAt
+	}
+	nodePool.Status.Rollout = &status
+
+	message := fmt.Sprintf("%d of %d nodes updated", status.UpdatedNodes, nodePool.Spec.Quantity)
+	if status.Message != "" {
+		message = status.Message
+	}
+	v32.NodePoolConditionRolledOut.Unknown(nodePool)
+	v32.NodePoolConditionRolledOut.Message(nodePool, message)
+}
+
+// rollingOut is true while nodes of a pool are replaced with ones from a newer template revision
+func rollingOut(nodePool *v3.NodePool) bool {
+	return nodePool.Spec.RolloutStrategy != nil && nodePool.Status.Rollout != nil && nodePool.Status.Rollout.CompletedAt == ""
+}
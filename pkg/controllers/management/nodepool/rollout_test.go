@@ -0,0 +1,217 @@
+package nodepool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rancher/norman/objectclient"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// templateClient serves node templates of the API with their driver config
+type templateClient struct {
+	objectclient.GenericClient
+	template *unstructured.Unstructured
+	gets     int
+}
+
+func (c *templateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (runtime.Object, error) {
+	c.gets++
+	return c.template.DeepCopy(), nil
+}
+
+func revisionNode(name, revision string, ready bool) *v3.Node {
+	node := testNode(name, ready)
+	node.Annotations = map[string]string{templateRevisionAnnotation: revision}
+	return node
+}
+
+func rolloutPool(quantity int, maxSurge, maxUnavailable string) *v3.NodePool {
+	pool := testPool(quantity, 0, 0)
+	pool.Spec.RolloutStrategy = &v32.NodePoolRolloutStrategy{MaxSurge: maxSurge, MaxUnavailable: maxUnavailable}
+	return pool
+}
+
+func names(nodes []*v3.Node) []string {
+	var result []string
+	for _, node := range nodes {
+		result = append(result, node.Name)
+	}
+	return result
+}
+
+func TestPlanRollout(t *testing.T) {
+	drained := revisionNode("old-3", "r1", true)
+	drained.Annotations[rolloutDrainAnnotation] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	drained.Status.Conditions = []v32.NodeCondition{{
+		Type:           v32.NodeConditionDrained,
+		Status:         v1.ConditionTrue,
+		LastUpdateTime: time.Now().UTC().Format(time.RFC3339),
+	}}
+
+	tests := []struct {
+		name       string
+		pool       *v3.NodePool
+		nodes      []*v3.Node
+		wantNil    bool
+		wantCreate int
+		wantDrain  []string
+		wantRemove []string
+	}{
+		{
+			name:    "all nodes on the current revision",
+			pool:    rolloutPool(2, "1", "0"),
+			nodes:   []*v3.Node{revisionNode("new-1", "r2", true), revisionNode("new-2", "r2", true)},
+			wantNil: true,
+		},
+		{
+			name:       "surge before taking nodes away",
+			pool:       rolloutPool(3, "1", "0"),
+			nodes:      []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", true), revisionNode("old-3", "r1", true)},
+			wantCreate: 1,
+		},
+		{
+			name: "drain once the surge node is active",
+			pool: rolloutPool(3, "1", "0"),
+			nodes: []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", true), revisionNode("old-3", "r1", true),
+				revisionNode("new-1", "r2", true)},
+			wantDrain: []string{"old-1"},
+		},
+		{
+			name: "wait for the surge node to become active",
+			pool: rolloutPool(3, "1", "0"),
+			nodes: []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", true), revisionNode("old-3", "r1", true),
+				revisionNode("new-1", "r2", false)},
+		},
+		{
+			name: "drained node is deleted",
+			pool: rolloutPool(3, "1", "0"),
+			nodes: []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", true), drained,
+				revisionNode("new-1", "r2", true)},
+			wantRemove: []string{"old-3"},
+		},
+		{
+			name:      "max unavailable without surge",
+			pool:      rolloutPool(4, "0", "50%"),
+			nodes:     []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", true), revisionNode("old-3", "r1", true), revisionNode("old-4", "r1", true)},
+			wantDrain: []string{"old-1", "old-2"},
+		},
+		{
+			name:       "unavailable old nodes are removed first",
+			pool:       rolloutPool(2, "1", "0"),
+			nodes:      []*v3.Node{revisionNode("old-1", "r1", true), revisionNode("old-2", "r1", false), revisionNode("new-1", "r2", true)},
+			wantRemove: []string{"old-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planRollout(tt.pool, "r2", tt.nodes)
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, plan)
+				return
+			}
+			require.NotNil(t, plan)
+			assert.Equal(t, tt.wantCreate, plan.create)
+			assert.Equal(t, tt.wantDrain, names(plan.drain))
+			assert.Equal(t, tt.wantRemove, names(plan.remove))
+		})
+	}
+}
+
+func TestRolloutLimits(t *testing.T) {
+	surge, unavailable, err := rolloutLimits(&v32.NodePoolRolloutStrategy{MaxSurge: "25%", MaxUnavailable: "25%"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, surge)
+	assert.Equal(t, 2, unavailable)
+
+	// neither surge nor unavailable nodes fall back to a surge of one
+	surge, unavailable, err = rolloutLimits(&v32.NodePoolRolloutStrategy{MaxSurge: "0", MaxUnavailable: "0"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, surge)
+	assert.Equal(t, 0, unavailable)
+
+	_, _, err = rolloutLimits(&v32.NodePoolRolloutStrategy{MaxSurge: "a lot"}, 10)
+	assert.Error(t, err)
+}
+
+func TestRevisionOf(t *testing.T) {
+	template := func(description, instanceType string) map[string]interface{} {
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"driver":      "amazonec2",
+				"description": description,
+			},
+			"amazonec2Config": map[string]interface{}{
+				"instanceType": instanceType,
+			},
+		}
+	}
+
+	base, err := revisionOf("cattle-global-nt:nt-1", template("workers", "t3.large"))
+	require.NoError(t, err)
+
+	described, err := revisionOf("cattle-global-nt:nt-1", template("general workers", "t3.large"))
+	require.NoError(t, err)
+	assert.Equal(t, base, described)
+
+	resized, err := revisionOf("cattle-global-nt:nt-1", template("workers", "t3.xlarge"))
+	require.NoError(t, err)
+	assert.NotEqual(t, base, resized)
+
+	renamed, err := revisionOf("cattle-global-nt:nt-2", template("workers", "t3.large"))
+	require.NoError(t, err)
+	assert.NotEqual(t, base, renamed)
+}
+
+func TestRolloutRevision(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata":        map[string]interface{}{"name": "nt-1", "namespace": "cattle-global-nt", "resourceVersion": "1"},
+		"spec":            map[string]interface{}{"driver": "amazonec2"},
+		"amazonec2Config": map[string]interface{}{"instanceType": "t3.large"},
+	}}
+	client := &templateClient{template: template}
+	c := &Controller{
+		NodeTemplateLister: &fakes.NodeTemplateListerMock{
+			GetFunc: func(namespace, name string) (*v3.NodeTemplate, error) {
+				return &v3.NodeTemplate{ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					ResourceVersion: template.GetResourceVersion(),
+				}}, nil
+			},
+		},
+		NodeTemplateGenericClient: client,
+		revisions:                 map[string]templateRevision{},
+	}
+
+	pool := testPool(1, 0, 0)
+	pool.Spec.NodeTemplateName = "cattle-global-nt:nt-1"
+	revision, err := c.rolloutRevision(pool)
+	require.NoError(t, err)
+	assert.Empty(t, revision, "pools without a rollout strategy don't track revisions")
+	assert.Equal(t, 0, client.gets)
+
+	pool.Spec.RolloutStrategy = &v32.NodePoolRolloutStrategy{}
+	first, err := c.rolloutRevision(pool)
+	require.NoError(t, err)
+	assert.NotEmpty(t, first)
+	_, err = c.rolloutRevision(pool)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.gets, "an unchanged template is not read from the API again")
+
+	template.Object["amazonec2Config"] = map[string]interface{}{"instanceType": "t3.xlarge"}
+	template.SetResourceVersion("2")
+	second, err := c.rolloutRevision(pool)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, 2, client.gets)
+}
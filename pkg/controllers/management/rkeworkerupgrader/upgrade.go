@@ -349,6 +349,12 @@ func (uh *upgradeHandler) upgradeCluster(cluster *v3.Cluster, nodeName string, p
 		state = "drain"
 	}
 
+	if cluster.Annotations[v32.ClusterUpgradeWorkerGateAnnotation] == "true" {
+		// an upgrade plan holds back new worker batches until the control plane passed its health check
+		logrus.Infof("cluster [%s] worker-upgrade: waiting for upgrade plan to release worker nodes", clusterName)
+		status.toPrepare = nil
+	}
+
 	for _, node := range status.toPrepare {
 		if unavailable == maxAllowed {
 			break
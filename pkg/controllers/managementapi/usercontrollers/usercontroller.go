@@ -2,9 +2,6 @@
 
 import (
 	"context"
-	"hash/crc32"
-	"math"
-	"sort"
 	"sync"
 	"time"
 
@@ -81,6 +78,7 @@ type userControllersController struct {
 	clusters      v3.ClusterInterface
 	ctx           context.Context
 	peers         tpeermanager.Peers
+	ring          *tpeermanager.Ring
 	start         time.Time
 }
 
@@ -104,8 +102,7 @@ func (u *userControllersController) setPeers(peers *tpeermanager.Peers) error {
 
 	if peers != nil {
 		u.peers = *peers
-		u.peers.IDs = append(u.peers.IDs, u.peers.SelfID)
-		sort.Strings(u.peers.IDs)
+		u.ring = u.peers.Ring()
 	}
 
 	if err := u.peersSync(); err != nil {
@@ -149,19 +146,11 @@ func (u *userControllersController) amOwner(peers tpeermanager.Peers, cluster *v
 		return true
 	}
 
-	if !peers.Ready || len(peers.IDs) == 0 || (len(peers.IDs) == 1 && !peers.Leader) {
-		return false
-	}
-
-	ck := crc32.ChecksumIEEE([]byte(cluster.UID))
-	if ck == math.MaxUint32 {
-		ck--
-	}
-
-	scaled := int(ck) * len(peers.IDs) / math.MaxUint32
-	logrus.Debugf("%s(%v): (%v * %v) / %v = %v[%v] = %v, self = %v\n", cluster.Name, cluster.UID, ck,
-		uint32(len(peers.IDs)), math.MaxUint32, peers.IDs, scaled, peers.IDs[scaled], peers.SelfID)
-	return peers.IDs[scaled] == peers.SelfID
+	// clusters are sharded by name, like the tunnel sessions of their agents, so that the controllers of a cluster
+	// run on the server its agents are connected to
+	owner := peers.IsOwner(u.ring, cluster.Name)
+	logrus.Debugf("%s: owner = %v, peers = %v, self = %v", cluster.Name, owner, peers.IDs, peers.SelfID)
+	return owner
 }
 
 func (u *userControllersController) cleanFinalizers(key string, cluster *v3.Cluster) error {
@@ -1,13 +1,25 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// EscalationRouteLabel, EscalationStepLabel and EscalatedFromLabel mark the copies of the alerts sent to the
+	// recipients of an escalation step, EscalatedFromLabel is the fingerprint of the original alert
+	EscalationRouteLabel = "escalation_route"
+	EscalationStepLabel  = "escalation_step"
+	EscalatedFromLabel   = "escalated_from"
+)
+
 func GetRuleID(groupID string, ruleName string) string {
 	return fmt.Sprintf("%s_%s", groupID, ruleName)
 }
@@ -16,6 +28,14 @@ func GetGroupID(namespace, name string) string {
 	return fmt.Sprintf("%s:%s", namespace, name)
 }
 
+func GetRouteReceiverName(groupID, routeName string) string {
+	return fmt.Sprintf("%s-%s", groupID, routeName)
+}
+
+func GetEscalationReceiverName(groupID, routeName string, step int) string {
+	return fmt.Sprintf("%s-%s-escalation-%d", groupID, routeName, step)
+}
+
 func GetAlertManagerSecretName(appName string) string {
 	return fmt.Sprintf("alertmanager-%s", appName)
 }
@@ -52,3 +72,95 @@ func GetProjectDisplayName(projectID string, projectLister v3.ProjectLister) str
 
 	return formatProjectDisplayName(project.Spec.DisplayName, projectID)
 }
+
+// RouteMatches tells if the labels of an alert match the route, the way Alertmanager matches the route compiled from it
+func RouteMatches(route v32.AlertRoute, labels map[string]string) bool {
+	if len(route.Severities) > 0 {
+		found := false
+		for _, severity := range route.Severities {
+			if labels["severity"] == severity {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for name, value := range route.Match {
+		if labels[name] != value {
+			return false
+		}
+	}
+	for name, value := range route.MatchRegex {
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil || !re.MatchString(labels[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// OnCallParticipant returns the participant of the schedule on call at the time and when the next one takes over,
+// the first participant is also on call before the schedule starts
+func OnCallParticipant(spec v32.OnCallScheduleSpec, t time.Time) (*v32.OnCallParticipant, time.Time, error) {
+	if len(spec.Participants) == 0 {
+		return nil, time.Time{}, errors.New("the schedule has no participants")
+	}
+	start, err := time.Parse(time.RFC3339, spec.StartsAt)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid start of the schedule: %v", err)
+	}
+	rotation := time.Duration(spec.RotationHours) * time.Hour
+	if rotation <= 0 {
+		return nil, time.Time{}, errors.New("the rotation of the schedule must be at least an hour")
+	}
+
+	if t.Before(start) {
+		return &spec.Participants[0], start.Add(rotation), nil
+	}
+	turns := int64(t.Sub(start) / rotation)
+	return &spec.Participants[turns%int64(len(spec.Participants))], start.Add(time.Duration(turns+1) * rotation), nil
+}
+
+// GroupReceivers returns the recipients of the Alertmanager receivers compiled from an alert group, by the name of
+// the receiver
+func GroupReceivers(groupID string, recipients []v32.Recipient, routes []v32.AlertRoute) map[string][]v32.Recipient {
+	receivers := map[string][]v32.Recipient{groupID: recipients}
+	for _, route := range routes {
+		receivers[GetRouteReceiverName(groupID, route.Name)] = route.Recipients
+		for i, escalation := range route.Escalations {
+			receivers[GetEscalationReceiverName(groupID, route.Name, i+1)] = escalation.Recipients
+		}
+	}
+	return receivers
+}
+
+// NotifierType returns the type of the notifier the recipients refer to, it is empty when no config is set
+func NotifierType(spec v32.NotifierSpec) string {
+	switch {
+	case spec.SMTPConfig != nil:
+		return "email"
+	case spec.SlackConfig != nil:
+		return "slack"
+	case spec.PagerdutyConfig != nil:
+		return "pagerduty"
+	case spec.WebhookConfig != nil:
+		return "webhook"
+	case spec.WechatConfig != nil:
+		return "wechat"
+	case spec.DingtalkConfig != nil:
+		return "dingtalk"
+	case spec.MSTeamsConfig != nil:
+		return "msteams"
+	case spec.OpsgenieConfig != nil:
+		return "opsgenie"
+	case spec.TelegramConfig != nil:
+		return "telegram"
+	case spec.MattermostConfig != nil:
+		return "mattermost"
+	case spec.GoogleChatConfig != nil:
+		return "googlechat"
+	}
+	return ""
+}
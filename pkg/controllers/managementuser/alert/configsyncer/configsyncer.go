@@ -5,8 +5,10 @@
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -14,6 +16,7 @@
 	"github.com/pkg/errors"
 	"github.com/prometheus/common/model"
 	"github.com/rancher/norman/controller"
+	"github.com/rancher/rancher/pkg/alertrelay"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
 	alertconfig "github.com/rancher/rancher/pkg/controllers/managementuser/alert/config"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
@@ -25,6 +28,7 @@
 	notifierutil "github.com/rancher/rancher/pkg/notifiers"
 	"github.com/rancher/rancher/pkg/project"
 	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 
 	"github.com/sirupsen/logrus"
@@ -65,11 +69,15 @@ func NewConfigSyncer(ctx context.Context, cluster *config.UserContext, alertMana
 		apps:                    cluster.Management.Project.Apps(metav1.NamespaceAll),
 		appLister:               cluster.Management.Project.Apps(metav1.NamespaceAll).Controller().Lister(),
 		secretsGetter:           cluster.Core,
+		relaySecrets:            cluster.Management.Core.Secrets(cluster.ClusterName),
+		relaySecretLister:       cluster.Management.Core.Secrets("").Controller().Lister(),
 		clusterAlertGroupLister: cluster.Management.Management.ClusterAlertGroups(cluster.ClusterName).Controller().Lister(),
 		projectAlertGroupLister: cluster.Management.Management.ProjectAlertGroups("").Controller().Lister(),
 		clusterAlertRuleLister:  cluster.Management.Management.ClusterAlertRules(cluster.ClusterName).Controller().Lister(),
 		projectAlertRuleLister:  cluster.Management.Management.ProjectAlertRules("").Controller().Lister(),
 		notifierLister:          cluster.Management.Management.Notifiers(cluster.ClusterName).Controller().Lister(),
+		onCallSchedules:         cluster.Management.Management.OnCallSchedules(cluster.ClusterName),
+		onCallScheduleLister:    cluster.Management.Management.OnCallSchedules(cluster.ClusterName).Controller().Lister(),
 		clusterLister:           cluster.Management.Management.Clusters(metav1.NamespaceAll).Controller().Lister(),
 		projectLister:           cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
 		clusterName:             cluster.ClusterName,
@@ -82,11 +90,15 @@ type ConfigSyncer struct {
 	apps                    projectv3.AppInterface
 	appLister               projectv3.AppLister
 	secretsGetter           v1.SecretsGetter
+	relaySecrets            v1.SecretInterface
+	relaySecretLister       v1.SecretLister
 	projectAlertGroupLister v3.ProjectAlertGroupLister
 	clusterAlertGroupLister v3.ClusterAlertGroupLister
 	projectAlertRuleLister  v3.ProjectAlertRuleLister
 	clusterAlertRuleLister  v3.ClusterAlertRuleLister
 	notifierLister          v3.NotifierLister
+	onCallSchedules         v3.OnCallScheduleInterface
+	onCallScheduleLister    v3.OnCallScheduleLister
 	clusterLister           v3.ClusterLister
 	projectLister           v3.ProjectLister
 	clusterName             string
@@ -114,6 +126,34 @@ func (d *ConfigSyncer) NotifierSync(key string, alert *v3.Notifier) (runtime.Obj
 	return nil, d.sync()
 }
 
+// OnCallScheduleSync records who is on call and syncs the config again at the next handoff
+func (d *ConfigSyncer) OnCallScheduleSync(key string, schedule *v3.OnCallSchedule) (runtime.Object, error) {
+	if schedule == nil || schedule.DeletionTimestamp != nil {
+		return nil, d.sync()
+	}
+
+	participant, next, err := common.OnCallParticipant(schedule.Spec, time.Now())
+	if err != nil {
+		logrus.Warnf("Failed to resolve the on-call schedule %s: %v", key, err)
+		return nil, d.sync()
+	}
+
+	status := v32.OnCallScheduleStatus{
+		CurrentParticipant: participant.Name,
+		NextHandoff:        next.UTC().Format(time.RFC3339),
+	}
+	if schedule.Status != status {
+		newSchedule := schedule.DeepCopy()
+		newSchedule.Status = status
+		if _, err := d.onCallSchedules.Update(newSchedule); err != nil {
+			return nil, err
+		}
+	}
+	d.onCallSchedules.Controller().EnqueueAfter(schedule.Namespace, schedule.Name, time.Until(next))
+
+	return nil, d.sync()
+}
+
 //sync: update the secret which store the configuration of alertmanager given the latest configured notifiers and alerts rules.
 //For each alert, it will generate a route and a receiver in the alertmanager's configuration file, for metric rules it will update operator crd also.
 func (d *ConfigSyncer) sync() error {
@@ -142,6 +182,12 @@ func (d *ConfigSyncer) sync() error {
 		return errors.Wrapf(err, "List notifiers")
 	}
 
+	schedules, err := d.onCallScheduleLister.List("", labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "List on-call schedules")
+	}
+	onCall := onCallRecipients(schedules, time.Now())
+
 	clusterAlertGroup, err := d.clusterAlertGroupLister.List(metav1.NamespaceAll, labels.NewSelector())
 	if err != nil {
 		return errors.Wrapf(err, "List cluster alert group")
@@ -149,8 +195,11 @@ func (d *ConfigSyncer) sync() error {
 
 	cAlertGroupsMap := map[string]*v3.ClusterAlertGroup{}
 	for _, v := range clusterAlertGroup {
-		if len(v.Spec.Recipients) > 0 {
+		if len(v.Spec.Recipients) > 0 || len(v.Spec.Routes) > 0 {
 			groupID := common.GetGroupID(v.Namespace, v.Name)
+			v = v.DeepCopy()
+			v.Spec.Recipients = d.resolveOnCall(v.Spec.Recipients, onCall, notifiers)
+			d.resolveRoutesOnCall(v.Spec.Routes, onCall, notifiers)
 			cAlertGroupsMap[groupID] = v
 		}
 	}
@@ -162,8 +211,11 @@ func (d *ConfigSyncer) sync() error {
 
 	pAlertGroupsMap := map[string]*v3.ProjectAlertGroup{}
 	for _, v := range projectAlertGroup {
-		if len(v.Spec.Recipients) > 0 && controller.ObjectInCluster(d.clusterName, v) {
+		if (len(v.Spec.Recipients) > 0 || len(v.Spec.Routes) > 0) && controller.ObjectInCluster(d.clusterName, v) {
 			groupID := common.GetGroupID(v.Namespace, v.Name)
+			v = v.DeepCopy()
+			v.Spec.Recipients = d.resolveOnCall(v.Spec.Recipients, onCall, notifiers)
+			d.resolveRoutesOnCall(v.Spec.Routes, onCall, notifiers)
 			pAlertGroupsMap[groupID] = v
 		}
 	}
@@ -220,11 +272,16 @@ func (d *ConfigSyncer) sync() error {
 	config := manager.GetAlertManagerDefaultConfig()
 	config.Global.PagerdutyURL = "https://events.pagerduty.com/v2/enqueue"
 
-	if err = d.addClusterAlert2Config(config, cAlertsMap, cAlertsKey, cAlertGroupsMap, notifiers); err != nil {
+	relay, err := d.newRelayTarget()
+	if err != nil {
+		return errors.Wrapf(err, "Get alert relay token")
+	}
+
+	if err = d.addClusterAlert2Config(config, cAlertsMap, cAlertsKey, cAlertGroupsMap, notifiers, relay); err != nil {
 		return err
 	}
 
-	if err = d.addProjectAlert2Config(config, pAlertsMap, pAlertsKey, pAlertGroupsMap, notifiers); err != nil {
+	if err = d.addProjectAlert2Config(config, pAlertsMap, pAlertsKey, pAlertGroupsMap, notifiers, relay); err != nil {
 		return err
 	}
 
@@ -241,10 +298,17 @@ func (d *ConfigSyncer) sync() error {
 		return errors.Wrapf(err, "Get secrets")
 	}
 
-	if string(configSecret.Data["alertmanager.yaml"]) != string(data) || string(configSecret.Data["notification.tmpl"]) != deployer.NotificationTmpl {
+	caCerts := settings.CACerts.Get()
+	if string(configSecret.Data["alertmanager.yaml"]) != string(data) || string(configSecret.Data["notification.tmpl"]) != deployer.NotificationTmpl ||
+		string(configSecret.Data[relayCAKey]) != caCerts {
 		newConfigSecret := configSecret.DeepCopy()
 		newConfigSecret.Data["alertmanager.yaml"] = data
 		newConfigSecret.Data["notification.tmpl"] = []byte(deployer.NotificationTmpl)
+		if caCerts != "" {
+			newConfigSecret.Data[relayCAKey] = []byte(caCerts)
+		} else {
+			delete(newConfigSecret.Data, relayCAKey)
+		}
 
 		_, err = secretClient.Update(newConfigSecret)
 		if err != nil {
@@ -340,7 +404,7 @@ func (d *ConfigSyncer) addClusterAlert2Operator(clusterDisplayName string, group
 	return nil
 }
 
-func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projectGroups map[string]map[string][]*v3.ProjectAlertRule, keys []string, alertGroups map[string]*v3.ProjectAlertGroup, notifiers []*v3.Notifier) error {
+func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projectGroups map[string]map[string][]*v3.ProjectAlertRule, keys []string, alertGroups map[string]*v3.ProjectAlertGroup, notifiers []*v3.Notifier, relay *relayTarget) error {
 	for _, projectName := range keys {
 		groups := projectGroups[projectName]
 		var groupIDs []string
@@ -358,9 +422,9 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 
 			receiver := &alertconfig.Receiver{Name: groupID}
 
-			exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients)
+			exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients, groupID, group.Spec.Template, relay)
 
-			if exist {
+			if exist || len(group.Spec.Routes) > 0 {
 				config.Receivers = append(config.Receivers, receiver)
 				r1 := d.newRoute(map[string]string{"group_id": groupID}, false, group.Spec.TimingField, []model.LabelName{"group_id"})
 
@@ -371,12 +435,13 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 
 					groupBy := getProjectAlertGroupBy(alert.Spec)
 
-					if alert.Spec.PodRule != nil || alert.Spec.WorkloadRule != nil || alert.Spec.MetricRule != nil {
+					if groupBy != nil {
 						ruleID := common.GetRuleID(groupID, alert.Name)
 						d.addRule(ruleID, r1, alert.Spec.CommonRuleField, groupBy)
 					}
 
 				}
+				d.addGroupRoutes(config, r1, groupID, group.Spec.Routes, group.Spec.Template, notifiers, relay)
 				d.appendRoute(config.Route, r1)
 			}
 		}
@@ -385,7 +450,7 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 	return nil
 }
 
-func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts map[string][]*v3.ClusterAlertRule, keys []string, alertGroups map[string]*v3.ClusterAlertGroup, notifiers []*v3.Notifier) error {
+func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts map[string][]*v3.ClusterAlertRule, keys []string, alertGroups map[string]*v3.ClusterAlertGroup, notifiers []*v3.Notifier, relay *relayTarget) error {
 	for _, groupID := range keys {
 		groupRules := alerts[groupID]
 		receiver := &alertconfig.Receiver{Name: groupID}
@@ -395,9 +460,9 @@ func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts
 			return fmt.Errorf("get cluster alert group %s failed", groupID)
 		}
 
-		exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients)
+		exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients, groupID, group.Spec.Template, relay)
 
-		if exist {
+		if exist || len(group.Spec.Routes) > 0 {
 			config.Receivers = append(config.Receivers, receiver)
 			r1 := d.newRoute(map[string]string{"group_id": groupID}, false, group.Spec.TimingField, []model.LabelName{"group_id"})
 			for _, alert := range groupRules {
@@ -424,12 +489,163 @@ func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts
 
 			}
 
+			d.addGroupRoutes(config, r1, groupID, group.Spec.Routes, group.Spec.Template, notifiers, relay)
 			d.appendRoute(config.Route, r1)
 		}
 	}
 	return nil
 }
 
+// addGroupRoutes puts the routes of the alert group in front of the routes of its rules. The escalated copies of the
+// alerts come first so they only reach the recipients of their step, every route then gets the routes of the rules so
+// the timing of the rules still applies to its recipients. A later escalation step inhibits the earlier ones.
+func (d *ConfigSyncer) addGroupRoutes(config *alertconfig.Config, groupRoute *alertconfig.Route, groupID string, routes []v32.AlertRoute, groupTemplate *v32.NotificationTemplate, notifiers []*v3.Notifier, relay *relayTarget) {
+	if len(routes) == 0 {
+		return
+	}
+
+	ruleRoutes := groupRoute.Routes
+	var escalationRoutes, alertRoutes []*alertconfig.Route
+	for _, route := range routes {
+		for i, escalation := range route.Escalations {
+			step := strconv.Itoa(i + 1)
+			name := common.GetEscalationReceiverName(groupID, route.Name, i+1)
+			receiver := &alertconfig.Receiver{Name: name}
+			d.addRecipients(notifiers, receiver, escalation.Recipients, groupID, groupTemplate, relay)
+			config.Receivers = append(config.Receivers, receiver)
+
+			escalationRoutes = append(escalationRoutes, &alertconfig.Route{
+				Receiver: name,
+				Match: map[string]string{
+					common.EscalationRouteLabel: route.Name,
+					common.EscalationStepLabel:  step,
+				},
+			})
+
+			if i > 0 {
+				config.InhibitRules = append(config.InhibitRules, &alertconfig.InhibitRule{
+					SourceMatch: map[string]string{
+						"group_id":                  groupID,
+						common.EscalationRouteLabel: route.Name,
+						common.EscalationStepLabel:  step,
+					},
+					TargetMatch: map[string]string{
+						"group_id":                  groupID,
+						common.EscalationRouteLabel: route.Name,
+						common.EscalationStepLabel:  strconv.Itoa(i),
+					},
+					Equal: model.LabelNames{common.EscalatedFromLabel},
+				})
+			}
+		}
+
+		name := common.GetRouteReceiverName(groupID, route.Name)
+		receiver := &alertconfig.Receiver{Name: name}
+		d.addRecipients(notifiers, receiver, route.Recipients, groupID, groupTemplate, relay)
+		config.Receivers = append(config.Receivers, receiver)
+
+		// the routes of the rules don't name a receiver, they use the one of the route they are under
+		r := newAlertRoute(name, route)
+		for _, ruleRoute := range ruleRoutes {
+			rr := *ruleRoute
+			d.appendRoute(r, &rr)
+		}
+		alertRoutes = append(alertRoutes, r)
+	}
+
+	groupRoute.Routes = append(append(escalationRoutes, alertRoutes...), ruleRoutes...)
+}
+
+// newAlertRoute matches the severities and labels of the route, the first route that doesn't continue keeps the alerts
+// from the recipients of the group
+func newAlertRoute(receiver string, route v32.AlertRoute) *alertconfig.Route {
+	r := &alertconfig.Route{
+		Receiver: receiver,
+		Continue: route.Continue,
+		Match:    map[string]string{},
+		MatchRE:  map[string]alertconfig.Regexp{},
+	}
+	for name, value := range route.Match {
+		r.Match[name] = value
+	}
+	for name, value := range route.MatchRegex {
+		re, err := regexp.Compile(value)
+		if err != nil {
+			logrus.Warnf("Failed to compile the regex of label %s of alert route %s: %v", name, route.Name, err)
+			continue
+		}
+		r.MatchRE[name] = alertconfig.Regexp{Regexp: re}
+	}
+
+	if len(route.Severities) == 1 {
+		r.Match["severity"] = route.Severities[0]
+	} else if len(route.Severities) > 1 {
+		var severities []string
+		for _, severity := range route.Severities {
+			severities = append(severities, regexp.QuoteMeta(severity))
+		}
+		r.MatchRE["severity"] = alertconfig.Regexp{Regexp: regexp.MustCompile(strings.Join(severities, "|"))}
+	}
+
+	if len(r.Match) == 0 {
+		r.Match = nil
+	}
+	if len(r.MatchRE) == 0 {
+		r.MatchRE = nil
+	}
+	return r
+}
+
+// onCallRecipients returns who is on call now, by the ID of the schedule
+func onCallRecipients(schedules []*v3.OnCallSchedule, now time.Time) map[string]*v32.OnCallParticipant {
+	participants := map[string]*v32.OnCallParticipant{}
+	for _, schedule := range schedules {
+		participant, _, err := common.OnCallParticipant(schedule.Spec, now)
+		if err != nil {
+			logrus.Debugf("Failed to resolve the on-call schedule %s:%s: %v", schedule.Namespace, schedule.Name, err)
+			continue
+		}
+		participants[common.GetGroupID(schedule.Namespace, schedule.Name)] = participant
+	}
+	return participants
+}
+
+// resolveOnCall replaces the recipients that refer to an on-call schedule with the participant on call, the
+// recipients of a schedule that can't be resolved keep their own notifier and recipient. A participant with its own
+// notifier also brings the type of the notifier, the receivers are compiled by the type of the recipients.
+func (d *ConfigSyncer) resolveOnCall(recipients []v32.Recipient, onCall map[string]*v32.OnCallParticipant, notifiers []*v3.Notifier) []v32.Recipient {
+	for i, r := range recipients {
+		if r.OnCallScheduleName == "" {
+			continue
+		}
+		participant, ok := onCall[r.OnCallScheduleName]
+		if !ok {
+			logrus.Debugf("Can not find who is on call for the schedule %s", r.OnCallScheduleName)
+			continue
+		}
+		if participant.NotifierName != "" {
+			notifier := d.getNotifier(participant.NotifierName, notifiers)
+			if notifier == nil {
+				logrus.Debugf("Can not find the notifier %s of %s on call for the schedule %s", participant.NotifierName, participant.Name, r.OnCallScheduleName)
+				continue
+			}
+			recipients[i].NotifierName = participant.NotifierName
+			recipients[i].NotifierType = common.NotifierType(notifier.Spec)
+		}
+		recipients[i].Recipient = participant.Recipient
+	}
+	return recipients
+}
+
+func (d *ConfigSyncer) resolveRoutesOnCall(routes []v32.AlertRoute, onCall map[string]*v32.OnCallParticipant, notifiers []*v3.Notifier) {
+	for i := range routes {
+		routes[i].Recipients = d.resolveOnCall(routes[i].Recipients, onCall, notifiers)
+		for j := range routes[i].Escalations {
+			routes[i].Escalations[j].Recipients = d.resolveOnCall(routes[i].Escalations[j].Recipients, onCall, notifiers)
+		}
+	}
+}
+
 func (d *ConfigSyncer) addRule(ruleID string, route *alertconfig.Route, comm v32.CommonRuleField, groupBy []model.LabelName) {
 	inherited := true
 	if comm.Inherited != nil {
@@ -475,7 +691,7 @@ func (d *ConfigSyncer) appendRoute(route *alertconfig.Route, subRoute *alertconf
 	route.Routes = append(route.Routes, subRoute)
 }
 
-func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertconfig.Receiver, recipients []v32.Recipient) bool {
+func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertconfig.Receiver, recipients []v32.Recipient, groupID string, groupTemplate *v32.NotificationTemplate, relay *relayTarget) bool {
 	receiverExist := false
 	for _, r := range recipients {
 		if r.NotifierName != "" {
@@ -487,11 +703,27 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 			commonNotifierConfig := alertconfig.NotifierConfig{
 				VSendResolved: notifier.Spec.SendResolved,
 			}
-			if notifier.Spec.PagerdutyConfig != nil {
+			tmpl := deployer.MergeNotificationTemplates(notifier.Spec.Template, groupTemplate)
+			if tmpl == nil {
+				tmpl = &v32.NotificationTemplate{}
+			}
+			title := templateOrDefault(tmpl.Title, `{{ template "rancher.title" . }}`)
+			if alertrelay.Relayed(notifier, tmpl) {
+				if relay == nil {
+					logrus.Warnf("Can not send the alerts to notifier %s, rancher relays them and the %s setting is not set", r.NotifierName, settings.ServerURL.Name)
+					continue
+				}
+				receiver.WebhookConfigs = append(receiver.WebhookConfigs, relay.webhookConfig(commonNotifierConfig, d.clusterName, groupID, r))
+				receiverExist = true
+
+			} else if notifier.Spec.PagerdutyConfig != nil {
 				pagerduty := &alertconfig.PagerdutyConfig{
 					NotifierConfig: commonNotifierConfig,
 					ServiceKey:     alertconfig.Secret(notifier.Spec.PagerdutyConfig.ServiceKey),
-					Description:    `{{ template "rancher.title" . }}`,
+					Description:    title,
+				}
+				if tmpl.Text != "" {
+					pagerduty.Details = map[string]string{"message": tmpl.Text}
 				}
 
 				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.PagerdutyConfig.HTTPClientConfig) {
@@ -516,7 +748,7 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 					APISecret:      alertconfig.Secret(notifier.Spec.WechatConfig.Secret),
 					AgentID:        notifier.Spec.WechatConfig.Agent,
 					CorpID:         notifier.Spec.WechatConfig.Corp,
-					Message:        `{{ template "wechat.text" . }}`,
+					Message:        templateOrDefault(tmpl.Text, `{{ template "wechat.text" . }}`),
 				}
 
 				recipient := notifier.Spec.WechatConfig.DefaultRecipient
@@ -567,6 +799,65 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 				receiver.WebhookConfigs = append(receiver.WebhookConfigs, msTeams)
 				receiverExist = true
 
+			} else if notifier.Spec.OpsgenieConfig != nil {
+				opsgenie := &alertconfig.OpsGenieConfig{
+					NotifierConfig: commonNotifierConfig,
+					APIKey:         alertconfig.Secret(notifier.Spec.OpsgenieConfig.APIKey),
+					APIURL:         notifier.Spec.OpsgenieConfig.APIURL,
+					Message:        title,
+					Description:    templateOrDefault(tmpl.Text, `{{ template "slack.text" . }}`),
+					Source:         "rancher",
+					Priority:       `{{ if eq (index .Alerts 0).Labels.severity "critical" }}P1{{ else if eq (index .Alerts 0).Labels.severity "warning" }}P3{{ else }}P5{{ end }}`,
+				}
+				team := notifier.Spec.OpsgenieConfig.DefaultRecipient
+				if r.Recipient != "" {
+					team = r.Recipient
+				}
+				if team != "" {
+					opsgenie.Teams = team
+				}
+
+				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.OpsgenieConfig.HTTPClientConfig) {
+					url, err := toAlertManagerURL(notifier.Spec.OpsgenieConfig.HTTPClientConfig.ProxyURL)
+					if err != nil {
+						logrus.Errorf("Failed to parse opsgenie proxy url %s, %v", notifier.Spec.OpsgenieConfig.HTTPClientConfig.ProxyURL, err)
+						continue
+					}
+					opsgenie.HTTPConfig = &alertconfig.HTTPClientConfig{
+						ProxyURL: *url,
+					}
+				}
+				receiver.OpsGenieConfigs = append(receiver.OpsGenieConfigs, opsgenie)
+				receiverExist = true
+
+			} else if notifier.Spec.MattermostConfig != nil {
+				// Mattermost and Rocket.Chat incoming webhooks accept the Slack messages of Alertmanager
+				mattermost := &alertconfig.SlackConfig{
+					NotifierConfig: commonNotifierConfig,
+					APIURL:         alertconfig.Secret(notifier.Spec.MattermostConfig.URL),
+					Channel:        notifier.Spec.MattermostConfig.DefaultRecipient,
+					Text:           templateOrDefault(tmpl.Text, `{{ template "slack.text" . }}`),
+					Title:          title,
+					TitleLink:      "",
+					Color:          `{{ if eq (index .Alerts 0).Labels.severity "critical" }}danger{{ else if eq (index .Alerts 0).Labels.severity "warning" }}warning{{ else }}good{{ end }}`,
+				}
+				if r.Recipient != "" {
+					mattermost.Channel = r.Recipient
+				}
+
+				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.MattermostConfig.HTTPClientConfig) {
+					url, err := toAlertManagerURL(notifier.Spec.MattermostConfig.HTTPClientConfig.ProxyURL)
+					if err != nil {
+						logrus.Errorf("Failed to parse mattermost proxy url %s, %v", notifier.Spec.MattermostConfig.HTTPClientConfig.ProxyURL, err)
+						continue
+					}
+					mattermost.HTTPConfig = &alertconfig.HTTPClientConfig{
+						ProxyURL: *url,
+					}
+				}
+				receiver.SlackConfigs = append(receiver.SlackConfigs, mattermost)
+				receiverExist = true
+
 			} else if notifier.Spec.WebhookConfig != nil {
 				webhook := &alertconfig.WebhookConfig{
 					NotifierConfig: commonNotifierConfig,
@@ -594,8 +885,8 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 					NotifierConfig: commonNotifierConfig,
 					APIURL:         alertconfig.Secret(notifier.Spec.SlackConfig.URL),
 					Channel:        notifier.Spec.SlackConfig.DefaultRecipient,
-					Text:           `{{ template "slack.text" . }}`,
-					Title:          `{{ template "rancher.title" . }}`,
+					Text:           templateOrDefault(tmpl.Text, `{{ template "slack.text" . }}`),
+					Title:          title,
 					TitleLink:      "",
 					Color:          `{{ if eq (index .Alerts 0).Labels.severity "critical" }}danger{{ else if eq (index .Alerts 0).Labels.severity "warning" }}warning{{ else }}good{{ end }}`,
 				}
@@ -618,7 +909,7 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 
 			} else if notifier.Spec.SMTPConfig != nil {
 				header := map[string]string{}
-				header["Subject"] = `{{ template "rancher.title" . }}`
+				header["Subject"] = title
 				email := &alertconfig.EmailConfig{
 					NotifierConfig: commonNotifierConfig,
 					Smarthost:      notifier.Spec.SMTPConfig.Host + ":" + strconv.Itoa(notifier.Spec.SMTPConfig.Port),
@@ -628,7 +919,7 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 					To:             notifier.Spec.SMTPConfig.DefaultRecipient,
 					Headers:        header,
 					From:           notifier.Spec.SMTPConfig.Sender,
-					HTML:           `{{ template "email.text" . }}`,
+					HTML:           templateOrDefault(tmpl.HTML, `{{ template "email.text" . }}`),
 				}
 				if r.Recipient != "" {
 					email.To = r.Recipient
@@ -644,6 +935,13 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 
 }
 
+func templateOrDefault(tmpl, defaultTmpl string) string {
+	if tmpl != "" {
+		return tmpl
+	}
+	return defaultTmpl
+}
+
 func (d *ConfigSyncer) isAppDeploy(appNamespace string) (bool, error) {
 	appName, _ := monitorutil.ClusterAlertManagerInfo()
 	app, err := d.appLister.Get(appNamespace, appName)
@@ -690,6 +988,14 @@ func getProjectAlertGroupBy(spec v32.ProjectAlertRuleSpec) []model.LabelName {
 		return []model.LabelName{"rule_id", "namespace", "pod_name", "alert_type"}
 	} else if spec.WorkloadRule != nil {
 		return []model.LabelName{"rule_id", "workload_namespace", "workload_name", "workload_kind"}
+	} else if spec.VolumeRule != nil {
+		return []model.LabelName{"rule_id", "namespace", "volume_claim_name"}
+	} else if spec.CertificateRule != nil {
+		return []model.LabelName{"rule_id", "namespace", "certificate_name"}
+	} else if spec.JobRule != nil {
+		return []model.LabelName{"rule_id", "namespace", "job_name"}
+	} else if spec.HPARule != nil {
+		return []model.LabelName{"rule_id", "namespace", "hpa_name"}
 	} else if spec.MetricRule != nil {
 		return []model.LabelName{"rule_id"}
 	}
@@ -705,14 +1011,73 @@ func toAlertManagerURL(urlStr string) (*alertconfig.URL, error) {
 	return &alertconfig.URL{URL: url}, nil
 }
 
+// relayCAKey is the key of the CA certificates of rancher in the Alertmanager secret, the secret is mounted in
+// alertmanagerConfigDir
+const (
+	relayCAKey            = "rancher-ca.crt"
+	alertmanagerConfigDir = "/etc/alertmanager/config/"
+)
+
+// relayTarget is where Alertmanager posts the alerts of the notifiers it has no integration for, rancher sends them
+type relayTarget struct {
+	serverURL string
+	token     string
+	caFile    string
+}
+
+// newRelayTarget returns nil when the server URL is not set, Alertmanager can not reach rancher then
+func (d *ConfigSyncer) newRelayTarget() (*relayTarget, error) {
+	serverURL := settings.ServerURL.Get()
+	if serverURL == "" {
+		return nil, nil
+	}
+	token, err := alertrelay.EnsureToken(d.relaySecrets, d.relaySecretLister, d.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	relay := &relayTarget{
+		serverURL: serverURL,
+		token:     token,
+	}
+	if settings.CACerts.Get() != "" {
+		relay.caFile = alertmanagerConfigDir + relayCAKey
+	}
+	return relay, nil
+}
+
+func (t *relayTarget) webhookConfig(notifierConfig alertconfig.NotifierConfig, clusterName, groupID string, r v32.Recipient) *alertconfig.WebhookConfig {
+	return &alertconfig.WebhookConfig{
+		NotifierConfig: notifierConfig,
+		URL:            alertrelay.URL(t.serverURL, clusterName, groupID, r),
+		HTTPConfig: &alertconfig.HTTPClientConfig{
+			BearerToken: alertconfig.Secret(t.token),
+			TLSConfig: alertconfig.TLSConfig{
+				CAFile: t.caFile,
+			},
+		},
+	}
+}
+
+type groupRecipients struct {
+	recipients []v32.Recipient
+	template   *v32.NotificationTemplate
+}
+
+// addGroupRecipients adds the recipients of the alert group and of its routes by the names of their receivers
+func addGroupRecipients(groups map[string]groupRecipients, groupID string, recipients []v32.Recipient, routes []v32.AlertRoute, tmpl *v32.NotificationTemplate) {
+	for name, r := range common.GroupReceivers(groupID, recipients, routes) {
+		groups[name] = groupRecipients{recipients: r, template: tmpl}
+	}
+}
+
 func (d *ConfigSyncer) syncReceiver(notifiers []*v3.Notifier, cAlertGroupsMap map[string]*v3.ClusterAlertGroup, pAlertGroupsMap map[string]*v3.ProjectAlertGroup) error {
-	var recipients []v32.Recipient
-	for _, group := range cAlertGroupsMap {
-		recipients = append(recipients, group.Spec.Recipients...)
+	groups := map[string]groupRecipients{}
+	for groupID, group := range cAlertGroupsMap {
+		addGroupRecipients(groups, groupID, group.Spec.Recipients, group.Spec.Routes, group.Spec.Template)
 	}
 
-	for _, group := range pAlertGroupsMap {
-		recipients = append(recipients, group.Spec.Recipients...)
+	for groupID, group := range pAlertGroupsMap {
+		addGroupRecipients(groups, groupID, group.Spec.Recipients, group.Spec.Routes, group.Spec.Template)
 	}
 
 	webhookSecreteName, altermanagerAppNamespace := monitorutil.SecretWebhook()
@@ -726,42 +1091,26 @@ func (d *ConfigSyncer) syncReceiver(notifiers []*v3.Notifier, cAlertGroupsMap ma
 
 	providers := make(map[string]*Provider)
 	receivers := make(map[string]*Receiver)
-	for _, r := range recipients {
-		if r.NotifierName != "" {
+	for _, group := range groups {
+		for _, r := range group.recipients {
+			if r.NotifierName == "" {
+				continue
+			}
 			notifier := d.getNotifier(r.NotifierName, notifiers)
 			if notifier == nil {
 				logrus.Debugf("Can not find the notifier %s", r.NotifierName)
 				continue
 			}
-			if notifier.Spec.DingtalkConfig != nil {
-				provider := &Provider{
-					Type:       DingTalk,
-					WebHookURL: notifier.Spec.DingtalkConfig.URL,
-				}
-				if notifier.Spec.DingtalkConfig.Secret != "" {
-					provider.Secret = notifier.Spec.DingtalkConfig.Secret
-				}
-				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.DingtalkConfig.HTTPClientConfig) {
-					provider.ProxyURL = notifier.Spec.DingtalkConfig.HTTPClientConfig.ProxyURL
-				}
-				receiver := &Receiver{
-					Provider: r.NotifierName,
-				}
-				providers[r.NotifierName] = provider
-				receivers[r.NotifierName] = receiver
-			} else if notifier.Spec.MSTeamsConfig != nil {
-				provider := &Provider{
-					Type:       MicrosoftTeams,
-					WebHookURL: notifier.Spec.MSTeamsConfig.URL,
-				}
-				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.MSTeamsConfig.HTTPClientConfig) {
-					provider.ProxyURL = notifier.Spec.MSTeamsConfig.HTTPClientConfig.ProxyURL
-				}
-				receiver := &Receiver{
-					Provider: r.NotifierName,
-				}
-				providers[r.NotifierName] = provider
-				receivers[r.NotifierName] = receiver
+			if alertrelay.Relayed(notifier, deployer.MergeNotificationTemplates(notifier.Spec.Template, group.template)) {
+				continue
+			}
+			provider := newProvider(notifier)
+			if provider == nil {
+				continue
+			}
+			providers[r.NotifierName] = provider
+			receivers[r.NotifierName] = &Receiver{
+				Provider: r.NotifierName,
 			}
 		}
 	}
@@ -784,3 +1133,30 @@ func (d *ConfigSyncer) syncReceiver(notifiers []*v3.Notifier, cAlertGroupsMap ma
 
 	return nil
 }
+
+// newProvider returns the webhook receiver provider of the notifier, or nil when Alertmanager sends the alerts itself
+func newProvider(notifier *v3.Notifier) *Provider {
+	var provider *Provider
+	if notifier.Spec.DingtalkConfig != nil {
+		provider = &Provider{
+			Type:       DingTalk,
+			WebHookURL: notifier.Spec.DingtalkConfig.URL,
+		}
+		if notifier.Spec.DingtalkConfig.Secret != "" {
+			provider.Secret = notifier.Spec.DingtalkConfig.Secret
+		}
+		if notifierutil.IsHTTPClientConfigSet(notifier.Spec.DingtalkConfig.HTTPClientConfig) {
+			provider.ProxyURL = notifier.Spec.DingtalkConfig.HTTPClientConfig.ProxyURL
+		}
+	} else if notifier.Spec.MSTeamsConfig != nil {
+		provider = &Provider{
+			Type:       MicrosoftTeams,
+			WebHookURL: notifier.Spec.MSTeamsConfig.URL,
+		}
+		if notifierutil.IsHTTPClientConfigSet(notifier.Spec.MSTeamsConfig.HTTPClientConfig) {
+			provider.ProxyURL = notifier.Spec.MSTeamsConfig.HTTPClientConfig.ProxyURL
+		}
+	}
+
+	return provider
+}
@@ -8,8 +8,14 @@
 
 	"github.com/prometheus/common/model"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	alertconfig "github.com/rancher/rancher/pkg/controllers/managementuser/alert/config"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -37,7 +43,7 @@ func TestAddClusterAlert2Config(t *testing.T) {
 			clusterName: clusterName,
 		}
 
-		if err := configSyncer.addClusterAlert2Config(config, tt.in, keys, clusterGroupMap, notifiers); err != nil {
+		if err := configSyncer.addClusterAlert2Config(config, tt.in, keys, clusterGroupMap, notifiers, nil); err != nil {
 			t.Error(err)
 			return
 		}
@@ -93,7 +99,7 @@ func TestAddProjectAlert2Config(t *testing.T) {
 			clusterName: clusterName,
 		}
 
-		if err := configSyncer.addProjectAlert2Config(config, tt.in, keys, projectGroupMap, notifiers); err != nil {
+		if err := configSyncer.addProjectAlert2Config(config, tt.in, keys, projectGroupMap, notifiers, nil); err != nil {
 			t.Error(err)
 			return
 		}
@@ -448,3 +454,263 @@ func TestAddProjectAlert2Config(t *testing.T) {
 
 	projectMetricGroupBy = getProjectAlertGroupBy(projectMetricAlert.Spec)
 )
+
+func TestAddRecipientsWithTemplate(t *testing.T) {
+	templated := []*v3.Notifier{notifiers[0].DeepCopy()}
+	templated[0].Spec.Template = &v32.NotificationTemplate{
+		Title: "notifier title",
+		Text:  "notifier text",
+	}
+	groupTemplate := &v32.NotificationTemplate{Text: "group text"}
+
+	d := ConfigSyncer{clusterName: clusterName}
+	receiver := &alertconfig.Receiver{Name: groupID}
+	if !d.addRecipients(templated, receiver, recipients, groupID, groupTemplate, nil) {
+		t.Fatal("expected the slack receiver")
+	}
+	if len(receiver.SlackConfigs) != 1 {
+		t.Fatalf("expected one slack config, got %d", len(receiver.SlackConfigs))
+	}
+	if receiver.SlackConfigs[0].Title != "notifier title" || receiver.SlackConfigs[0].Text != "group text" {
+		t.Errorf("unexpected slack title %q and text %q", receiver.SlackConfigs[0].Title, receiver.SlackConfigs[0].Text)
+	}
+
+	groupTemplate.Payload = `{"text": "{{ .Status }}"}`
+	receiver = &alertconfig.Receiver{Name: groupID}
+	relay := &relayTarget{serverURL: "https://rancher.example.com", token: "relaytoken"}
+	d.addRecipients(templated, receiver, recipients, groupID, groupTemplate, relay)
+	if len(receiver.SlackConfigs) != 0 || len(receiver.WebhookConfigs) != 1 {
+		t.Fatalf("expected the payload to be relayed")
+	}
+	expectedURL := "https://rancher.example.com/v3/alertrelay/testCluster/slack?group=testcluster%3AtestGroup&recipient=testChannel"
+	if receiver.WebhookConfigs[0].URL != expectedURL {
+		t.Errorf("expected url %s, got %s", expectedURL, receiver.WebhookConfigs[0].URL)
+	}
+}
+
+func TestAddGroupRoutes(t *testing.T) {
+	group := clusterGroupMap[groupID].DeepCopy()
+	group.Spec.Routes = []v32.AlertRoute{
+		{
+			Name:       "critical",
+			Severities: []string{"critical"},
+			Recipients: recipients,
+			Escalations: []v32.AlertEscalation{
+				{AfterMinutes: 15, Recipients: recipients},
+				{AfterMinutes: 30, Recipients: recipients},
+			},
+		},
+	}
+	config := manager.GetAlertManagerDefaultConfig()
+	d := ConfigSyncer{clusterName: clusterName}
+	if err := d.addClusterAlert2Config(config, nodeRulesMap, []string{groupID}, map[string]*v3.ClusterAlertGroup{groupID: group}, notifiers, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// the default receiver, the group, the route and its two escalation steps
+	if len(config.Receivers) != 5 {
+		t.Fatalf("expected 5 receivers, got %d", len(config.Receivers))
+	}
+
+	routes := config.Route.Routes[0].Routes
+	if len(routes) != 4 {
+		t.Fatalf("expected 2 escalation routes, the alert route and the rule route, got %d routes", len(routes))
+	}
+	for i, step := range []string{"1", "2"} {
+		r := routes[i]
+		if r.Continue || r.Match[common.EscalationRouteLabel] != "critical" || r.Match[common.EscalationStepLabel] != step {
+			t.Errorf("unexpected escalation route %+v", r)
+		}
+		if r.Receiver != common.GetEscalationReceiverName(groupID, "critical", i+1) {
+			t.Errorf("unexpected receiver %s of escalation step %s", r.Receiver, step)
+		}
+	}
+
+	alertRoute := routes[2]
+	if alertRoute.Continue || alertRoute.Match["severity"] != "critical" || alertRoute.Receiver != common.GetRouteReceiverName(groupID, "critical") {
+		t.Errorf("unexpected alert route %+v", alertRoute)
+	}
+	if len(alertRoute.Routes) != 1 || alertRoute.Routes[0].Receiver != "" || alertRoute.Routes[0].Match["rule_id"] != routes[3].Match["rule_id"] {
+		t.Errorf("expected the rule route under the alert route, got %+v", alertRoute.Routes)
+	}
+
+	if len(config.InhibitRules) != 1 {
+		t.Fatalf("expected the second step to inhibit the first one, got %d inhibit rules", len(config.InhibitRules))
+	}
+	inhibit := config.InhibitRules[0]
+	if inhibit.SourceMatch[common.EscalationStepLabel] != "2" || inhibit.TargetMatch[common.EscalationStepLabel] != "1" ||
+		!reflect.DeepEqual(inhibit.Equal, model.LabelNames{common.EscalatedFromLabel}) {
+		t.Errorf("unexpected inhibit rule %+v", inhibit)
+	}
+}
+
+func TestResolveOnCall(t *testing.T) {
+	start := time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC)
+	schedule := &v3.OnCallSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "primary", Namespace: clusterName},
+		Spec: v32.OnCallScheduleSpec{
+			ClusterName: clusterName,
+			Participants: []v32.OnCallParticipant{
+				{Name: "alice", Recipient: "alice@example.com"},
+				{Name: "bob", Recipient: "PBOBKEY", NotifierName: clusterName + ":pagerduty"},
+			},
+			StartsAt:      start.Format(time.RFC3339),
+			RotationHours: 24,
+		},
+	}
+	notifiers := []*v3.Notifier{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "email", Namespace: clusterName},
+			Spec:       v32.NotifierSpec{SMTPConfig: &v32.SMTPConfig{}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pagerduty", Namespace: clusterName},
+			Spec:       v32.NotifierSpec{PagerdutyConfig: &v32.PagerdutyConfig{}},
+		},
+	}
+	in := []v32.Recipient{
+		{NotifierName: clusterName + ":email", NotifierType: "email", OnCallScheduleName: clusterName + ":primary"},
+		{NotifierName: clusterName + ":email", NotifierType: "email", OnCallScheduleName: clusterName + ":missing", Recipient: "ops@example.com"},
+	}
+
+	d := &ConfigSyncer{clusterName: clusterName}
+	out := d.resolveOnCall(append([]v32.Recipient{}, in...), onCallRecipients([]*v3.OnCallSchedule{schedule}, start.Add(time.Hour)), notifiers)
+	if out[0].Recipient != "alice@example.com" || out[0].NotifierName != clusterName+":email" {
+		t.Errorf("expected alice on call, got %+v", out[0])
+	}
+	if out[1].Recipient != "ops@example.com" {
+		t.Errorf("expected the recipient of an unknown schedule to stay, got %+v", out[1])
+	}
+
+	out = d.resolveOnCall(append([]v32.Recipient{}, in...), onCallRecipients([]*v3.OnCallSchedule{schedule}, start.Add(25*time.Hour)), notifiers)
+	if out[0].Recipient != "PBOBKEY" || out[0].NotifierName != clusterName+":pagerduty" || out[0].NotifierType != "pagerduty" {
+		t.Errorf("expected bob and his notifier on call, got %+v", out[0])
+	}
+
+	out = d.resolveOnCall(append([]v32.Recipient{}, in...), onCallRecipients([]*v3.OnCallSchedule{schedule}, start.Add(25*time.Hour)), notifiers[:1])
+	if out[0].Recipient != "" || out[0].NotifierName != clusterName+":email" || out[0].NotifierType != "email" {
+		t.Errorf("expected the recipient to stay when the notifier of bob is missing, got %+v", out[0])
+	}
+}
+
+func TestAddRecipientsRelayed(t *testing.T) {
+	relayed := []*v3.Notifier{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "telegram", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName:    clusterName,
+				TelegramConfig: &v32.TelegramConfig{Token: "123:abc", DefaultRecipient: "-100"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "opsgenie", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName:    clusterName,
+				OpsgenieConfig: &v32.OpsgenieConfig{APIKey: "key", APIURL: "https://api.eu.opsgenie.com/", DefaultRecipient: "ops"},
+			},
+		},
+	}
+	in := []v32.Recipient{
+		{NotifierName: clusterName + ":telegram", NotifierType: "telegram", Recipient: "-200"},
+		{NotifierName: clusterName + ":opsgenie", NotifierType: "opsgenie"},
+	}
+	relay := &relayTarget{serverURL: "https://rancher.example.com/", token: "relaytoken", caFile: alertmanagerConfigDir + relayCAKey}
+
+	d := ConfigSyncer{clusterName: clusterName}
+	receiver := &alertconfig.Receiver{Name: groupID}
+	if !d.addRecipients(relayed, receiver, in, groupID, nil, relay) {
+		t.Fatal("expected the receiver to have recipients")
+	}
+	if len(receiver.WebhookConfigs) != 1 {
+		t.Fatalf("expected the telegram alerts to be relayed, got %d webhook configs", len(receiver.WebhookConfigs))
+	}
+	webhook := receiver.WebhookConfigs[0]
+	expectedURL := "https://rancher.example.com/v3/alertrelay/testCluster/telegram?group=testcluster%3AtestGroup&recipient=-200"
+	if webhook.URL != expectedURL {
+		t.Errorf("expected url %s, got %s", expectedURL, webhook.URL)
+	}
+	if webhook.HTTPConfig == nil || webhook.HTTPConfig.BearerToken != "relaytoken" || webhook.HTTPConfig.TLSConfig.CAFile != "/etc/alertmanager/config/rancher-ca.crt" {
+		t.Errorf("unexpected http config %+v", webhook.HTTPConfig)
+	}
+	if len(receiver.OpsGenieConfigs) != 1 || receiver.OpsGenieConfigs[0].Teams != "ops" {
+		t.Fatalf("expected the opsgenie alerts to go to the ops team, got %+v", receiver.OpsGenieConfigs)
+	}
+
+	// the config must parse like Alertmanager parses it
+	config := manager.GetAlertManagerDefaultConfig()
+	config.Receivers = append(config.Receivers, receiver)
+	if _, err := alertconfig.Load(config.String()); err != nil {
+		t.Errorf("failed to load the config: %v", err)
+	}
+
+	receiver = &alertconfig.Receiver{Name: groupID}
+	if d.addRecipients(relayed[:1], receiver, in[:1], groupID, nil, nil) {
+		t.Errorf("expected no recipient without the server url, got %+v", receiver.WebhookConfigs)
+	}
+}
+
+func TestSyncReceiver(t *testing.T) {
+	in := []*v3.Notifier{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "dingtalk", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName:    clusterName,
+				DingtalkConfig: &v32.DingtalkConfig{URL: "https://oapi.dingtalk.com/robot/send", Secret: "secret"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "telegram", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName:    clusterName,
+				TelegramConfig: &v32.TelegramConfig{Token: "123:abc", DefaultRecipient: "-100"},
+			},
+		},
+	}
+	group := clusterGroupMap[groupID].DeepCopy()
+	group.Spec.Recipients = []v32.Recipient{
+		{NotifierName: clusterName + ":dingtalk", NotifierType: "dingtalk"},
+		{NotifierName: clusterName + ":telegram", NotifierType: "telegram"},
+	}
+
+	var updated *corev1.Secret
+	secrets := &fakes.SecretInterfaceMock{
+		GetFunc: func(name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+			return &corev1.Secret{Data: map[string][]byte{}}, nil
+		},
+		UpdateFunc: func(in *corev1.Secret) (*corev1.Secret, error) {
+			updated = in
+			return in, nil
+		},
+	}
+	d := ConfigSyncer{
+		clusterName: clusterName,
+		secretsGetter: &fakes.SecretsGetterMock{
+			SecretsFunc: func(namespace string) v1.SecretInterface {
+				return secrets
+			},
+		},
+	}
+	if err := d.syncReceiver(in, map[string]*v3.ClusterAlertGroup{groupID: group}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if updated == nil {
+		t.Fatal("expected the webhook receiver config to be updated")
+	}
+
+	config := WebhookReceiverConfig{}
+	if err := yaml.Unmarshal(updated.Data["config.yaml"], &config); err != nil {
+		t.Fatal(err)
+	}
+	name := clusterName + ":dingtalk"
+	expected := WebhookReceiverConfig{
+		Providers: map[string]*Provider{
+			name: {Type: DingTalk, WebHookURL: "https://oapi.dingtalk.com/robot/send", Secret: "secret"},
+		},
+		Receivers: map[string]*Receiver{
+			name: {Provider: name},
+		},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("expected only the dingtalk provider, got %+v", config)
+	}
+}
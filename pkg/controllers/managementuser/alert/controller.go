@@ -10,7 +10,10 @@
 	"github.com/rancher/norman/controller"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/configsyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/escalator"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/historysyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/silencesyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/statesyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/watcher"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -45,6 +48,7 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	projectAlertGroups := cluster.Management.Management.ProjectAlertGroups("")
 
 	notifiers := cluster.Management.Management.Notifiers(cluster.ClusterName)
+	onCallSchedules := cluster.Management.Management.OnCallSchedules(cluster.ClusterName)
 
 	deploy := deployer.NewDeployer(cluster, alertmanager)
 	clusterAlertGroups.AddClusterScopedHandler(ctx, "cluster-alert-group-deployer", cluster.ClusterName, deploy.ClusterGroupSync)
@@ -60,6 +64,7 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	clusterAlertRules.AddClusterScopedHandler(ctx, "cluster-alert-rule-controller", cluster.ClusterName, configSyncer.ClusterRuleSync)
 	projectAlertRules.AddClusterScopedHandler(ctx, "project-alert-rule-controller", cluster.ClusterName, configSyncer.ProjectRuleSync)
 	notifiers.AddClusterScopedHandler(ctx, "notifier-config-syncer", cluster.ClusterName, configSyncer.NotifierSync)
+	onCallSchedules.AddClusterScopedHandler(ctx, "on-call-schedule-config-syncer", cluster.ClusterName, configSyncer.OnCallScheduleSync)
 
 	cleaner := &alertGroupCleaner{
 		clusterName:        cluster.ClusterName,
@@ -84,6 +89,9 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	projects.AddClusterScopedLifecycle(ctx, "project-precan-alert-controller", cluster.ClusterName, projectLifecycle)
 
 	statesyncer.StartStateSyncer(ctx, cluster, alertmanager)
+	silencesyncer.Register(ctx, cluster, alertmanager)
+	escalator.StartEscalator(ctx, cluster, alertmanager)
+	historysyncer.StartHistorySyncer(ctx, cluster, alertmanager)
 
 	i := &initClusterAlerts{
 		clusterAlertGroups:      clusterAlertGroups,
@@ -107,6 +115,10 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	watcher.StartWorkloadWatcher(ctx, cluster, alertmanager)
 	watcher.StartNodeWatcher(ctx, cluster, alertmanager)
 	watcher.StartClusterScanWatcher(ctx, cluster, alertmanager)
+	watcher.StartVolumeWatcher(ctx, cluster, alertmanager)
+	watcher.StartCertificateWatcher(ctx, cluster, alertmanager)
+	watcher.StartJobWatcher(ctx, cluster, alertmanager)
+	watcher.StartHPAWatcher(ctx, cluster, alertmanager)
 
 }
 
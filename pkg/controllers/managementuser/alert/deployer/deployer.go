@@ -50,6 +50,7 @@ type Deployer struct {
 	clusterAlertGroupLister mgmtv3.ClusterAlertGroupLister
 	projectAlertGroupLister mgmtv3.ProjectAlertGroupLister
 	notifierLister          mgmtv3.NotifierLister
+	onCallScheduleLister    mgmtv3.OnCallScheduleLister
 	projectLister           mgmtv3.ProjectLister
 	clusters                mgmtv3.ClusterInterface
 	clusterLister           mgmtv3.ClusterLister
@@ -86,6 +87,7 @@ func NewDeployer(cluster *config.UserContext, manager *manager.AlertManager) *De
 		clusterAlertGroupLister: cluster.Management.Management.ClusterAlertGroups(cluster.ClusterName).Controller().Lister(),
 		projectAlertGroupLister: cluster.Management.Management.ProjectAlertGroups(metav1.NamespaceAll).Controller().Lister(),
 		notifierLister:          cluster.Management.Management.Notifiers(cluster.ClusterName).Controller().Lister(),
+		onCallScheduleLister:    cluster.Management.Management.OnCallSchedules(cluster.ClusterName).Controller().Lister(),
 		projectLister:           cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
 		clusters:                cluster.Management.Management.Clusters(metav1.NamespaceAll),
 		clusterLister:           cluster.Management.Management.Clusters(metav1.NamespaceAll).Controller().Lister(),
@@ -179,7 +181,6 @@ func (d *Deployer) sync() error {
 // //only deploy the alertmanager when notifier is configured and alert is using it.
 func (d *Deployer) needDeploy() (bool, bool, error) {
 	needDeploy := false
-	needWebhookReceiver := false
 
 	notifiers, err := d.notifierLister.List("", labels.NewSelector())
 	if err != nil {
@@ -190,19 +191,22 @@ func (d *Deployer) needDeploy() (bool, bool, error) {
 		return false, false, err
 	}
 
+	schedules, err := d.onCallScheduleLister.List("", labels.NewSelector())
+	if err != nil {
+		return false, false, err
+	}
+	onCallWebhookReceiver := onCallWebhookReceiverSchedules(schedules, notifiers)
+
 	clusterAlerts, err := d.clusterAlertGroupLister.List("", labels.NewSelector())
 	if err != nil {
 		return false, false, err
 	}
 
 	for _, alert := range clusterAlerts {
-		if len(alert.Spec.Recipients) > 0 {
+		if len(alert.Spec.Recipients) > 0 || len(alert.Spec.Routes) > 0 {
 			needDeploy = true
-			for _, r := range alert.Spec.Recipients {
-				if slice.ContainsString(webhookReceiverTypes, r.NotifierType) {
-					needWebhookReceiver = true
-					return needDeploy, needWebhookReceiver, nil
-				}
+			if needWebhookReceiver(alert.Spec.Recipients, alert.Spec.Routes, onCallWebhookReceiver) {
+				return needDeploy, true, nil
 			}
 		}
 	}
@@ -214,19 +218,48 @@ func (d *Deployer) needDeploy() (bool, bool, error) {
 
 	for _, alert := range projectAlerts {
 		if controller.ObjectInCluster(d.clusterName, alert) {
-			if len(alert.Spec.Recipients) > 0 {
+			if len(alert.Spec.Recipients) > 0 || len(alert.Spec.Routes) > 0 {
 				needDeploy = true
-				for _, r := range alert.Spec.Recipients {
-					if slice.ContainsString(webhookReceiverTypes, r.NotifierType) {
-						needWebhookReceiver = true
-						return needDeploy, needWebhookReceiver, nil
-					}
+				if needWebhookReceiver(alert.Spec.Recipients, alert.Spec.Routes, onCallWebhookReceiver) {
+					return needDeploy, true, nil
 				}
 			}
 		}
 	}
 
-	return needDeploy, needWebhookReceiver, nil
+	return needDeploy, false, nil
+}
+
+// onCallWebhookReceiverSchedules returns the IDs of the on-call schedules with a participant whose notifier sends
+// through the webhook receiver, who is on call changes without an update of the alert groups
+func onCallWebhookReceiverSchedules(schedules []*mgmtv3.OnCallSchedule, notifiers []*mgmtv3.Notifier) map[string]bool {
+	notifierTypes := map[string]string{}
+	for _, n := range notifiers {
+		notifierTypes[alertutil.GetGroupID(n.Namespace, n.Name)] = alertutil.NotifierType(n.Spec)
+	}
+
+	result := map[string]bool{}
+	for _, schedule := range schedules {
+		for _, participant := range schedule.Spec.Participants {
+			if slice.ContainsString(webhookReceiverTypes, notifierTypes[participant.NotifierName]) {
+				result[alertutil.GetGroupID(schedule.Namespace, schedule.Name)] = true
+			}
+		}
+	}
+	return result
+}
+
+// needWebhookReceiver returns whether a recipient of the alert group, of its routes or their escalations sends
+// through the webhook receiver
+func needWebhookReceiver(recipients []v32.Recipient, routes []v32.AlertRoute, onCallWebhookReceiver map[string]bool) bool {
+	for _, groupRecipients := range alertutil.GroupReceivers("", recipients, routes) {
+		for _, r := range groupRecipients {
+			if slice.ContainsString(webhookReceiverTypes, r.NotifierType) || onCallWebhookReceiver[r.OnCallScheduleName] {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (d *appDeployer) isDeploySuccess(cluster *mgmtv3.Cluster, appName, appTargetNamespace string) error {
@@ -0,0 +1,58 @@
+package deployer
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNeedWebhookReceiver(t *testing.T) {
+	notifiers := []*mgmtv3.Notifier{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "email", Namespace: "c-1"},
+			Spec:       v32.NotifierSpec{SMTPConfig: &v32.SMTPConfig{}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "dingtalk", Namespace: "c-1"},
+			Spec:       v32.NotifierSpec{DingtalkConfig: &v32.DingtalkConfig{}},
+		},
+	}
+	schedules := []*mgmtv3.OnCallSchedule{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "email", Namespace: "c-1"},
+			Spec: v32.OnCallScheduleSpec{Participants: []v32.OnCallParticipant{
+				{Name: "alice", Recipient: "alice@example.com"},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mixed", Namespace: "c-1"},
+			Spec: v32.OnCallScheduleSpec{Participants: []v32.OnCallParticipant{
+				{Name: "alice", Recipient: "alice@example.com"},
+				{Name: "bob", NotifierName: "c-1:dingtalk"},
+			}},
+		},
+	}
+	onCall := onCallWebhookReceiverSchedules(schedules, notifiers)
+
+	email := v32.Recipient{NotifierName: "c-1:email", NotifierType: "email"}
+	cases := []struct {
+		name       string
+		recipients []v32.Recipient
+		routes     []v32.AlertRoute
+		expected   bool
+	}{
+		{"email", []v32.Recipient{email}, nil, false},
+		{"group recipient", []v32.Recipient{email, {NotifierName: "c-1:dingtalk", NotifierType: "dingtalk"}}, nil, true},
+		{"route recipient", nil, []v32.AlertRoute{{Recipients: []v32.Recipient{{NotifierType: "msteams"}}}}, true},
+		{"escalation recipient", nil, []v32.AlertRoute{{Escalations: []v32.AlertEscalation{{Recipients: []v32.Recipient{{NotifierType: "dingtalk"}}}}}}, true},
+		{"email on call", []v32.Recipient{{NotifierType: "email", OnCallScheduleName: "c-1:email"}}, nil, false},
+		{"dingtalk on call", []v32.Recipient{{NotifierType: "email", OnCallScheduleName: "c-1:mixed"}}, nil, true},
+	}
+	for _, c := range cases {
+		if got := needWebhookReceiver(c.recipients, c.routes, onCall); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+}
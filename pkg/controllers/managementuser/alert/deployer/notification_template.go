@@ -21,6 +21,9 @@
 {{- else if eq .CommonLabels.alert_type "nodeMemory" -}}
 The memory usage on the node {{ .GroupLabels.node_name}} is over {{ .CommonLabels.mem_threshold}}%
 
+{{- else if eq .CommonLabels.alert_type "nodeDiskPressure" -}}
+The node {{ .GroupLabels.node_name}} is under disk pressure
+
 {{- else if eq .CommonLabels.alert_type "podNotScheduled" -}}
 The Pod {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.pod_name}} is not scheduled
 
@@ -33,6 +36,18 @@
 {{- else if eq .CommonLabels.alert_type "workload" -}}
 The workload {{ if .GroupLabels.workload_namespace}}{{.GroupLabels.workload_namespace}}:{{end}}{{.GroupLabels.workload_name}} has available replicas less than {{ .CommonLabels.available_percentage}}%
 
+{{- else if eq .CommonLabels.alert_type "volumeUsage" -}}
+The usage of the persistent volume claim {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.volume_claim_name}} is over {{ .CommonLabels.used_percentage}}%
+
+{{- else if eq .CommonLabels.alert_type "certificateExpiry" -}}
+The certificate {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.certificate_name}} expires in less than {{ .CommonLabels.expires_in_days}} days
+
+{{- else if eq .CommonLabels.alert_type "jobFailed" -}}
+The job {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.job_name}} failed
+
+{{- else if eq .CommonLabels.alert_type "hpaSaturation" -}}
+The horizontal pod autoscaler {{ if .GroupLabels.namespace}}{{.GroupLabels.namespace}}:{{end}}{{.GroupLabels.hpa_name}} is at its maximum of {{ .CommonLabels.max_replicas}} replicas for over {{ .CommonLabels.duration}} sec
+
 {{- else if eq .CommonLabels.alert_type "metric" -}}
 The metric {{ .CommonLabels.alert_name}} crossed the threshold 
 {{ end -}}
@@ -106,6 +121,25 @@
 Project Name: {{ .Labels.project_name}}
 Available Replicas: {{ .Labels.available_replicas}}
 Desired Replicas: {{ .Labels.desired_replicas}}
+{{- else if eq .Labels.alert_type "volumeUsage" }}
+Project Name: {{ .Labels.project_name}}
+Used Volume: {{ .Labels.used_volume}}
+Total Volume: {{ .Labels.total_volume}}
+{{- else if eq .Labels.alert_type "certificateExpiry" }}
+Project Name: {{ .Labels.project_name}}
+{{- if .Labels.ingress_name }}
+Ingress Name: {{ .Labels.ingress_name}}{{ end }}
+Common Name: {{ .Labels.common_name}}
+Expiration: {{ .Labels.expiration}}
+{{- else if eq .Labels.alert_type "jobFailed" }}
+Project Name: {{ .Labels.project_name}}
+Workload Name: {{ .Labels.workload_name}}
+Failed Reason: {{ .Labels.failed_reason}}
+{{- else if eq .Labels.alert_type "hpaSaturation" }}
+Project Name: {{ .Labels.project_name}}
+Workload Name: {{ .Labels.workload_name}}
+Current Replicas: {{ .Labels.current_replicas}}
+Desired Replicas: {{ .Labels.desired_replicas}}
 {{- else if eq .Labels.alert_type "metric" }}
 {{- if .Labels.namespace }}
 Namespace: {{ .Labels.namespace}}{{ end }}
@@ -185,6 +219,26 @@
 Project Name: {{.Labels.project_name}}<br>
 Available Replicas: {{ .Labels.available_replicas}}<br>
 Desired Replicas: {{ .Labels.desired_replicas}}<br>
+{{- else if eq .Labels.alert_type "volumeUsage" }}
+Project Name: {{.Labels.project_name}}<br>
+Used Volume: {{ .Labels.used_volume}}<br>
+Total Volume: {{ .Labels.total_volume}}<br>
+{{- else if eq .Labels.alert_type "certificateExpiry" }}
+Project Name: {{.Labels.project_name}}<br>
+{{- if .Labels.ingress_name }}
+Ingress Name: {{.Labels.ingress_name}}<br>
+{{ end -}}
+Common Name: {{ .Labels.common_name}}<br>
+Expiration: {{ .Labels.expiration}}<br>
+{{- else if eq .Labels.alert_type "jobFailed" }}
+Project Name: {{.Labels.project_name}}<br>
+Workload Name: {{.Labels.workload_name}}<br>
+Failed Reason: {{ .Labels.failed_reason}}<br>
+{{- else if eq .Labels.alert_type "hpaSaturation" }}
+Project Name: {{.Labels.project_name}}<br>
+Workload Name: {{.Labels.workload_name}}<br>
+Current Replicas: {{ .Labels.current_replicas}}<br>
+Desired Replicas: {{ .Labels.desired_replicas}}<br>
 {{- else if eq .Labels.alert_type "metric" }}
 {{- if .Labels.project_name }}
 Project Name: {{.Labels.project_name}}<br>
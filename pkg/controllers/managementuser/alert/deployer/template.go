@@ -0,0 +1,382 @@
+package deployer
+
+import (
+	"bytes"
+	"encoding/json"
+	htmltemplate "html/template"
+	"regexp"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+)
+
+// TemplateData is the data Alertmanager passes to the notification templates
+type TemplateData struct {
+	Receiver string `json:"receiver"`
+	Status   string `json:"status"`
+	Alerts   Alerts `json:"alerts"`
+
+	GroupLabels       KV `json:"groupLabels"`
+	CommonLabels      KV `json:"commonLabels"`
+	CommonAnnotations KV `json:"commonAnnotations"`
+
+	ExternalURL string `json:"externalURL"`
+}
+
+type Alert struct {
+	Status       string    `json:"status"`
+	Labels       KV        `json:"labels"`
+	Annotations  KV        `json:"annotations"`
+	StartsAt     time.Time `json:"startsAt"`
+	EndsAt       time.Time `json:"endsAt"`
+	GeneratorURL string    `json:"generatorURL"`
+	Fingerprint  string    `json:"fingerprint"`
+}
+
+type Alerts []Alert
+
+func (as Alerts) Firing() []Alert {
+	res := []Alert{}
+	for _, a := range as {
+		if a.Status == "firing" {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+func (as Alerts) Resolved() []Alert {
+	res := []Alert{}
+	for _, a := range as {
+		if a.Status == "resolved" {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+type KV map[string]string
+
+type Pair struct {
+	Name, Value string
+}
+
+type Pairs []Pair
+
+func (ps Pairs) Names() []string {
+	ns := make([]string, 0, len(ps))
+	for _, p := range ps {
+		ns = append(ns, p.Name)
+	}
+	return ns
+}
+
+func (ps Pairs) Values() []string {
+	vs := make([]string, 0, len(ps))
+	for _, p := range ps {
+		vs = append(vs, p.Value)
+	}
+	return vs
+}
+
+func (kv KV) SortedPairs() Pairs {
+	pairs := make(Pairs, 0, len(kv))
+	for k, v := range kv {
+		pairs = append(pairs, Pair{Name: k, Value: v})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Name < pairs[j].Name })
+	return pairs
+}
+
+func (kv KV) Remove(keys []string) KV {
+	res := KV{}
+	for k, v := range kv {
+		res[k] = v
+	}
+	for _, k := range keys {
+		delete(res, k)
+	}
+	return res
+}
+
+func (kv KV) Names() []string {
+	return kv.SortedPairs().Names()
+}
+
+func (kv KV) Values() []string {
+	return kv.SortedPairs().Values()
+}
+
+// templateFuncs are the functions Alertmanager adds to the template language
+var templateFuncs = map[string]interface{}{
+	"toUpper": strings.ToUpper,
+	"toLower": strings.ToLower,
+	"title":   strings.Title,
+	"join": func(sep string, s []string) string {
+		return strings.Join(s, sep)
+	},
+	"match": regexp.MatchString,
+	"safeHtml": func(text string) htmltemplate.HTML {
+		return htmltemplate.HTML(text)
+	},
+	"reReplaceAll": func(pattern, repl, text string) string {
+		re := regexp.MustCompile(pattern)
+		return re.ReplaceAllString(text, repl)
+	},
+	"stringSlice": func(s ...string) []string {
+		return s
+	},
+}
+
+// MergeNotificationTemplates returns the template of the notifier with the fields set on the alert group replacing its own
+func MergeNotificationTemplates(notifierTemplate, groupTemplate *v32.NotificationTemplate) *v32.NotificationTemplate {
+	if notifierTemplate == nil && groupTemplate == nil {
+		return nil
+	}
+
+	merged := &v32.NotificationTemplate{}
+	if notifierTemplate != nil {
+		*merged = *notifierTemplate
+	}
+	if groupTemplate == nil {
+		return merged
+	}
+	if groupTemplate.Title != "" {
+		merged.Title = groupTemplate.Title
+	}
+	if groupTemplate.Text != "" {
+		merged.Text = groupTemplate.Text
+	}
+	if groupTemplate.HTML != "" {
+		merged.HTML = groupTemplate.HTML
+	}
+	if groupTemplate.Payload != "" {
+		merged.Payload = groupTemplate.Payload
+	}
+	return merged
+}
+
+// ValidateNotificationTemplate parses the template and renders it against sample data of every alert type
+func ValidateNotificationTemplate(tmpl *v32.NotificationTemplate) error {
+	if tmpl == nil {
+		return nil
+	}
+
+	for _, alertType := range sampleAlertTypes {
+		if _, err := RenderNotificationTemplate(tmpl, SampleTemplateData(alertType, false, "sample")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenderNotificationTemplate renders the fields of the template the way Alertmanager does, with the built-in templates available
+func RenderNotificationTemplate(tmpl *v32.NotificationTemplate, data *TemplateData) (*v32.NotificationPreviewOutput, error) {
+	output := &v32.NotificationPreviewOutput{}
+	if tmpl == nil {
+		return output, nil
+	}
+
+	var err error
+	if output.Title, err = renderText("title", tmpl.Title, data); err != nil {
+		return nil, err
+	}
+	if output.Text, err = renderText("text", tmpl.Text, data); err != nil {
+		return nil, err
+	}
+	if output.HTML, err = renderHTML("html", tmpl.HTML, data); err != nil {
+		return nil, err
+	}
+	if output.Payload, err = renderText("payload", tmpl.Payload, data); err != nil {
+		return nil, err
+	}
+	if output.Payload != "" && !json.Valid([]byte(output.Payload)) {
+		return nil, errors.New("payload: the rendered payload is not valid JSON")
+	}
+	return output, nil
+}
+
+func renderText(name, text string, data *TemplateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	t, err := texttemplate.New("").Option("missingkey=zero").Funcs(templateFuncs).Parse(NotificationTmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "parse built-in notification template")
+	}
+	if t, err = t.New(name).Parse(text); err != nil {
+		return "", errors.Wrap(err, name)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(err, name)
+	}
+	return buf.String(), nil
+}
+
+func renderHTML(name, text string, data *TemplateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	t, err := htmltemplate.New("").Option("missingkey=zero").Funcs(templateFuncs).Parse(NotificationTmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "parse built-in notification template")
+	}
+	if t, err = t.New(name).Parse(text); err != nil {
+		return "", errors.Wrap(err, name)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(err, name)
+	}
+	return buf.String(), nil
+}
+
+var sampleAlertTypes = []string{"event", "systemService", "nodeHealthy", "nodeCPU", "nodeMemory", "nodeDiskPressure", "podNotScheduled", "podNotRunning", "podRestarts", "workload", "volumeUsage", "certificateExpiry", "jobFailed", "hpaSaturation", "metric"}
+
+// SampleTemplateData returns a notification of a single alert of the alert type, with the labels the rules of this type set
+func SampleTemplateData(alertType string, resolved bool, clusterName string) *TemplateData {
+	labels := KV{
+		"alert_type":   alertType,
+		"alert_name":   "Sample " + alertType + " alert",
+		"severity":     "critical",
+		"cluster_name": clusterName,
+		"group_id":     "c-sample:sample-alert-group",
+		"rule_id":      "c-sample:sample-alert-group_sample-alert-rule",
+	}
+	annotations := KV{}
+	groupLabels := KV{"group_id": labels["group_id"], "rule_id": labels["rule_id"]}
+
+	switch alertType {
+	case "event":
+		for k, v := range map[string]string{
+			"event_type":       "Warning",
+			"resource_kind":    "Pod",
+			"target_namespace": "default",
+			"target_name":      "nginx-7db9fccd9b-x4x2m",
+			"workload_name":    "nginx",
+			"event_count":      "5",
+			"event_message":    "Back-off restarting failed container",
+			"event_firstseen":  "2020-01-01 08:00:00 +0000 UTC",
+			"event_lastseen":   "2020-01-01 08:05:00 +0000 UTC",
+		} {
+			labels[k] = v
+		}
+		groupLabels["resource_kind"] = labels["resource_kind"]
+	case "systemService":
+		labels["component_name"] = "etcd"
+		groupLabels["component_name"] = labels["component_name"]
+	case "nodeHealthy", "nodeCPU", "nodeMemory", "nodeDiskPressure":
+		labels["node_name"] = "worker-1"
+		labels["cpu_threshold"] = "70"
+		labels["used_cpu"] = "1800"
+		labels["total_cpu"] = "2000"
+		labels["mem_threshold"] = "70"
+		labels["used_mem"] = "7.2Gi"
+		labels["total_mem"] = "8Gi"
+		groupLabels["node_name"] = labels["node_name"]
+	case "podNotScheduled", "podNotRunning", "podRestarts":
+		labels["project_name"] = "Default"
+		labels["namespace"] = "default"
+		labels["pod_name"] = "nginx-7db9fccd9b-x4x2m"
+		labels["workload_name"] = "nginx"
+		labels["container_name"] = "nginx"
+		labels["restart_times"] = "3"
+		labels["restart_interval"] = "300"
+		groupLabels["namespace"] = labels["namespace"]
+		groupLabels["pod_name"] = labels["pod_name"]
+	case "workload":
+		labels["project_name"] = "Default"
+		labels["workload_namespace"] = "default"
+		labels["workload_name"] = "nginx"
+		labels["workload_kind"] = "deployment"
+		labels["available_percentage"] = "70"
+		labels["available_replicas"] = "1"
+		labels["desired_replicas"] = "3"
+		groupLabels["workload_namespace"] = labels["workload_namespace"]
+		groupLabels["workload_name"] = labels["workload_name"]
+	case "volumeUsage":
+		labels["project_name"] = "Default"
+		labels["namespace"] = "default"
+		labels["volume_claim_name"] = "data-mysql-0"
+		labels["used_percentage"] = "80"
+		labels["used_volume"] = "9Gi"
+		labels["total_volume"] = "10Gi"
+		groupLabels["namespace"] = labels["namespace"]
+		groupLabels["volume_claim_name"] = labels["volume_claim_name"]
+	case "certificateExpiry":
+		labels["project_name"] = "Default"
+		labels["namespace"] = "default"
+		labels["certificate_name"] = "nginx-tls"
+		labels["ingress_name"] = "nginx"
+		labels["common_name"] = "nginx.example.com"
+		labels["expires_in_days"] = "14"
+		labels["expiration"] = "2020-01-10T08:00:00Z"
+		groupLabels["namespace"] = labels["namespace"]
+		groupLabels["certificate_name"] = labels["certificate_name"]
+	case "jobFailed":
+		labels["project_name"] = "Default"
+		labels["namespace"] = "default"
+		labels["job_name"] = "backup-1577865600"
+		labels["workload_name"] = "backup"
+		labels["workload_kind"] = "cronjob"
+		labels["failed_reason"] = "BackoffLimitExceeded"
+		labels["logs"] = "Job has reached the specified backoff limit"
+		groupLabels["namespace"] = labels["namespace"]
+		groupLabels["job_name"] = labels["job_name"]
+	case "hpaSaturation":
+		labels["project_name"] = "Default"
+		labels["namespace"] = "default"
+		labels["hpa_name"] = "nginx"
+		labels["workload_name"] = "nginx"
+		labels["max_replicas"] = "10"
+		labels["current_replicas"] = "10"
+		labels["desired_replicas"] = "14"
+		labels["duration"] = "600"
+		groupLabels["namespace"] = labels["namespace"]
+		groupLabels["hpa_name"] = labels["hpa_name"]
+	case "metric":
+		labels["expression"] = `sum(node_load1) by (node) / sum(machine_cpu_cores) by (node)`
+		labels["comparison"] = "greater-than"
+		labels["threshold_value"] = "1"
+		labels["duration"] = "5m"
+		annotations["current_value"] = "1.25"
+	}
+
+	status := "firing"
+	startsAt := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	var endsAt time.Time
+	if resolved {
+		status = "resolved"
+		endsAt = startsAt.Add(10 * time.Minute)
+	}
+
+	commonLabels := KV{}
+	for k, v := range labels {
+		commonLabels[k] = v
+	}
+
+	return &TemplateData{
+		Receiver: labels["group_id"],
+		Status:   status,
+		Alerts: Alerts{{
+			Status:      status,
+			Labels:      labels,
+			Annotations: annotations,
+			StartsAt:    startsAt,
+			EndsAt:      endsAt,
+		}},
+		GroupLabels:       groupLabels,
+		CommonLabels:      commonLabels,
+		CommonAnnotations: annotations,
+	}
+}
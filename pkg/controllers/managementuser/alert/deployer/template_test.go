@@ -0,0 +1,75 @@
+package deployer
+
+import (
+	"strings"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+func TestRenderNotificationTemplate(t *testing.T) {
+	tmpl := &v32.NotificationTemplate{
+		Title:   `[{{ .Status | toUpper }}] {{ .CommonLabels.alert_name }}`,
+		Text:    `{{ template "slack.text" . }}`,
+		HTML:    `<b>{{ .CommonLabels.node_name }}</b>`,
+		Payload: `{"node": "{{ .CommonLabels.node_name }}", "alerts": {{ len .Alerts.Firing }}}`,
+	}
+
+	output, err := RenderNotificationTemplate(tmpl, SampleTemplateData("nodeCPU", false, "local"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.Title != "[FIRING] Sample nodeCPU alert" {
+		t.Errorf("unexpected title %q", output.Title)
+	}
+	if !strings.Contains(output.Text, "Cluster Name: local") || !strings.Contains(output.Text, "Used CPU: 1800 m") {
+		t.Errorf("the text does not render the built-in slack template: %q", output.Text)
+	}
+	if output.HTML != "<b>worker-1</b>" {
+		t.Errorf("unexpected html %q", output.HTML)
+	}
+	if output.Payload != `{"node": "worker-1", "alerts": 1}` {
+		t.Errorf("unexpected payload %q", output.Payload)
+	}
+}
+
+func TestValidateNotificationTemplate(t *testing.T) {
+	tests := []struct {
+		caseName string
+		tmpl     *v32.NotificationTemplate
+		valid    bool
+	}{
+		{"no template", nil, true},
+		{"built-in templates", &v32.NotificationTemplate{Title: `{{ template "rancher.title" . }}`, HTML: `{{ template "email.text" . }}`}, true},
+		{"unclosed action", &v32.NotificationTemplate{Text: `{{ .Status `}, false},
+		{"unknown template", &v32.NotificationTemplate{Text: `{{ template "missing" . }}`}, false},
+		{"unknown function", &v32.NotificationTemplate{Title: `{{ .Status | shout }}`}, false},
+		{"invalid json payload", &v32.NotificationTemplate{Payload: `{"status": {{ .Status }}}`}, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateNotificationTemplate(tt.tmpl)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.caseName, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected an error", tt.caseName)
+		}
+	}
+}
+
+func TestMergeNotificationTemplates(t *testing.T) {
+	notifierTemplate := &v32.NotificationTemplate{Title: "notifier title", Text: "notifier text"}
+	groupTemplate := &v32.NotificationTemplate{Text: "group text"}
+
+	merged := MergeNotificationTemplates(notifierTemplate, groupTemplate)
+	if merged.Title != "notifier title" || merged.Text != "group text" {
+		t.Errorf("unexpected merged template %+v", merged)
+	}
+	if notifierTemplate.Text != "notifier text" {
+		t.Errorf("the notifier template was changed")
+	}
+	if MergeNotificationTemplates(nil, nil) != nil {
+		t.Errorf("expected no template")
+	}
+}
@@ -85,6 +85,21 @@ func (l *AlertService) Version() (string, error) {
 	return fmt.Sprintf("%s-%s", monitorutil.RancherMonitoringTemplateName, initVersion), nil
 }
 
+func (l *AlertService) TargetVersion() (string, error) {
+	template, err := l.templateLister.Get(namespace.GlobalNamespace, monitorutil.RancherMonitoringTemplateName)
+	if err != nil {
+		return "", fmt.Errorf("get template %s:%s failed, %v", namespace.GlobalNamespace, monitorutil.RancherMonitoringTemplateName, err)
+	}
+
+	templateVersion, err := versionutil.LatestAvailableTemplateVersion(template)
+	if err != nil {
+		return "", err
+	}
+
+	newVersion, _, err := common.ParseExternalID(templateVersion.ExternalID)
+	return newVersion, err
+}
+
 func (l *AlertService) Upgrade(currentVersion string) (string, error) {
 	template, err := l.templateLister.Get(namespace.GlobalNamespace, monitorutil.RancherMonitoringTemplateName)
 	if err != nil {
@@ -0,0 +1,143 @@
+package escalator
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// StartEscalator sends a copy of the alerts that stay unresolved and unacknowledged for longer than an escalation step
+// of their route, Alertmanager routes the copies to the recipients of the step. The copies are sent again while the
+// alert fires, so they resolve on their own once the alert resolves or a silence acknowledges it.
+func StartEscalator(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	e := &Escalator{
+		clusterAlertGroupLister: cluster.Management.Management.ClusterAlertGroups(cluster.ClusterName).Controller().Lister(),
+		projectAlertGroupLister: cluster.Management.Management.ProjectAlertGroups("").Controller().Lister(),
+		alertManager:            manager,
+		clusterName:             cluster.ClusterName,
+	}
+	go e.watch(ctx, 30*time.Second)
+}
+
+type Escalator struct {
+	clusterAlertGroupLister v3.ClusterAlertGroupLister
+	projectAlertGroupLister v3.ProjectAlertGroupLister
+	alertManager            *manager.AlertManager
+	clusterName             string
+}
+
+func (e *Escalator) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		if err := e.escalate(); err != nil {
+			logrus.Infof("Failed to escalate alerts, error: %v", err)
+		}
+	}
+}
+
+func (e *Escalator) escalate() error {
+	if e.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	groupRoutes := map[string][]v32.AlertRoute{}
+	clusterGroups, err := e.clusterAlertGroupLister.List(e.clusterName, labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	for _, g := range clusterGroups {
+		if hasEscalations(g.Spec.Routes) {
+			groupRoutes[common.GetGroupID(g.Namespace, g.Name)] = g.Spec.Routes
+		}
+	}
+
+	projectGroups, err := e.projectAlertGroupLister.List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	for _, g := range projectGroups {
+		if controller.ObjectInCluster(e.clusterName, g) && hasEscalations(g.Spec.Routes) {
+			groupRoutes[common.GetGroupID(g.Namespace, g.Name)] = g.Spec.Routes
+		}
+	}
+
+	if len(groupRoutes) == 0 {
+		return nil
+	}
+
+	apiAlerts, err := e.alertManager.GetAlertList()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, a := range apiAlerts {
+		for _, data := range escalations(a, groupRoutes, now) {
+			if err := e.alertManager.SendAlert(data); err != nil {
+				logrus.Errorf("Failed to send alert: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasEscalations(routes []v32.AlertRoute) bool {
+	for _, r := range routes {
+		if len(r.Escalations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// escalations returns the labels of the copies of the alert for the escalation steps that are due. The routes are
+// evaluated like Alertmanager does, the first route that matches and doesn't continue is the last one. Only a silence
+// acknowledges an alert, an inhibited alert is still escalated.
+func escalations(a *manager.APIAlert, groupRoutes map[string][]v32.AlertRoute, now time.Time) []map[string]string {
+	if a.Alert == nil || len(a.Status.SilencedBy) > 0 || a.Resolved() {
+		return nil
+	}
+	if _, ok := a.Labels[common.EscalationRouteLabel]; ok {
+		return nil
+	}
+
+	alertLabels := map[string]string{}
+	for k, v := range a.Labels {
+		alertLabels[string(k)] = string(v)
+	}
+
+	var result []map[string]string
+	for _, route := range groupRoutes[alertLabels["group_id"]] {
+		if !common.RouteMatches(route, alertLabels) {
+			continue
+		}
+		for i, escalation := range route.Escalations {
+			if now.Sub(a.StartsAt) < time.Duration(escalation.AfterMinutes)*time.Minute {
+				break
+			}
+			data := map[string]string{}
+			for k, v := range alertLabels {
+				data[k] = v
+			}
+			data[common.EscalationRouteLabel] = route.Name
+			data[common.EscalationStepLabel] = strconv.Itoa(i + 1)
+			data[common.EscalatedFromLabel] = a.Fingerprint
+			result = append(result, data)
+		}
+		if !route.Continue {
+			break
+		}
+	}
+	return result
+}
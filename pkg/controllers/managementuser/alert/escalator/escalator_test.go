@@ -0,0 +1,68 @@
+package escalator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscalations(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	groupRoutes := map[string][]v32.AlertRoute{
+		"c-1:ops": {
+			{
+				Name:       "critical",
+				Severities: []string{"critical"},
+				Escalations: []v32.AlertEscalation{
+					{AfterMinutes: 10},
+					{AfterMinutes: 30},
+				},
+			},
+			{
+				Name:        "all",
+				Escalations: []v32.AlertEscalation{{AfterMinutes: 5}},
+			},
+		},
+	}
+	newAlert := func(severity string, age time.Duration) *manager.APIAlert {
+		return &manager.APIAlert{
+			Alert: &model.Alert{
+				Labels:   model.LabelSet{"group_id": "c-1:ops", "severity": model.LabelValue(severity)},
+				StartsAt: now.Add(-age),
+			},
+			Fingerprint: "abc",
+		}
+	}
+
+	// the first route that matches and doesn't continue is the last one
+	copies := escalations(newAlert("critical", 15*time.Minute), groupRoutes, now)
+	if assert.Len(copies, 1) {
+		assert.Equal("critical", copies[0][common.EscalationRouteLabel])
+		assert.Equal("1", copies[0][common.EscalationStepLabel])
+		assert.Equal("abc", copies[0][common.EscalatedFromLabel])
+		assert.Equal("c-1:ops", copies[0]["group_id"])
+	}
+	assert.Len(escalations(newAlert("critical", time.Hour), groupRoutes, now), 2)
+	assert.Empty(escalations(newAlert("critical", 5*time.Minute), groupRoutes, now))
+	assert.Len(escalations(newAlert("warning", 5*time.Minute), groupRoutes, now), 1)
+
+	acknowledged := newAlert("critical", time.Hour)
+	acknowledged.Status.State = manager.AlertStateSuppressed
+	acknowledged.Status.SilencedBy = []string{"silence-1"}
+	assert.Empty(escalations(acknowledged, groupRoutes, now))
+
+	inhibited := newAlert("critical", time.Hour)
+	inhibited.Status.State = manager.AlertStateSuppressed
+	inhibited.Status.InhibitedBy = []string{"def"}
+	assert.Len(escalations(inhibited, groupRoutes, now), 2, "inhibited alerts are not acknowledged")
+
+	escalated := newAlert("critical", time.Hour)
+	escalated.Labels[common.EscalationRouteLabel] = "critical"
+	assert.Empty(escalations(escalated, groupRoutes, now), "the copies are not escalated again")
+}
@@ -0,0 +1,479 @@
+package historysyncer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	IncidentStateFiring   = "firing"
+	IncidentStateResolved = "resolved"
+
+	EventTypeFired        = "fired"
+	EventTypeSuppressed   = "suppressed"
+	EventTypeUnsuppressed = "unsuppressed"
+	EventTypeEscalated    = "escalated"
+	EventTypeResolved     = "resolved"
+
+	// maxTimelineEvents caps the timeline of an incident, an alert that is silenced and unsilenced over and over
+	// again would otherwise grow it without a bound
+	maxTimelineEvents = 50
+)
+
+// StartHistorySyncer records the alerts of the cluster as incidents, with a timeline of what happened to them until
+// they resolve. The incidents are kept until there are more than the alert-history-limit setting allows.
+func StartHistorySyncer(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	s := &HistorySyncer{
+		clusterAlertIncidents:      cluster.Management.Management.ClusterAlertIncidents(cluster.ClusterName),
+		clusterAlertIncidentLister: cluster.Management.Management.ClusterAlertIncidents(cluster.ClusterName).Controller().Lister(),
+		projectAlertIncidents:      cluster.Management.Management.ProjectAlertIncidents(""),
+		projectAlertIncidentLister: cluster.Management.Management.ProjectAlertIncidents("").Controller().Lister(),
+		clusterAlertGroupLister:    cluster.Management.Management.ClusterAlertGroups(cluster.ClusterName).Controller().Lister(),
+		projectAlertGroupLister:    cluster.Management.Management.ProjectAlertGroups("").Controller().Lister(),
+		onCallScheduleLister:       cluster.Management.Management.OnCallSchedules(cluster.ClusterName).Controller().Lister(),
+		alertManager:               manager,
+		clusterName:                cluster.ClusterName,
+	}
+	go s.watch(ctx, 30*time.Second)
+}
+
+type HistorySyncer struct {
+	clusterAlertIncidents      v3.ClusterAlertIncidentInterface
+	clusterAlertIncidentLister v3.ClusterAlertIncidentLister
+	projectAlertIncidents      v3.ProjectAlertIncidentInterface
+	projectAlertIncidentLister v3.ProjectAlertIncidentLister
+	clusterAlertGroupLister    v3.ClusterAlertGroupLister
+	projectAlertGroupLister    v3.ProjectAlertGroupLister
+	onCallScheduleLister       v3.OnCallScheduleLister
+	alertManager               *manager.AlertManager
+	clusterName                string
+}
+
+// incident is what the syncer needs of a cluster or a project incident
+type incident struct {
+	namespace string
+	name      string
+	project   bool
+	firedAt   string
+	status    v32.AlertIncidentStatus
+}
+
+func (s *HistorySyncer) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		if err := s.sync(); err != nil {
+			logrus.Infof("Failed to sync alert history, error: %v", err)
+		}
+	}
+}
+
+func (s *HistorySyncer) sync() error {
+	if s.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	apiAlerts, err := s.alertManager.GetAlertList()
+	if err != nil {
+		return err
+	}
+
+	incidents, err := s.listIncidents()
+	if err != nil {
+		return err
+	}
+	existing := map[string]*incident{}
+	for _, i := range incidents {
+		existing[i.name] = i
+	}
+
+	receivers, err := s.receiverNotifiers()
+	if err != nil {
+		return err
+	}
+
+	escalated := map[string][]*manager.APIAlert{}
+	for _, a := range apiAlerts {
+		if from, ok := a.Labels[common.EscalatedFromLabel]; ok {
+			escalated[string(from)] = append(escalated[string(from)], a)
+		}
+	}
+
+	now := time.Now()
+	firing := map[string]bool{}
+	for _, a := range apiAlerts {
+		if a.Alert == nil || a.Resolved() {
+			continue
+		}
+		if _, ok := a.Labels[common.EscalationRouteLabel]; ok {
+			continue
+		}
+		groupID, ruleID := string(a.Labels["group_id"]), string(a.Labels["rule_id"])
+		if groupID == "" || ruleID == "" {
+			continue
+		}
+
+		name := incidentName(a)
+		firing[name] = true
+		i, ok := existing[name]
+		if !ok {
+			if err := s.createIncident(name, groupID, ruleID, a, newStatus(a, escalated[a.Fingerprint], receivers, now)); err != nil {
+				logrus.Errorf("Failed to record the alert %s: %v", a.Fingerprint, err)
+			}
+			continue
+		}
+		if status, changed := updateStatus(i.status, a, escalated[a.Fingerprint], receivers, now); changed {
+			if err := s.updateStatus(i, status); err != nil {
+				logrus.Errorf("Failed to update the alert incident %s: %v", name, err)
+			}
+		}
+	}
+
+	for _, i := range incidents {
+		if i.status.IncidentState == IncidentStateFiring && !firing[i.name] {
+			i.status = resolveStatus(i.status, now)
+			if err := s.updateStatus(i, i.status); err != nil {
+				logrus.Errorf("Failed to resolve the alert incident %s: %v", i.name, err)
+			}
+		}
+	}
+
+	for _, i := range prunable(incidents, settings.AlertHistoryLimit.GetInt()) {
+		if err := s.deleteIncident(i); err != nil && !apierrors.IsNotFound(err) {
+			logrus.Errorf("Failed to remove the alert incident %s: %v", i.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *HistorySyncer) listIncidents() ([]*incident, error) {
+	var incidents []*incident
+	clusterIncidents, err := s.clusterAlertIncidentLister.List(s.clusterName, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range clusterIncidents {
+		incidents = append(incidents, &incident{
+			namespace: i.Namespace,
+			name:      i.Name,
+			firedAt:   i.Spec.FiredAt,
+			status:    i.Status,
+		})
+	}
+
+	projectIncidents, err := s.projectAlertIncidentLister.List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range projectIncidents {
+		if !controller.ObjectInCluster(s.clusterName, i) {
+			continue
+		}
+		incidents = append(incidents, &incident{
+			namespace: i.Namespace,
+			name:      i.Name,
+			project:   true,
+			firedAt:   i.Spec.FiredAt,
+			status:    i.Status,
+		})
+	}
+	return incidents, nil
+}
+
+// receiverNotifiers returns the notifiers of the recipients of the Alertmanager receivers, by the name of the receiver.
+// The recipients of an on-call schedule are the participant on call now.
+func (s *HistorySyncer) receiverNotifiers() (map[string][]string, error) {
+	schedules, err := s.onCallScheduleLister.List(s.clusterName, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	onCall := map[string]string{}
+	now := time.Now()
+	for _, schedule := range schedules {
+		if participant, _, err := common.OnCallParticipant(schedule.Spec, now); err == nil {
+			onCall[ref.Ref(schedule)] = participant.NotifierName
+		}
+	}
+
+	receivers := map[string][]v32.Recipient{}
+	clusterGroups, err := s.clusterAlertGroupLister.List(s.clusterName, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range clusterGroups {
+		for name, recipients := range common.GroupReceivers(common.GetGroupID(g.Namespace, g.Name), g.Spec.Recipients, g.Spec.Routes) {
+			receivers[name] = recipients
+		}
+	}
+
+	projectGroups, err := s.projectAlertGroupLister.List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range projectGroups {
+		if !controller.ObjectInCluster(s.clusterName, g) {
+			continue
+		}
+		for name, recipients := range common.GroupReceivers(common.GetGroupID(g.Namespace, g.Name), g.Spec.Recipients, g.Spec.Routes) {
+			receivers[name] = recipients
+		}
+	}
+
+	result := map[string][]string{}
+	for name, recipients := range receivers {
+		for _, r := range recipients {
+			notifierName := r.NotifierName
+			if r.OnCallScheduleName != "" {
+				notifierName = onCall[r.OnCallScheduleName]
+			}
+			if notifierName != "" {
+				result[name] = append(result[name], notifierName)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *HistorySyncer) createIncident(name, groupID, ruleID string, a *manager.APIAlert, status v32.AlertIncidentStatus) error {
+	groupNamespace, _ := ref.Parse(groupID)
+	ruleName := groupNamespace + ":" + strings.TrimPrefix(ruleID, groupID+"_")
+	field := v32.CommonIncidentField{
+		DisplayName: string(a.Labels["alert_name"]),
+		AlertType:   string(a.Labels["alert_type"]),
+		Severity:    string(a.Labels["severity"]),
+		AlertLabels: map[string]string{},
+		Fingerprint: a.Fingerprint,
+		FiredAt:     a.StartsAt.UTC().Format(time.RFC3339),
+	}
+	for k, v := range a.Labels {
+		field.AlertLabels[string(k)] = string(v)
+	}
+
+	if groupNamespace == s.clusterName {
+		_, err := s.clusterAlertIncidents.Create(&v3.ClusterAlertIncident{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: s.clusterName,
+			},
+			Spec: v32.ClusterAlertIncidentSpec{
+				ClusterName:         s.clusterName,
+				GroupName:           groupID,
+				RuleName:            ruleName,
+				CommonIncidentField: field,
+			},
+			Status: status,
+		})
+		return err
+	}
+
+	_, err := s.projectAlertIncidents.Create(&v3.ProjectAlertIncident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: groupNamespace,
+		},
+		Spec: v32.ProjectAlertIncidentSpec{
+			ProjectName:         s.clusterName + ":" + groupNamespace,
+			GroupName:           groupID,
+			RuleName:            ruleName,
+			CommonIncidentField: field,
+		},
+		Status: status,
+	})
+	return err
+}
+
+func (s *HistorySyncer) updateStatus(i *incident, status v32.AlertIncidentStatus) error {
+	if i.project {
+		obj, err := s.projectAlertIncidentLister.Get(i.namespace, i.name)
+		if err != nil {
+			return err
+		}
+		newObj := obj.DeepCopy()
+		newObj.Status = status
+		_, err = s.projectAlertIncidents.Update(newObj)
+		return err
+	}
+
+	obj, err := s.clusterAlertIncidentLister.Get(i.namespace, i.name)
+	if err != nil {
+		return err
+	}
+	newObj := obj.DeepCopy()
+	newObj.Status = status
+	_, err = s.clusterAlertIncidents.Update(newObj)
+	return err
+}
+
+func (s *HistorySyncer) deleteIncident(i *incident) error {
+	if i.project {
+		return s.projectAlertIncidents.DeleteNamespaced(i.namespace, i.name, &metav1.DeleteOptions{})
+	}
+	return s.clusterAlertIncidents.DeleteNamespaced(i.namespace, i.name, &metav1.DeleteOptions{})
+}
+
+// incidentName is the same for the alert until it resolves, an alert that fires again is a new incident
+func incidentName(a *manager.APIAlert) string {
+	return fmt.Sprintf("%s-%d", a.Fingerprint, a.StartsAt.Unix())
+}
+
+func newStatus(a *manager.APIAlert, escalations []*manager.APIAlert, receivers map[string][]string, now time.Time) v32.AlertIncidentStatus {
+	status := v32.AlertIncidentStatus{
+		IncidentState: IncidentStateFiring,
+		Timeline: []v32.IncidentEvent{
+			newEvent(a.StartsAt, EventTypeFired, ""),
+		},
+	}
+	status, _ = updateStatus(status, a, escalations, receivers, now)
+	return status
+}
+
+// updateStatus adds the notifiers the alert and its escalations were sent to, and the changes of its state to the
+// timeline
+func updateStatus(status v32.AlertIncidentStatus, a *manager.APIAlert, escalations []*manager.APIAlert, receivers map[string][]string, now time.Time) (v32.AlertIncidentStatus, bool) {
+	changed := false
+	notifiers := map[string]bool{}
+	for _, n := range status.NotifierNames {
+		notifiers[n] = true
+	}
+	addNotifiers := func(alertReceivers []string) {
+		for _, r := range alertReceivers {
+			for _, n := range receivers[r] {
+				if !notifiers[n] {
+					notifiers[n] = true
+					status.NotifierNames = append(status.NotifierNames, n)
+					changed = true
+				}
+			}
+		}
+	}
+	addNotifiers(a.Receivers)
+
+	suppressed := a.Status.State == manager.AlertStateSuppressed
+	if suppressed != isSuppressed(status.Timeline) {
+		if suppressed {
+			status.Timeline = append(status.Timeline, newEvent(now, EventTypeSuppressed, suppressedMessage(a)))
+		} else {
+			status.Timeline = append(status.Timeline, newEvent(now, EventTypeUnsuppressed, ""))
+		}
+		status.Timeline = capTimeline(status.Timeline, maxTimelineEvents)
+		changed = true
+	}
+
+	sort.Slice(escalations, func(i, j int) bool {
+		return escalations[i].Labels[common.EscalationStepLabel] < escalations[j].Labels[common.EscalationStepLabel]
+	})
+	for _, e := range escalations {
+		addNotifiers(e.Receivers)
+		message := fmt.Sprintf("escalated to step %s of the route %s", e.Labels[common.EscalationStepLabel], e.Labels[common.EscalationRouteLabel])
+		if !hasEvent(status.Timeline, EventTypeEscalated, message) {
+			status.Timeline = append(status.Timeline, newEvent(now, EventTypeEscalated, message))
+			changed = true
+		}
+	}
+
+	return status, changed
+}
+
+func resolveStatus(status v32.AlertIncidentStatus, now time.Time) v32.AlertIncidentStatus {
+	status.IncidentState = IncidentStateResolved
+	status.ResolvedAt = now.UTC().Format(time.RFC3339)
+	status.Timeline = append(status.Timeline, newEvent(now, EventTypeResolved, ""))
+	return status
+}
+
+// prunable returns the oldest resolved incidents that are over the limit, the incidents that are still firing are kept
+func prunable(incidents []*incident, limit int) []*incident {
+	if limit < 0 || len(incidents) <= limit {
+		return nil
+	}
+	var resolved []*incident
+	for _, i := range incidents {
+		if i.status.IncidentState == IncidentStateResolved {
+			resolved = append(resolved, i)
+		}
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].firedAt < resolved[j].firedAt
+	})
+
+	over := len(incidents) - limit
+	if over > len(resolved) {
+		over = len(resolved)
+	}
+	return resolved[:over]
+}
+
+func newEvent(t time.Time, eventType, message string) v32.IncidentEvent {
+	return v32.IncidentEvent{
+		Time:      t.UTC().Format(time.RFC3339),
+		EventType: eventType,
+		Message:   message,
+	}
+}
+
+// capTimeline drops the oldest suppressed and unsuppressed events of a timeline that is over the limit, the events
+// the incident is fired, escalated and resolved with are kept
+func capTimeline(timeline []v32.IncidentEvent, limit int) []v32.IncidentEvent {
+	for len(timeline) > limit {
+		oldest := -1
+		for i, e := range timeline {
+			if e.EventType == EventTypeSuppressed || e.EventType == EventTypeUnsuppressed {
+				oldest = i
+				break
+			}
+		}
+		if oldest < 0 {
+			break
+		}
+		timeline = append(timeline[:oldest:oldest], timeline[oldest+1:]...)
+	}
+	return timeline
+}
+
+func isSuppressed(timeline []v32.IncidentEvent) bool {
+	for i := len(timeline) - 1; i >= 0; i-- {
+		switch timeline[i].EventType {
+		case EventTypeSuppressed:
+			return true
+		case EventTypeUnsuppressed:
+			return false
+		}
+	}
+	return false
+}
+
+func hasEvent(timeline []v32.IncidentEvent, eventType, message string) bool {
+	for _, e := range timeline {
+		if e.EventType == eventType && e.Message == message {
+			return true
+		}
+	}
+	return false
+}
+
+func suppressedMessage(a *manager.APIAlert) string {
+	if len(a.Status.SilencedBy) > 0 {
+		return "silenced by " + strings.Join(a.Status.SilencedBy, ", ")
+	}
+	if len(a.Status.InhibitedBy) > 0 {
+		return "inhibited by " + strings.Join(a.Status.InhibitedBy, ", ")
+	}
+	return ""
+}
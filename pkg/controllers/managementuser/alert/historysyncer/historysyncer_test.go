@@ -0,0 +1,122 @@
+package historysyncer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/prometheus/common/model"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateStatus(t *testing.T) {
+	assert := assert.New(t)
+	firedAt := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	now := firedAt.Add(time.Hour)
+	receivers := map[string][]string{
+		"c-1:ops":                       {"c-1:slack"},
+		"c-1:ops-critical":              {"c-1:slack", "c-1:pagerduty"},
+		"c-1:ops-critical-escalation-1": {"c-1:opsgenie"},
+	}
+	a := &manager.APIAlert{
+		Alert: &model.Alert{
+			Labels:   model.LabelSet{"group_id": "c-1:ops", "rule_id": "c-1:ops_cpu"},
+			StartsAt: firedAt,
+		},
+		Receivers:   []string{"c-1:ops-critical"},
+		Fingerprint: "abc",
+	}
+
+	status := newStatus(a, nil, receivers, now)
+	assert.Equal(IncidentStateFiring, status.IncidentState)
+	assert.Equal([]string{"c-1:slack", "c-1:pagerduty"}, status.NotifierNames)
+	if assert.Len(status.Timeline, 1) {
+		assert.Equal(EventTypeFired, status.Timeline[0].EventType)
+		assert.Equal("2020-01-01T08:00:00Z", status.Timeline[0].Time)
+	}
+
+	_, changed := updateStatus(status, a, nil, receivers, now)
+	assert.False(changed, "nothing happened to the alert")
+
+	a.Status.State = manager.AlertStateSuppressed
+	a.Status.SilencedBy = []string{"s-1"}
+	status, changed = updateStatus(status, a, nil, receivers, now)
+	assert.True(changed)
+	assert.Equal(EventTypeSuppressed, status.Timeline[1].EventType)
+	assert.Equal("silenced by s-1", status.Timeline[1].Message)
+	_, changed = updateStatus(status, a, nil, receivers, now)
+	assert.False(changed, "the alert is still suppressed")
+
+	a.Status = manager.AlertStatus{State: manager.AlertStateActive}
+	escalation := &manager.APIAlert{
+		Alert: &model.Alert{
+			Labels: model.LabelSet{
+				common.EscalationRouteLabel: "critical",
+				common.EscalationStepLabel:  "1",
+				common.EscalatedFromLabel:   "abc",
+			},
+		},
+		Receivers: []string{"c-1:ops-critical-escalation-1"},
+	}
+	status, changed = updateStatus(status, a, []*manager.APIAlert{escalation}, receivers, now)
+	assert.True(changed)
+	assert.Equal([]string{"c-1:slack", "c-1:pagerduty", "c-1:opsgenie"}, status.NotifierNames)
+	if assert.Len(status.Timeline, 4) {
+		assert.Equal(EventTypeUnsuppressed, status.Timeline[2].EventType)
+		assert.Equal(EventTypeEscalated, status.Timeline[3].EventType)
+		assert.Equal("escalated to step 1 of the route critical", status.Timeline[3].Message)
+	}
+	_, changed = updateStatus(status, a, []*manager.APIAlert{escalation}, receivers, now)
+	assert.False(changed, "the escalation is recorded once")
+
+	status = resolveStatus(status, now)
+	assert.Equal(IncidentStateResolved, status.IncidentState)
+	assert.Equal("2020-01-01T09:00:00Z", status.ResolvedAt)
+	assert.Equal(EventTypeResolved, status.Timeline[4].EventType)
+}
+
+func TestCapTimeline(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	timeline := []v32.IncidentEvent{
+		newEvent(now, EventTypeFired, ""),
+		newEvent(now, EventTypeEscalated, "escalated to step 1 of the route critical"),
+	}
+	for i := 0; i < maxTimelineEvents; i++ {
+		timeline = append(timeline, newEvent(now, EventTypeSuppressed, fmt.Sprintf("silenced by s-%d", i)))
+		timeline = append(timeline, newEvent(now, EventTypeUnsuppressed, ""))
+	}
+	timeline = append(timeline, newEvent(now, EventTypeSuppressed, "silenced by s-last"))
+
+	capped := capTimeline(timeline, maxTimelineEvents)
+	if assert.Len(capped, maxTimelineEvents) {
+		assert.Equal(EventTypeFired, capped[0].EventType)
+		assert.Equal(EventTypeEscalated, capped[1].EventType)
+		assert.Equal("silenced by s-last", capped[maxTimelineEvents-1].Message)
+	}
+	assert.True(isSuppressed(capped))
+	assert.Equal(EventTypeFired, timeline[0].EventType, "the timeline of the cached incident is not modified")
+	assert.Equal("silenced by s-0", timeline[2].Message, "the timeline of the cached incident is not modified")
+
+	short := timeline[:3]
+	assert.Equal(short, capTimeline(short, maxTimelineEvents))
+}
+
+func TestPrunable(t *testing.T) {
+	assert := assert.New(t)
+	incidents := []*incident{
+		{name: "firing-old", firedAt: "2020-01-01T00:00:00Z", status: v32.AlertIncidentStatus{IncidentState: IncidentStateFiring}},
+		{name: "resolved-new", firedAt: "2020-01-03T00:00:00Z", status: v32.AlertIncidentStatus{IncidentState: IncidentStateResolved}},
+		{name: "resolved-old", firedAt: "2020-01-02T00:00:00Z", status: v32.AlertIncidentStatus{IncidentState: IncidentStateResolved}},
+	}
+
+	assert.Empty(prunable(incidents, 3))
+	if pruned := prunable(incidents, 2); assert.Len(pruned, 1) {
+		assert.Equal("resolved-old", pruned[0].name)
+	}
+	assert.Len(prunable(incidents, 0), 2, "the incidents that are still firing are kept")
+}
@@ -319,6 +319,157 @@ func (m *AlertManager) RemoveSilenceRule(matcherName, matcherValue string) error
 	return nil
 }
 
+// UpsertSilence creates the silence, or updates it when its ID is set, and returns the ID Alertmanager assigned.
+// Alertmanager replaces a started silence with a new one when its matchers or start change, so the ID may differ.
+func (m *AlertManager) UpsertSilence(silence *Silence) (string, error) {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return "", err
+	}
+
+	silenceData, err := json.Marshal(silence)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url+"/api/v1/silences", bytes.NewBuffer(silenceData))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("alertmanager response is %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	res := struct {
+		Data struct {
+			SilenceID string `json:"silenceId"`
+		} `json:"data"`
+		Status string `json:"status"`
+	}{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", err
+	}
+
+	return res.Data.SilenceID, nil
+}
+
+// GetSilence returns the silence of the ID, or nil when Alertmanager doesn't know it, e.g. after a restart without storage
+func (m *AlertManager) GetSilence(id string) (*Silence, error) {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url+"/api/v1/silence/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("alertmanager response is %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	res := struct {
+		Data   *Silence `json:"data"`
+		Status string   `json:"status"`
+	}{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Data, nil
+}
+
+// ExpireSilence expires the silence of the ID, a silence Alertmanager doesn't know or already expired is not an error
+func (m *AlertManager) ExpireSilence(id string) error {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, url+"/api/v1/silence/"+id, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	// Alertmanager answers bad data for a silence it doesn't know or that is already expired
+	if bytes.Contains(body, []byte("not found")) || bytes.Contains(body, []byte("already expired")) {
+		return nil
+	}
+	return fmt.Errorf("alertmanager response is %d, body: %s", resp.StatusCode, string(body))
+}
+
+// Init compiles the regular expression of the matcher, anchored the way Alertmanager does
+func (m *Matcher) Init() error {
+	if !m.IsRegex {
+		return nil
+	}
+	re, err := regexp.Compile("^(?:" + m.Value + ")$")
+	if err != nil {
+		return err
+	}
+	m.regex = re
+	return nil
+}
+
+// Matches reports whether the value satisfies the matcher, Init must have been called for regex matchers
+func (m *Matcher) Matches(s string) bool {
+	if m.IsRegex {
+		return m.regex != nil && m.regex.MatchString(s)
+	}
+	return s == m.Value
+}
+
+// Matches reports whether the label set satisfies all the matchers
+func (ms Matchers) Matches(lset model.LabelSet) bool {
+	for _, m := range ms {
+		if !m.Matches(string(lset[model.LabelName(m.Name)])) {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *AlertManager) SendAlert(labels map[string]string) error {
 	url, err := m.GetAlertManagerEndpoint()
 	if err != nil {
@@ -0,0 +1,304 @@
+package silencesyncer
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	creatorIDAnn   = "field.cattle.io/creatorId"
+	defaultCreator = "rancherlabs"
+	defaultComment = "silence"
+
+	SilenceStatePending = "pending"
+	SilenceStateActive  = "active"
+	SilenceStateExpired = "expired"
+)
+
+func Register(ctx context.Context, cluster *config.UserContext, alertManager *manager.AlertManager) {
+	s := &SilenceSyncer{
+		clusterAlertSilences: cluster.Management.Management.ClusterAlertSilences(cluster.ClusterName),
+		projectAlertSilences: cluster.Management.Management.ProjectAlertSilences(""),
+		alertManager:         alertManager,
+		clusterName:          cluster.ClusterName,
+	}
+
+	s.clusterAlertSilences.AddClusterScopedLifecycle(ctx, "cluster-alert-silence-syncer", cluster.ClusterName, &clusterSilenceLifecycle{syncer: s})
+	s.projectAlertSilences.AddClusterScopedLifecycle(ctx, "project-alert-silence-syncer", cluster.ClusterName, &projectSilenceLifecycle{syncer: s})
+
+	go s.watch(ctx, 30*time.Second)
+}
+
+type SilenceSyncer struct {
+	clusterAlertSilences v3.ClusterAlertSilenceInterface
+	projectAlertSilences v3.ProjectAlertSilenceInterface
+	alertManager         *manager.AlertManager
+	clusterName          string
+}
+
+type clusterSilenceLifecycle struct {
+	syncer *SilenceSyncer
+}
+
+type projectSilenceLifecycle struct {
+	syncer *SilenceSyncer
+}
+
+func (l *clusterSilenceLifecycle) Create(obj *v3.ClusterAlertSilence) (runtime.Object, error) {
+	return l.Updated(obj)
+}
+
+func (l *clusterSilenceLifecycle) Updated(obj *v3.ClusterAlertSilence) (runtime.Object, error) {
+	status, err := l.syncer.sync(obj, obj.Spec.CommonSilenceField, nil, obj.Status, nil)
+	if err != nil {
+		return obj, err
+	}
+	obj.Status = status
+	return obj, nil
+}
+
+func (l *clusterSilenceLifecycle) Remove(obj *v3.ClusterAlertSilence) (runtime.Object, error) {
+	return obj, l.syncer.expire(obj.Status)
+}
+
+func (l *projectSilenceLifecycle) Create(obj *v3.ProjectAlertSilence) (runtime.Object, error) {
+	return l.Updated(obj)
+}
+
+func (l *projectSilenceLifecycle) Updated(obj *v3.ProjectAlertSilence) (runtime.Object, error) {
+	status, err := l.syncer.sync(obj, obj.Spec.CommonSilenceField, projectScope(obj.Spec.ProjectName), obj.Status, nil)
+	if err != nil {
+		return obj, err
+	}
+	obj.Status = status
+	return obj, nil
+}
+
+func (l *projectSilenceLifecycle) Remove(obj *v3.ProjectAlertSilence) (runtime.Object, error) {
+	return obj, l.syncer.expire(obj.Status)
+}
+
+// projectScope limits the silence of a project to the alerts of its alert groups
+func projectScope(projectID string) manager.Matchers {
+	_, projectName := ref.Parse(projectID)
+	return manager.Matchers{{
+		Name:    "group_id",
+		Value:   projectName + ":.*",
+		IsRegex: true,
+	}}
+}
+
+func (s *SilenceSyncer) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		if err := s.syncState(); err != nil {
+			logrus.Warnf("Failed to sync the state of alert silences for cluster %s: %v", s.clusterName, err)
+		}
+	}
+}
+
+// syncState moves the silences between pending, active and expired as time passes, and expires the acknowledgements
+// of alerts that resolved
+func (s *SilenceSyncer) syncState() error {
+	if s.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	apiAlerts, err := s.alertManager.GetAlertList()
+	if err != nil {
+		return err
+	}
+
+	clusterSilences, err := s.clusterAlertSilences.Controller().Lister().List(s.clusterName, labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	for _, silence := range clusterSilences {
+		status, err := s.sync(silence, silence.Spec.CommonSilenceField, nil, silence.Status, apiAlerts)
+		if err != nil {
+			logrus.Warnf("Failed to sync alert silence %s:%s, %v", silence.Namespace, silence.Name, err)
+			continue
+		}
+		if reflect.DeepEqual(status, silence.Status) {
+			continue
+		}
+		toUpdate := silence.DeepCopy()
+		toUpdate.Status = status
+		if _, err := s.clusterAlertSilences.Update(toUpdate); err != nil {
+			logrus.Warnf("Failed to update the status of alert silence %s:%s, %v", silence.Namespace, silence.Name, err)
+		}
+	}
+
+	projectSilences, err := s.projectAlertSilences.Controller().Lister().List("", labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	for _, silence := range projectSilences {
+		if !controller.ObjectInCluster(s.clusterName, silence) {
+			continue
+		}
+		status, err := s.sync(silence, silence.Spec.CommonSilenceField, projectScope(silence.Spec.ProjectName), silence.Status, apiAlerts)
+		if err != nil {
+			logrus.Warnf("Failed to sync alert silence %s:%s, %v", silence.Namespace, silence.Name, err)
+			continue
+		}
+		if reflect.DeepEqual(status, silence.Status) {
+			continue
+		}
+		toUpdate := silence.DeepCopy()
+		toUpdate.Status = status
+		if _, err := s.projectAlertSilences.Update(toUpdate); err != nil {
+			logrus.Warnf("Failed to update the status of alert silence %s:%s, %v", silence.Namespace, silence.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// sync pushes the silence to Alertmanager and returns its new status. An expired silence is never synced again.
+// When the alerts are given, an active acknowledgement matching none of them is expired.
+func (s *SilenceSyncer) sync(obj metav1.Object, spec v32.CommonSilenceField, scope manager.Matchers, status v32.AlertSilenceStatus, apiAlerts []*manager.APIAlert) (v32.AlertSilenceStatus, error) {
+	if status.SilenceState == SilenceStateExpired || s.alertManager.IsDeploy == false {
+		return status, nil
+	}
+
+	now := time.Now()
+	silence := toSilence(obj, spec, scope)
+	state := silenceState(silence, now)
+	if state == SilenceStateActive && spec.Acknowledgement && apiAlerts != nil && !matchesAny(silence.Matchers, apiAlerts) {
+		state = SilenceStateExpired
+	}
+
+	if state == SilenceStateExpired {
+		if err := s.expire(status); err != nil {
+			return status, err
+		}
+		status.SilenceState = SilenceStateExpired
+		status.ExpiredAt = now.UTC().Format(time.RFC3339)
+		return status, nil
+	}
+
+	if status.SilenceID != "" {
+		existing, err := s.alertManager.GetSilence(status.SilenceID)
+		if err != nil {
+			return status, err
+		}
+		if existing != nil && existing.Status.State == manager.SilenceStateExpired {
+			status.SilenceState = SilenceStateExpired
+			status.ExpiredAt = existing.EndsAt.UTC().Format(time.RFC3339)
+			return status, nil
+		}
+		if existing != nil && sameSilence(existing, silence, now) {
+			status.SilenceState = state
+			return status, nil
+		}
+		silence.ID = status.SilenceID
+	}
+
+	id, err := s.alertManager.UpsertSilence(silence)
+	if err != nil {
+		return status, err
+	}
+	status.SilenceID = id
+	status.SilenceState = state
+	return status, nil
+}
+
+func (s *SilenceSyncer) expire(status v32.AlertSilenceStatus) error {
+	if status.SilenceID == "" || status.SilenceState == SilenceStateExpired || s.alertManager.IsDeploy == false {
+		return nil
+	}
+	return s.alertManager.ExpireSilence(status.SilenceID)
+}
+
+// toSilence converts the spec to an Alertmanager silence. The start defaults to the creation time and an
+// acknowledgement without an end lasts until the alerts resolve.
+func toSilence(obj metav1.Object, spec v32.CommonSilenceField, scope manager.Matchers) *manager.Silence {
+	silence := &manager.Silence{
+		CreatedBy: obj.GetAnnotations()[creatorIDAnn],
+		Comment:   spec.Comment,
+		StartsAt:  obj.GetCreationTimestamp().Time,
+	}
+	if silence.CreatedBy == "" {
+		silence.CreatedBy = defaultCreator
+	}
+	if silence.Comment == "" {
+		silence.Comment = defaultComment
+	}
+	if startsAt, err := time.Parse(time.RFC3339, spec.StartsAt); err == nil {
+		silence.StartsAt = startsAt
+	}
+	if endsAt, err := time.Parse(time.RFC3339, spec.EndsAt); err == nil {
+		silence.EndsAt = endsAt
+	} else {
+		silence.EndsAt = silence.StartsAt.AddDate(100, 0, 0)
+	}
+
+	for _, m := range spec.Matchers {
+		silence.Matchers = append(silence.Matchers, &manager.Matcher{
+			Name:    m.Name,
+			Value:   m.Value,
+			IsRegex: m.IsRegex,
+		})
+	}
+	silence.Matchers = append(silence.Matchers, scope...)
+	for _, m := range silence.Matchers {
+		if err := m.Init(); err != nil {
+			logrus.Warnf("Invalid regex %q in alert silence %s:%s, %v", m.Value, obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	return silence
+}
+
+func silenceState(silence *manager.Silence, now time.Time) string {
+	if now.Before(silence.StartsAt) {
+		return SilenceStatePending
+	}
+	if !now.Before(silence.EndsAt) {
+		return SilenceStateExpired
+	}
+	return SilenceStateActive
+}
+
+func matchesAny(matchers manager.Matchers, apiAlerts []*manager.APIAlert) bool {
+	for _, a := range apiAlerts {
+		if a.Alert != nil && matchers.Matches(a.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameSilence compares what Alertmanager keeps of the silence, it moves the start of a silence to its creation time
+func sameSilence(existing, desired *manager.Silence, now time.Time) bool {
+	if existing.Comment != desired.Comment || !existing.EndsAt.Equal(desired.EndsAt) {
+		return false
+	}
+	if desired.StartsAt.After(now) && !existing.StartsAt.Equal(desired.StartsAt) {
+		return false
+	}
+	if len(existing.Matchers) != len(desired.Matchers) {
+		return false
+	}
+	for i := range existing.Matchers {
+		e, d := existing.Matchers[i], desired.Matchers[i]
+		if e.Name != d.Name || e.Value != d.Value || e.IsRegex != d.IsRegex {
+			return false
+		}
+	}
+	return true
+}
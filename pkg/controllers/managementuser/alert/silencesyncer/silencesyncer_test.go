@@ -0,0 +1,96 @@
+package silencesyncer
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/prometheus/common/model"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToSilence(t *testing.T) {
+	assert := assert.New(t)
+	created := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	silence := &v3.ProjectAlertSilence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "s-1",
+			Namespace:         "p-1",
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations:       map[string]string{creatorIDAnn: "u-1"},
+		},
+		Spec: v32.ProjectAlertSilenceSpec{
+			ProjectName: "c-1:p-1",
+			CommonSilenceField: v32.CommonSilenceField{
+				Matchers:        []v32.SilenceMatcher{{Name: "pod_name", Value: "nginx-.*", IsRegex: true}},
+				Acknowledgement: true,
+			},
+		},
+	}
+
+	s := toSilence(silence, silence.Spec.CommonSilenceField, projectScope(silence.Spec.ProjectName))
+	assert.Equal("u-1", s.CreatedBy)
+	assert.Equal(defaultComment, s.Comment)
+	assert.True(s.StartsAt.Equal(created))
+	assert.True(s.EndsAt.After(created.AddDate(50, 0, 0)), "an acknowledgement without an end should last until the alerts resolve")
+	assert.Len(s.Matchers, 2)
+	assert.Equal("group_id", s.Matchers[1].Name)
+	assert.Equal("p-1:.*", s.Matchers[1].Value)
+
+	firing := []*manager.APIAlert{{Alert: &model.Alert{Labels: model.LabelSet{
+		"pod_name": "nginx-7db9fccd9b-x4x2m",
+		"group_id": "p-1:pod-alert",
+	}}}}
+	assert.True(matchesAny(s.Matchers, firing))
+
+	otherProject := []*manager.APIAlert{{Alert: &model.Alert{Labels: model.LabelSet{
+		"pod_name": "nginx-7db9fccd9b-x4x2m",
+		"group_id": "p-2:pod-alert",
+	}}}}
+	assert.False(matchesAny(s.Matchers, otherProject))
+
+	// the regex is anchored like Alertmanager does
+	partial := []*manager.APIAlert{{Alert: &model.Alert{Labels: model.LabelSet{
+		"pod_name": "my-nginx-7db9fccd9b-x4x2m",
+		"group_id": "p-1:pod-alert",
+	}}}}
+	assert.False(matchesAny(s.Matchers, partial))
+}
+
+func TestSilenceState(t *testing.T) {
+	assert := assert.New(t)
+	startsAt := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	silence := &manager.Silence{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+
+	assert.Equal(SilenceStatePending, silenceState(silence, startsAt.Add(-time.Minute)))
+	assert.Equal(SilenceStateActive, silenceState(silence, startsAt))
+	assert.Equal(SilenceStateActive, silenceState(silence, startsAt.Add(30*time.Minute)))
+	assert.Equal(SilenceStateExpired, silenceState(silence, startsAt.Add(time.Hour)))
+}
+
+func TestSameSilence(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	desired := &manager.Silence{
+		Matchers: manager.Matchers{{Name: "node_name", Value: "worker-1"}},
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+		Comment:  "maintenance",
+	}
+
+	existing := *desired
+	existing.StartsAt = now.Add(-30 * time.Minute)
+	assert.True(sameSilence(&existing, desired, now), "Alertmanager moves the start of a started silence")
+
+	existing.EndsAt = now.Add(2 * time.Hour)
+	assert.False(sameSilence(&existing, desired, now))
+
+	existing = *desired
+	existing.Matchers = manager.Matchers{{Name: "node_name", Value: "worker-2"}}
+	assert.False(sameSilence(&existing, desired, now))
+}
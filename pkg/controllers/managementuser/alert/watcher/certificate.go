@@ -0,0 +1,208 @@
+package watcher
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	extv1beta1 "github.com/rancher/rancher/pkg/generated/norman/extensions/v1beta1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+type CertificateWatcher struct {
+	secretLister           v1.SecretLister
+	ingressLister          extv1beta1.IngressLister
+	projectAlertPolicies   v3.ProjectAlertRuleInterface
+	projectAlertRuleLister v3.ProjectAlertRuleLister
+	namespaceIndexer       cache.Indexer
+	alertManager           *manager.AlertManager
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	projectLister          v3.ProjectLister
+}
+
+func StartCertificateWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	projectAlerts := cluster.Management.Management.ProjectAlertRules("")
+	w := &CertificateWatcher{
+		secretLister:           cluster.Core.Secrets("").Controller().Lister(),
+		ingressLister:          cluster.Extensions.Ingresses("").Controller().Lister(),
+		projectAlertPolicies:   projectAlerts,
+		projectAlertRuleLister: projectAlerts.Controller().Lister(),
+		namespaceIndexer:       projectNamespaceIndexer(cluster),
+		alertManager:           manager,
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+		projectLister:          cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+	}
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *CertificateWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule()
+		if err != nil {
+			logrus.Infof("Failed to watch certificate, error: %v", err)
+		}
+	}
+}
+
+func (w *CertificateWatcher) watchRule() error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	pAlerts, err := activeProjectAlerts(w.projectAlertRuleLister, w.clusterName, func(alert *v3.ProjectAlertRule) bool {
+		return alert.Spec.CertificateRule != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range pAlerts {
+		if alert.Spec.CertificateRule.CertificateName != "" {
+			parts := strings.SplitN(alert.Spec.CertificateRule.CertificateName, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			secret, err := w.secretLister.Get(parts[0], parts[1])
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					if err = w.projectAlertPolicies.DeleteNamespaced(alert.Namespace, alert.Name, &metav1.DeleteOptions{}); err != nil {
+						return err
+					}
+				}
+				logrus.Debugf("Failed to get certificate %s: %v", alert.Spec.CertificateRule.CertificateName, err)
+				continue
+			}
+			w.checkCertificateExpiry(secret, "", alert)
+			continue
+		}
+
+		namespaces, err := projectNamespaces(w.namespaceIndexer, alert.Spec.ProjectName)
+		if err != nil {
+			return err
+		}
+		for _, ns := range namespaces {
+			if alert.Spec.CertificateRule.IngressOnly {
+				w.checkIngressCertificates(ns, alert)
+				continue
+			}
+
+			secrets, err := w.secretLister.List(ns, labels.NewSelector())
+			if err != nil {
+				logrus.Warnf("Fail to list secret: %v", err)
+				continue
+			}
+			for _, secret := range secrets {
+				if secret.Type == corev1.SecretTypeTLS {
+					w.checkCertificateExpiry(secret, "", alert)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *CertificateWatcher) checkIngressCertificates(namespace string, alert *v3.ProjectAlertRule) {
+	for _, c := range w.ingressCertificates(namespace) {
+		w.checkCertificateExpiry(c.secret, c.ingressName, alert)
+	}
+}
+
+// ingressCertificate is a TLS secret and the first ingress of the namespace that serves it
+type ingressCertificate struct {
+	secret      *corev1.Secret
+	ingressName string
+}
+
+// ingressCertificates returns the TLS secrets the ingresses of the namespace serve, each secret once
+func (w *CertificateWatcher) ingressCertificates(namespace string) []ingressCertificate {
+	ingresses, err := w.ingressLister.List(namespace, labels.NewSelector())
+	if err != nil {
+		logrus.Warnf("Fail to list ingress: %v", err)
+		return nil
+	}
+
+	var certificates []ingressCertificate
+	checked := map[string]bool{}
+	for _, ingress := range ingresses {
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName == "" || checked[tls.SecretName] {
+				continue
+			}
+			checked[tls.SecretName] = true
+
+			secret, err := w.secretLister.Get(namespace, tls.SecretName)
+			if err != nil {
+				logrus.Debugf("Failed to get certificate %s:%s of ingress %s: %v", namespace, tls.SecretName, ingress.Name, err)
+				continue
+			}
+			certificates = append(certificates, ingressCertificate{secret: secret, ingressName: ingress.Name})
+		}
+	}
+	return certificates
+}
+
+func (w *CertificateWatcher) checkCertificateExpiry(secret *corev1.Secret, ingressName string, alert *v3.ProjectAlertRule) {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		logrus.Debugf("Failed to parse certificate %s:%s: %v", secret.Namespace, secret.Name, err)
+		return
+	}
+
+	days := alert.Spec.CertificateRule.ExpiresInDays
+	if time.Until(cert.NotAfter) > time.Duration(days)*24*time.Hour {
+		return
+	}
+
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+	projectDisplayName := common.GetProjectDisplayName(alert.Spec.ProjectName, w.projectLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = "certificateExpiry"
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["project_name"] = projectDisplayName
+	data["namespace"] = secret.Namespace
+	data["certificate_name"] = secret.Name
+	data["common_name"] = cert.Subject.CommonName
+	data["expires_in_days"] = strconv.Itoa(days)
+	data["expiration"] = cert.NotAfter.UTC().Format(time.RFC3339)
+	if ingressName != "" {
+		data["ingress_name"] = ingressName
+	}
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+// parseCertificate returns the first certificate of the PEM chain, the one the server presents
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,99 @@
+package watcher
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	v1fakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	extv1beta1fakes "github.com/rancher/rancher/pkg/generated/norman/extensions/v1beta1/fakes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newCertificatePEM(t *testing.T, commonName string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestParseCertificate(t *testing.T) {
+	assert := assert.New(t)
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the server certificate comes first in the chain
+	chain := append(newCertificatePEM(t, "example.com", notAfter), newCertificatePEM(t, "intermediate", notAfter.AddDate(5, 0, 0))...)
+	cert, err := parseCertificate(chain)
+	if assert.Nil(err) {
+		assert.Equal("example.com", cert.Subject.CommonName)
+		assert.Equal(notAfter, cert.NotAfter)
+	}
+
+	_, err = parseCertificate(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("key")}))
+	assert.NotNil(err)
+
+	_, err = parseCertificate([]byte("not a certificate"))
+	assert.NotNil(err)
+}
+
+func TestIngressCertificates(t *testing.T) {
+	assert := assert.New(t)
+
+	secrets := map[string]*corev1.Secret{
+		"shared-tls": {ObjectMeta: metav1.ObjectMeta{Name: "shared-tls", Namespace: "ns1"}},
+		"api-tls":    {ObjectMeta: metav1.ObjectMeta{Name: "api-tls", Namespace: "ns1"}},
+	}
+	newIngress := func(name string, secretNames ...string) *v1beta1.Ingress {
+		ingress := &v1beta1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"}}
+		for _, secretName := range secretNames {
+			ingress.Spec.TLS = append(ingress.Spec.TLS, v1beta1.IngressTLS{SecretName: secretName})
+		}
+		return ingress
+	}
+	w := &CertificateWatcher{
+		ingressLister: &extv1beta1fakes.IngressListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v1beta1.Ingress, error) {
+				return []*v1beta1.Ingress{
+					newIngress("web", "shared-tls", ""),
+					newIngress("api", "api-tls", "shared-tls", "missing-tls"),
+				}, nil
+			},
+		},
+		secretLister: &v1fakes.SecretListerMock{
+			GetFunc: func(namespace, name string) (*corev1.Secret, error) {
+				if secret, ok := secrets[name]; ok {
+					return secret, nil
+				}
+				return nil, kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+			},
+		},
+	}
+
+	assert.Equal([]ingressCertificate{
+		{secret: secrets["shared-tls"], ingressName: "web"},
+		{secret: secrets["api-tls"], ingressName: "api"},
+	}, w.ingressCertificates("ns1"))
+}
@@ -0,0 +1,52 @@
+package watcher
+
+import (
+	"github.com/rancher/norman/controller"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	nsutils "github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/types/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// projectNamespaceIndexer returns the indexer of the namespaces of the cluster, indexed by project.
+// The watchers share the index, adding it again only returns a conflict.
+func projectNamespaceIndexer(cluster *config.UserContext) cache.Indexer {
+	nsInformer := cluster.Core.Namespaces("").Controller().Informer()
+	nsInformer.AddIndexers(map[string]cache.IndexFunc{
+		nsByProjectIndex: nsutils.NsByProjectID,
+	})
+	return nsInformer.GetIndexer()
+}
+
+func projectNamespaces(namespaceIndexer cache.Indexer, projectID string) ([]string, error) {
+	objs, err := namespaceIndexer.ByIndex(nsByProjectIndex, projectID)
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, obj := range objs {
+		if ns, ok := obj.(*corev1.Namespace); ok {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+	return namespaces, nil
+}
+
+// activeProjectAlerts returns the active project alert rules of the cluster the filter accepts
+func activeProjectAlerts(lister v3.ProjectAlertRuleLister, clusterName string, filter func(*v3.ProjectAlertRule) bool) ([]*v3.ProjectAlertRule, error) {
+	projectAlerts, err := lister.List("", labels.NewSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	var pAlerts []*v3.ProjectAlertRule
+	for _, alert := range projectAlerts {
+		if !controller.ObjectInCluster(clusterName, alert) || alert.Status.AlertState == "inactive" || !filter(alert) {
+			continue
+		}
+		pAlerts = append(pAlerts, alert)
+	}
+	return pAlerts, nil
+}
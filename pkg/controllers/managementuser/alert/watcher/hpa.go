@@ -0,0 +1,167 @@
+package watcher
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	autoscalingv2beta2 "github.com/rancher/rancher/pkg/generated/norman/autoscaling/v2beta2"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	kautoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+type HPAWatcher struct {
+	hpaLister              autoscalingv2beta2.HorizontalPodAutoscalerLister
+	projectAlertPolicies   v3.ProjectAlertRuleInterface
+	projectAlertRuleLister v3.ProjectAlertRuleLister
+	namespaceIndexer       cache.Indexer
+	alertManager           *manager.AlertManager
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	projectLister          v3.ProjectLister
+	// saturatedSince tracks when the HPAs reached their maximum, it's only touched by the watch loop
+	saturatedSince map[string]time.Time
+}
+
+func StartHPAWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	projectAlerts := cluster.Management.Management.ProjectAlertRules("")
+	w := &HPAWatcher{
+		hpaLister:              cluster.Autoscaling.HorizontalPodAutoscalers("").Controller().Lister(),
+		projectAlertPolicies:   projectAlerts,
+		projectAlertRuleLister: projectAlerts.Controller().Lister(),
+		namespaceIndexer:       projectNamespaceIndexer(cluster),
+		alertManager:           manager,
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+		projectLister:          cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		saturatedSince:         map[string]time.Time{},
+	}
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *HPAWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule()
+		if err != nil {
+			logrus.Infof("Failed to watch horizontal pod autoscaler, error: %v", err)
+		}
+	}
+}
+
+func (w *HPAWatcher) watchRule() error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	pAlerts, err := activeProjectAlerts(w.projectAlertRuleLister, w.clusterName, func(alert *v3.ProjectAlertRule) bool {
+		return alert.Spec.HPARule != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	saturatedSince := map[string]time.Time{}
+	for _, alert := range pAlerts {
+		var hpas []*kautoscalingv2beta2.HorizontalPodAutoscaler
+		if alert.Spec.HPARule.HPAName != "" {
+			parts := strings.SplitN(alert.Spec.HPARule.HPAName, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			hpa, err := w.hpaLister.Get(parts[0], parts[1])
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					if err = w.projectAlertPolicies.DeleteNamespaced(alert.Namespace, alert.Name, &metav1.DeleteOptions{}); err != nil {
+						return err
+					}
+				}
+				logrus.Debugf("Failed to get horizontal pod autoscaler %s: %v", alert.Spec.HPARule.HPAName, err)
+				continue
+			}
+			hpas = append(hpas, hpa)
+
+		} else if alert.Spec.HPARule.Selector != nil {
+			namespaces, err := projectNamespaces(w.namespaceIndexer, alert.Spec.ProjectName)
+			if err != nil {
+				return err
+			}
+			for _, ns := range namespaces {
+				list, err := w.hpaLister.List(ns, labels.SelectorFromSet(alert.Spec.HPARule.Selector))
+				if err != nil {
+					logrus.Warnf("Fail to list horizontal pod autoscaler: %v", err)
+					continue
+				}
+				hpas = append(hpas, list...)
+			}
+		}
+
+		for _, hpa := range hpas {
+			if hpa.Status.CurrentReplicas < hpa.Spec.MaxReplicas {
+				continue
+			}
+			key := hpa.Namespace + ":" + hpa.Name
+			since, ok := w.saturatedSince[key]
+			if !ok {
+				since = saturationStart(hpa, now)
+			}
+			saturatedSince[key] = since
+
+			if now.Sub(since) >= time.Duration(alert.Spec.HPARule.DurationSeconds)*time.Second {
+				w.sendHPAAlert(hpa, alert)
+			}
+		}
+	}
+	// HPAs that scaled down or aren't watched anymore start over
+	w.saturatedSince = saturatedSince
+
+	return nil
+}
+
+// saturationStart returns since when the HPA wants more replicas than its maximum, or now when it doesn't tell
+func saturationStart(hpa *kautoscalingv2beta2.HorizontalPodAutoscaler, now time.Time) time.Time {
+	for _, c := range hpa.Status.Conditions {
+		if c.Type == kautoscalingv2beta2.ScalingLimited && c.Status == corev1.ConditionTrue && c.Reason == "TooManyReplicas" && !c.LastTransitionTime.IsZero() {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return now
+}
+
+func (w *HPAWatcher) sendHPAAlert(hpa *kautoscalingv2beta2.HorizontalPodAutoscaler, alert *v3.ProjectAlertRule) {
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+	projectDisplayName := common.GetProjectDisplayName(alert.Spec.ProjectName, w.projectLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = "hpaSaturation"
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["project_name"] = projectDisplayName
+	data["namespace"] = hpa.Namespace
+	data["hpa_name"] = hpa.Name
+	data["workload_name"] = hpa.Spec.ScaleTargetRef.Name
+	data["max_replicas"] = strconv.Itoa(int(hpa.Spec.MaxReplicas))
+	data["current_replicas"] = strconv.Itoa(int(hpa.Status.CurrentReplicas))
+	data["desired_replicas"] = strconv.Itoa(int(hpa.Status.DesiredReplicas))
+	data["duration"] = strconv.Itoa(alert.Spec.HPARule.DurationSeconds)
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
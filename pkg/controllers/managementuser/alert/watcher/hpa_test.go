@@ -0,0 +1,31 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kautoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSaturationStart(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	limitedAt := now.Add(-15 * time.Minute)
+
+	hpa := &kautoscalingv2beta2.HorizontalPodAutoscaler{}
+	assert.Equal(now, saturationStart(hpa, now))
+
+	hpa.Status.Conditions = []kautoscalingv2beta2.HorizontalPodAutoscalerCondition{{
+		Type:               kautoscalingv2beta2.ScalingLimited,
+		Status:             corev1.ConditionTrue,
+		Reason:             "TooFewReplicas",
+		LastTransitionTime: metav1.NewTime(limitedAt),
+	}}
+	assert.Equal(now, saturationStart(hpa, now), "being limited by the minimum is not a saturation")
+
+	hpa.Status.Conditions[0].Reason = "TooManyReplicas"
+	assert.Equal(limitedAt, saturationStart(hpa, now))
+}
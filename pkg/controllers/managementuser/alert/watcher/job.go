@@ -0,0 +1,189 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementagent/workload"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	batchv1 "github.com/rancher/rancher/pkg/generated/norman/batch/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	kbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+type JobWatcher struct {
+	workloadController     workload.CommonController
+	jobLister              batchv1.JobLister
+	projectAlertPolicies   v3.ProjectAlertRuleInterface
+	projectAlertRuleLister v3.ProjectAlertRuleLister
+	namespaceIndexer       cache.Indexer
+	alertManager           *manager.AlertManager
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	projectLister          v3.ProjectLister
+}
+
+func StartJobWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	projectAlerts := cluster.Management.Management.ProjectAlertRules("")
+	w := &JobWatcher{
+		workloadController:     workload.NewWorkloadController(ctx, cluster.UserOnlyContext(), nil),
+		jobLister:              cluster.BatchV1.Jobs("").Controller().Lister(),
+		projectAlertPolicies:   projectAlerts,
+		projectAlertRuleLister: projectAlerts.Controller().Lister(),
+		namespaceIndexer:       projectNamespaceIndexer(cluster),
+		alertManager:           manager,
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+		projectLister:          cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+	}
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *JobWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule()
+		if err != nil {
+			logrus.Infof("Failed to watch job, error: %v", err)
+		}
+	}
+}
+
+func (w *JobWatcher) watchRule() error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	pAlerts, err := activeProjectAlerts(w.projectAlertRuleLister, w.clusterName, func(alert *v3.ProjectAlertRule) bool {
+		return alert.Spec.JobRule != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range pAlerts {
+		if alert.Spec.JobRule.WorkloadID != "" {
+			wl, err := w.workloadController.GetByWorkloadID(alert.Spec.JobRule.WorkloadID)
+			if err != nil {
+				if kerrors.IsNotFound(err) || wl == nil {
+					if err = w.projectAlertPolicies.DeleteNamespaced(alert.Namespace, alert.Name, &metav1.DeleteOptions{}); err != nil {
+						return err
+					}
+				}
+				logrus.Warnf("Fail to get workload for %s, %v", alert.Spec.JobRule.WorkloadID, err)
+				continue
+			}
+			w.checkJobFailed(wl, alert)
+
+		} else if alert.Spec.JobRule.Selector != nil {
+			namespaces, err := projectNamespaces(w.namespaceIndexer, alert.Spec.ProjectName)
+			if err != nil {
+				return err
+			}
+			for _, ns := range namespaces {
+				wls, err := w.workloadController.GetWorkloadsMatchingSelector(ns, alert.Spec.JobRule.Selector)
+				if err != nil {
+					logrus.Warnf("Fail to list workload: %v", err)
+					continue
+				}
+				for _, wl := range wls {
+					w.checkJobFailed(wl, alert)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *JobWatcher) checkJobFailed(wl *workload.Workload, alert *v3.ProjectAlertRule) {
+	var job *kbatchv1.Job
+	switch wl.Kind {
+	case workload.JobType:
+		j, err := w.jobLister.Get(wl.Namespace, wl.Name)
+		if err != nil {
+			logrus.Debugf("Failed to get job %s:%s: %v", wl.Namespace, wl.Name, err)
+			return
+		}
+		job = j
+	case workload.CronJobType:
+		jobs, err := w.jobLister.List(wl.Namespace, labels.NewSelector())
+		if err != nil {
+			logrus.Warnf("Fail to list job: %v", err)
+			return
+		}
+		job = lastFinishedJob(jobs, wl.Name)
+	default:
+		return
+	}
+
+	if job == nil {
+		return
+	}
+	failed := jobCondition(job, kbatchv1.JobFailed)
+	if failed == nil {
+		return
+	}
+
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+	projectDisplayName := common.GetProjectDisplayName(alert.Spec.ProjectName, w.projectLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = "jobFailed"
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["project_name"] = projectDisplayName
+	data["namespace"] = job.Namespace
+	data["job_name"] = job.Name
+	data["workload_name"] = wl.Name
+	data["workload_kind"] = wl.Kind
+	data["failed_reason"] = failed.Reason
+	if failed.Message != "" {
+		data["logs"] = failed.Message
+	}
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+// lastFinishedJob returns the most recent job of the cronjob that completed or failed, an earlier failure
+// is not reported once a later run succeeded
+func lastFinishedJob(jobs []*kbatchv1.Job, cronJobName string) *kbatchv1.Job {
+	var last *kbatchv1.Job
+	for _, job := range jobs {
+		owner := metav1.GetControllerOf(job)
+		if owner == nil || owner.Kind != "CronJob" || owner.Name != cronJobName {
+			continue
+		}
+		if jobCondition(job, kbatchv1.JobComplete) == nil && jobCondition(job, kbatchv1.JobFailed) == nil {
+			continue
+		}
+		if last == nil || last.CreationTimestamp.Before(&job.CreationTimestamp) {
+			last = job
+		}
+	}
+	return last
+}
+
+func jobCondition(job *kbatchv1.Job, conditionType kbatchv1.JobConditionType) *kbatchv1.JobCondition {
+	for i, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kbatchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCronJobRun(name, cronJobName string, created time.Time, condition kbatchv1.JobConditionType) *kbatchv1.Job {
+	isController := true
+	job := &kbatchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "CronJob",
+				Name:       cronJobName,
+				Controller: &isController,
+			}},
+		},
+	}
+	if condition != "" {
+		job.Status.Conditions = []kbatchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue}}
+	}
+	return job
+}
+
+func TestLastFinishedJob(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	failed := newCronJobRun("backup-1", "backup", start, kbatchv1.JobFailed)
+	succeeded := newCronJobRun("backup-2", "backup", start.Add(time.Hour), kbatchv1.JobComplete)
+	running := newCronJobRun("backup-3", "backup", start.Add(2*time.Hour), "")
+	other := newCronJobRun("report-1", "report", start.Add(3*time.Hour), kbatchv1.JobFailed)
+
+	// a later successful run clears the failure, a run in progress doesn't count
+	assert.Equal(succeeded, lastFinishedJob([]*kbatchv1.Job{failed, succeeded, running, other}, "backup"))
+	assert.Nil(jobCondition(succeeded, kbatchv1.JobFailed))
+
+	assert.Equal(failed, lastFinishedJob([]*kbatchv1.Job{running, failed}, "backup"))
+	assert.NotNil(jobCondition(failed, kbatchv1.JobFailed))
+
+	assert.Nil(lastFinishedJob([]*kbatchv1.Job{running}, "backup"))
+}
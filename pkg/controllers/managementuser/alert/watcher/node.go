@@ -139,6 +139,8 @@ func (w *NodeWatcher) checkNodeCondition(alert *v3.ClusterAlertRule, machine *v3
 		w.checkNodeMemUsage(alert, machine)
 	case "cpu":
 		w.checkNodeCPUUsage(alert, machine)
+	case "diskpressure":
+		w.checkNodeDiskPressure(alert, machine)
 	}
 }
 
@@ -227,3 +229,43 @@ func (w *NodeWatcher) checkNodeReady(alert *v3.ClusterAlertRule, machine *v3.Nod
 		}
 	}
 }
+
+func (w *NodeWatcher) checkNodeDiskPressure(alert *v3.ClusterAlertRule, machine *v3.Node) {
+	cond := diskPressure(machine)
+	if cond == nil {
+		return
+	}
+
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_name"] = alert.Spec.DisplayName
+	data["alert_type"] = "nodeDiskPressure"
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["node_name"] = nodeHelper.GetNodeName(machine)
+
+	if cond.Message != "" {
+		data["logs"] = cond.Message
+	}
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
+
+// diskPressure returns the disk pressure condition of the node when the kubelet reports it
+func diskPressure(machine *v3.Node) *corev1.NodeCondition {
+	for i, cond := range machine.Status.InternalNodeStatus.Conditions {
+		if cond.Type == corev1.NodeDiskPressure {
+			if cond.Status == corev1.ConditionTrue {
+				return &machine.Status.InternalNodeStatus.Conditions[i]
+			}
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package watcher
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDiskPressure(t *testing.T) {
+	assert := assert.New(t)
+
+	machine := &v3.Node{}
+	assert.Nil(diskPressure(machine))
+
+	machine.Status.InternalNodeStatus.Conditions = []corev1.NodeCondition{
+		{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+	}
+	assert.Nil(diskPressure(machine))
+
+	machine.Status.InternalNodeStatus.Conditions[1].Status = corev1.ConditionTrue
+	machine.Status.InternalNodeStatus.Conditions[1].Message = "kubelet has disk pressure"
+	cond := diskPressure(machine)
+	if assert.NotNil(cond) {
+		assert.Equal("kubelet has disk pressure", cond.Message)
+	}
+}
@@ -0,0 +1,302 @@
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	volumeStatsTimeout = 10 * time.Second
+	// volumeStatsWorkers is how many kubelets are asked for their stats summary at the same time
+	volumeStatsWorkers = 5
+)
+
+type VolumeWatcher struct {
+	podLister              v1.PodLister
+	pvcLister              v1.PersistentVolumeClaimLister
+	projectAlertPolicies   v3.ProjectAlertRuleInterface
+	projectAlertRuleLister v3.ProjectAlertRuleLister
+	namespaceIndexer       cache.Indexer
+	alertManager           *manager.AlertManager
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	projectLister          v3.ProjectLister
+	statsSummary           func(ctx context.Context, nodeName string) ([]byte, error)
+}
+
+// volumeCheck is a persistent volume claim an alert rule watches
+type volumeCheck struct {
+	alert *v3.ProjectAlertRule
+	pvc   *corev1.PersistentVolumeClaim
+}
+
+// volumeStats is the usage of a persistent volume claim the kubelet reports in its stats summary
+type volumeStats struct {
+	UsedBytes     uint64
+	CapacityBytes uint64
+}
+
+// statsSummary is the part of the kubelet stats summary with the volumes of the pods
+type statsSummary struct {
+	Pods []struct {
+		VolumeStats []struct {
+			PVCRef *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef,omitempty"`
+			CapacityBytes *uint64 `json:"capacityBytes,omitempty"`
+			UsedBytes     *uint64 `json:"usedBytes,omitempty"`
+		} `json:"volume,omitempty"`
+	} `json:"pods"`
+}
+
+func StartVolumeWatcher(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
+	projectAlerts := cluster.Management.Management.ProjectAlertRules("")
+	w := &VolumeWatcher{
+		podLister:              cluster.Core.Pods("").Controller().Lister(),
+		pvcLister:              cluster.Core.PersistentVolumeClaims("").Controller().Lister(),
+		projectAlertPolicies:   projectAlerts,
+		projectAlertRuleLister: projectAlerts.Controller().Lister(),
+		namespaceIndexer:       projectNamespaceIndexer(cluster),
+		alertManager:           manager,
+		clusterName:            cluster.ClusterName,
+		clusterLister:          cluster.Management.Management.Clusters("").Controller().Lister(),
+		projectLister:          cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		statsSummary: func(ctx context.Context, nodeName string) ([]byte, error) {
+			return cluster.K8sClient.CoreV1().RESTClient().Get().
+				Resource("nodes").Name(nodeName).SubResource("proxy").Suffix("stats/summary").
+				DoRaw(ctx)
+		},
+	}
+	go w.watch(ctx, syncInterval)
+}
+
+func (w *VolumeWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		err := w.watchRule(ctx)
+		if err != nil {
+			logrus.Infof("Failed to watch persistent volume claim, error: %v", err)
+		}
+	}
+}
+
+func (w *VolumeWatcher) watchRule(ctx context.Context) error {
+	if w.alertManager.IsDeploy == false {
+		return nil
+	}
+
+	pAlerts, err := activeProjectAlerts(w.projectAlertRuleLister, w.clusterName, func(alert *v3.ProjectAlertRule) bool {
+		return alert.Spec.VolumeRule != nil
+	})
+	if err != nil || len(pAlerts) == 0 {
+		return err
+	}
+
+	var checks []volumeCheck
+	for _, alert := range pAlerts {
+		if alert.Spec.VolumeRule.VolumeClaimName != "" {
+			parts := strings.SplitN(alert.Spec.VolumeRule.VolumeClaimName, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			pvc, err := w.pvcLister.Get(parts[0], parts[1])
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					if err = w.projectAlertPolicies.DeleteNamespaced(alert.Namespace, alert.Name, &metav1.DeleteOptions{}); err != nil {
+						return err
+					}
+				}
+				logrus.Debugf("Failed to get persistent volume claim %s: %v", alert.Spec.VolumeRule.VolumeClaimName, err)
+				continue
+			}
+			checks = append(checks, volumeCheck{alert: alert, pvc: pvc})
+
+		} else if alert.Spec.VolumeRule.Selector != nil {
+			namespaces, err := projectNamespaces(w.namespaceIndexer, alert.Spec.ProjectName)
+			if err != nil {
+				return err
+			}
+			for _, ns := range namespaces {
+				pvcs, err := w.pvcLister.List(ns, labels.SelectorFromSet(alert.Spec.VolumeRule.Selector))
+				if err != nil {
+					logrus.Warnf("Fail to list persistent volume claim: %v", err)
+					continue
+				}
+				for _, pvc := range pvcs {
+					checks = append(checks, volumeCheck{alert: alert, pvc: pvc})
+				}
+			}
+		}
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	usage, err := w.getVolumeUsage(ctx, checks)
+	if err != nil {
+		return err
+	}
+	for _, check := range checks {
+		w.checkVolumeUsage(check.pvc, usage, check.alert)
+	}
+
+	return nil
+}
+
+// claimNodes returns the nodes running the pods that mount the claims, the usage of a volume is only known to the
+// kubelets of these nodes
+func (w *VolumeWatcher) claimNodes(checks []volumeCheck) ([]string, error) {
+	claims := map[string]map[string]bool{}
+	for _, check := range checks {
+		if claims[check.pvc.Namespace] == nil {
+			claims[check.pvc.Namespace] = map[string]bool{}
+		}
+		claims[check.pvc.Namespace][check.pvc.Name] = true
+	}
+
+	nodes := map[string]bool{}
+	for namespace, names := range claims {
+		pods, err := w.podLister.List(namespace, labels.NewSelector())
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			if pod.Spec.NodeName == "" || nodes[pod.Spec.NodeName] {
+				continue
+			}
+			for _, volume := range pod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil && names[volume.PersistentVolumeClaim.ClaimName] {
+					nodes[pod.Spec.NodeName] = true
+					break
+				}
+			}
+		}
+	}
+
+	var result []string
+	for node := range nodes {
+		result = append(result, node)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// getVolumeUsage asks the kubelets of the nodes mounting the claims for their stats summary, a few at a time
+func (w *VolumeWatcher) getVolumeUsage(ctx context.Context, checks []volumeCheck) (map[string]volumeStats, error) {
+	nodes, err := w.claimNodes(checks)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		workers = make(chan struct{}, volumeStatsWorkers)
+		usage   = map[string]volumeStats{}
+	)
+	for _, node := range nodes {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(node string) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			nodeUsage, err := w.nodeVolumeUsage(ctx, node)
+			if err != nil {
+				logrus.Debugf("Failed to get the volume usage of node %s: %v", node, err)
+				return
+			}
+			mu.Lock()
+			for claim, stats := range nodeUsage {
+				usage[claim] = stats
+			}
+			mu.Unlock()
+		}(node)
+	}
+	wg.Wait()
+
+	return usage, nil
+}
+
+func (w *VolumeWatcher) nodeVolumeUsage(ctx context.Context, node string) (map[string]volumeStats, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, volumeStatsTimeout)
+	defer cancel()
+	data, err := w.statsSummary(reqCtx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := statsSummary{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, err
+	}
+	usage := map[string]volumeStats{}
+	for _, pod := range summary.Pods {
+		for _, v := range pod.VolumeStats {
+			if v.PVCRef == nil || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			usage[v.PVCRef.Namespace+":"+v.PVCRef.Name] = volumeStats{
+				UsedBytes:     *v.UsedBytes,
+				CapacityBytes: *v.CapacityBytes,
+			}
+		}
+	}
+	return usage, nil
+}
+
+func (w *VolumeWatcher) checkVolumeUsage(pvc *corev1.PersistentVolumeClaim, usage map[string]volumeStats, alert *v3.ProjectAlertRule) {
+	stats, ok := usage[pvc.Namespace+":"+pvc.Name]
+	if !ok || stats.CapacityBytes == 0 {
+		return
+	}
+
+	percentage := alert.Spec.VolumeRule.UsedPercentage
+	if stats.UsedBytes*100/stats.CapacityBytes < uint64(percentage) {
+		return
+	}
+
+	ruleID := common.GetRuleID(alert.Spec.GroupName, alert.Name)
+
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+	projectDisplayName := common.GetProjectDisplayName(alert.Spec.ProjectName, w.projectLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alert.Spec.GroupName
+	data["alert_type"] = "volumeUsage"
+	data["alert_name"] = alert.Spec.DisplayName
+	data["severity"] = alert.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["project_name"] = projectDisplayName
+	data["namespace"] = pvc.Namespace
+	data["volume_claim_name"] = pvc.Name
+	data["used_percentage"] = strconv.Itoa(percentage)
+	data["used_volume"] = resource.NewQuantity(int64(stats.UsedBytes), resource.BinarySI).String()
+	data["total_volume"] = resource.NewQuantity(int64(stats.CapacityBytes), resource.BinarySI).String()
+
+	if err := w.alertManager.SendAlert(data); err != nil {
+		logrus.Errorf("Failed to send alert: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newClaimPod(namespace, name, nodeName, claimName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			}},
+		},
+	}
+}
+
+func newVolumeCheck(namespace, name string) volumeCheck {
+	return volumeCheck{pvc: &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}}
+}
+
+func TestClaimNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	pods := map[string][]*corev1.Pod{
+		"ns1": {
+			newClaimPod("ns1", "mounts-data", "node1", "data"),
+			newClaimPod("ns1", "mounts-other", "node2", "other"),
+			newClaimPod("ns1", "pending", "", "data"),
+		},
+		"ns2": {
+			newClaimPod("ns2", "mounts-logs", "node3", "logs"),
+			newClaimPod("ns2", "mounts-logs-too", "node1", "logs"),
+		},
+	}
+	w := &VolumeWatcher{
+		podLister: &fakes.PodListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*corev1.Pod, error) {
+				return pods[namespace], nil
+			},
+		},
+	}
+
+	nodes, err := w.claimNodes([]volumeCheck{newVolumeCheck("ns1", "data"), newVolumeCheck("ns2", "logs")})
+	assert.Nil(err)
+	assert.Equal([]string{"node1", "node3"}, nodes)
+}
+
+func TestGetVolumeUsage(t *testing.T) {
+	assert := assert.New(t)
+
+	var pods []*corev1.Pod
+	for i := 0; i < 3*volumeStatsWorkers; i++ {
+		pods = append(pods, newClaimPod("ns1", fmt.Sprintf("pod%d", i), fmt.Sprintf("node%d", i), fmt.Sprintf("claim%d", i)))
+	}
+	var checks []volumeCheck
+	for _, pod := range pods {
+		checks = append(checks, newVolumeCheck("ns1", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName))
+	}
+
+	var (
+		mu                sync.Mutex
+		running, observed int
+	)
+	w := &VolumeWatcher{
+		podLister: &fakes.PodListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*corev1.Pod, error) {
+				return pods, nil
+			},
+		},
+		statsSummary: func(ctx context.Context, nodeName string) ([]byte, error) {
+			mu.Lock()
+			running++
+			if running > observed {
+				observed = running
+			}
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				running--
+				mu.Unlock()
+			}()
+
+			if nodeName == "node0" {
+				return nil, errors.New("kubelet unreachable")
+			}
+			claim := "claim" + nodeName[len("node"):]
+			return []byte(fmt.Sprintf(`{"pods":[{"volume":[{"pvcRef":{"name":%q,"namespace":"ns1"},"usedBytes":80,"capacityBytes":100},{"name":"tmp"}]}]}`, claim)), nil
+		},
+	}
+
+	usage, err := w.getVolumeUsage(context.Background(), checks)
+	assert.Nil(err)
+	assert.Len(usage, len(pods)-1, "the claim of the unreachable kubelet has no usage")
+	assert.Equal(volumeStats{UsedBytes: 80, CapacityBytes: 100}, usage["ns1:claim1"])
+	assert.True(observed <= volumeStatsWorkers, "at most %d kubelets are asked at once, got %d", volumeStatsWorkers, observed)
+}
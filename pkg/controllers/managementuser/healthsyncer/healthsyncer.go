@@ -40,6 +40,8 @@ type HealthSyncer struct {
 	componentStatuses corev1.ComponentStatusInterface
 	namespaces        corev1.NamespaceInterface
 	k8s               kubernetes.Interface
+	probes            []Probe
+	lastProbe         time.Time
 }
 
 func Register(ctx context.Context, workload *config.UserContext) {
@@ -51,6 +53,7 @@ func Register(ctx context.Context, workload *config.UserContext) {
 		componentStatuses: workload.Core.ComponentStatuses(""),
 		namespaces:        workload.Core.Namespaces(""),
 		k8s:               workload.K8sClient,
+		probes:            newProbes(workload),
 	}
 
 	go h.syncHealth(ctx, syncInterval)
@@ -123,6 +126,12 @@ func (h *HealthSyncer) updateClusterHealth() error {
 	if err == nil {
 		v32.ClusterConditionWaiting.True(newObj)
 		v32.ClusterConditionWaiting.Message(newObj, "")
+
+		// a failed or timed out attempt counts too, probes of an unhealthy cluster are not retried on every sync
+		if time.Since(h.lastProbe) >= probeInterval {
+			h.lastProbe = time.Now()
+			h.runProbes(newObj.(*v3.Cluster))
+		}
 	}
 
 	if !reflect.DeepEqual(oldCluster, newObj) {
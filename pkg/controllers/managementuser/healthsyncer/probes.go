@@ -0,0 +1,400 @@
+package healthsyncer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/rancher/norman/condition"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/types/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	probeInterval = time.Minute
+	probeTimeout  = 10 * time.Second
+	// dbSizeInterval limits how often the etcd probe reads the API server metrics, they are large
+	dbSizeInterval = 10 * time.Minute
+	// the etcd probe fails when a member uses more than dbSizeThreshold of its quota
+	dbSizeThreshold    = 0.8
+	defaultEtcdQuota   = 2 * 1024 * 1024 * 1024
+	etcdDBSizeMetric   = "etcd_db_total_size_in_bytes"
+	maxTunnelLatency   = 2 * time.Second
+	clusterDNSSelector = "k8s-app=kube-dns"
+	coreDNSHealthPort  = "8080"
+)
+
+// cniDaemonSets are the kube-system daemon sets of the network plugins the network probe checks
+var cniDaemonSets = map[string]bool{
+	"aws-node":        true,
+	"calico-node":     true,
+	"canal":           true,
+	"cilium":          true,
+	"kube-flannel":    true,
+	"kube-flannel-ds": true,
+	"kube-router":     true,
+	"rke2-canal":      true,
+	"weave-net":       true,
+}
+
+// Probe checks the health of one component of a downstream cluster, each probe owns a cluster condition and its
+// result is exported as the cluster_manager_health_probe_status metric
+type Probe interface {
+	Name() string
+	Condition() condition.Cond
+	// Check returns why the component is unhealthy, nil when it is healthy
+	Check(ctx context.Context, cluster *v3.Cluster) error
+}
+
+// ProbeFactory creates a probe for a downstream cluster
+type ProbeFactory func(workload *config.UserContext) Probe
+
+var (
+	probeFactoriesLock sync.Mutex
+	probeFactories     = []ProbeFactory{
+		newAPIServerProbe,
+		newEtcdProbe,
+		newDNSProbe,
+		newNetworkProbe,
+		newTunnelProbe,
+	}
+)
+
+// RegisterProbe adds a probe to the ones run on every downstream cluster, it applies to the clusters whose
+// controllers start afterwards
+func RegisterProbe(factory ProbeFactory) {
+	probeFactoriesLock.Lock()
+	defer probeFactoriesLock.Unlock()
+	probeFactories = append(probeFactories, factory)
+}
+
+func newProbes(workload *config.UserContext) []Probe {
+	probeFactoriesLock.Lock()
+	defer probeFactoriesLock.Unlock()
+	var probes []Probe
+	for _, factory := range probeFactories {
+		probes = append(probes, factory(workload))
+	}
+	return probes
+}
+
+// runProbes updates the condition of each probe on the cluster, the probes run at once under a single deadline so
+// that a slow component does not hold back the others
+func (h *HealthSyncer) runProbes(cluster *v3.Cluster) {
+	ctx, cancel := context.WithTimeout(h.ctx, probeTimeout)
+	defer cancel()
+
+	errs := make([]error, len(h.probes))
+	durations := make([]time.Duration, len(h.probes))
+	var wg sync.WaitGroup
+	for i, probe := range h.probes {
+		wg.Add(1)
+		go func(i int, probe Probe) {
+			defer wg.Done()
+			start := time.Now()
+			errs[i] = probe.Check(ctx, cluster)
+			durations[i] = time.Since(start)
+		}(i, probe)
+	}
+	wg.Wait()
+
+	for i, probe := range h.probes {
+		err := errs[i]
+		metrics.SetClusterHealthProbe(cluster.Name, probe.Name(), err == nil, durations[i])
+		if err != nil {
+			probe.Condition().False(cluster)
+			probe.Condition().Message(cluster, err.Error())
+			continue
+		}
+		probe.Condition().True(cluster)
+		probe.Condition().Message(cluster, "")
+	}
+}
+
+// apiServerProbe reports the failing checks of the /livez and /readyz endpoints of the API server
+type apiServerProbe struct {
+	k8s kubernetes.Interface
+}
+
+func newAPIServerProbe(workload *config.UserContext) Probe {
+	return &apiServerProbe{k8s: workload.K8sClient}
+}
+
+func (p *apiServerProbe) Name() string { return "apiserver" }
+
+func (p *apiServerProbe) Condition() condition.Cond { return v32.ClusterConditionAPIServerHealthy }
+
+func (p *apiServerProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	var failed []string
+	for _, endpoint := range []string{"livez", "readyz"} {
+		body, err := p.k8s.CoreV1().RESTClient().Get().AbsPath("/"+endpoint).Param("verbose", "").DoRaw(ctx)
+		if apierrors.IsNotFound(err) {
+			// the endpoints were added in Kubernetes 1.16
+			continue
+		}
+		checks := failedChecks(body)
+		if err != nil && len(checks) == 0 {
+			return errors.Wrapf(err, "failed to get /%s", endpoint)
+		}
+		for _, check := range checks {
+			failed = append(failed, endpoint+"/"+check)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failing API server checks: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// failedChecks returns the names of the failed checks of a verbose health endpoint response, formatted as
+// "[-]etcd failed: reason withheld"
+func failedChecks(body []byte) []string {
+	var failed []string
+	for _, line := range strings.Split(string(body), "\n") {
+		if !strings.HasPrefix(line, "[-]") {
+			continue
+		}
+		name := strings.TrimPrefix(line, "[-]")
+		if i := strings.IndexAny(name, " :"); i > 0 {
+			name = name[:i]
+		}
+		failed = append(failed, name)
+	}
+	return failed
+}
+
+// etcdProbe checks the API server reaches etcd and that the database of the etcd members stays under their quota
+type etcdProbe struct {
+	k8s kubernetes.Interface
+
+	lastDBSizeCheck time.Time
+	dbSizeErr       error
+}
+
+func newEtcdProbe(workload *config.UserContext) Probe {
+	return &etcdProbe{k8s: workload.K8sClient}
+}
+
+func (p *etcdProbe) Name() string { return "etcd" }
+
+func (p *etcdProbe) Condition() condition.Cond { return v32.ClusterConditionEtcdHealthy }
+
+func (p *etcdProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	body, err := p.k8s.CoreV1().RESTClient().Get().AbsPath("/readyz/etcd").DoRaw(ctx)
+	if apierrors.IsNotFound(err) {
+		body, err = p.k8s.CoreV1().RESTClient().Get().AbsPath("/healthz/etcd").DoRaw(ctx)
+	}
+	if err != nil {
+		if len(body) > 0 {
+			return fmt.Errorf("etcd is unhealthy: %s", strings.TrimSpace(string(body)))
+		}
+		return errors.Wrap(err, "etcd is unhealthy")
+	}
+
+	if time.Since(p.lastDBSizeCheck) < dbSizeInterval {
+		return p.dbSizeErr
+	}
+	metricsBody, err := p.k8s.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to read API server metrics")
+	}
+	sizes, err := etcdDBSizes(metricsBody)
+	if err != nil {
+		return err
+	}
+	for member, size := range sizes {
+		metrics.SetClusterEtcdDBSize(cluster.Name, member, size)
+	}
+	p.lastDBSizeCheck = time.Now()
+	p.dbSizeErr = checkDBSizes(sizes, etcdQuota(cluster))
+	return p.dbSizeErr
+}
+
+// etcdDBSizes returns the database size of each etcd member the API server talks to
+func etcdDBSizes(body []byte) (map[string]float64, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse API server metrics: %v", err)
+	}
+	sizes := map[string]float64{}
+	family, ok := families[etcdDBSizeMetric]
+	if !ok {
+		// hosted clusters and API servers older than 1.18 do not report it
+		return sizes, nil
+	}
+	for _, m := range family.GetMetric() {
+		member := ""
+		for _, l := range m.GetLabel() {
+			if l.GetName() == "endpoint" {
+				member = l.GetValue()
+			}
+		}
+		sizes[member] = m.GetGauge().GetValue()
+	}
+	return sizes, nil
+}
+
+func checkDBSizes(sizes map[string]float64, quota float64) error {
+	var full []string
+	for member, size := range sizes {
+		if size > quota*dbSizeThreshold {
+			full = append(full, fmt.Sprintf("%s uses %d%% of its %d MiB quota", member, int(100*size/quota), int64(quota)/(1024*1024)))
+		}
+	}
+	if len(full) == 0 {
+		return nil
+	}
+	sort.Strings(full)
+	return fmt.Errorf("etcd database is almost full: %s", strings.Join(full, ", "))
+}
+
+// etcdQuota returns the database quota of the etcd members, set through the extra args of RKE clusters
+func etcdQuota(cluster *v3.Cluster) float64 {
+	if rkeConfig := cluster.Spec.RancherKubernetesEngineConfig; rkeConfig != nil {
+		if quota, err := strconv.ParseFloat(rkeConfig.Services.Etcd.ExtraArgs["quota-backend-bytes"], 64); err == nil && quota > 0 {
+			return quota
+		}
+	}
+	return defaultEtcdQuota
+}
+
+// dnsProbe checks the cluster DNS service has ready endpoints and that the CoreDNS pods behind it pass their health
+// check
+type dnsProbe struct {
+	k8s kubernetes.Interface
+}
+
+func newDNSProbe(workload *config.UserContext) Probe {
+	return &dnsProbe{k8s: workload.K8sClient}
+}
+
+func (p *dnsProbe) Name() string { return "dns" }
+
+func (p *dnsProbe) Condition() condition.Cond { return v32.ClusterConditionDNSHealthy }
+
+func (p *dnsProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	services, err := p.k8s.CoreV1().Services("kube-system").List(ctx, metav1.ListOptions{LabelSelector: clusterDNSSelector})
+	if err != nil {
+		return errors.Wrap(err, "failed to list cluster DNS services")
+	}
+	if len(services.Items) == 0 {
+		return fmt.Errorf("no cluster DNS service found with label %s", clusterDNSSelector)
+	}
+
+	var problems []string
+	for _, service := range services.Items {
+		endpoints, err := p.k8s.CoreV1().Endpoints(service.Namespace).Get(ctx, service.Name, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the endpoints of cluster DNS service %s", service.Name)
+		}
+		ready, notReady := 0, 0
+		for _, subset := range endpoints.Subsets {
+			notReady += len(subset.NotReadyAddresses)
+			for _, address := range subset.Addresses {
+				ready++
+				if address.TargetRef == nil || address.TargetRef.Kind != "Pod" || !strings.HasPrefix(address.TargetRef.Name, "coredns") {
+					continue
+				}
+				if err := p.checkCoreDNS(ctx, address.TargetRef.Namespace, address.TargetRef.Name); err != nil {
+					problems = append(problems, err.Error())
+				}
+			}
+		}
+		if ready == 0 {
+			problems = append(problems, fmt.Sprintf("service %s has no ready endpoints", service.Name))
+		} else if notReady > 0 {
+			problems = append(problems, fmt.Sprintf("service %s has %d endpoints not ready", service.Name, notReady))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("cluster DNS is unhealthy: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// checkCoreDNS calls the health plugin of a CoreDNS pod through the API server proxy
+func (p *dnsProbe) checkCoreDNS(ctx context.Context, namespace, name string) error {
+	_, err := p.k8s.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name + ":" + coreDNSHealthPort).
+		SubResource("proxy").
+		Suffix("health").
+		DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("pod %s failed its health check: %v", name, err)
+	}
+	return nil
+}
+
+// networkProbe checks the pods of the network plugin are ready on every node
+type networkProbe struct {
+	k8s kubernetes.Interface
+}
+
+func newNetworkProbe(workload *config.UserContext) Probe {
+	return &networkProbe{k8s: workload.K8sClient}
+}
+
+func (p *networkProbe) Name() string { return "network" }
+
+func (p *networkProbe) Condition() condition.Cond { return v32.ClusterConditionNetworkHealthy }
+
+func (p *networkProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	daemonSets, err := p.k8s.AppsV1().DaemonSets("kube-system").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list network plugin daemon sets")
+	}
+	var notReady []string
+	for _, ds := range daemonSets.Items {
+		if !cniDaemonSets[ds.Name] {
+			continue
+		}
+		if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+			notReady = append(notReady, fmt.Sprintf("%s has %d/%d pods ready", ds.Name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled))
+		}
+	}
+	// clusters with an embedded network plugin, like k3s, have no daemon set to check
+	if len(notReady) > 0 {
+		return fmt.Errorf("network plugin is not ready: %s", strings.Join(notReady, ", "))
+	}
+	return nil
+}
+
+// tunnelProbe times a round trip to the API server of the cluster, through the cluster agent tunnel for clusters
+// that are not local
+type tunnelProbe struct {
+	k8s kubernetes.Interface
+}
+
+func newTunnelProbe(workload *config.UserContext) Probe {
+	return &tunnelProbe{k8s: workload.K8sClient}
+}
+
+func (p *tunnelProbe) Name() string { return "tunnel" }
+
+func (p *tunnelProbe) Condition() condition.Cond { return v32.ClusterConditionAgentTunnelHealthy }
+
+func (p *tunnelProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	start := time.Now()
+	if _, err := p.k8s.CoreV1().RESTClient().Get().AbsPath("/version").DoRaw(ctx); err != nil {
+		return errors.Wrap(err, "failed to reach the API server through the cluster agent")
+	}
+	if latency := time.Since(start); latency > maxTunnelLatency {
+		return fmt.Errorf("round trip through the cluster agent took %v", latency.Round(time.Millisecond))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package healthsyncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rancher/norman/condition"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFailedChecks(t *testing.T) {
+	body := []byte(`[+]ping ok
+[+]log ok
+[-]etcd failed: reason withheld
+[+]poststarthook/start-kube-apiserver-admission-initializer ok
+[-]poststarthook/crd-informer-synced failed: reason withheld
+readyz check failed`)
+
+	assert.Equal(t, []string{"etcd", "poststarthook/crd-informer-synced"}, failedChecks(body))
+	assert.Empty(t, failedChecks([]byte("[+]ping ok\nreadyz check passed")))
+}
+
+func TestEtcdDBSize(t *testing.T) {
+	body := []byte(`# HELP etcd_db_total_size_in_bytes [ALPHA] Total size of the etcd database file physically allocated in bytes.
+# TYPE etcd_db_total_size_in_bytes gauge
+etcd_db_total_size_in_bytes{endpoint="https://10.0.0.1:2379"} 1.8e+09
+etcd_db_total_size_in_bytes{endpoint="https://10.0.0.2:2379"} 2.4e+07
+`)
+	sizes, err := etcdDBSizes(body)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]float64{"https://10.0.0.1:2379": 1.8e+09, "https://10.0.0.2:2379": 2.4e+07}, sizes)
+
+	assert.EqualError(t, checkDBSizes(sizes, defaultEtcdQuota),
+		"etcd database is almost full: https://10.0.0.1:2379 uses 83% of its 2048 MiB quota")
+
+	cluster := &v3.Cluster{
+		Spec: v32.ClusterSpec{
+			ClusterSpecBase: v32.ClusterSpecBase{
+				RancherKubernetesEngineConfig: &rketypes.RancherKubernetesEngineConfig{
+					Services: rketypes.RKEConfigServices{
+						Etcd: rketypes.ETCDService{
+							BaseService: rketypes.BaseService{ExtraArgs: map[string]string{"quota-backend-bytes": "8589934592"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, checkDBSizes(sizes, etcdQuota(cluster)))
+
+	sizes, err = etcdDBSizes([]byte("apiserver_request_total 1\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, sizes)
+}
+
+func TestNetworkProbe(t *testing.T) {
+	daemonSet := func(name string, ready, desired int32) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system"},
+			Status:     appsv1.DaemonSetStatus{NumberReady: ready, DesiredNumberScheduled: desired},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ds      []*appsv1.DaemonSet
+		wantErr string
+	}{
+		{
+			name: "embedded network plugin",
+			ds:   []*appsv1.DaemonSet{daemonSet("kube-proxy", 1, 3)},
+		},
+		{
+			name: "ready network plugin",
+			ds:   []*appsv1.DaemonSet{daemonSet("canal", 3, 3)},
+		},
+		{
+			name:    "network plugin not ready",
+			ds:      []*appsv1.DaemonSet{daemonSet("calico-node", 2, 3), daemonSet("kube-proxy", 1, 3)},
+			wantErr: "network plugin is not ready: calico-node has 2/3 pods ready",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8s := fake.NewSimpleClientset()
+			for _, ds := range tt.ds {
+				_, err := k8s.AppsV1().DaemonSets(ds.Namespace).Create(context.Background(), ds, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			err := (&networkProbe{k8s: k8s}).Check(context.Background(), &v3.Cluster{})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// slowProbe is a probe that takes delay to answer, or until its deadline
+type slowProbe struct {
+	name  string
+	cond  condition.Cond
+	delay time.Duration
+}
+
+func (p *slowProbe) Name() string { return p.name }
+
+func (p *slowProbe) Condition() condition.Cond { return p.cond }
+
+func (p *slowProbe) Check(ctx context.Context, cluster *v3.Cluster) error {
+	select {
+	case <-time.After(p.delay):
+		return nil
+	case <-ctx.Done():
+		return errors.New("probe timed out")
+	}
+}
+
+func TestRunProbesConcurrently(t *testing.T) {
+	// the sync is stopped before the slow probe answers, one after the other the probes would not all fit
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	h := &HealthSyncer{
+		ctx: ctx,
+		probes: []Probe{
+			&slowProbe{name: "etcd", cond: v32.ClusterConditionEtcdHealthy, delay: 300 * time.Millisecond},
+			&slowProbe{name: "dns", cond: v32.ClusterConditionDNSHealthy, delay: 300 * time.Millisecond},
+			&slowProbe{name: "network", cond: v32.ClusterConditionNetworkHealthy, delay: time.Hour},
+		},
+	}
+	cluster := &v3.Cluster{}
+
+	h.runProbes(cluster)
+
+	assert.True(t, v32.ClusterConditionEtcdHealthy.IsTrue(cluster))
+	assert.True(t, v32.ClusterConditionDNSHealthy.IsTrue(cluster))
+	assert.True(t, v32.ClusterConditionNetworkHealthy.IsFalse(cluster))
+	assert.Equal(t, "probe timed out", v32.ClusterConditionNetworkHealthy.GetMessage(cluster))
+}
@@ -26,6 +26,12 @@
 	FluentdTesterContainerName = "dry-run"
 )
 
+//metrics
+const (
+	FluentdMetricsPort = "24231"
+	FluentdMetricsPath = "metrics"
+)
+
 //config
 const (
 	LoggingSecretName             = "fluentd"
@@ -35,4 +35,6 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	namespaces.AddClusterScopedHandler(ctx, "namespace-logging-configsysncer", cluster.ClusterName, configSyncer.NamespaceSync)
 
 	watcher.StartEndpointWatcher(ctx, cluster)
+	watcher.StartDroppedLinesWatcher(ctx, cluster)
+	watcher.StartAuditLogWatcher(ctx, cluster)
 }
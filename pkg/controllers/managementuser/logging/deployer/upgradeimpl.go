@@ -66,6 +66,20 @@ func (l *LoggingService) Version() (string, error) {
 	return loggingconfig.RancherLoggingInitVersion(), nil
 }
 
+func (l *LoggingService) TargetVersion() (string, error) {
+	templateID := loggingconfig.RancherLoggingTemplateID()
+	template, err := l.templateLister.Get(namespace.GlobalNamespace, templateID)
+	if err != nil {
+		return "", errors.Wrapf(err, "get template %s failed", templateID)
+	}
+
+	templateVersion, err := versionutil.LatestAvailableTemplateVersion(template)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s", templateID, templateVersion.Version), nil
+}
+
 func (l *LoggingService) Upgrade(currentVersion string) (string, error) {
 	appName := loggingconfig.AppName
 	templateID := loggingconfig.RancherLoggingTemplateID()
@@ -0,0 +1,191 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+)
+
+const (
+	AuditLogSourceKubeAPIServer = "kube-apiserver"
+	AuditLogSourceRancher       = "rancher"
+
+	// DefaultKubeAPIServerAuditLogPath is where RKE writes the audit log of kube-apiserver on the controlplane nodes
+	DefaultKubeAPIServerAuditLogPath = "/var/log/kube-audit/audit-log.json"
+	// DefaultRancherAuditLogPath is the log of the rancher-audit-log sidecar of the Rancher server pods, which prints
+	// the audit log of the Rancher API
+	DefaultRancherAuditLogPath = "/var/log/containers/rancher-*_cattle-system_rancher-audit-log-*.log"
+
+	// TailFileMetric is the position of fluentd in the files it tails, labeled with their path. It is exported when the
+	// cluster ships audit logs, the fluentd pod of a node that has no audit log file reports no position for it.
+	TailFileMetric    = "fluentd_tail_file_position"
+	TailFilePathLabel = "path"
+
+	containerLogDir  = "/var/log/containers/"
+	localClusterName = "local"
+)
+
+var (
+	auditLogPathRegexp  = regexp.MustCompile(`^/[-A-Za-z0-9_.*/]+$`)
+	auditLogIndexRegexp = regexp.MustCompile(`^[a-z0-9][-a-z0-9_.]*$`)
+)
+
+type AuditLogTemplateWrap struct {
+	Source      string
+	Tag         string
+	LogType     string
+	Path        string
+	PosFilename string
+	TimeKey     string
+	TimeFormat  string
+	// ContainerLog is true when the audit log is the output of a container, its lines are unwrapped before they
+	// are parsed
+	ContainerLog bool
+
+	// Store is the logging of the cluster with the target and the buffer of the audit log, the outputs of the audit
+	// log are rendered from it
+	Store ClusterLoggingTemplateWrap
+}
+
+func newAuditLogTemplateWraps(logging v32.ClusterLoggingSpec, cluster *ClusterLoggingTemplateWrap) ([]AuditLogTemplateWrap, error) {
+	if err := ValidateAuditLogSources(logging.ClusterName, logging.AuditLogSources, cluster.CurrentTarget); err != nil {
+		return nil, err
+	}
+	sources := logging.AuditLogSources
+	if sources == nil {
+		return nil, nil
+	}
+
+	var wraps []AuditLogTemplateWrap
+	if s := sources.KubeAPIServer; s != nil {
+		wraps = append(wraps, newAuditLogTemplateWrap(s, cluster, AuditLogTemplateWrap{
+			Source:     AuditLogSourceKubeAPIServer,
+			Tag:        "kube-audit",
+			LogType:    "k8s_audit",
+			Path:       DefaultKubeAPIServerAuditLogPath,
+			TimeKey:    "stageTimestamp",
+			TimeFormat: "%Y-%m-%dT%H:%M:%S.%NZ",
+		}))
+	}
+	if s := sources.Rancher; s != nil {
+		wraps = append(wraps, newAuditLogTemplateWrap(s, cluster, AuditLogTemplateWrap{
+			Source:     AuditLogSourceRancher,
+			Tag:        "rancher-audit",
+			LogType:    "rancher_audit",
+			Path:       DefaultRancherAuditLogPath,
+			TimeKey:    "requestTimestamp",
+			TimeFormat: "%Y-%m-%dT%H:%M:%S%z",
+		}))
+	}
+	return wraps, nil
+}
+
+func newAuditLogTemplateWrap(source *v32.LoggingAuditLogSource, cluster *ClusterLoggingTemplateWrap, wrap AuditLogTemplateWrap) AuditLogTemplateWrap {
+	if source.Path != "" {
+		wrap.Path = source.Path
+	}
+	wrap.PosFilename = fmt.Sprintf("fluentd-%s.pos", wrap.Tag)
+	wrap.ContainerLog = strings.HasPrefix(wrap.Path, containerLogDir)
+	wrap.Store = *cluster
+	wrap.Store.WrapAuditLogs = nil
+	wrap.Store.LoggingTargetTemplateWrap = auditTarget(cluster.LoggingTargetTemplateWrap, source.Index)
+	wrap.Store.BufferFile = getBufferFilename(loggingconfig.ClusterLevel, wrap.Tag)
+	return wrap
+}
+
+// AuditLogPaths returns the files of the audit log sources by source
+func AuditLogPaths(sources *v32.LoggingAuditLogSources) map[string]string {
+	paths := map[string]string{}
+	if sources == nil {
+		return paths
+	}
+	if s := sources.KubeAPIServer; s != nil {
+		paths[AuditLogSourceKubeAPIServer] = DefaultKubeAPIServerAuditLogPath
+		if s.Path != "" {
+			paths[AuditLogSourceKubeAPIServer] = s.Path
+		}
+	}
+	if s := sources.Rancher; s != nil {
+		paths[AuditLogSourceRancher] = DefaultRancherAuditLogPath
+		if s.Path != "" {
+			paths[AuditLogSourceRancher] = s.Path
+		}
+	}
+	return paths
+}
+
+// auditTarget replaces the index of the target when the audit log has one, the certificates and the secrets are the
+// ones of the logging
+func auditTarget(target LoggingTargetTemplateWrap, index string) LoggingTargetTemplateWrap {
+	if index == "" {
+		return target
+	}
+	switch target.CurrentTarget {
+	case loggingconfig.Elasticsearch:
+		target.ElasticsearchTemplateWrap.IndexPrefix = index
+	case loggingconfig.Splunk:
+		target.SplunkTemplateWrap.Index = index
+	case loggingconfig.Kafka:
+		target.KafkaTemplateWrap.Topic = index
+	case loggingconfig.Syslog:
+		target.SyslogTemplateWrap.Program = index
+	}
+	return target
+}
+
+// AuditContainerLogPaths are the container logs that are audit logs, they are excluded from the container logs of
+// the cluster
+func (w ClusterLoggingTemplateWrap) AuditContainerLogPaths() []string {
+	var paths []string
+	for _, audit := range w.WrapAuditLogs {
+		if audit.ContainerLog {
+			paths = append(paths, audit.Path)
+		}
+	}
+	return paths
+}
+
+// ValidateAuditLogSources checks the audit log sources of a cluster logging, a separate index is only supported by
+// the targets that have one. The custom target can't ship audit logs, its content would be rendered once more for each
+// audit log with the same buffer path.
+func ValidateAuditLogSources(clusterName string, sources *v32.LoggingAuditLogSources, currentTarget string) error {
+	if sources == nil {
+		return nil
+	}
+	if currentTarget == loggingconfig.CustomTarget && (sources.KubeAPIServer != nil || sources.Rancher != nil) {
+		return fmt.Errorf("the %s target doesn't support audit logs", currentTarget)
+	}
+	if sources.Rancher != nil && clusterName != localClusterName {
+		return fmt.Errorf("the Rancher audit log can only be shipped by the logging of the %s cluster", localClusterName)
+	}
+
+	for _, s := range []struct {
+		name   string
+		source *v32.LoggingAuditLogSource
+	}{
+		{name: AuditLogSourceKubeAPIServer, source: sources.KubeAPIServer},
+		{name: AuditLogSourceRancher, source: sources.Rancher},
+	} {
+		name, source := s.name, s.source
+		if source == nil {
+			continue
+		}
+		if source.Path != "" && !auditLogPathRegexp.MatchString(source.Path) {
+			return fmt.Errorf("invalid %s audit log path %q, it must be absolute and can only contain letters, digits, _, -, ., * and /", name, source.Path)
+		}
+		if source.Index == "" {
+			continue
+		}
+		if !auditLogIndexRegexp.MatchString(source.Index) {
+			return fmt.Errorf("invalid %s audit log index %q, it can only contain lowercase letters, digits, _, - and .", name, source.Index)
+		}
+		if currentTarget == loggingconfig.FluentForwarder {
+			return fmt.Errorf("the %s target doesn't support a separate index for the %s audit log", currentTarget, name)
+		}
+	}
+	return nil
+}
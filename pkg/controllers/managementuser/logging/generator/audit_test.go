@@ -0,0 +1,149 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+)
+
+func TestAuditLogConfig(t *testing.T) {
+	spec := v32.ClusterLoggingSpec{
+		LoggingTargets: v32.LoggingTargets{
+			ElasticsearchConfig: &v32.ElasticsearchConfig{Endpoint: "http://elasticsearch:9200", IndexPrefix: "cluster"},
+		},
+		ClusterName: "local",
+		AuditLogSources: &v32.LoggingAuditLogSources{
+			KubeAPIServer: &v32.LoggingAuditLogSource{Index: "kube-audit"},
+			Rancher:       &v32.LoggingAuditLogSource{},
+		},
+	}
+
+	buf, err := GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := string(buf)
+	for _, expected := range []string{
+		"@type prometheus_tail_monitor",
+		"path  " + DefaultKubeAPIServerAuditLogPath,
+		"time_key stageTimestamp",
+		`exclude_path ["` + DefaultRancherAuditLogPath + `"]`,
+		"key_name log",
+		`audit_user ${record.dig("user", "name")}`,
+		"<match kube-audit.**>",
+		"<match rancher-audit.**>",
+		`logstash_prefix "kube-audit"`,
+		"path /fluentd/log/buffer/cluster.kube-audit.buffer",
+		"path /fluentd/log/buffer/cluster.rancher-audit.buffer",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %q, got %s", expected, config)
+		}
+	}
+	// the Rancher audit log and the container logs share the index
+	if n := strings.Count(config, `logstash_prefix "cluster"`); n != 2 {
+		t.Errorf("expected the index of the logging twice, got %d times", n)
+	}
+
+	// the files are only monitored for the audit logs
+	spec.AuditLogSources = nil
+	buf, err = GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(buf), "prometheus_tail_monitor") {
+		t.Errorf("expected no tail monitor without audit logs, got %s", buf)
+	}
+}
+
+func TestValidateAuditLogSources(t *testing.T) {
+	// 1. the Rancher audit log is only in the local cluster
+	err := ValidateAuditLogSources("c-1", &v32.LoggingAuditLogSources{Rancher: &v32.LoggingAuditLogSource{}}, loggingconfig.Elasticsearch)
+	if err == nil || !strings.Contains(err.Error(), "local cluster") {
+		t.Errorf("expected the Rancher audit log of a downstream cluster to be rejected, got %v", err)
+	}
+
+	// 2. paths are absolute and can't break out of the configuration
+	for _, path := range []string{"audit.log", "/var/log/a.log\n</source>"} {
+		err = ValidateAuditLogSources("c-1", &v32.LoggingAuditLogSources{KubeAPIServer: &v32.LoggingAuditLogSource{Path: path}}, loggingconfig.Elasticsearch)
+		if err == nil || !strings.Contains(err.Error(), "invalid kube-apiserver audit log path") {
+			t.Errorf("expected the path %q to be rejected, got %v", path, err)
+		}
+	}
+
+	// 3. indexes are lowercase and need a target that has them
+	err = ValidateAuditLogSources("local", &v32.LoggingAuditLogSources{Rancher: &v32.LoggingAuditLogSource{Index: "Audit"}}, loggingconfig.Elasticsearch)
+	if err == nil || !strings.Contains(err.Error(), "invalid rancher audit log index") {
+		t.Errorf("expected an index with uppercase letters to be rejected, got %v", err)
+	}
+	err = ValidateAuditLogSources("c-1", &v32.LoggingAuditLogSources{KubeAPIServer: &v32.LoggingAuditLogSource{Index: "audit"}}, loggingconfig.FluentForwarder)
+	if err == nil || !strings.Contains(err.Error(), "doesn't support a separate index") {
+		t.Errorf("expected an index of the fluent forwarder target to be rejected, got %v", err)
+	}
+
+	// 4. the custom target can't ship audit logs, even to its index
+	err = ValidateAuditLogSources("c-1", &v32.LoggingAuditLogSources{KubeAPIServer: &v32.LoggingAuditLogSource{}}, loggingconfig.CustomTarget)
+	if err == nil || !strings.Contains(err.Error(), "doesn't support audit logs") {
+		t.Errorf("expected the audit logs of the custom target to be rejected, got %v", err)
+	}
+
+	// 5. valid sources
+	err = ValidateAuditLogSources("c-1", &v32.LoggingAuditLogSources{KubeAPIServer: &v32.LoggingAuditLogSource{Path: "/var/log/kube-audit/*.json", Index: "audit"}}, loggingconfig.Kafka)
+	if err != nil {
+		t.Errorf("expected valid sources, got %v", err)
+	}
+	if err = ValidateAuditLogSources("c-1", nil, loggingconfig.CustomTarget); err != nil {
+		t.Errorf("expected no sources to be valid, got %v", err)
+	}
+}
+
+func TestAuditTarget(t *testing.T) {
+	// 1. the store of the audit log has its index
+	for _, targets := range []v32.LoggingTargets{
+		{SplunkConfig: &v32.SplunkConfig{Endpoint: "https://splunk:8088", Token: "token", Index: "containers"}},
+		{KafkaConfig: &v32.KafkaConfig{BrokerEndpoints: []string{"http://kafka:9092"}, Topic: "containers"}},
+		{SyslogConfig: &v32.SyslogConfig{Endpoint: "syslog:514", Program: "containers"}},
+	} {
+		spec := v32.ClusterLoggingSpec{
+			LoggingTargets:  targets,
+			ClusterName:     "c-1",
+			AuditLogSources: &v32.LoggingAuditLogSources{KubeAPIServer: &v32.LoggingAuditLogSource{Index: "kube-audit"}},
+		}
+		buf, err := GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		config := string(buf)
+		// the store of the audit log, without its match and its buffer
+		store := config[strings.Index(config, "<match kube-audit.**>"):]
+		store = store[strings.Index(store, "<store>"):strings.Index(store, "<buffer")]
+		if !strings.Contains(store, "kube-audit") || strings.Contains(store, "containers") {
+			t.Errorf("expected the audit log to be sent to its index, got %s", store)
+		}
+	}
+
+	// 2. without an index the audit log is sent with the container logs
+	target := LoggingTargetTemplateWrap{CurrentTarget: loggingconfig.Elasticsearch}
+	target.ElasticsearchTemplateWrap.IndexPrefix = "containers"
+	target = auditTarget(target, "")
+	if target.ElasticsearchTemplateWrap.IndexPrefix != "containers" {
+		t.Errorf("expected the index of the logging, got %s", target.ElasticsearchTemplateWrap.IndexPrefix)
+	}
+}
+
+func TestAuditLogPaths(t *testing.T) {
+	paths := AuditLogPaths(&v32.LoggingAuditLogSources{
+		KubeAPIServer: &v32.LoggingAuditLogSource{Path: "/var/log/kube-audit/*.json"},
+		Rancher:       &v32.LoggingAuditLogSource{},
+	})
+	if len(paths) != 2 || paths[AuditLogSourceKubeAPIServer] != "/var/log/kube-audit/*.json" || paths[AuditLogSourceRancher] != DefaultRancherAuditLogPath {
+		t.Errorf("expected the path of the kube-apiserver audit log and the default path of the Rancher one, got %v", paths)
+	}
+	if paths = AuditLogPaths(nil); len(paths) != 0 {
+		t.Errorf("expected no paths without sources, got %v", paths)
+	}
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DroppingMetric counts the records at the stages of the selection and the sampling, the lines a stage drops are
+	// the difference between the counts before and after it
+	DroppingMetric       = "fluentd_logging_records_total"
+	DroppingMetricLabel  = "logging"
+	DroppingStageLabel   = "stage"
+	DroppingStageIn      = "received"
+	DroppingStageSelect  = "selected"
+	DroppingStageSample  = "sampling"
+	DroppingStageSampled = "sampled"
+
+	defaultDebugLevelField = "level"
+	sampledOutField        = "rancher_sampled_out"
+)
+
+var workloadNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+type DroppingTemplateWrap struct {
+	// Tag matches the container records of the logging, it also labels its counters
+	Tag   string
+	Stage string
+
+	// IncludePattern and ExcludePattern match the tags of the records of the selected namespaces and workloads
+	IncludePattern string
+	ExcludePattern string
+
+	SamplingLevelField     string
+	SamplingKeepPercentage int
+	SampledOutField        string
+}
+
+func (w DroppingTemplateWrap) HasSelector() bool {
+	return w.IncludePattern != "" || w.ExcludePattern != ""
+}
+
+// NewDroppingTemplateWrap returns nil when the logging doesn't drop lines, so no counter is rendered for it
+func NewDroppingTemplateWrap(containerLogSourceTag string, field v32.LoggingCommonField) *DroppingTemplateWrap {
+	if field.Selector == nil && field.DebugSampling == nil {
+		return nil
+	}
+
+	wrap := &DroppingTemplateWrap{
+		Tag:             containerLogSourceTag,
+		SampledOutField: sampledOutField,
+	}
+	if s := field.Selector; s != nil {
+		wrap.IncludePattern = selectorPattern(s.IncludeNamespaces, s.IncludeWorkloads)
+		wrap.ExcludePattern = selectorPattern(s.ExcludeNamespaces, s.ExcludeWorkloads)
+	}
+	if d := field.DebugSampling; d != nil && d.KeepPercentage < 100 {
+		wrap.SamplingLevelField = d.LevelField
+		if wrap.SamplingLevelField == "" {
+			wrap.SamplingLevelField = defaultDebugLevelField
+		}
+		wrap.SamplingKeepPercentage = d.KeepPercentage
+	}
+	return wrap
+}
+
+// droppingStage is used by the templates to render the counter of a stage
+func droppingStage(wrap DroppingTemplateWrap, stage string) DroppingTemplateWrap {
+	wrap.Stage = stage
+	return wrap
+}
+
+// selectorPattern matches the tag of the records of the containers of the namespaces and of the pods of the
+// workloads, which is the name of their log file <pod>_<namespace>_<container>-<id>.log. The pods of deployments, cron
+// jobs, daemon sets and jobs end with generated suffixes and the pods of stateful sets with their ordinal. The
+// namespaces and the workloads are a single alternation, the grep plugin requires all of its regexps to match.
+func selectorPattern(namespaces, workloads []string) string {
+	var pods []string
+	if len(namespaces) > 0 {
+		pods = append(pods, fmt.Sprintf("[^_]+_(?:%s)", strings.Join(namespaces, "|")))
+	}
+	for _, workload := range workloads {
+		namespace, name := splitWorkload(workload)
+		pods = append(pods, fmt.Sprintf("%s-(?:(?:[a-z0-9]{1,10}-)?[a-z0-9]{5}|[0-9]+)_%s", regexp.QuoteMeta(name), namespace))
+	}
+	if len(pods) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`\.var\.log\.containers\.(?:%s)_`, strings.Join(pods, "|"))
+}
+
+func splitWorkload(workload string) (string, string) {
+	parts := strings.SplitN(workload, ":", 2)
+	if len(parts) != 2 {
+		return "", workload
+	}
+	return parts[0], parts[1]
+}
+
+// ValidateDroppingFields checks the selector and the debug sampling before they are rendered
+func ValidateDroppingFields(field v32.LoggingCommonField) error {
+	if s := field.Selector; s != nil {
+		for _, namespaces := range [][]string{s.IncludeNamespaces, s.ExcludeNamespaces} {
+			for _, ns := range namespaces {
+				if !dnsLabelRegexp.MatchString(ns) {
+					return fmt.Errorf("invalid namespace %q", ns)
+				}
+			}
+		}
+		for _, workloads := range [][]string{s.IncludeWorkloads, s.ExcludeWorkloads} {
+			for _, workload := range workloads {
+				namespace, name := splitWorkload(workload)
+				if !dnsLabelRegexp.MatchString(namespace) || !workloadNameRegexp.MatchString(name) {
+					return fmt.Errorf("invalid workload %q, it must be namespace:name", workload)
+				}
+			}
+		}
+	}
+
+	if d := field.DebugSampling; d != nil {
+		if d.KeepPercentage < 0 || d.KeepPercentage > 100 {
+			return errors.New("the percentage of the debug lines to keep must be between 0 and 100")
+		}
+		if d.LevelField != "" && !fieldNameRegexp.MatchString(d.LevelField) {
+			return fmt.Errorf("invalid level field %q, it can only contain letters, digits, _, @, . and -", d.LevelField)
+		}
+	}
+	return nil
+}
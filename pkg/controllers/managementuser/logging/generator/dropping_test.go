@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+)
+
+func TestSelectorPattern(t *testing.T) {
+	// the pattern is written for Ruby, it has no syntax Go doesn't support
+	re := regexp.MustCompile(selectorPattern([]string{"monitoring"}, []string{"billing:web", "payments:db.v1"}))
+	matched := func(pod, namespace string) bool {
+		return re.MatchString("cluster.var.log.containers." + pod + "_" + namespace + "_app-0123456789abcdef.log")
+	}
+
+	testData := []struct {
+		pod, namespace string
+		matched        bool
+		description    string
+	}{
+		{"web-7d4b9c8f6d-x2k9p", "billing", true, "deployment pod"},
+		{"web-x2k9p", "billing", true, "daemon set pod"},
+		{"db.v1-0", "payments", true, "stateful set pod"},
+		{"prometheus-0", "monitoring", true, "pod of an included namespace"},
+		{"web-api-7d4b9c8f6d-x2k9p", "billing", false, "pod of another workload with the same prefix"},
+		{"web-x2k9p", "payments", false, "pod of the namespace of another workload"},
+		{"dbxv1-0", "payments", false, "dots are escaped"},
+		{"prometheus-0", "monitoring-2", false, "pod of a namespace with the same prefix"},
+	}
+	for _, d := range testData {
+		if matched(d.pod, d.namespace) != d.matched {
+			t.Errorf("%s: expected matched %v for pod %s of namespace %s", d.description, d.matched, d.pod, d.namespace)
+		}
+	}
+
+	if pattern := selectorPattern(nil, nil); pattern != "" {
+		t.Errorf("expected no pattern without namespaces and workloads, got %s", pattern)
+	}
+}
+
+func TestValidateDropping(t *testing.T) {
+	// 1. namespaces and workloads can't break out of the pattern
+	field := v32.LoggingCommonField{Selector: &v32.LoggingSelector{ExcludeNamespaces: []string{"a|.*"}}}
+	if err := compareDroppingErr(field, "invalid namespace"); err != nil {
+		t.Error(err)
+	}
+	field = v32.LoggingCommonField{Selector: &v32.LoggingSelector{IncludeWorkloads: []string{"web"}}}
+	if err := compareDroppingErr(field, "namespace:name"); err != nil {
+		t.Error(err)
+	}
+
+	// 2. the debug sampling keeps a percentage and reads a valid field
+	field = v32.LoggingCommonField{DebugSampling: &v32.LoggingDebugSampling{KeepPercentage: 101}}
+	if err := compareDroppingErr(field, "between 0 and 100"); err != nil {
+		t.Error(err)
+	}
+	field = v32.LoggingCommonField{DebugSampling: &v32.LoggingDebugSampling{LevelField: `level"]}`}}
+	if err := compareDroppingErr(field, "invalid level field"); err != nil {
+		t.Error(err)
+	}
+
+	// 3. valid selector and sampling
+	field = v32.LoggingCommonField{
+		Selector: &v32.LoggingSelector{
+			IncludeNamespaces: []string{"billing", "payments"},
+			ExcludeNamespaces: []string{"kube-system"},
+			IncludeWorkloads:  []string{"monitoring:prometheus"},
+			ExcludeWorkloads:  []string{"payments:db"},
+		},
+		DebugSampling: &v32.LoggingDebugSampling{KeepPercentage: 5, LevelField: "severity"},
+	}
+	if err := compareDroppingErr(field, ""); err != nil {
+		t.Error(err)
+	}
+}
+
+func compareDroppingErr(field v32.LoggingCommonField, expectedErrMsg string) error {
+	var actualErrMsg string
+	if err := ValidateDropping(field, NewDroppingTemplateWrap(loggingconfig.ClusterLevel, field)); err != nil {
+		actualErrMsg = err.Error()
+	}
+	if expectedErrMsg == "" && actualErrMsg != "" {
+		return compareErr(actualErrMsg, "no error")
+	}
+	return compareErr(actualErrMsg, expectedErrMsg)
+}
+
+func TestDroppingConfig(t *testing.T) {
+	spec := v32.ClusterLoggingSpec{
+		LoggingTargets: v32.LoggingTargets{
+			ElasticsearchConfig: &v32.ElasticsearchConfig{Endpoint: "http://elasticsearch:9200", IndexPrefix: "cluster"},
+		},
+		ClusterName: "c-1",
+	}
+
+	buf, err := GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(buf), DroppingMetric) {
+		t.Error("expected no counter without selector or sampling")
+	}
+
+	spec.Selector = &v32.LoggingSelector{IncludeNamespaces: []string{"billing"}, IncludeWorkloads: []string{"payments:web"}}
+	spec.DebugSampling = &v32.LoggingDebugSampling{KeepPercentage: 10}
+	buf, err = GenerateClusterConfig(spec, "", loggingconfig.DefaultCertDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := string(buf)
+	for _, stage := range []string{DroppingStageIn, DroppingStageSelect, DroppingStageSample, DroppingStageSampled} {
+		if !strings.Contains(config, "stage "+stage) {
+			t.Errorf("expected the counter of the stage %s", stage)
+		}
+	}
+	if strings.Count(config, "<regexp>") != 1 {
+		t.Errorf("expected the included namespaces and workloads in a single regexp, got %s", config)
+	}
+	if !strings.Contains(config, `rand(100) >= 10`) {
+		t.Error("expected the debug lines to be sampled")
+	}
+}
@@ -19,7 +19,7 @@
 var tmplCache = template.New("template")
 
 func init() {
-	tmplCache = tmplCache.Funcs(template.FuncMap{"escapeString": escapeString})
+	tmplCache = tmplCache.Funcs(template.FuncMap{"escapeString": escapeString, "fieldTransformRuby": fieldTransformRuby, "droppingStage": droppingStage})
 	tmplCache = template.Must(tmplCache.Parse(SourceTemplate))
 	tmplCache = template.Must(tmplCache.Parse(FilterTemplate))
 	tmplCache = template.Must(tmplCache.Parse(MatchTemplate))
@@ -56,6 +56,18 @@ func GenerateClusterConfig(logging v32.ClusterLoggingSpec, excludeNamespaces, ce
 		}
 	}
 
+	if err = ValidateParsing(logging.LoggingCommonField, wl.WrapParsingRules, wl); err != nil {
+		return nil, err
+	}
+
+	if err = ValidateDropping(logging.LoggingCommonField, wl.WrapDropping); err != nil {
+		return nil, err
+	}
+
+	if err = ValidateAuditLogs(wl.WrapAuditLogs); err != nil {
+		return nil, err
+	}
+
 	validateData := *wl
 	if logging.FluentForwarderConfig != nil && wl.EnableShareKey {
 		validateData.EnableShareKey = false //skip generate precan configure included ruby code
@@ -111,6 +123,14 @@ func GenerateProjectConfig(projectLoggings []*mgmtv3.ProjectLogging, namespaces
 			}
 		}
 
+		if err = ValidateParsing(wpl.LoggingCommonField, wpl.WrapParsingRules, wpl); err != nil {
+			return nil, err
+		}
+
+		if err = ValidateDropping(wpl.LoggingCommonField, wpl.WrapDropping); err != nil {
+			return nil, err
+		}
+
 		validateData := *wpl
 		if v.Spec.FluentForwarderConfig != nil && wpl.EnableShareKey {
 			validateData.EnableShareKey = false //skip generate precan configure included ruby code
@@ -0,0 +1,339 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ParsingFormatJSON     = "json"
+	ParsingFormatRegexp   = "regexp"
+	ParsingFormatKeyValue = "keyValue"
+
+	defaultKeyValueDelimiter = " "
+	defaultKeyValueSeparator = "="
+	redactedValue            = "[REDACTED]"
+)
+
+var (
+	dnsLabelRegexp  = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	fieldNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_@.\-]+$`)
+)
+
+type ParsingRuleTemplateWrap struct {
+	v32.LoggingParsingRule
+	// Tags match the records of the containers the rule applies to
+	Tags               string
+	WrapExpression     string
+	WrapDelimiter      string
+	WrapLabelDelimiter string
+}
+
+func NewParsingRuleWraps(containerLogSourceTag string, rules []v32.LoggingParsingRule) []ParsingRuleTemplateWrap {
+	var wraps []ParsingRuleTemplateWrap
+	for _, rule := range rules {
+		wrap := ParsingRuleTemplateWrap{
+			LoggingParsingRule: rule,
+			Tags:               parsingRuleTags(containerLogSourceTag, rule.Namespaces),
+			WrapExpression:     rubyRegexp(rule.Expression),
+		}
+		wrap.WrapDelimiter, wrap.WrapLabelDelimiter = keyValueDelimiters(rule)
+		wraps = append(wraps, wrap)
+	}
+	return wraps
+}
+
+// parsingRuleTags matches the records of the containers of the namespaces by the name of their log file, which is
+// <pod>_<namespace>_<container>-<id>.log
+func parsingRuleTags(containerLogSourceTag string, namespaces []string) string {
+	if len(namespaces) == 0 {
+		return containerLogSourceTag + ".**"
+	}
+	var tags []string
+	for _, ns := range namespaces {
+		tags = append(tags, fmt.Sprintf("%s.var.log.containers.*_%s_*.log", containerLogSourceTag, ns))
+	}
+	return strings.Join(tags, " ")
+}
+
+// rubyRegexp and goRegexp convert the named groups between the syntax of Ruby, which fluentd uses, and Go. ^ and $
+// match at line breaks in Ruby.
+func rubyRegexp(expr string) string {
+	return strings.Replace(expr, "(?P<", "(?<", -1)
+}
+
+func goRegexp(expr string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("(?m)")
+	for i := 0; i < len(expr); i++ {
+		if strings.HasPrefix(expr[i:], "(?<") && !strings.HasPrefix(expr[i:], "(?<=") && !strings.HasPrefix(expr[i:], "(?<!") {
+			b.WriteString("(?P<")
+			i += 2
+			continue
+		}
+		b.WriteByte(expr[i])
+	}
+	return regexp.Compile(b.String())
+}
+
+// fieldTransformRuby is the Ruby expression of record_transformer that returns the transformed record as JSON, the
+// parser then replaces the record with it. The fields are redacted and renamed only when the record has them, the redacted fields keep their redacted value
+// under their new name.
+func fieldTransformRuby(transform *v32.LoggingFieldTransform) string {
+	var froms []string
+	for from := range transform.RenameFields {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+	var renames []string
+	for _, from := range froms {
+		renames = append(renames, fmt.Sprintf("[%q, %q]", from, transform.RenameFields[from]))
+	}
+
+	return fmt.Sprintf("r = record.dup; v = Hash.new; %s.each do |f| r[f] = %q if r.key?(f) end; "+
+		"[%s].each do |from, to| v[to] = r.delete(from) if r.key?(from) end; r.merge!(v); "+
+		"%s.each do |f| r.delete(f) end; r.to_json",
+		rubyStrings(transform.RedactFields), redactedValue, strings.Join(renames, ", "), rubyStrings(transform.DropFields))
+}
+
+func rubyStrings(values []string) string {
+	var quoted []string
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// ValidateParsingRules checks the parsing rules and the field transform before they are rendered
+func ValidateParsingRules(rules []v32.LoggingParsingRule, transform *v32.LoggingFieldTransform) error {
+	names := map[string]bool{}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return errors.New("parsing rule name is required")
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate parsing rule name %s", rule.Name)
+		}
+		names[rule.Name] = true
+		if err := validateParsingRule(rule); err != nil {
+			return errors.Wrapf(err, "invalid parsing rule %s", rule.Name)
+		}
+	}
+
+	if transform == nil {
+		return nil
+	}
+	var fields []string
+	renamed := map[string]string{}
+	for from, to := range transform.RenameFields {
+		if other, ok := renamed[to]; ok {
+			return fmt.Errorf("fields %s and %s are both renamed to %s", other, from, to)
+		}
+		renamed[to] = from
+		fields = append(fields, from, to)
+	}
+	fields = append(fields, transform.DropFields...)
+	fields = append(fields, transform.RedactFields...)
+	for _, field := range fields {
+		if !fieldNameRegexp.MatchString(field) {
+			return fmt.Errorf("invalid field name %q, it can only contain letters, digits, _, @, . and -", field)
+		}
+	}
+	return nil
+}
+
+func validateParsingRule(rule v32.LoggingParsingRule) error {
+	for _, ns := range rule.Namespaces {
+		if !dnsLabelRegexp.MatchString(ns) {
+			return fmt.Errorf("invalid namespace %q", ns)
+		}
+	}
+
+	switch rule.Format {
+	case ParsingFormatJSON:
+	case ParsingFormatRegexp:
+		if rule.Expression == "" {
+			return errors.New("expression is required for the regexp format")
+		}
+		re, err := compilePattern(rule.Expression)
+		if err != nil {
+			return errors.Wrap(err, "invalid expression")
+		}
+		named := false
+		for _, name := range re.SubexpNames() {
+			if name != "" {
+				named = true
+			}
+		}
+		if !named {
+			return errors.New("the expression must have named groups, like (?<level>\\w+)")
+		}
+	case ParsingFormatKeyValue:
+		for _, d := range []string{rule.KeyValueDelimiter, rule.KeyValueSeparator} {
+			if d != "" && (len([]rune(d)) != 1 || strings.ContainsAny(d, "\"\\\n\r")) {
+				return fmt.Errorf("invalid delimiter %q, it must be a single character other than a quote or a backslash", d)
+			}
+		}
+		if delimiter, separator := keyValueDelimiters(rule); delimiter == separator {
+			return errors.New("the delimiter of the pairs and the separator of their keys and values must differ")
+		}
+	default:
+		return fmt.Errorf("invalid format %q", rule.Format)
+	}
+	return nil
+}
+
+// compilePattern compiles a pattern that is written in the fluentd configuration
+func compilePattern(expr string) (*regexp.Regexp, error) {
+	if strings.ContainsAny(expr, "\n\r") {
+		return nil, errors.New("line breaks aren't allowed")
+	}
+	return goRegexp(expr)
+}
+
+// ParseSampleLines parses the sample lines of a container of the namespace the way fluentd does with the parsing rules
+// and the field transform
+func ParseSampleLines(rules []v32.LoggingParsingRule, transform *v32.LoggingFieldTransform, namespace string, lines []string) ([]v32.LoggingTestRecord, error) {
+	if err := ValidateParsingRules(rules, transform); err != nil {
+		return nil, err
+	}
+
+	records := make([]*testRecord, 0, len(lines))
+	for _, line := range lines {
+		records = append(records, &testRecord{fields: map[string]interface{}{"log": line}})
+	}
+
+	for _, rule := range rules {
+		if !parsingRuleApplies(rule, namespace) {
+			continue
+		}
+		for _, r := range records {
+			log, ok := r.fields["log"].(string)
+			if !ok {
+				r.errors = append(r.errors, fmt.Sprintf("rule %s: the record has no log field", rule.Name))
+				continue
+			}
+			parsed, err := parseLine(rule, log)
+			if err != nil {
+				r.errors = append(r.errors, fmt.Sprintf("rule %s: %v", rule.Name, err))
+				continue
+			}
+			for k, v := range parsed {
+				r.fields[k] = v
+			}
+		}
+	}
+
+	var result []v32.LoggingTestRecord
+	for _, r := range records {
+		if transform != nil {
+			transformFields(transform, r.fields)
+		}
+		record := v32.LoggingTestRecord{
+			Fields: map[string]string{},
+			Errors: r.errors,
+		}
+		for k, v := range r.fields {
+			if s, ok := v.(string); ok {
+				record.Fields[k] = s
+				continue
+			}
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			record.Fields[k] = string(data)
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+type testRecord struct {
+	fields map[string]interface{}
+	errors []string
+}
+
+func parsingRuleApplies(rule v32.LoggingParsingRule, namespace string) bool {
+	if len(rule.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range rule.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLine(rule v32.LoggingParsingRule, line string) (map[string]interface{}, error) {
+	parsed := map[string]interface{}{}
+	switch rule.Format {
+	case ParsingFormatJSON:
+		if err := json.Unmarshal([]byte(line), &parsed); err != nil {
+			return nil, errors.New("the line isn't a JSON object")
+		}
+	case ParsingFormatRegexp:
+		re, err := compilePattern(rule.Expression)
+		if err != nil {
+			return nil, err
+		}
+		match := re.FindStringSubmatch(line)
+		if match == nil {
+			return nil, errors.New("the line doesn't match the expression")
+		}
+		for i, name := range re.SubexpNames() {
+			if name != "" {
+				parsed[name] = match[i]
+			}
+		}
+	case ParsingFormatKeyValue:
+		delimiter, separator := keyValueDelimiters(rule)
+		for _, pair := range strings.Split(line, delimiter) {
+			kv := strings.SplitN(pair, separator, 2)
+			if len(kv) == 2 {
+				parsed[kv[0]] = kv[1]
+			}
+		}
+	}
+	return parsed, nil
+}
+
+func keyValueDelimiters(rule v32.LoggingParsingRule) (string, string) {
+	delimiter, separator := rule.KeyValueDelimiter, rule.KeyValueSeparator
+	if delimiter == "" {
+		delimiter = defaultKeyValueDelimiter
+	}
+	if separator == "" {
+		separator = defaultKeyValueSeparator
+	}
+	return delimiter, separator
+}
+
+func transformFields(transform *v32.LoggingFieldTransform, fields map[string]interface{}) {
+	for _, field := range transform.RedactFields {
+		if _, ok := fields[field]; ok {
+			fields[field] = redactedValue
+		}
+	}
+	renamed := map[string]interface{}{}
+	for from, to := range transform.RenameFields {
+		if value, ok := fields[from]; ok {
+			renamed[to] = value
+			delete(fields, from)
+		}
+	}
+	for k, v := range renamed {
+		fields[k] = v
+	}
+	for _, field := range transform.DropFields {
+		delete(fields, field)
+	}
+}
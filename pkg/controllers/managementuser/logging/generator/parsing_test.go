@@ -0,0 +1,191 @@
+package generator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+)
+
+func TestParseSampleLines(t *testing.T) {
+	rules := []v32.LoggingParsingRule{
+		{Name: "access", Namespaces: []string{"billing"}, Format: ParsingFormatRegexp, Expression: `^(?<level>\w+) (?P<message>.*)$`},
+		{Name: "json", Namespaces: []string{"payments"}, Format: ParsingFormatJSON},
+	}
+	transform := &v32.LoggingFieldTransform{
+		RenameFields: map[string]string{"message": "msg"},
+		DropFields:   []string{"log"},
+		RedactFields: []string{"password", "token"},
+	}
+
+	// 1. the fields of the matching lines are renamed, the missing ones aren't added
+	records, err := ParseSampleLines(rules, transform, "billing", []string{
+		"ERROR failed to charge",
+		"-- not matched",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	expected := map[string]string{"level": "ERROR", "msg": "failed to charge"}
+	if !reflect.DeepEqual(records[0].Fields, expected) || len(records[0].Errors) != 0 {
+		t.Errorf("expected fields %v without errors, got %+v", expected, records[0])
+	}
+	if !reflect.DeepEqual(records[1].Errors, []string{"rule access: the line doesn't match the expression"}) {
+		t.Errorf("expected the line not to match the expression, got %v", records[1].Errors)
+	}
+
+	// 2. only the fields the record has are redacted
+	records, err = ParseSampleLines(rules, transform, "payments", []string{`{"password":"secret","count":2}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]string{"password": redactedValue, "count": "2"}
+	if len(records) != 1 || !reflect.DeepEqual(records[0].Fields, expected) {
+		t.Errorf("expected fields %v, got %+v", expected, records)
+	}
+
+	// 3. key value pairs with their own delimiters
+	keyValue := []v32.LoggingParsingRule{{Name: "kv", Format: ParsingFormatKeyValue, KeyValueDelimiter: ",", KeyValueSeparator: ":"}}
+	records, err = ParseSampleLines(keyValue, nil, "default", []string{"user:alice,status:200"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Fields["user"] != "alice" || records[0].Fields["status"] != "200" {
+		t.Errorf("expected user alice and status 200, got %+v", records)
+	}
+
+}
+
+func TestTransformFields(t *testing.T) {
+	transform := &v32.LoggingFieldTransform{
+		RenameFields: map[string]string{"a": "b", "b": "a", "password": "secret", "missing": "found"},
+		DropFields:   []string{"log"},
+		RedactFields: []string{"password", "token"},
+	}
+	fields := map[string]interface{}{"a": "1", "b": "2", "password": "hunter2", "log": "line"}
+	transformFields(transform, fields)
+
+	expected := map[string]interface{}{"a": "2", "b": "1", "secret": redactedValue}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected fields %v, got %v", expected, fields)
+	}
+}
+
+func TestValidateParsingRules(t *testing.T) {
+	// 1. rules need a unique name
+	err := ValidateParsingRules([]v32.LoggingParsingRule{{Format: ParsingFormatJSON}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "name is required") {
+		t.Errorf("expected a rule without a name to be rejected, got %v", err)
+	}
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatJSON}, {Name: "a", Format: ParsingFormatJSON}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Errorf("expected duplicate rule names to be rejected, got %v", err)
+	}
+
+	// 2. namespaces are DNS labels
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatJSON, Namespaces: []string{"Billing"}}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid namespace") {
+		t.Errorf("expected an invalid namespace to be rejected, got %v", err)
+	}
+
+	// 3. regexps need named groups and can't break out of the configuration
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatRegexp, Expression: `^\w+$`}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "named groups") {
+		t.Errorf("expected an expression without named groups to be rejected, got %v", err)
+	}
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatRegexp, Expression: "(?<a>.*)\n<source>"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "line breaks") {
+		t.Errorf("expected an expression with a line break to be rejected, got %v", err)
+	}
+
+	// 4. key value delimiters are single distinct characters
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatKeyValue, KeyValueDelimiter: "="}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "must differ") {
+		t.Errorf("expected the same delimiter and separator to be rejected, got %v", err)
+	}
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: ParsingFormatKeyValue, KeyValueDelimiter: `"`}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid delimiter") {
+		t.Errorf("expected a quote delimiter to be rejected, got %v", err)
+	}
+
+	// 5. unknown formats
+	err = ValidateParsingRules([]v32.LoggingParsingRule{{Name: "a", Format: "xml"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Errorf("expected an unknown format to be rejected, got %v", err)
+	}
+
+	// 6. field names of the transform
+	err = ValidateParsingRules(nil, &v32.LoggingFieldTransform{DropFields: []string{`a"]}`}})
+	if err == nil || !strings.Contains(err.Error(), "invalid field name") {
+		t.Errorf("expected an invalid field name to be rejected, got %v", err)
+	}
+	err = ValidateParsingRules(nil, &v32.LoggingFieldTransform{RenameFields: map[string]string{"a": "c", "b": "c"}})
+	if err == nil || !strings.Contains(err.Error(), "both renamed to c") {
+		t.Errorf("expected two fields renamed to the same field to be rejected, got %v", err)
+	}
+
+	// 7. valid rules and transform
+	err = ValidateParsingRules([]v32.LoggingParsingRule{
+		{Name: "a", Format: ParsingFormatRegexp, Expression: `(?<level>\w+)`},
+		{Name: "b", Format: ParsingFormatKeyValue},
+	}, &v32.LoggingFieldTransform{RenameFields: map[string]string{"@timestamp": "time"}})
+	if err != nil {
+		t.Errorf("expected valid rules, got %v", err)
+	}
+}
+
+func TestParsingConfig(t *testing.T) {
+	field := v32.LoggingCommonField{
+		ParsingRules: []v32.LoggingParsingRule{
+			{Name: "access", Format: ParsingFormatRegexp, Expression: `^(?P<level>\w+) (?P<message>.*)$`},
+			{Name: "json", Namespaces: []string{"billing", "payments"}, Format: ParsingFormatJSON},
+			{Name: "kv", Format: ParsingFormatKeyValue},
+		},
+		FieldTransform: &v32.LoggingFieldTransform{
+			RenameFields: map[string]string{"message": "msg"},
+			DropFields:   []string{"log"},
+			RedactFields: []string{"password"},
+		},
+	}
+	wrap := ClusterLoggingTemplateWrap{
+		ContainerLogSourceTag: loggingconfig.ClusterLevel,
+		CustomLogSourceTag:    getCustomLogSourceTag(loggingconfig.ClusterLevel, ""),
+		LoggingCommonField:    field,
+		WrapParsingRules:      NewParsingRuleWraps(loggingconfig.ClusterLevel, field.ParsingRules),
+	}
+	if err := ValidateParsingRuleTemplates(wrap.WrapParsingRules); err != nil {
+		t.Errorf("expected the parsing rules to render valid filters, got %v", err)
+	}
+	if err := ValidateFieldTransform(wrap); err != nil {
+		t.Errorf("expected the field transform to render a valid filter, got %v", err)
+	}
+
+	buf, err := GenerateConfig("cluster-template", wrap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := string(buf)
+	for _, expected := range []string{
+		"<filter cluster.var.log.containers.*_billing_*.log cluster.var.log.containers.*_payments_*.log>",
+		"expression /^(?<level>\\w+) (?<message>.*)$/",
+		`r.to_json}`,
+		"key_name __rancher_field_transform",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %q, got %s", expected, config)
+		}
+	}
+
+	// the records are parsed in the order of the rules, then transformed and sent
+	order := regexp.MustCompile(`(?s)@type regexp.*@type json.*@type ltsv.*__rancher_field_transform.*<match\s+cluster\.\*\*`)
+	if !order.MatchString(config) {
+		t.Errorf("expected the rules in order before the field transform and the outputs, got %s", config)
+	}
+}
@@ -28,6 +28,9 @@ type ClusterLoggingTemplateWrap struct {
 
 	v32.LoggingCommonField
 	LoggingTargetTemplateWrap
+	WrapParsingRules        []ParsingRuleTemplateWrap
+	WrapDropping            *DroppingTemplateWrap
+	WrapAuditLogs           []AuditLogTemplateWrap
 	IncludeRke              bool
 	CertFilePrefix          string
 	BufferFile              string
@@ -43,6 +46,8 @@ type ProjectLoggingTemplateWrap struct {
 
 	v32.LoggingCommonField
 	LoggingTargetTemplateWrap
+	WrapParsingRules        []ParsingRuleTemplateWrap
+	WrapDropping            *DroppingTemplateWrap
 	IncludeRke              bool
 	CertFilePrefix          string
 	BufferFile              string
@@ -73,10 +78,13 @@ func newWrapClusterLogging(logging v32.ClusterLoggingSpec, excludeNamespace, cer
 	bufferFile := getBufferFilename(level, "")
 	customLogSourceTag := getCustomLogSourceTag(level, "")
 	containerLogPosFilename := getContainerLogPosFilename(level, "")
-	return &ClusterLoggingTemplateWrap{
+
+	clusterWrap := &ClusterLoggingTemplateWrap{
 		ExcludeNamespace:          excludeNamespace,
 		LoggingCommonField:        logging.LoggingCommonField,
 		LoggingTargetTemplateWrap: *wrap,
+		WrapParsingRules:          NewParsingRuleWraps(level, logging.ParsingRules),
+		WrapDropping:              NewDroppingTemplateWrap(level, logging.LoggingCommonField),
 		IncludeRke:                includeSystemComponent,
 		CertFilePrefix:            certFilePrefix,
 		BufferFile:                bufferFile,
@@ -85,7 +93,11 @@ func newWrapClusterLogging(logging v32.ClusterLoggingSpec, excludeNamespace, cer
 		ContainerLogPosFilename:   containerLogPosFilename,
 		RkeLogTag:                 "rke",
 		RkeLogPosFilename:         "fluentd-rke-logging.pos",
-	}, nil
+	}
+	if clusterWrap.WrapAuditLogs, err = newAuditLogTemplateWraps(logging, clusterWrap); err != nil {
+		return nil, err
+	}
+	return clusterWrap, nil
 }
 
 func newWrapProjectLogging(logging v32.ProjectLoggingSpec, containerSourcePath, certDir string, isSystemProject bool) (*ProjectLoggingTemplateWrap, error) {
@@ -109,6 +121,8 @@ func newWrapProjectLogging(logging v32.ProjectLoggingSpec, containerSourcePath,
 		ContainerSourcePath:       containerSourcePath,
 		LoggingCommonField:        logging.LoggingCommonField,
 		LoggingTargetTemplateWrap: *wrap,
+		WrapParsingRules:          NewParsingRuleWraps(logging.ProjectName, logging.ParsingRules),
+		WrapDropping:              NewDroppingTemplateWrap(logging.ProjectName, logging.LoggingCommonField),
 		IncludeRke:                isSystemProject,
 		CertFilePrefix:            certFilePrefix,
 		BufferFile:                bufferFile,
@@ -6,14 +6,26 @@
 {{- template "source-rke" . -}}
 {{- template "filter-rke" . -}}
 {{end }}
+{{- if .WrapAuditLogs }}
+{{- template "source-tail-monitor" . -}}
+{{- end }}
+{{- range $i, $audit := .WrapAuditLogs }}
+{{- template "source-audit" $audit -}}
+{{- template "filter-audit" $audit -}}
+{{- template "match-audit" $audit -}}
+{{- end }}
 {{- template "source-container" . -}}
 {{- template "filter-container" . -}}
 {{- template "filter-add-logtype" . -}}
+{{- template "filter-select" . -}}
 {{- template "filter-custom-tags" . -}}
 {{- template "filter-prometheus" . -}}
 {{- template "filter-exclude-system-component" . -}}
 {{- template "filter-sumo" . -}}
 {{- template "filter-json" . -}}
+{{- template "filter-parsing-rules" . -}}
+{{- template "filter-debug-sampling" . -}}
+{{- template "filter-field-transform" . -}}
 {{- template "match" . -}}
 {{end}}
 
@@ -26,10 +38,14 @@
 {{- template "source-project-container" $store -}}
 {{- template "filter-container" $store -}}
 {{- template "filter-add-projectid" $store -}}
+{{- template "filter-select" $store -}}
 {{- template "filter-custom-tags" $store -}}
 {{- template "filter-prometheus" $store -}}
 {{- template "filter-sumo" $store -}}
 {{- template "filter-json" $store -}}
+{{- template "filter-parsing-rules" $store -}}
+{{- template "filter-debug-sampling" $store -}}
+{{- template "filter-field-transform" $store -}}
 {{- template "match" $store -}}
 {{end}}
 {{end}}
@@ -28,6 +28,44 @@
 </filter>
 {{end}}
 
+{{define "filter-audit"}}
+{{- if .ContainerLog }}
+<filter {{ .Tag }}.**>
+  @type parser
+  key_name log
+  <parse>
+    @type json
+    time_key {{ .TimeKey }}
+    time_format {{ .TimeFormat }}
+    keep_time_key true
+  </parse>
+</filter>
+{{- end }}
+
+<filter {{ .Tag }}.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    tag ${tag}
+    log_type {{ .LogType }}
+    audit_source {{ .Source }}
+    {{- if eq .Source "kube-apiserver" }}
+    audit_user ${record.dig("user", "username")}
+    audit_verb ${record["verb"]}
+    audit_uri ${record["requestURI"]}
+    audit_resource ${record.dig("objectRef", "resource")}
+    audit_namespace ${record.dig("objectRef", "namespace")}
+    audit_code ${record.dig("responseStatus", "code")}
+    {{- else }}
+    audit_user ${record.dig("user", "name")}
+    audit_verb ${record["method"]}
+    audit_uri ${record["requestURI"]}
+    audit_code ${record["responseCode"]}
+    {{- end }}
+  </record>
+</filter>
+{{end}}
+
 {{define "filter-container"}}
 <filter  {{ .ContainerLogSourceTag }}.**>
   @type  kubernetes_metadata
@@ -141,4 +179,133 @@
 </filter>
 {{end}}
 {{end}}
+
+{{define "filter-parsing-rules"}}
+{{- range $rule := .WrapParsingRules }}
+{{- template "filter-parsing-rule" $rule -}}
+{{- end }}
+{{end}}
+
+{{define "filter-parsing-rule"}}
+<filter {{ .Tags }}>
+  @type parser
+  key_name log
+  reserve_data true
+  emit_invalid_record_to_error false
+  <parse>
+  {{- if eq .Format "json" }}
+    @type json
+  {{- else if eq .Format "regexp" }}
+    @type regexp
+    expression /{{ .WrapExpression }}/
+  {{- else }}
+    @type ltsv
+    delimiter "{{ .WrapDelimiter }}"
+    label_delimiter "{{ .WrapLabelDelimiter }}"
+  {{- end }}
+  </parse>
+</filter>
+{{end}}
+
+{{define "filter-field-transform"}}
+{{- with .FieldTransform }}
+{{- if or .RenameFields .DropFields .RedactFields }}
+{{- template "filter-field-transform-record" $ }}
+<filter {{ $.ContainerLogSourceTag }}.**>
+  @type parser
+  key_name __rancher_field_transform
+  reserve_time true
+  <parse>
+    @type json
+    time_key __rancher_field_transform
+  </parse>
+</filter>
+{{end}}
+{{- end }}
+{{end}}
+
+{{define "filter-field-transform-record"}}
+<filter {{ .ContainerLogSourceTag }}.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    __rancher_field_transform ${ {{- fieldTransformRuby .FieldTransform -}} }
+  </record>
+</filter>
+{{end}}
+
+{{define "filter-select"}}
+{{- with .WrapDropping }}
+{{- template "filter-dropping-counter" (droppingStage . "received") -}}
+{{- if .HasSelector }}
+{{- template "filter-select-grep" . -}}
+{{- end }}
+{{- template "filter-dropping-counter" (droppingStage . "selected") -}}
+{{- end }}
+{{end}}
+
+{{define "filter-select-grep"}}
+<filter {{ .Tag }}.**>
+  @type grep
+  {{- if .IncludePattern }}
+  <regexp>
+    key tag
+    pattern /{{ .IncludePattern }}/
+  </regexp>
+  {{- end }}
+  {{- if .ExcludePattern }}
+  <exclude>
+    key tag
+    pattern /{{ .ExcludePattern }}/
+  </exclude>
+  {{- end }}
+</filter>
+{{end}}
+
+{{define "filter-debug-sampling"}}
+{{- with .WrapDropping }}
+{{- template "filter-dropping-counter" (droppingStage . "sampling") -}}
+{{- if .SamplingLevelField }}
+{{- template "filter-debug-sampling-mark" . -}}
+<filter {{ .Tag }}.**>
+  @type grep
+  <exclude>
+    key {{ .SampledOutField }}
+    pattern /^true$/
+  </exclude>
+</filter>
+
+<filter {{ .Tag }}.**>
+  @type record_transformer
+  remove_keys {{ .SampledOutField }}
+</filter>
+{{end }}
+{{- template "filter-dropping-counter" (droppingStage . "sampled") -}}
+{{- end }}
+{{end}}
+
+{{define "filter-debug-sampling-mark"}}
+<filter {{ .Tag }}.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    {{ .SampledOutField }} ${(record["{{ .SamplingLevelField }}"] || record["log"]).to_s =~ /\bdebug\b/i && rand(100) >= {{ .SamplingKeepPercentage }} ? "true" : nil}
+  </record>
+</filter>
+{{end}}
+
+{{define "filter-dropping-counter"}}
+<filter {{ .Tag }}.**>
+  @type prometheus
+  <metric>
+    name fluentd_logging_records_total
+    type counter
+    desc The number of records that reached a stage of the selection and the sampling
+    <labels>
+      logging {{ .Tag }}
+      stage {{ .Stage }}
+    </labels>
+  </metric>
+</filter>
+{{end}}
 `
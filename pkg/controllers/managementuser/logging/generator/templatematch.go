@@ -9,6 +9,14 @@
 </match>
 {{end}}
 
+{{define "match-audit"}}
+<match {{ .Tag }}.**>
+  @type copy
+  {{- template "store-target" .Store -}}
+  {{- template "store-prometheus" .Store -}}
+</match>
+{{end}}
+
 {{define "store-target"}}
   <store>
   {{- template "elasticsearch" . -}}
@@ -20,6 +20,9 @@
   tag  {{ .ContainerLogSourceTag }}.*
   skip_refresh_on_startup true
   read_from_head true
+  {{- with .AuditContainerLogPaths }}
+  exclude_path [{{ range $i, $path := . }}{{ if $i }}, {{ end }}"{{ $path }}"{{ end }}]
+  {{- end }}
 
   <parse>
 	@type multi_format
@@ -36,6 +39,45 @@
 </source>
 {{end}}
 
+{{define "source-tail-monitor"}}
+<source>
+  @type prometheus_tail_monitor
+</source>
+{{end}}
+
+{{define "source-audit"}}
+<source>
+  @type  tail
+  path  {{ .Path }}
+  pos_file  /fluentd/log/{{ .PosFilename }}
+  tag  {{ .Tag }}.*
+  read_from_head true
+  {{- if .ContainerLog }}
+
+  <parse>
+	@type multi_format
+	<pattern>
+	  format json
+	  time_format %Y-%m-%dT%H:%M:%S.%NZ
+	</pattern>
+	<pattern>
+	  format regexp
+	  time_format %Y-%m-%dT%H:%M:%S.%N%:z
+	  expression /^(?<time>.+)\b(?<stream>stdout|stderr)\b(?<log>.*)$/
+	</pattern>
+  </parse>
+  {{- else }}
+
+  <parse>
+	@type json
+	time_key {{ .TimeKey }}
+	time_format {{ .TimeFormat }}
+	keep_time_key true
+  </parse>
+  {{- end }}
+</source>
+{{end}}
+
 {{define "source-project-container"}}
 <source>
   @type  tail
@@ -5,6 +5,8 @@
 	"regexp"
 	"strings"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
 	"github.com/pkg/errors"
 	"github.com/vmware/kube-fluentd-operator/config-reloader/fluentd"
 )
@@ -12,9 +14,15 @@
 var (
 	fluentdForwardType    = "forward"
 	recordTransformerType = "record_transformer"
+	parserType            = "parser"
+	grepType              = "grep"
+	tailType              = "tail"
 	rubyCodeBlockReg      = regexp.MustCompile(`#\{.*\}`)
 	generalAllowFragnent  = map[string]int{"buffer": 1}
 	filterAllowFragments  = map[string]int{"record": 1}
+	parserAllowFragments  = map[string]int{"parse": 1}
+	grepAllowFragments    = map[string]int{"regexp": 2, "exclude": 2}
+	tailAllowFragments    = map[string]int{"parse": 1}
 	forwardAllowFragments = map[string]int{
 		"buffer":   1,
 		"security": 1,
@@ -34,6 +42,69 @@ func ValidateCustomTarget(data interface{}) error {
 	return validateFragments("store-target", "store", data)
 }
 
+// ValidateParsing checks the parsing rules and the field transform of the logging, and the configuration rendered
+// from them
+func ValidateParsing(field v32.LoggingCommonField, rules []ParsingRuleTemplateWrap, data interface{}) error {
+	if err := ValidateParsingRules(field.ParsingRules, field.FieldTransform); err != nil {
+		return err
+	}
+	if err := ValidateParsingRuleTemplates(rules); err != nil {
+		return err
+	}
+	if t := field.FieldTransform; t != nil && (len(t.RenameFields) > 0 || len(t.DropFields) > 0 || len(t.RedactFields) > 0) {
+		return ValidateFieldTransform(data)
+	}
+	return nil
+}
+
+// ValidateDropping checks the selector and the debug sampling of the logging, and the configuration rendered from
+// them
+func ValidateDropping(field v32.LoggingCommonField, wrap *DroppingTemplateWrap) error {
+	if err := ValidateDroppingFields(field); err != nil {
+		return err
+	}
+	if wrap == nil {
+		return nil
+	}
+	if wrap.HasSelector() {
+		if err := validateFragments("filter-select-grep", "filter", wrap); err != nil {
+			return err
+		}
+	}
+	if wrap.SamplingLevelField != "" {
+		return validateFragments("filter-debug-sampling-mark", "filter", wrap)
+	}
+	return nil
+}
+
+// ValidateAuditLogs checks the sources of the audit logs and their targets, whose index may differ from the one of
+// the logging
+func ValidateAuditLogs(auditLogs []AuditLogTemplateWrap) error {
+	for _, auditLog := range auditLogs {
+		if err := validateFragments("source-audit", "source", auditLog); err != nil {
+			return errors.Wrapf(err, "invalid %s audit log source", auditLog.Source)
+		}
+		auditLog.Store.EnableShareKey = false //skip generate precan configure included ruby code
+		if err := ValidateCustomTarget(auditLog.Store); err != nil {
+			return errors.Wrapf(err, "invalid %s audit log target", auditLog.Source)
+		}
+	}
+	return nil
+}
+
+func ValidateParsingRuleTemplates(rules []ParsingRuleTemplateWrap) error {
+	for _, rule := range rules {
+		if err := validateFragments("filter-parsing-rule", "filter", rule); err != nil {
+			return errors.Wrapf(err, "invalid parsing rule %s", rule.Name)
+		}
+	}
+	return nil
+}
+
+func ValidateFieldTransform(data interface{}) error {
+	return validateFragments("filter-field-transform-record", "filter", data)
+}
+
 func validateFragments(templateName, fragmentName string, data interface{}) error {
 	fragments, err := generateFragments(templateName, data)
 	if err != nil {
@@ -51,6 +122,12 @@ func validateFragments(templateName, fragmentName string, data interface{}) erro
 		allow = filterAllowFragments
 	case fluentdForwardType:
 		allow = forwardAllowFragments
+	case parserType:
+		allow = parserAllowFragments
+	case grepType:
+		allow = grepAllowFragments
+	case tailType:
+		allow = tailAllowFragments
 	default:
 		allow = generalAllowFragnent
 	}
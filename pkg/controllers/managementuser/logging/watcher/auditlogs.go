@@ -0,0 +1,212 @@
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rancher/norman/condition"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/generator"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// controlPlaneSelector selects the nodes RKE runs kube-apiserver on, each of them has a kube-apiserver audit log
+var controlPlaneSelector = labels.Set(map[string]string{"node-role.kubernetes.io/controlplane": "true"}).AsSelector()
+
+type auditLogWatcher struct {
+	podLister       v1.PodLister
+	nodeLister      v1.NodeLister
+	clusterName     string
+	clusterLoggings mgmtv3.ClusterLoggingInterface
+	metrics         func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error)
+}
+
+// StartAuditLogWatcher reports the audit logs of the cluster logging that fluentd doesn't tail, from the files the
+// fluentd pods tail
+func StartAuditLogWatcher(ctx context.Context, cluster *config.UserContext) {
+	s := &auditLogWatcher{
+		podLister:       cluster.Core.Pods(loggingconfig.LoggingNamespace).Controller().Lister(),
+		nodeLister:      cluster.Core.Nodes("").Controller().Lister(),
+		clusterName:     cluster.ClusterName,
+		clusterLoggings: cluster.Management.Management.ClusterLoggings(cluster.ClusterName),
+	}
+	s.metrics = func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error) {
+		return fluentdMetrics(ctx, cluster.K8sClient, pod)
+	}
+	go s.watch(ctx, 60*time.Second)
+}
+
+func (a *auditLogWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		if err := a.checkClusterLogging(ctx); err != nil {
+			logrus.Error(err)
+		}
+	}
+}
+
+func (a *auditLogWatcher) checkClusterLogging(ctx context.Context) error {
+	cls, err := a.clusterLoggings.Controller().Lister().List(a.clusterName, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "list clusterlogging fail in audit log watcher")
+	}
+	if len(cls) == 0 {
+		return nil
+	}
+	obj := cls[0]
+
+	updatedObj := obj.DeepCopy()
+	paths := generator.AuditLogPaths(obj.Spec.AuditLogSources)
+	if len(paths) == 0 {
+		if v32.LoggingConditionAuditLogShipped.GetStatus(obj) == "" {
+			return nil
+		}
+		removeLoggingCondition(updatedObj, v32.LoggingConditionAuditLogShipped)
+	} else {
+		missing, scraped, err := a.missingAuditLogs(ctx, paths)
+		if err != nil {
+			return err
+		}
+		if !scraped {
+			return nil
+		}
+		if len(missing) == 0 {
+			v32.LoggingConditionAuditLogShipped.True(updatedObj)
+			v32.LoggingConditionAuditLogShipped.Message(updatedObj, "")
+		} else {
+			v32.LoggingConditionAuditLogShipped.False(updatedObj)
+			v32.LoggingConditionAuditLogShipped.Message(updatedObj, strings.Join(missing, "; "))
+		}
+	}
+
+	if reflect.DeepEqual(updatedObj, obj) {
+		return nil
+	}
+	if _, err := a.clusterLoggings.Update(updatedObj); err != nil {
+		return errors.Wrapf(err, "set audit log condition of clusterlogging %s failed", obj.Name)
+	}
+	return nil
+}
+
+// missingAuditLogs returns the audit logs fluentd doesn't tail on the nodes that have them, the kube-apiserver audit
+// log is on each controlplane node and the other ones are on any node. scraped is false when the metrics of no fluentd
+// pod could be read.
+func (a *auditLogWatcher) missingAuditLogs(ctx context.Context, paths map[string]string) (missing []string, scraped bool, err error) {
+	pods, err := a.podLister.List(loggingconfig.LoggingNamespace, labels.SelectorFromSet(loggingconfig.FluentdSelector))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "list fluentd pods failed in audit log watcher")
+	}
+	controlPlanes, err := a.nodeLister.List("", controlPlaneSelector)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "list controlplane nodes failed in audit log watcher")
+	}
+
+	// tailed are the files the fluentd pod of each node tails, the nodes whose pod can't be scraped have none
+	tailed := map[string][]string{}
+	for pod, body := range scrapeFluentd(ctx, pods, a.metrics) {
+		files, err := tailedFiles(body)
+		if err != nil {
+			logrus.Debugf("parse metrics of fluentd pod %s failed: %v", pod.Name, err)
+			continue
+		}
+		tailed[pod.Spec.NodeName] = files
+	}
+	if len(tailed) == 0 {
+		return nil, false, nil
+	}
+	fluentdNodes := map[string]bool{}
+	for _, pod := range pods {
+		fluentdNodes[pod.Spec.NodeName] = true
+	}
+
+	var sources []string
+	for source := range paths {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		path := paths[source]
+		if source != generator.AuditLogSourceKubeAPIServer || len(controlPlanes) == 0 {
+			if !tailedOnAnyNode(tailed, path) {
+				missing = append(missing, fmt.Sprintf("fluentd doesn't tail the %s audit log %s on any node", source, path))
+			}
+			continue
+		}
+
+		var nodes []string
+		for _, node := range controlPlanes {
+			files, scrapedNode := tailed[node.Name]
+			if !fluentdNodes[node.Name] || scrapedNode && !matchesAny(files, path) {
+				nodes = append(nodes, node.Name)
+			}
+		}
+		if len(nodes) > 0 {
+			sort.Strings(nodes)
+			missing = append(missing, fmt.Sprintf("fluentd doesn't tail the %s audit log %s on the nodes %s, the file is missing or fluentd doesn't run there",
+				source, path, strings.Join(nodes, ", ")))
+		}
+	}
+	return missing, true, nil
+}
+
+func tailedOnAnyNode(tailed map[string][]string, pattern string) bool {
+	for _, files := range tailed {
+		if matchesAny(files, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns whether one of the files matches the path of an audit log, which may have wildcards
+func matchesAny(files []string, pattern string) bool {
+	for _, file := range files {
+		if ok, _ := filepath.Match(pattern, file); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func tailedFiles(body []byte) ([]string, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, m := range families[generator.TailFileMetric].GetMetric() {
+		for _, l := range m.GetLabel() {
+			if l.GetName() == generator.TailFilePathLabel {
+				files = append(files, l.GetValue())
+			}
+		}
+	}
+	return files, nil
+}
+
+func removeLoggingCondition(obj *mgmtv3.ClusterLogging, cond condition.Cond) {
+	var conditions []v32.LoggingCondition
+	for _, c := range obj.Status.Conditions {
+		if c.Type != cond {
+			conditions = append(conditions, c)
+		}
+	}
+	obj.Status.Conditions = conditions
+}
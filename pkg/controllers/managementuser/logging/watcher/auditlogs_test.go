@@ -0,0 +1,96 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/generator"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestMissingAuditLogs(t *testing.T) {
+	assert := assert.New(t)
+	newPod := func(name, node string) *k8scorev1.Pod {
+		return &k8scorev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: loggingconfig.LoggingNamespace},
+			Spec:       k8scorev1.PodSpec{NodeName: node},
+			Status:     k8scorev1.PodStatus{Phase: k8scorev1.PodRunning},
+		}
+	}
+	tailing := func(paths ...string) []byte {
+		body := "# TYPE fluentd_tail_file_position gauge\n"
+		for _, path := range paths {
+			body += `fluentd_tail_file_position{plugin_id="object:1",type="tail",path="` + path + `"} 1024` + "\n"
+		}
+		return []byte(body)
+	}
+
+	pods := []*k8scorev1.Pod{newPod("fluentd-1", "cp-1"), newPod("fluentd-2", "cp-2"), newPod("fluentd-3", "worker-1")}
+	controlPlanes := []*k8scorev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cp-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cp-2"}},
+	}
+	metrics := map[string][]byte{
+		"fluentd-1": tailing(generator.DefaultKubeAPIServerAuditLogPath, "/var/log/containers/a.log"),
+		"fluentd-2": tailing(generator.DefaultKubeAPIServerAuditLogPath),
+		"fluentd-3": tailing("/var/log/containers/rancher-7d4b9c8f6d-x2k9p_cattle-system_rancher-audit-log-0123.log"),
+	}
+	a := &auditLogWatcher{
+		podLister: &fakes.PodListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*k8scorev1.Pod, error) {
+				return pods, nil
+			},
+		},
+		nodeLister: &fakes.NodeListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*k8scorev1.Node, error) {
+				return controlPlanes, nil
+			},
+		},
+		metrics: func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error) {
+			if body, ok := metrics[pod.Name]; ok {
+				return body, nil
+			}
+			return nil, errors.New("unreachable")
+		},
+	}
+	paths := map[string]string{
+		generator.AuditLogSourceKubeAPIServer: generator.DefaultKubeAPIServerAuditLogPath,
+		generator.AuditLogSourceRancher:       generator.DefaultRancherAuditLogPath,
+	}
+
+	missing, scraped, err := a.missingAuditLogs(context.Background(), paths)
+	assert.NoError(err)
+	assert.True(scraped)
+	assert.Empty(missing)
+
+	// a controlplane node without the file, and one fluentd doesn't run on
+	metrics["fluentd-2"] = tailing()
+	controlPlanes = append(controlPlanes, &k8scorev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cp-3"}})
+	missing, _, err = a.missingAuditLogs(context.Background(), paths)
+	assert.NoError(err)
+	if assert.Len(missing, 1) {
+		assert.Contains(missing[0], "on the nodes cp-2, cp-3")
+	}
+
+	// the node whose fluentd pod can't be reached isn't reported
+	delete(metrics, "fluentd-2")
+	controlPlanes = controlPlanes[:2]
+	delete(metrics, "fluentd-3")
+	missing, _, err = a.missingAuditLogs(context.Background(), paths)
+	assert.NoError(err)
+	if assert.Len(missing, 1) {
+		assert.Contains(missing[0], "rancher audit log "+generator.DefaultRancherAuditLogPath+" on any node")
+	}
+
+	// nothing is known without metrics
+	metrics = map[string][]byte{}
+	_, scraped, err = a.missingAuditLogs(context.Background(), paths)
+	assert.NoError(err)
+	assert.False(scraped)
+}
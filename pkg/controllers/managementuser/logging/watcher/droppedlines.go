@@ -0,0 +1,284 @@
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/logging/generator"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/ticker"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// droppedLinesThreshold is the change of a count of dropped lines that is written to a logging, smaller changes
+	// are written once they add up to it
+	droppedLinesThreshold = 100
+)
+
+// stageCounts are the records that reached each stage of the dropping of a logging, summed over the fluentd pods
+type stageCounts map[string]float64
+
+// podCounts are the high-water marks of the counts of a fluentd pod by logging, the counts before the last restart of
+// its container are kept in base
+type podCounts struct {
+	base map[string]stageCounts
+	last map[string]stageCounts
+}
+
+type droppedLinesWatcher struct {
+	podLister       v1.PodLister
+	clusterName     string
+	clusterLoggings mgmtv3.ClusterLoggingInterface
+	projectLoggings mgmtv3.ProjectLoggingInterface
+	metrics         func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error)
+
+	// pods are the counts of the fluentd pods by their UID, the counts of the pods that are gone are added to gone
+	pods map[types.UID]*podCounts
+	gone map[string]stageCounts
+}
+
+// StartDroppedLinesWatcher reports the lines the loggings of the cluster dropped by their selector and debug sampling,
+// from the counters of the fluentd pods
+func StartDroppedLinesWatcher(ctx context.Context, cluster *config.UserContext) {
+	s := &droppedLinesWatcher{
+		podLister:       cluster.Core.Pods(loggingconfig.LoggingNamespace).Controller().Lister(),
+		clusterName:     cluster.ClusterName,
+		clusterLoggings: cluster.Management.Management.ClusterLoggings(cluster.ClusterName),
+		projectLoggings: cluster.Management.Management.ProjectLoggings(metav1.NamespaceAll),
+		pods:            map[types.UID]*podCounts{},
+		gone:            map[string]stageCounts{},
+	}
+	s.metrics = func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error) {
+		return fluentdMetrics(ctx, cluster.K8sClient, pod)
+	}
+	go s.watch(ctx, 60*time.Second)
+}
+
+func (d *droppedLinesWatcher) watch(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		counts, err := d.scrape(ctx)
+		if err != nil {
+			logrus.Error(err)
+			continue
+		}
+
+		if err := d.updateClusterLogging(counts); err != nil {
+			logrus.Error(err)
+		}
+
+		if err := d.updateProjectLoggings(counts); err != nil {
+			logrus.Error(err)
+		}
+	}
+}
+
+// scrape reads the counters of the running fluentd pods concurrently and returns the counts of the loggings, the
+// pods that can't be reached keep the counts of their last scrape
+func (d *droppedLinesWatcher) scrape(ctx context.Context) (map[string]stageCounts, error) {
+	pods, err := d.podLister.List(loggingconfig.LoggingNamespace, labels.SelectorFromSet(loggingconfig.FluentdSelector))
+	if err != nil {
+		return nil, errors.Wrap(err, "list fluentd pods failed in dropped lines watcher")
+	}
+
+	scraped := map[types.UID]map[string]stageCounts{}
+	for pod, body := range scrapeFluentd(ctx, pods, d.metrics) {
+		counts := map[string]stageCounts{}
+		if err := addStageCounts(counts, body); err != nil {
+			logrus.Debugf("parse metrics of fluentd pod %s failed: %v", pod.Name, err)
+			continue
+		}
+		scraped[pod.UID] = counts
+	}
+
+	current := map[types.UID]bool{}
+	for _, pod := range pods {
+		current[pod.UID] = true
+	}
+	for uid, p := range d.pods {
+		if !current[uid] {
+			addCounts(d.gone, p.base)
+			addCounts(d.gone, p.last)
+			delete(d.pods, uid)
+		}
+	}
+	for uid, counts := range scraped {
+		p, ok := d.pods[uid]
+		if !ok {
+			p = &podCounts{base: map[string]stageCounts{}}
+			d.pods[uid] = p
+		}
+		if decreased(p.last, counts) {
+			addCounts(p.base, p.last)
+		}
+		p.last = counts
+	}
+
+	total := map[string]stageCounts{}
+	addCounts(total, d.gone)
+	for _, p := range d.pods {
+		addCounts(total, p.base)
+		addCounts(total, p.last)
+	}
+	return total, nil
+}
+
+// decreased returns whether a counter went down, the container of the pod was restarted and its counters start over
+func decreased(last, current map[string]stageCounts) bool {
+	for logging, stages := range last {
+		for stage, count := range stages {
+			if current[logging][stage] < count {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func addCounts(to, from map[string]stageCounts) {
+	for logging, stages := range from {
+		if to[logging] == nil {
+			to[logging] = stageCounts{}
+		}
+		for stage, count := range stages {
+			to[logging][stage] += count
+		}
+	}
+}
+
+func addStageCounts(counts map[string]stageCounts, body []byte) error {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	family, ok := families[generator.DroppingMetric]
+	if !ok {
+		return nil
+	}
+	for _, m := range family.GetMetric() {
+		var logging, stage string
+		for _, l := range m.GetLabel() {
+			switch l.GetName() {
+			case generator.DroppingMetricLabel:
+				logging = l.GetValue()
+			case generator.DroppingStageLabel:
+				stage = l.GetValue()
+			}
+		}
+		if logging == "" || stage == "" {
+			continue
+		}
+		if counts[logging] == nil {
+			counts[logging] = stageCounts{}
+		}
+		counts[logging][stage] += m.GetCounter().GetValue()
+	}
+	return nil
+}
+
+// droppedLines are the differences between the counts before and after each stage, it is nil when the logging
+// doesn't drop lines
+func droppedLines(counts stageCounts) *v32.LoggingDroppedLines {
+	if counts == nil {
+		return nil
+	}
+	dropped := func(before, after string) int64 {
+		if n := int64(counts[before] - counts[after]); n > 0 {
+			return n
+		}
+		return 0
+	}
+	return &v32.LoggingDroppedLines{
+		Excluded: dropped(generator.DroppingStageIn, generator.DroppingStageSelect),
+		Sampled:  dropped(generator.DroppingStageSample, generator.DroppingStageSampled),
+	}
+}
+
+// droppedLinesChanged returns whether the dropped lines changed enough to be written to the logging, the first dropped
+// line of a kind is always written
+func droppedLinesChanged(old, new *v32.LoggingDroppedLines) bool {
+	if old == nil || new == nil {
+		return old != new
+	}
+	for _, c := range [][2]int64{
+		{old.Excluded, new.Excluded},
+		{old.Sampled, new.Sampled},
+	} {
+		diff := c[1] - c[0]
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff >= droppedLinesThreshold || (c[0] == 0) != (c[1] == 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *droppedLinesWatcher) updateClusterLogging(counts map[string]stageCounts) error {
+	cls, err := d.clusterLoggings.Controller().Lister().List(d.clusterName, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "list clusterlogging fail in dropped lines watcher")
+	}
+	if len(cls) == 0 {
+		return nil
+	}
+	obj := cls[0]
+
+	dropped := droppedLines(counts[loggingconfig.ClusterLevel])
+	if obj.Spec.Selector == nil && obj.Spec.DebugSampling == nil {
+		dropped = nil
+	}
+	if !droppedLinesChanged(obj.Status.DroppedLines, dropped) {
+		return nil
+	}
+
+	updatedObj := obj.DeepCopy()
+	updatedObj.Status.DroppedLines = dropped
+	if _, err := d.clusterLoggings.Update(updatedObj); err != nil {
+		return errors.Wrapf(err, "set dropped lines of clusterlogging %s failed", obj.Name)
+	}
+	return nil
+}
+
+func (d *droppedLinesWatcher) updateProjectLoggings(counts map[string]stageCounts) error {
+	pls, err := d.projectLoggings.Controller().Lister().List(metav1.NamespaceAll, labels.NewSelector())
+	if err != nil {
+		return errors.Wrapf(err, "list projectlogging fail in dropped lines watcher")
+	}
+
+	for _, obj := range pls {
+		if obj.Spec.ObjClusterName() != d.clusterName {
+			continue
+		}
+
+		dropped := droppedLines(counts[obj.Spec.ProjectName])
+		if obj.Spec.Selector == nil && obj.Spec.DebugSampling == nil {
+			dropped = nil
+		}
+		if !droppedLinesChanged(obj.Status.DroppedLines, dropped) {
+			continue
+		}
+
+		updatedObj := obj.DeepCopy()
+		updatedObj.Status.DroppedLines = dropped
+		if _, err := d.projectLoggings.Update(updatedObj); err != nil {
+			return errors.Wrapf(err, "set dropped lines of projectlogging %s failed", obj.Name)
+		}
+	}
+	return nil
+}
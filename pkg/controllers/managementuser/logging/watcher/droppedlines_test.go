@@ -0,0 +1,118 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDroppedLines(t *testing.T) {
+	assert := assert.New(t)
+	pod1 := []byte(`# HELP fluentd_logging_records_total The number of records
+# TYPE fluentd_logging_records_total counter
+fluentd_logging_records_total{logging="cluster",stage="received"} 100
+fluentd_logging_records_total{logging="cluster",stage="selected"} 80
+fluentd_logging_records_total{logging="cluster",stage="sampling"} 80
+fluentd_logging_records_total{logging="cluster",stage="sampled"} 70
+fluentd_logging_records_total{logging="c-1:p-1",stage="received"} 10
+# HELP fluentd_input_status_num_records_total The total number of incoming records
+# TYPE fluentd_input_status_num_records_total counter
+fluentd_input_status_num_records_total{tag="cluster.var.log.containers.a.log",hostname="node-1"} 100
+`)
+	pod2 := []byte(`# TYPE fluentd_logging_records_total counter
+fluentd_logging_records_total{logging="cluster",stage="received"} 10
+fluentd_logging_records_total{logging="cluster",stage="selected"} 10
+fluentd_logging_records_total{logging="cluster",stage="sampling"} 10
+fluentd_logging_records_total{logging="cluster",stage="sampled"} 5
+`)
+
+	counts := map[string]stageCounts{}
+	assert.NoError(addStageCounts(counts, pod1))
+	assert.NoError(addStageCounts(counts, pod2))
+
+	assert.Equal(&v32.LoggingDroppedLines{Excluded: 20, Sampled: 15}, droppedLines(counts["cluster"]))
+	// the counter of a stage appears with the first record that reaches it
+	assert.Equal(&v32.LoggingDroppedLines{Excluded: 10}, droppedLines(counts["c-1:p-1"]), "all the lines are excluded")
+	assert.Nil(droppedLines(counts["c-1:p-2"]))
+}
+
+func TestScrape(t *testing.T) {
+	assert := assert.New(t)
+	newPod := func(name string) *k8scorev1.Pod {
+		return &k8scorev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: loggingconfig.LoggingNamespace, UID: types.UID(name)},
+			Status:     k8scorev1.PodStatus{Phase: k8scorev1.PodRunning},
+		}
+	}
+	counter := func(received, selected int) []byte {
+		return []byte(fmt.Sprintf(`# TYPE fluentd_logging_records_total counter
+fluentd_logging_records_total{logging="cluster",stage="received"} %d
+fluentd_logging_records_total{logging="cluster",stage="selected"} %d
+`, received, selected))
+	}
+
+	pods := []*k8scorev1.Pod{newPod("fluentd-1"), newPod("fluentd-2")}
+	var mu sync.Mutex
+	metrics := map[string][]byte{
+		"fluentd-1": counter(100, 80),
+		"fluentd-2": counter(10, 10),
+	}
+	d := &droppedLinesWatcher{
+		podLister: &fakes.PodListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*k8scorev1.Pod, error) {
+				return pods, nil
+			},
+		},
+		metrics: func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if body, ok := metrics[pod.Name]; ok {
+				return body, nil
+			}
+			return nil, errors.New("unreachable")
+		},
+		pods: map[types.UID]*podCounts{},
+		gone: map[string]stageCounts{},
+	}
+
+	counts, err := d.scrape(context.Background())
+	assert.NoError(err)
+	assert.Equal(stageCounts{"received": 110, "selected": 90}, counts["cluster"])
+
+	// the pod that can't be reached keeps its counts, the restarted one starts over
+	delete(metrics, "fluentd-2")
+	metrics["fluentd-1"] = counter(5, 5)
+	counts, err = d.scrape(context.Background())
+	assert.NoError(err)
+	assert.Equal(stageCounts{"received": 115, "selected": 95}, counts["cluster"])
+
+	// the counts of a deleted pod are kept
+	pods = pods[:1]
+	metrics["fluentd-1"] = counter(20, 5)
+	counts, err = d.scrape(context.Background())
+	assert.NoError(err)
+	assert.Equal(stageCounts{"received": 130, "selected": 95}, counts["cluster"])
+	assert.Len(d.pods, 1)
+}
+
+func TestDroppedLinesChanged(t *testing.T) {
+	assert := assert.New(t)
+	assert.False(droppedLinesChanged(nil, nil))
+	assert.True(droppedLinesChanged(nil, &v32.LoggingDroppedLines{}))
+	assert.True(droppedLinesChanged(&v32.LoggingDroppedLines{}, nil))
+	assert.True(droppedLinesChanged(&v32.LoggingDroppedLines{}, &v32.LoggingDroppedLines{Sampled: 1}), "the first sampled line")
+	assert.False(droppedLinesChanged(&v32.LoggingDroppedLines{Excluded: 500}, &v32.LoggingDroppedLines{Excluded: 599}))
+	assert.True(droppedLinesChanged(&v32.LoggingDroppedLines{Excluded: 500}, &v32.LoggingDroppedLines{Excluded: 600}))
+}
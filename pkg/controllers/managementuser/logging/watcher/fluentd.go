@@ -0,0 +1,66 @@
+package watcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	loggingconfig "github.com/rancher/rancher/pkg/controllers/managementuser/logging/config"
+
+	"github.com/sirupsen/logrus"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	scrapeTimeout = 10 * time.Second
+	scrapeWorkers = 5
+)
+
+// scrapeFluentd reads the metrics of the running fluentd pods concurrently, the pods whose metrics can't be read are
+// left out
+func scrapeFluentd(ctx context.Context, pods []*k8scorev1.Pod, metrics func(ctx context.Context, pod *k8scorev1.Pod) ([]byte, error)) map[*k8scorev1.Pod][]byte {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		workers = make(chan struct{}, scrapeWorkers)
+		bodies  = map[*k8scorev1.Pod][]byte{}
+	)
+	for _, pod := range pods {
+		if pod.Status.Phase != k8scorev1.PodRunning {
+			continue
+		}
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(pod *k8scorev1.Pod) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			body, err := metrics(ctx, pod)
+			if err != nil {
+				logrus.Debugf("get metrics of fluentd pod %s failed: %v", pod.Name, err)
+				return
+			}
+			mu.Lock()
+			bodies[pod] = body
+			mu.Unlock()
+		}(pod)
+	}
+	wg.Wait()
+	return bodies
+}
+
+// fluentdMetrics reads the metrics of a fluentd pod through the API server proxy
+func fluentdMetrics(ctx context.Context, k8s kubernetes.Interface, pod *k8scorev1.Pod) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, scrapeTimeout)
+	defer cancel()
+	return k8s.CoreV1().RESTClient().Get().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name + ":" + loggingconfig.FluentdMetricsPort).
+		SubResource("proxy").
+		Suffix(loggingconfig.FluentdMetricsPath).
+		DoRaw(ctx)
+}
@@ -55,6 +55,10 @@ func (l *PipelineService) Version() (string, error) {
 	return getDefaultVersion(raw)
 }
 
+func (l *PipelineService) TargetVersion() (string, error) {
+	return l.Version()
+}
+
 func (l *PipelineService) Upgrade(currentVersion string) (newVersion string, err error) {
 	set := labels.Set(map[string]string{utils.PipelineNamespaceLabel: "true"})
 	pipelineNamespaces, err := l.namespaceLister.List("", set.AsSelector())
@@ -16,6 +16,8 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 
 	syncer := Syncer{
 		clusterName:    cluster.ClusterName,
+		clusters:       cluster.Management.Management.Clusters(""),
+		clusterLister:  cluster.Management.Management.Clusters("").Controller().Lister(),
 		projects:       projClient,
 		projectLister:  projClient.Controller().Lister(),
 		systemServices: systemServices,
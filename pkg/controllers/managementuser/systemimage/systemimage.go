@@ -3,13 +3,17 @@
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	cutils "github.com/rancher/rancher/pkg/catalog/utils"
 	alerting "github.com/rancher/rancher/pkg/controllers/managementuser/alert/deployer"
 	logging "github.com/rancher/rancher/pkg/controllers/managementuser/logging/deployer"
 	pipeline "github.com/rancher/rancher/pkg/controllers/managementuser/pipeline/upgrade"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/maintenance"
 	"github.com/rancher/rancher/pkg/project"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +23,8 @@
 
 type Syncer struct {
 	clusterName    string
+	clusters       v3.ClusterInterface
+	clusterLister  v3.ClusterLister
 	projectLister  v3.ProjectLister
 	projects       v3.ProjectInterface
 	systemServices map[string]SystemService
@@ -68,10 +74,32 @@ func (s *Syncer) Sync() error {
 		}
 	}
 
-	changed := false
+	// only the services that are not on their target version are upgraded, nothing waits for the maintenance
+	// window when all of them are
+	var pending []string
 	for k, v := range s.systemServices {
+		targetVersion, err := v.TargetVersion()
+		if err != nil {
+			return errors.Wrapf(err, "get cluster %s system service %s version failed", s.clusterName, k)
+		}
+		if versionMap[k] != targetVersion {
+			pending = append(pending, k)
+		}
+	}
+	if len(pending) == 0 {
+		return s.maintenanceDone()
+	}
+	sort.Strings(pending)
+
+	// upgrades of the system services restart them, they wait for the maintenance window of the cluster
+	if open, err := s.maintenanceWindowOpen(systemProject); err != nil || !open {
+		return err
+	}
+
+	changed := false
+	for _, k := range pending {
 		oldVersion := versionMap[k]
-		newVersion, err := v.Upgrade(oldVersion)
+		newVersion, err := s.systemServices[k].Upgrade(oldVersion)
 		if err != nil {
 			return errors.Wrapf(err, "upgrade cluster %s system service %s failed", s.clusterName, k)
 		}
@@ -95,6 +123,46 @@ func (s *Syncer) Sync() error {
 	return err
 }
 
+// maintenanceDone clears a planned upgrade of the system services once there is nothing left to upgrade
+func (s *Syncer) maintenanceDone() error {
+	cluster, err := s.clusterLister.Get("", s.clusterName)
+	if err != nil {
+		return err
+	}
+	toUpdate := cluster.DeepCopy()
+	if maintenance.Done(toUpdate, v32.MaintenanceActionSystemImageUpgrade) {
+		_, err = s.clusters.Update(toUpdate)
+	}
+	return err
+}
+
+func (s *Syncer) maintenanceWindowOpen(systemProject *v3.Project) (bool, error) {
+	cluster, err := s.clusterLister.Get("", s.clusterName)
+	if err != nil {
+		return false, err
+	}
+	open, next, err := maintenance.IsOpen(cluster.Spec.MaintenanceWindow, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("invalid maintenance window for cluster %s, %v", s.clusterName, err)
+	}
+
+	toUpdate := cluster.DeepCopy()
+	if open {
+		if maintenance.Done(toUpdate, v32.MaintenanceActionSystemImageUpgrade) {
+			_, err = s.clusters.Update(toUpdate)
+		}
+		return err == nil, err
+	}
+
+	if maintenance.Plan(toUpdate, v32.MaintenanceActionSystemImageUpgrade, "upgrade of the system services", next) {
+		if _, err := s.clusters.Update(toUpdate); err != nil {
+			return false, err
+		}
+	}
+	s.projects.Controller().EnqueueAfter(systemProject.Namespace, systemProject.Name, time.Until(next))
+	return false, nil
+}
+
 func GetSystemImageVersion() (string, error) {
 	versionMap := make(map[string]string)
 	systemServices := getSystemService()
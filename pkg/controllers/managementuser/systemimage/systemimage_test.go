@@ -0,0 +1,163 @@
+package systemimage
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/project"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type fakeService struct {
+	version  string
+	upgrades int
+}
+
+func (f *fakeService) Init(cluster *config.UserContext) {}
+
+func (f *fakeService) Version() (string, error) {
+	return f.version, nil
+}
+
+func (f *fakeService) TargetVersion() (string, error) {
+	return f.version, nil
+}
+
+func (f *fakeService) Upgrade(currentVersion string) (string, error) {
+	f.upgrades++
+	return f.version, nil
+}
+
+type fakeSyncer struct {
+	*Syncer
+	updatedClusters []*v3.Cluster
+	updatedProjects []*v3.Project
+	requeues        []time.Duration
+}
+
+func newFakeSyncer(cluster *v3.Cluster, versionAnnotation string, services map[string]SystemService) *fakeSyncer {
+	systemProject := &v3.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "p-system",
+			Namespace:   cluster.Name,
+			Annotations: map[string]string{project.SystemImageVersionAnn: versionAnnotation},
+		},
+		Spec: v32.ProjectSpec{DisplayName: project.System},
+	}
+
+	f := &fakeSyncer{}
+	f.Syncer = &Syncer{
+		clusterName: cluster.Name,
+		clusters: &fakes.ClusterInterfaceMock{
+			UpdateFunc: func(cluster *v3.Cluster) (*v3.Cluster, error) {
+				f.updatedClusters = append(f.updatedClusters, cluster)
+				return cluster, nil
+			},
+		},
+		clusterLister: &fakes.ClusterListerMock{
+			GetFunc: func(namespace, name string) (*v3.Cluster, error) {
+				return cluster, nil
+			},
+		},
+		projectLister: &fakes.ProjectListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.Project, error) {
+				return []*v3.Project{systemProject}, nil
+			},
+		},
+		projects: &fakes.ProjectInterfaceMock{
+			UpdateFunc: func(project *v3.Project) (*v3.Project, error) {
+				f.updatedProjects = append(f.updatedProjects, project)
+				return project, nil
+			},
+			ControllerFunc: func() v3.ProjectController {
+				return &fakes.ProjectControllerMock{
+					EnqueueAfterFunc: func(namespace, name string, after time.Duration) {
+						f.requeues = append(f.requeues, after)
+					},
+				}
+			},
+		},
+		systemServices: services,
+	}
+	return f
+}
+
+// closedWindowCluster is a cluster whose maintenance window opens in an hour
+func closedWindowCluster() *v3.Cluster {
+	now := time.Now()
+	return &v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+		Spec: v32.ClusterSpec{
+			ClusterSpecBase: v32.ClusterSpecBase{
+				MaintenanceWindow: &v32.MaintenanceWindow{
+					Blackouts: []v32.MaintenanceBlackout{{
+						Start: now.Add(-time.Hour).UTC().Format(time.RFC3339),
+						End:   now.Add(time.Hour).UTC().Format(time.RFC3339),
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestSyncUpToDateDoesNotPlanMaintenance(t *testing.T) {
+	logging := &fakeService{version: "logging-0.2.0"}
+	s := newFakeSyncer(closedWindowCluster(), `{"logging":"logging-0.2.0"}`, map[string]SystemService{"logging": logging})
+
+	assert.NoError(t, s.Sync())
+	assert.Empty(t, s.updatedClusters, "no maintenance is planned without a pending upgrade")
+	assert.Empty(t, s.updatedProjects)
+	assert.Empty(t, s.requeues)
+	assert.Equal(t, 0, logging.upgrades)
+}
+
+func TestSyncClearsPlannedMaintenanceWhenUpToDate(t *testing.T) {
+	cluster := closedWindowCluster()
+	cluster.Status.MaintenanceStatus = &v32.MaintenanceStatus{PlannedActions: []v32.PlannedMaintenanceAction{{
+		Action: v32.MaintenanceActionSystemImageUpgrade,
+	}}}
+	s := newFakeSyncer(cluster, `{"logging":"logging-0.2.0"}`, map[string]SystemService{"logging": &fakeService{version: "logging-0.2.0"}})
+
+	assert.NoError(t, s.Sync())
+	if assert.Len(t, s.updatedClusters, 1) {
+		assert.Empty(t, s.updatedClusters[0].Status.MaintenanceStatus.PlannedActions)
+	}
+}
+
+func TestSyncDefersPendingUpgrade(t *testing.T) {
+	logging := &fakeService{version: "logging-0.3.0"}
+	alerting := &fakeService{version: "alerting-0.1.0"}
+	s := newFakeSyncer(closedWindowCluster(), `{"logging":"logging-0.2.0","alerting":"alerting-0.1.0"}`,
+		map[string]SystemService{"logging": logging, "alerting": alerting})
+
+	assert.NoError(t, s.Sync())
+	if assert.Len(t, s.updatedClusters, 1) {
+		actions := s.updatedClusters[0].Status.MaintenanceStatus.PlannedActions
+		if assert.Len(t, actions, 1) {
+			assert.Equal(t, v32.MaintenanceActionSystemImageUpgrade, actions[0].Action)
+		}
+	}
+	assert.Len(t, s.requeues, 1)
+	assert.Equal(t, 0, logging.upgrades, "the upgrade waits for the window")
+	assert.Empty(t, s.updatedProjects)
+}
+
+func TestSyncUpgradesPendingServices(t *testing.T) {
+	logging := &fakeService{version: "logging-0.3.0"}
+	alerting := &fakeService{version: "alerting-0.1.0"}
+	s := newFakeSyncer(&v3.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "c-1"}}, `{"logging":"logging-0.2.0","alerting":"alerting-0.1.0"}`,
+		map[string]SystemService{"logging": logging, "alerting": alerting})
+
+	assert.NoError(t, s.Sync())
+	assert.Equal(t, 1, logging.upgrades)
+	assert.Equal(t, 0, alerting.upgrades, "services on their target version are not upgraded")
+	if assert.Len(t, s.updatedProjects, 1) {
+		assert.JSONEq(t, `{"logging":"logging-0.3.0","alerting":"alerting-0.1.0"}`, s.updatedProjects[0].Annotations[project.SystemImageVersionAnn])
+	}
+}
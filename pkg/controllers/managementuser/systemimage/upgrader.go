@@ -7,5 +7,7 @@
 type SystemService interface {
 	Init(cluster *config.UserContext)
 	Upgrade(currentVersion string) (newVersion string, err error)
+	// TargetVersion is the version Upgrade moves the service to
+	TargetVersion() (string, error)
 	Version() (string, error)
 }
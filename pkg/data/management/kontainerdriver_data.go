@@ -47,6 +47,14 @@ func addKontainerDrivers(management *config.ManagementContext) error {
 		return err
 	}
 
+	if err := creator.add("digitalOceanKubernetesService"); err != nil {
+		return err
+	}
+
+	if err := creator.add("linodeKubernetesEngine"); err != nil {
+		return err
+	}
+
 	if err := creator.addCustomDriver(
 		"baiducloudcontainerengine",
 		"https://drivers.rancher.cn/kontainer-engine-driver-baidu/0.2.0/kontainer-engine-driver-baidu-linux",
@@ -144,7 +144,10 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 		addRule().apiGroups("management.cattle.io").resources("clusterloggings").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clusteralertrules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clusteralertgroups").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("clusteralertsilences").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("clusteralertincidents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("notifiers").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("oncallschedules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clustercatalogs").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clustermonitorgraphs").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("catalogtemplates").verbs("get", "list", "watch").
@@ -197,6 +200,10 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 	rb.addRoleTemplate("Manage Cluster Backups", "backups-manage", "cluster", false, false, false).
 		addRule().apiGroups("management.cattle.io").resources("etcdbackups").verbs("*")
 
+	rb.addRoleTemplate("Manage Cluster Upgrade Plans", "upgradeplans-manage", "cluster", false, false, false).
+		addRule().apiGroups("management.cattle.io").resources("clusterupgradeplans").verbs("*").
+		addRule().apiGroups("management.cattle.io").resources("etcdbackups").verbs("get", "list", "watch")
+
 	// Project roles
 	rb.addRoleTemplate("Project Owner", "project-owner", "project", false, false, false).
 		addRule().apiGroups("management.cattle.io").resources("projectroletemplatebindings").verbs("*").
@@ -214,8 +221,11 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 		addRule().apiGroups("metrics.k8s.io").resources("pods").verbs("*").
 		addRule().apiGroups("management.cattle.io").resources("clusterevents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("notifiers").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("oncallschedules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectalertrules").verbs("*").
 		addRule().apiGroups("management.cattle.io").resources("projectalertgroups").verbs("*").
+		addRule().apiGroups("management.cattle.io").resources("projectalertsilences").verbs("*").
+		addRule().apiGroups("management.cattle.io").resources("projectalertincidents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectloggings").verbs("*").
 		addRule().apiGroups("management.cattle.io").resources("clustercatalogs").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectcatalogs").verbs("*").
@@ -248,8 +258,11 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 		addRule().apiGroups("metrics.k8s.io").resources("pods").verbs("*").
 		addRule().apiGroups("management.cattle.io").resources("clusterevents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("notifiers").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("oncallschedules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectalertrules").verbs("*").
 		addRule().apiGroups("management.cattle.io").resources("projectalertgroups").verbs("*").
+		addRule().apiGroups("management.cattle.io").resources("projectalertsilences").verbs("*").
+		addRule().apiGroups("management.cattle.io").resources("projectalertincidents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectloggings").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clustercatalogs").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectcatalogs").verbs("get", "list", "watch").
@@ -280,8 +293,11 @@ func addRoles(wrangler *wrangler.Context, management *config.ManagementContext)
 		addRule().apiGroups("metrics.k8s.io").resources("pods").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clusterevents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("notifiers").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("oncallschedules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectalertrules").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectalertgroups").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("projectalertsilences").verbs("get", "list", "watch").
+		addRule().apiGroups("management.cattle.io").resources("projectalertincidents").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectloggings").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("clustercatalogs").verbs("get", "list", "watch").
 		addRule().apiGroups("management.cattle.io").resources("projectcatalogs").verbs("get", "list", "watch").
@@ -27,21 +27,27 @@
 
 func NewFactory(apiContext *config.ScaledContext) (*Factory, error) {
 	authorizer := tunnelserver.NewAuthorizer(apiContext)
-	tunneler := tunnelserver.NewTunnelServer(authorizer)
+	diagnostics := tunnelserver.NewDiagnostics()
+	sharder := tunnelserver.NewSharder(diagnostics)
+	tunneler := tunnelserver.NewTunnelServer(authorizer, diagnostics, sharder)
 
 	return &Factory{
-		clusterLister:    apiContext.Management.Clusters("").Controller().Lister(),
-		nodeLister:       apiContext.Management.Nodes("").Controller().Lister(),
-		TunnelServer:     tunneler,
-		TunnelAuthorizer: authorizer,
+		clusterLister:     apiContext.Management.Clusters("").Controller().Lister(),
+		nodeLister:        apiContext.Management.Nodes("").Controller().Lister(),
+		TunnelServer:      tunneler,
+		TunnelAuthorizer:  authorizer,
+		TunnelDiagnostics: diagnostics,
+		TunnelSharder:     sharder,
 	}, nil
 }
 
 type Factory struct {
-	nodeLister       v3.NodeLister
-	clusterLister    v3.ClusterLister
-	TunnelServer     *remotedialer.Server
-	TunnelAuthorizer *tunnelserver.Authorizer
+	nodeLister        v3.NodeLister
+	clusterLister     v3.ClusterLister
+	TunnelServer      *remotedialer.Server
+	TunnelAuthorizer  *tunnelserver.Authorizer
+	TunnelDiagnostics *tunnelserver.Diagnostics
+	TunnelSharder     *tunnelserver.Sharder
 }
 
 func (f *Factory) ClusterDialer(clusterName string) (dialer.Dialer, error) {
@@ -156,7 +162,7 @@ func (f *Factory) clusterDialer(clusterName, address string) (dialer.Dialer, err
 
 	if f.TunnelServer.HasSession(cluster.Name) {
 		logrus.Tracef("dialerFactory: tunnel session found for cluster [%s]", cluster.Name)
-		cd := f.TunnelServer.Dialer(cluster.Name)
+		cd := f.tunnelDialer(cluster.Name)
 		return func(ctx context.Context, network, address string) (net.Conn, error) {
 			if cluster.Status.Driver == v32.ClusterDriverRKE {
 				address = f.translateClusterAddress(cluster, hostPort, address)
@@ -202,7 +208,7 @@ func (f *Factory) clusterDialer(clusterName, address string) (dialer.Dialer, err
 	for i := 0; i < 4; i++ {
 		if f.TunnelServer.HasSession(cluster.Name) {
 			logrus.Debugf("Cluster [%s] has reconnected, resuming", cluster.Name)
-			cd := f.TunnelServer.Dialer(cluster.Name)
+			cd := f.tunnelDialer(cluster.Name)
 			return func(ctx context.Context, network, address string) (net.Conn, error) {
 				if cluster.Status.Driver == v32.ClusterDriverRKE {
 					address = f.translateClusterAddress(cluster, hostPort, address)
@@ -249,7 +255,7 @@ func (f *Factory) DockerDialer(clusterName, machineName string) (dialer.Dialer,
 		if machine.Status.InternalNodeStatus.NodeInfo.OperatingSystem == "windows" {
 			network, address = "npipe", "//./pipe/docker_engine"
 		}
-		d := f.TunnelServer.Dialer(sessionKey)
+		d := f.tunnelDialer(sessionKey)
 		return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
 			return d(ctx, network, address)
 		}, nil
@@ -276,13 +282,18 @@ func (f *Factory) nodeDialer(clusterName, machineName string) (dialer.Dialer, er
 
 	sessionKey := machineSessionKey(machine)
 	if f.TunnelServer.HasSession(sessionKey) {
-		d := f.TunnelServer.Dialer(sessionKey)
+		d := f.tunnelDialer(sessionKey)
 		return dialer.Dialer(d), nil
 	}
 
 	return nil, fmt.Errorf("can not build dialer to [%s:%s]", clusterName, machineName)
 }
 
+// tunnelDialer dials through the tunnel of an agent, tracking the connections for the tunnel diagnostics
+func (f *Factory) tunnelDialer(sessionKey string) remotedialer.Dialer {
+	return f.TunnelDiagnostics.Dialer(sessionKey, f.TunnelServer.Dialer(sessionKey))
+}
+
 func machineSessionKey(machine *v3.Node) string {
 	return fmt.Sprintf("%s:%s", machine.Namespace, machine.Name)
 }
@@ -45,10 +45,15 @@ type Config struct {
 	ClusterAlerts                            map[string]managementClient.ClusterAlert                            `json:"clusterAlerts,omitempty" yaml:"clusterAlerts,omitempty"`
 	ProjectAlerts                            map[string]managementClient.ProjectAlert                            `json:"projectAlerts,omitempty" yaml:"projectAlerts,omitempty"`
 	Notifiers                                map[string]managementClient.Notifier                                `json:"notifiers,omitempty" yaml:"notifiers,omitempty"`
+	OnCallSchedules                          map[string]managementClient.OnCallSchedule                          `json:"onCallSchedules,omitempty" yaml:"onCallSchedules,omitempty"`
 	ClusterAlertGroups                       map[string]managementClient.ClusterAlertGroup                       `json:"clusterAlertGroups,omitempty" yaml:"clusterAlertGroups,omitempty"`
 	ProjectAlertGroups                       map[string]managementClient.ProjectAlertGroup                       `json:"projectAlertGroups,omitempty" yaml:"projectAlertGroups,omitempty"`
 	ClusterAlertRules                        map[string]managementClient.ClusterAlertRule                        `json:"clusterAlertRules,omitempty" yaml:"clusterAlertRules,omitempty"`
+	ClusterAlertSilences                     map[string]managementClient.ClusterAlertSilence                     `json:"clusterAlertSilences,omitempty" yaml:"clusterAlertSilences,omitempty"`
+	ClusterAlertIncidents                    map[string]managementClient.ClusterAlertIncident                    `json:"clusterAlertIncidents,omitempty" yaml:"clusterAlertIncidents,omitempty"`
 	ProjectAlertRules                        map[string]managementClient.ProjectAlertRule                        `json:"projectAlertRules,omitempty" yaml:"projectAlertRules,omitempty"`
+	ProjectAlertSilences                     map[string]managementClient.ProjectAlertSilence                     `json:"projectAlertSilences,omitempty" yaml:"projectAlertSilences,omitempty"`
+	ProjectAlertIncidents                    map[string]managementClient.ProjectAlertIncident                    `json:"projectAlertIncidents,omitempty" yaml:"projectAlertIncidents,omitempty"`
 	ComposeConfigs                           map[string]managementClient.ComposeConfig                           `json:"composeConfigs,omitempty" yaml:"composeConfigs,omitempty"`
 	ProjectCatalogs                          map[string]managementClient.ProjectCatalog                          `json:"projectCatalogs,omitempty" yaml:"projectCatalogs,omitempty"`
 	ClusterCatalogs                          map[string]managementClient.ClusterCatalog                          `json:"clusterCatalogs,omitempty" yaml:"clusterCatalogs,omitempty"`
@@ -58,6 +63,7 @@ type Config struct {
 	GlobalDnsProviders                       map[string]managementClient.GlobalDnsProvider                       `json:"globalDnsProviders,omitempty" yaml:"globalDnsProviders,omitempty"`
 	KontainerDrivers                         map[string]managementClient.KontainerDriver                         `json:"kontainerDrivers,omitempty" yaml:"kontainerDrivers,omitempty"`
 	EtcdBackups                              map[string]managementClient.EtcdBackup                              `json:"etcdBackups,omitempty" yaml:"etcdBackups,omitempty"`
+	ClusterUpgradePlans                      map[string]managementClient.ClusterUpgradePlan                      `json:"clusterUpgradePlans,omitempty" yaml:"clusterUpgradePlans,omitempty"`
 	MonitorMetrics                           map[string]managementClient.MonitorMetric                           `json:"monitorMetrics,omitempty" yaml:"monitorMetrics,omitempty"`
 	ClusterMonitorGraphs                     map[string]managementClient.ClusterMonitorGraph                     `json:"clusterMonitorGraphs,omitempty" yaml:"clusterMonitorGraphs,omitempty"`
 	ProjectMonitorGraphs                     map[string]managementClient.ProjectMonitorGraph                     `json:"projectMonitorGraphs,omitempty" yaml:"projectMonitorGraphs,omitempty"`
@@ -65,6 +71,9 @@ type Config struct {
 	ManagementSecrets                        map[string]managementClient.ManagementSecret                        `json:"managementSecrets,omitempty" yaml:"managementSecrets,omitempty"`
 	ClusterTemplates                         map[string]managementClient.ClusterTemplate                         `json:"clusterTemplates,omitempty" yaml:"clusterTemplates,omitempty"`
 	ClusterTemplateRevisions                 map[string]managementClient.ClusterTemplateRevision                 `json:"clusterTemplateRevisions,omitempty" yaml:"clusterTemplateRevisions,omitempty"`
+	ClusterTemplateRollouts                  map[string]managementClient.ClusterTemplateRollout                  `json:"clusterTemplateRollouts,omitempty" yaml:"clusterTemplateRollouts,omitempty"`
+	ClusterGroups                            map[string]managementClient.ClusterGroup                            `json:"clusterGroups,omitempty" yaml:"clusterGroups,omitempty"`
+	ClusterGroupRoleTemplateBindings         map[string]managementClient.ClusterGroupRoleTemplateBinding         `json:"clusterGroupRoleTemplateBindings,omitempty" yaml:"clusterGroupRoleTemplateBindings,omitempty"`
 	RkeK8sSystemImages                       map[string]managementClient.RkeK8sSystemImage                       `json:"rkeK8sSystemImages,omitempty" yaml:"rkeK8sSystemImages,omitempty"`
 	RkeK8sServiceOptions                     map[string]managementClient.RkeK8sServiceOption                     `json:"rkeK8sServiceOptions,omitempty" yaml:"rkeK8sServiceOptions,omitempty"`
 	RkeAddons                                map[string]managementClient.RkeAddon                                `json:"rkeAddons,omitempty" yaml:"rkeAddons,omitempty"`
@@ -45,7 +45,11 @@ type Interface interface {
 	ClusterAlert() ClusterAlertController
 	ClusterAlertGroup() ClusterAlertGroupController
 	ClusterAlertRule() ClusterAlertRuleController
+	ClusterAlertSilence() ClusterAlertSilenceController
+	ClusterAlertIncident() ClusterAlertIncidentController
 	ClusterCatalog() ClusterCatalogController
+	ClusterGroup() ClusterGroupController
+	ClusterGroupRoleTemplateBinding() ClusterGroupRoleTemplateBindingController
 	ClusterLogging() ClusterLoggingController
 	ClusterMonitorGraph() ClusterMonitorGraphController
 	ClusterRegistrationToken() ClusterRegistrationTokenController
@@ -53,6 +57,8 @@ type Interface interface {
 	ClusterScan() ClusterScanController
 	ClusterTemplate() ClusterTemplateController
 	ClusterTemplateRevision() ClusterTemplateRevisionController
+	ClusterTemplateRollout() ClusterTemplateRolloutController
+	ClusterUpgradePlan() ClusterUpgradePlanController
 	ComposeConfig() ComposeConfigController
 	DynamicSchema() DynamicSchemaController
 	EtcdBackup() EtcdBackupController
@@ -77,6 +83,7 @@ type Interface interface {
 	NodePool() NodePoolController
 	NodeTemplate() NodeTemplateController
 	Notifier() NotifierController
+	OnCallSchedule() OnCallScheduleController
 	OpenLdapProvider() OpenLdapProviderController
 	PodSecurityPolicyTemplate() PodSecurityPolicyTemplateController
 	PodSecurityPolicyTemplateProjectBinding() PodSecurityPolicyTemplateProjectBindingController
@@ -86,6 +93,8 @@ type Interface interface {
 	ProjectAlert() ProjectAlertController
 	ProjectAlertGroup() ProjectAlertGroupController
 	ProjectAlertRule() ProjectAlertRuleController
+	ProjectAlertSilence() ProjectAlertSilenceController
+	ProjectAlertIncident() ProjectAlertIncidentController
 	ProjectCatalog() ProjectCatalogController
 	ProjectLogging() ProjectLoggingController
 	ProjectMonitorGraph() ProjectMonitorGraphController
@@ -161,9 +170,21 @@ func (c *version) ClusterAlertGroup() ClusterAlertGroupController {
 func (c *version) ClusterAlertRule() ClusterAlertRuleController {
 	return NewClusterAlertRuleController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterAlertRule"}, "clusteralertrules", true, c.controllerFactory)
 }
+func (c *version) ClusterAlertSilence() ClusterAlertSilenceController {
+	return NewClusterAlertSilenceController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterAlertSilence"}, "clusteralertsilences", true, c.controllerFactory)
+}
+func (c *version) ClusterAlertIncident() ClusterAlertIncidentController {
+	return NewClusterAlertIncidentController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterAlertIncident"}, "clusteralertincidents", true, c.controllerFactory)
+}
 func (c *version) ClusterCatalog() ClusterCatalogController {
 	return NewClusterCatalogController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterCatalog"}, "clustercatalogs", true, c.controllerFactory)
 }
+func (c *version) ClusterGroup() ClusterGroupController {
+	return NewClusterGroupController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterGroup"}, "clustergroups", false, c.controllerFactory)
+}
+func (c *version) ClusterGroupRoleTemplateBinding() ClusterGroupRoleTemplateBindingController {
+	return NewClusterGroupRoleTemplateBindingController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterGroupRoleTemplateBinding"}, "clustergrouproletemplatebindings", false, c.controllerFactory)
+}
 func (c *version) ClusterLogging() ClusterLoggingController {
 	return NewClusterLoggingController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterLogging"}, "clusterloggings", true, c.controllerFactory)
 }
@@ -185,6 +206,12 @@ func (c *version) ClusterTemplate() ClusterTemplateController {
 func (c *version) ClusterTemplateRevision() ClusterTemplateRevisionController {
 	return NewClusterTemplateRevisionController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterTemplateRevision"}, "clustertemplaterevisions", true, c.controllerFactory)
 }
+func (c *version) ClusterTemplateRollout() ClusterTemplateRolloutController {
+	return NewClusterTemplateRolloutController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterTemplateRollout"}, "clustertemplaterollouts", true, c.controllerFactory)
+}
+func (c *version) ClusterUpgradePlan() ClusterUpgradePlanController {
+	return NewClusterUpgradePlanController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ClusterUpgradePlan"}, "clusterupgradeplans", true, c.controllerFactory)
+}
 func (c *version) ComposeConfig() ComposeConfigController {
 	return NewComposeConfigController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ComposeConfig"}, "composeconfigs", false, c.controllerFactory)
 }
@@ -257,6 +284,9 @@ func (c *version) NodeTemplate() NodeTemplateController {
 func (c *version) Notifier() NotifierController {
 	return NewNotifierController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "Notifier"}, "notifiers", true, c.controllerFactory)
 }
+func (c *version) OnCallSchedule() OnCallScheduleController {
+	return NewOnCallScheduleController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "OnCallSchedule"}, "onCallSchedules", true, c.controllerFactory)
+}
 func (c *version) OpenLdapProvider() OpenLdapProviderController {
 	return NewOpenLdapProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "OpenLdapProvider"}, "openldapproviders", false, c.controllerFactory)
 }
@@ -284,6 +314,12 @@ func (c *version) ProjectAlertGroup() ProjectAlertGroupController {
 func (c *version) ProjectAlertRule() ProjectAlertRuleController {
 	return NewProjectAlertRuleController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectAlertRule"}, "projectalertrules", true, c.controllerFactory)
 }
+func (c *version) ProjectAlertSilence() ProjectAlertSilenceController {
+	return NewProjectAlertSilenceController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectAlertSilence"}, "projectalertsilences", true, c.controllerFactory)
+}
+func (c *version) ProjectAlertIncident() ProjectAlertIncidentController {
+	return NewProjectAlertIncidentController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectAlertIncident"}, "projectalertincidents", true, c.controllerFactory)
+}
 func (c *version) ProjectCatalog() ProjectCatalogController {
 	return NewProjectCatalogController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectCatalog"}, "projectcatalogs", true, c.controllerFactory)
 }
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterAlertIncidentGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterAlertIncident",
+	}
+	ClusterAlertIncidentResource = metav1.APIResource{
+		Name:         "clusteralertincidents",
+		SingularName: "clusteralertincident",
+		Namespaced:   true,
+
+		Kind: ClusterAlertIncidentGroupVersionKind.Kind,
+	}
+
+	ClusterAlertIncidentGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clusteralertincidents",
+	}
+)
+
+func init() {
+	resource.Put(ClusterAlertIncidentGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterAlertIncident instead
+type ClusterAlertIncident = v3.ClusterAlertIncident
+
+func NewClusterAlertIncident(namespace, name string, obj v3.ClusterAlertIncident) *v3.ClusterAlertIncident {
+	obj.APIVersion, obj.Kind = ClusterAlertIncidentGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterAlertIncidentHandlerFunc func(key string, obj *v3.ClusterAlertIncident) (runtime.Object, error)
+
+type ClusterAlertIncidentChangeHandlerFunc func(obj *v3.ClusterAlertIncident) (runtime.Object, error)
+
+type ClusterAlertIncidentLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertIncident, err error)
+	Get(namespace, name string) (*v3.ClusterAlertIncident, error)
+}
+
+type ClusterAlertIncidentController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterAlertIncidentLister
+	AddHandler(ctx context.Context, name string, handler ClusterAlertIncidentHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertIncidentHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterAlertIncidentHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterAlertIncidentHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterAlertIncidentInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterAlertIncident) (*v3.ClusterAlertIncident, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertIncident, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertIncident, error)
+	Update(*v3.ClusterAlertIncident) (*v3.ClusterAlertIncident, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterAlertIncidentList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterAlertIncidentList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterAlertIncidentController
+	AddHandler(ctx context.Context, name string, sync ClusterAlertIncidentHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertIncidentHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterAlertIncidentLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterAlertIncidentLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterAlertIncidentHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterAlertIncidentHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterAlertIncidentLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterAlertIncidentLifecycle)
+}
+
+type clusterAlertIncidentLister struct {
+	ns         string
+	controller *clusterAlertIncidentController
+}
+
+func (l *clusterAlertIncidentLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertIncident, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAlertIncident))
+	})
+	return
+}
+
+func (l *clusterAlertIncidentLister) Get(namespace, name string) (*v3.ClusterAlertIncident, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterAlertIncidentGroupVersionKind.Group,
+			Resource: ClusterAlertIncidentGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterAlertIncident), nil
+}
+
+type clusterAlertIncidentController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterAlertIncidentController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAlertIncidentController) Lister() ClusterAlertIncidentLister {
+	return &clusterAlertIncidentLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterAlertIncidentController) AddHandler(ctx context.Context, name string, handler ClusterAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertIncident); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertIncidentController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertIncident); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertIncidentController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertIncident); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertIncidentController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertIncident); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAlertIncidentFactory struct {
+}
+
+func (c clusterAlertIncidentFactory) Object() runtime.Object {
+	return &v3.ClusterAlertIncident{}
+}
+
+func (c clusterAlertIncidentFactory) List() runtime.Object {
+	return &v3.ClusterAlertIncidentList{}
+}
+
+func (s *clusterAlertIncidentClient) Controller() ClusterAlertIncidentController {
+	genericController := controller.NewGenericController(s.ns, ClusterAlertIncidentGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterAlertIncidentGroupVersionResource, ClusterAlertIncidentGroupVersionKind.Kind, true))
+
+	return &clusterAlertIncidentController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterAlertIncidentClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterAlertIncidentController
+}
+
+func (s *clusterAlertIncidentClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterAlertIncidentClient) Create(o *v3.ClusterAlertIncident) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) Update(o *v3.ClusterAlertIncident) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) UpdateStatus(o *v3.ClusterAlertIncident) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterAlertIncidentClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAlertIncidentClient) List(opts metav1.ListOptions) (*v3.ClusterAlertIncidentList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterAlertIncidentList), err
+}
+
+func (s *clusterAlertIncidentClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterAlertIncidentList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterAlertIncidentList), err
+}
+
+func (s *clusterAlertIncidentClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterAlertIncidentClient) Patch(o *v3.ClusterAlertIncident, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterAlertIncident, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterAlertIncident), err
+}
+
+func (s *clusterAlertIncidentClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAlertIncidentClient) AddHandler(ctx context.Context, name string, sync ClusterAlertIncidentHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertIncidentHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterAlertIncidentLifecycle) {
+	sync := NewClusterAlertIncidentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterAlertIncidentLifecycle) {
+	sync := NewClusterAlertIncidentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterAlertIncidentHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterAlertIncidentHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterAlertIncidentLifecycle) {
+	sync := NewClusterAlertIncidentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertIncidentClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterAlertIncidentLifecycle) {
+	sync := NewClusterAlertIncidentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterAlertIncidentLifecycle interface {
+	Create(obj *v3.ClusterAlertIncident) (runtime.Object, error)
+	Remove(obj *v3.ClusterAlertIncident) (runtime.Object, error)
+	Updated(obj *v3.ClusterAlertIncident) (runtime.Object, error)
+}
+
+type clusterAlertIncidentLifecycleAdapter struct {
+	lifecycle ClusterAlertIncidentLifecycle
+}
+
+func (w *clusterAlertIncidentLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAlertIncidentLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAlertIncidentLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertIncidentLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertIncidentLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterAlertIncidentLifecycleAdapter(name string, clusterScoped bool, client ClusterAlertIncidentInterface, l ClusterAlertIncidentLifecycle) ClusterAlertIncidentHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterAlertIncidentGroupVersionResource)
+	}
+	adapter := &clusterAlertIncidentLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterAlertIncident) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterAlertSilenceGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterAlertSilence",
+	}
+	ClusterAlertSilenceResource = metav1.APIResource{
+		Name:         "clusteralertsilences",
+		SingularName: "clusteralertsilence",
+		Namespaced:   true,
+
+		Kind: ClusterAlertSilenceGroupVersionKind.Kind,
+	}
+
+	ClusterAlertSilenceGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clusteralertsilences",
+	}
+)
+
+func init() {
+	resource.Put(ClusterAlertSilenceGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterAlertSilence instead
+type ClusterAlertSilence = v3.ClusterAlertSilence
+
+func NewClusterAlertSilence(namespace, name string, obj v3.ClusterAlertSilence) *v3.ClusterAlertSilence {
+	obj.APIVersion, obj.Kind = ClusterAlertSilenceGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterAlertSilenceHandlerFunc func(key string, obj *v3.ClusterAlertSilence) (runtime.Object, error)
+
+type ClusterAlertSilenceChangeHandlerFunc func(obj *v3.ClusterAlertSilence) (runtime.Object, error)
+
+type ClusterAlertSilenceLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertSilence, err error)
+	Get(namespace, name string) (*v3.ClusterAlertSilence, error)
+}
+
+type ClusterAlertSilenceController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterAlertSilenceLister
+	AddHandler(ctx context.Context, name string, handler ClusterAlertSilenceHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertSilenceHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterAlertSilenceHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterAlertSilenceHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterAlertSilenceInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterAlertSilence) (*v3.ClusterAlertSilence, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertSilence, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertSilence, error)
+	Update(*v3.ClusterAlertSilence) (*v3.ClusterAlertSilence, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterAlertSilenceList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterAlertSilenceList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterAlertSilenceController
+	AddHandler(ctx context.Context, name string, sync ClusterAlertSilenceHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertSilenceHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterAlertSilenceLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterAlertSilenceLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterAlertSilenceHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterAlertSilenceHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterAlertSilenceLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterAlertSilenceLifecycle)
+}
+
+type clusterAlertSilenceLister struct {
+	ns         string
+	controller *clusterAlertSilenceController
+}
+
+func (l *clusterAlertSilenceLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertSilence, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAlertSilence))
+	})
+	return
+}
+
+func (l *clusterAlertSilenceLister) Get(namespace, name string) (*v3.ClusterAlertSilence, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterAlertSilenceGroupVersionKind.Group,
+			Resource: ClusterAlertSilenceGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterAlertSilence), nil
+}
+
+type clusterAlertSilenceController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterAlertSilenceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAlertSilenceController) Lister() ClusterAlertSilenceLister {
+	return &clusterAlertSilenceLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterAlertSilenceController) AddHandler(ctx context.Context, name string, handler ClusterAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertSilence); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertSilenceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertSilence); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertSilenceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertSilence); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertSilenceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertSilence); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAlertSilenceFactory struct {
+}
+
+func (c clusterAlertSilenceFactory) Object() runtime.Object {
+	return &v3.ClusterAlertSilence{}
+}
+
+func (c clusterAlertSilenceFactory) List() runtime.Object {
+	return &v3.ClusterAlertSilenceList{}
+}
+
+func (s *clusterAlertSilenceClient) Controller() ClusterAlertSilenceController {
+	genericController := controller.NewGenericController(s.ns, ClusterAlertSilenceGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterAlertSilenceGroupVersionResource, ClusterAlertSilenceGroupVersionKind.Kind, true))
+
+	return &clusterAlertSilenceController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterAlertSilenceClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterAlertSilenceController
+}
+
+func (s *clusterAlertSilenceClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterAlertSilenceClient) Create(o *v3.ClusterAlertSilence) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) Update(o *v3.ClusterAlertSilence) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) UpdateStatus(o *v3.ClusterAlertSilence) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterAlertSilenceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAlertSilenceClient) List(opts metav1.ListOptions) (*v3.ClusterAlertSilenceList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterAlertSilenceList), err
+}
+
+func (s *clusterAlertSilenceClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterAlertSilenceList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterAlertSilenceList), err
+}
+
+func (s *clusterAlertSilenceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterAlertSilenceClient) Patch(o *v3.ClusterAlertSilence, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterAlertSilence, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterAlertSilence), err
+}
+
+func (s *clusterAlertSilenceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAlertSilenceClient) AddHandler(ctx context.Context, name string, sync ClusterAlertSilenceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterAlertSilenceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterAlertSilenceLifecycle) {
+	sync := NewClusterAlertSilenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterAlertSilenceLifecycle) {
+	sync := NewClusterAlertSilenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterAlertSilenceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterAlertSilenceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterAlertSilenceLifecycle) {
+	sync := NewClusterAlertSilenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertSilenceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterAlertSilenceLifecycle) {
+	sync := NewClusterAlertSilenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
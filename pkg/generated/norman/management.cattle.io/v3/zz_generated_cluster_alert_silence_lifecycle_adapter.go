@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterAlertSilenceLifecycle interface {
+	Create(obj *v3.ClusterAlertSilence) (runtime.Object, error)
+	Remove(obj *v3.ClusterAlertSilence) (runtime.Object, error)
+	Updated(obj *v3.ClusterAlertSilence) (runtime.Object, error)
+}
+
+type clusterAlertSilenceLifecycleAdapter struct {
+	lifecycle ClusterAlertSilenceLifecycle
+}
+
+func (w *clusterAlertSilenceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAlertSilenceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAlertSilenceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertSilenceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertSilenceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterAlertSilenceLifecycleAdapter(name string, clusterScoped bool, client ClusterAlertSilenceInterface, l ClusterAlertSilenceLifecycle) ClusterAlertSilenceHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterAlertSilenceGroupVersionResource)
+	}
+	adapter := &clusterAlertSilenceLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterAlertSilence) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
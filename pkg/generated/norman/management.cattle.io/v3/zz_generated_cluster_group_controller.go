@@ -0,0 +1,325 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterGroupGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterGroup",
+	}
+	ClusterGroupResource = metav1.APIResource{
+		Name:         "clustergroups",
+		SingularName: "clustergroup",
+		Namespaced:   false,
+		Kind:         ClusterGroupGroupVersionKind.Kind,
+	}
+
+	ClusterGroupGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clustergroups",
+	}
+)
+
+func init() {
+	resource.Put(ClusterGroupGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterGroup instead
+type ClusterGroup = v3.ClusterGroup
+
+func NewClusterGroup(namespace, name string, obj v3.ClusterGroup) *v3.ClusterGroup {
+	obj.APIVersion, obj.Kind = ClusterGroupGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterGroupHandlerFunc func(key string, obj *v3.ClusterGroup) (runtime.Object, error)
+
+type ClusterGroupChangeHandlerFunc func(obj *v3.ClusterGroup) (runtime.Object, error)
+
+type ClusterGroupLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterGroup, err error)
+	Get(namespace, name string) (*v3.ClusterGroup, error)
+}
+
+type ClusterGroupController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterGroupLister
+	AddHandler(ctx context.Context, name string, handler ClusterGroupHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterGroupHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterGroupHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterGroupInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterGroup) (*v3.ClusterGroup, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterGroup, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterGroup, error)
+	Update(*v3.ClusterGroup) (*v3.ClusterGroup, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterGroupList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterGroupList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterGroupController
+	AddHandler(ctx context.Context, name string, sync ClusterGroupHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterGroupLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterGroupLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterGroupHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterGroupHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterGroupLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterGroupLifecycle)
+}
+
+type clusterGroupLister struct {
+	ns         string
+	controller *clusterGroupController
+}
+
+func (l *clusterGroupLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterGroup, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterGroup))
+	})
+	return
+}
+
+func (l *clusterGroupLister) Get(namespace, name string) (*v3.ClusterGroup, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterGroupGroupVersionKind.Group,
+			Resource: ClusterGroupGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterGroup), nil
+}
+
+type clusterGroupController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterGroupController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterGroupController) Lister() ClusterGroupLister {
+	return &clusterGroupLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterGroupController) AddHandler(ctx context.Context, name string, handler ClusterGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterGroupFactory struct {
+}
+
+func (c clusterGroupFactory) Object() runtime.Object {
+	return &v3.ClusterGroup{}
+}
+
+func (c clusterGroupFactory) List() runtime.Object {
+	return &v3.ClusterGroupList{}
+}
+
+func (s *clusterGroupClient) Controller() ClusterGroupController {
+	genericController := controller.NewGenericController(s.ns, ClusterGroupGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterGroupGroupVersionResource, ClusterGroupGroupVersionKind.Kind, false))
+
+	return &clusterGroupController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterGroupClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterGroupController
+}
+
+func (s *clusterGroupClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterGroupClient) Create(o *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) Update(o *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) UpdateStatus(o *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterGroupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterGroupClient) List(opts metav1.ListOptions) (*v3.ClusterGroupList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterGroupList), err
+}
+
+func (s *clusterGroupClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterGroupList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterGroupList), err
+}
+
+func (s *clusterGroupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterGroupClient) Patch(o *v3.ClusterGroup, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterGroup, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterGroup), err
+}
+
+func (s *clusterGroupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterGroupClient) AddHandler(ctx context.Context, name string, sync ClusterGroupHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterGroupClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterGroupClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterGroupLifecycle) {
+	sync := NewClusterGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterGroupClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterGroupLifecycle) {
+	sync := NewClusterGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterGroupClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterGroupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterGroupClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterGroupHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterGroupClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterGroupLifecycle) {
+	sync := NewClusterGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterGroupClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterGroupLifecycle) {
+	sync := NewClusterGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterGroupLifecycle interface {
+	Create(obj *v3.ClusterGroup) (runtime.Object, error)
+	Remove(obj *v3.ClusterGroup) (runtime.Object, error)
+	Updated(obj *v3.ClusterGroup) (runtime.Object, error)
+}
+
+type clusterGroupLifecycleAdapter struct {
+	lifecycle ClusterGroupLifecycle
+}
+
+func (w *clusterGroupLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterGroupLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterGroupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterGroupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterGroupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterGroupLifecycleAdapter(name string, clusterScoped bool, client ClusterGroupInterface, l ClusterGroupLifecycle) ClusterGroupHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterGroupGroupVersionResource)
+	}
+	adapter := &clusterGroupLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterGroup) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,325 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterGroupRoleTemplateBindingGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterGroupRoleTemplateBinding",
+	}
+	ClusterGroupRoleTemplateBindingResource = metav1.APIResource{
+		Name:         "clustergrouproletemplatebindings",
+		SingularName: "clustergrouproletemplatebinding",
+		Namespaced:   false,
+		Kind:         ClusterGroupRoleTemplateBindingGroupVersionKind.Kind,
+	}
+
+	ClusterGroupRoleTemplateBindingGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clustergrouproletemplatebindings",
+	}
+)
+
+func init() {
+	resource.Put(ClusterGroupRoleTemplateBindingGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterGroupRoleTemplateBinding instead
+type ClusterGroupRoleTemplateBinding = v3.ClusterGroupRoleTemplateBinding
+
+func NewClusterGroupRoleTemplateBinding(namespace, name string, obj v3.ClusterGroupRoleTemplateBinding) *v3.ClusterGroupRoleTemplateBinding {
+	obj.APIVersion, obj.Kind = ClusterGroupRoleTemplateBindingGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterGroupRoleTemplateBindingHandlerFunc func(key string, obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error)
+
+type ClusterGroupRoleTemplateBindingChangeHandlerFunc func(obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error)
+
+type ClusterGroupRoleTemplateBindingLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterGroupRoleTemplateBinding, err error)
+	Get(namespace, name string) (*v3.ClusterGroupRoleTemplateBinding, error)
+}
+
+type ClusterGroupRoleTemplateBindingController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterGroupRoleTemplateBindingLister
+	AddHandler(ctx context.Context, name string, handler ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterGroupRoleTemplateBindingHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterGroupRoleTemplateBindingInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterGroupRoleTemplateBinding) (*v3.ClusterGroupRoleTemplateBinding, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterGroupRoleTemplateBinding, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterGroupRoleTemplateBinding, error)
+	Update(*v3.ClusterGroupRoleTemplateBinding) (*v3.ClusterGroupRoleTemplateBinding, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterGroupRoleTemplateBindingList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterGroupRoleTemplateBindingList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterGroupRoleTemplateBindingController
+	AddHandler(ctx context.Context, name string, sync ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterGroupRoleTemplateBindingLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterGroupRoleTemplateBindingLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterGroupRoleTemplateBindingHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterGroupRoleTemplateBindingLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterGroupRoleTemplateBindingLifecycle)
+}
+
+type clusterGroupRoleTemplateBindingLister struct {
+	ns         string
+	controller *clusterGroupRoleTemplateBindingController
+}
+
+func (l *clusterGroupRoleTemplateBindingLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterGroupRoleTemplateBinding, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterGroupRoleTemplateBinding))
+	})
+	return
+}
+
+func (l *clusterGroupRoleTemplateBindingLister) Get(namespace, name string) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterGroupRoleTemplateBindingGroupVersionKind.Group,
+			Resource: ClusterGroupRoleTemplateBindingGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), nil
+}
+
+type clusterGroupRoleTemplateBindingController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterGroupRoleTemplateBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterGroupRoleTemplateBindingController) Lister() ClusterGroupRoleTemplateBindingLister {
+	return &clusterGroupRoleTemplateBindingLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterGroupRoleTemplateBindingController) AddHandler(ctx context.Context, name string, handler ClusterGroupRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroupRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupRoleTemplateBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterGroupRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroupRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupRoleTemplateBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterGroupRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroupRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterGroupRoleTemplateBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterGroupRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterGroupRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterGroupRoleTemplateBindingFactory struct {
+}
+
+func (c clusterGroupRoleTemplateBindingFactory) Object() runtime.Object {
+	return &v3.ClusterGroupRoleTemplateBinding{}
+}
+
+func (c clusterGroupRoleTemplateBindingFactory) List() runtime.Object {
+	return &v3.ClusterGroupRoleTemplateBindingList{}
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Controller() ClusterGroupRoleTemplateBindingController {
+	genericController := controller.NewGenericController(s.ns, ClusterGroupRoleTemplateBindingGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterGroupRoleTemplateBindingGroupVersionResource, ClusterGroupRoleTemplateBindingGroupVersionKind.Kind, false))
+
+	return &clusterGroupRoleTemplateBindingController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterGroupRoleTemplateBindingClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterGroupRoleTemplateBindingController
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Create(o *v3.ClusterGroupRoleTemplateBinding) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Update(o *v3.ClusterGroupRoleTemplateBinding) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) UpdateStatus(o *v3.ClusterGroupRoleTemplateBinding) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) List(opts metav1.ListOptions) (*v3.ClusterGroupRoleTemplateBindingList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterGroupRoleTemplateBindingList), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterGroupRoleTemplateBindingList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterGroupRoleTemplateBindingList), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterGroupRoleTemplateBindingClient) Patch(o *v3.ClusterGroupRoleTemplateBinding, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterGroupRoleTemplateBinding, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterGroupRoleTemplateBinding), err
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddHandler(ctx context.Context, name string, sync ClusterGroupRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterGroupRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterGroupRoleTemplateBindingLifecycle) {
+	sync := NewClusterGroupRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterGroupRoleTemplateBindingLifecycle) {
+	sync := NewClusterGroupRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterGroupRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterGroupRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterGroupRoleTemplateBindingLifecycle) {
+	sync := NewClusterGroupRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterGroupRoleTemplateBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterGroupRoleTemplateBindingLifecycle) {
+	sync := NewClusterGroupRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterGroupRoleTemplateBindingLifecycle interface {
+	Create(obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error)
+	Remove(obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error)
+	Updated(obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error)
+}
+
+type clusterGroupRoleTemplateBindingLifecycleAdapter struct {
+	lifecycle ClusterGroupRoleTemplateBindingLifecycle
+}
+
+func (w *clusterGroupRoleTemplateBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterGroupRoleTemplateBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterGroupRoleTemplateBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterGroupRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterGroupRoleTemplateBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterGroupRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterGroupRoleTemplateBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterGroupRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterGroupRoleTemplateBindingLifecycleAdapter(name string, clusterScoped bool, client ClusterGroupRoleTemplateBindingInterface, l ClusterGroupRoleTemplateBindingLifecycle) ClusterGroupRoleTemplateBindingHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterGroupRoleTemplateBindingGroupVersionResource)
+	}
+	adapter := &clusterGroupRoleTemplateBindingLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterGroupRoleTemplateBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterTemplateRolloutGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterTemplateRollout",
+	}
+	ClusterTemplateRolloutResource = metav1.APIResource{
+		Name:         "clustertemplaterollouts",
+		SingularName: "clustertemplaterollout",
+		Namespaced:   true,
+
+		Kind: ClusterTemplateRolloutGroupVersionKind.Kind,
+	}
+
+	ClusterTemplateRolloutGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clustertemplaterollouts",
+	}
+)
+
+func init() {
+	resource.Put(ClusterTemplateRolloutGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterTemplateRollout instead
+type ClusterTemplateRollout = v3.ClusterTemplateRollout
+
+func NewClusterTemplateRollout(namespace, name string, obj v3.ClusterTemplateRollout) *v3.ClusterTemplateRollout {
+	obj.APIVersion, obj.Kind = ClusterTemplateRolloutGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterTemplateRolloutHandlerFunc func(key string, obj *v3.ClusterTemplateRollout) (runtime.Object, error)
+
+type ClusterTemplateRolloutChangeHandlerFunc func(obj *v3.ClusterTemplateRollout) (runtime.Object, error)
+
+type ClusterTemplateRolloutLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterTemplateRollout, err error)
+	Get(namespace, name string) (*v3.ClusterTemplateRollout, error)
+}
+
+type ClusterTemplateRolloutController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterTemplateRolloutLister
+	AddHandler(ctx context.Context, name string, handler ClusterTemplateRolloutHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterTemplateRolloutHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterTemplateRolloutHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterTemplateRolloutHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterTemplateRolloutInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterTemplateRollout, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterTemplateRollout, error)
+	Update(*v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterTemplateRolloutList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterTemplateRolloutList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterTemplateRolloutController
+	AddHandler(ctx context.Context, name string, sync ClusterTemplateRolloutHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterTemplateRolloutHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterTemplateRolloutLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterTemplateRolloutLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterTemplateRolloutHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterTemplateRolloutHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterTemplateRolloutLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterTemplateRolloutLifecycle)
+}
+
+type clusterTemplateRolloutLister struct {
+	ns         string
+	controller *clusterTemplateRolloutController
+}
+
+func (l *clusterTemplateRolloutLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterTemplateRollout, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterTemplateRollout))
+	})
+	return
+}
+
+func (l *clusterTemplateRolloutLister) Get(namespace, name string) (*v3.ClusterTemplateRollout, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterTemplateRolloutGroupVersionKind.Group,
+			Resource: ClusterTemplateRolloutGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterTemplateRollout), nil
+}
+
+type clusterTemplateRolloutController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterTemplateRolloutController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterTemplateRolloutController) Lister() ClusterTemplateRolloutLister {
+	return &clusterTemplateRolloutLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterTemplateRolloutController) AddHandler(ctx context.Context, name string, handler ClusterTemplateRolloutHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRollout); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRolloutController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterTemplateRolloutHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRollout); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRolloutController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterTemplateRolloutHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRollout); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRolloutController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterTemplateRolloutHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRollout); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterTemplateRolloutFactory struct {
+}
+
+func (c clusterTemplateRolloutFactory) Object() runtime.Object {
+	return &v3.ClusterTemplateRollout{}
+}
+
+func (c clusterTemplateRolloutFactory) List() runtime.Object {
+	return &v3.ClusterTemplateRolloutList{}
+}
+
+func (s *clusterTemplateRolloutClient) Controller() ClusterTemplateRolloutController {
+	genericController := controller.NewGenericController(s.ns, ClusterTemplateRolloutGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterTemplateRolloutGroupVersionResource, ClusterTemplateRolloutGroupVersionKind.Kind, true))
+
+	return &clusterTemplateRolloutController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterTemplateRolloutClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterTemplateRolloutController
+}
+
+func (s *clusterTemplateRolloutClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterTemplateRolloutClient) Create(o *v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) Update(o *v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) UpdateStatus(o *v3.ClusterTemplateRollout) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterTemplateRolloutClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterTemplateRolloutClient) List(opts metav1.ListOptions) (*v3.ClusterTemplateRolloutList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterTemplateRolloutList), err
+}
+
+func (s *clusterTemplateRolloutClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterTemplateRolloutList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterTemplateRolloutList), err
+}
+
+func (s *clusterTemplateRolloutClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterTemplateRolloutClient) Patch(o *v3.ClusterTemplateRollout, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterTemplateRollout, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterTemplateRollout), err
+}
+
+func (s *clusterTemplateRolloutClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterTemplateRolloutClient) AddHandler(ctx context.Context, name string, sync ClusterTemplateRolloutHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterTemplateRolloutHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterTemplateRolloutLifecycle) {
+	sync := NewClusterTemplateRolloutLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterTemplateRolloutLifecycle) {
+	sync := NewClusterTemplateRolloutLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterTemplateRolloutHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterTemplateRolloutHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterTemplateRolloutLifecycle) {
+	sync := NewClusterTemplateRolloutLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRolloutClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterTemplateRolloutLifecycle) {
+	sync := NewClusterTemplateRolloutLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterTemplateRolloutLifecycle interface {
+	Create(obj *v3.ClusterTemplateRollout) (runtime.Object, error)
+	Remove(obj *v3.ClusterTemplateRollout) (runtime.Object, error)
+	Updated(obj *v3.ClusterTemplateRollout) (runtime.Object, error)
+}
+
+type clusterTemplateRolloutLifecycleAdapter struct {
+	lifecycle ClusterTemplateRolloutLifecycle
+}
+
+func (w *clusterTemplateRolloutLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterTemplateRolloutLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterTemplateRolloutLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterTemplateRollout))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRolloutLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterTemplateRollout))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRolloutLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterTemplateRollout))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterTemplateRolloutLifecycleAdapter(name string, clusterScoped bool, client ClusterTemplateRolloutInterface, l ClusterTemplateRolloutLifecycle) ClusterTemplateRolloutHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterTemplateRolloutGroupVersionResource)
+	}
+	adapter := &clusterTemplateRolloutLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterTemplateRollout) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterUpgradePlanGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterUpgradePlan",
+	}
+	ClusterUpgradePlanResource = metav1.APIResource{
+		Name:         "clusterupgradeplans",
+		SingularName: "clusterupgradeplan",
+		Namespaced:   true,
+
+		Kind: ClusterUpgradePlanGroupVersionKind.Kind,
+	}
+
+	ClusterUpgradePlanGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "clusterupgradeplans",
+	}
+)
+
+func init() {
+	resource.Put(ClusterUpgradePlanGroupVersionResource)
+}
+
+// Deprecated use v3.ClusterUpgradePlan instead
+type ClusterUpgradePlan = v3.ClusterUpgradePlan
+
+func NewClusterUpgradePlan(namespace, name string, obj v3.ClusterUpgradePlan) *v3.ClusterUpgradePlan {
+	obj.APIVersion, obj.Kind = ClusterUpgradePlanGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ClusterUpgradePlanHandlerFunc func(key string, obj *v3.ClusterUpgradePlan) (runtime.Object, error)
+
+type ClusterUpgradePlanChangeHandlerFunc func(obj *v3.ClusterUpgradePlan) (runtime.Object, error)
+
+type ClusterUpgradePlanLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ClusterUpgradePlan, err error)
+	Get(namespace, name string) (*v3.ClusterUpgradePlan, error)
+}
+
+type ClusterUpgradePlanController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterUpgradePlanLister
+	AddHandler(ctx context.Context, name string, handler ClusterUpgradePlanHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterUpgradePlanHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ClusterUpgradePlanHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ClusterUpgradePlanHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ClusterUpgradePlanInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterUpgradePlan, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ClusterUpgradePlan, error)
+	Update(*v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ClusterUpgradePlanList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterUpgradePlanList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterUpgradePlanController
+	AddHandler(ctx context.Context, name string, sync ClusterUpgradePlanHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterUpgradePlanHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ClusterUpgradePlanLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterUpgradePlanLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterUpgradePlanHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterUpgradePlanHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterUpgradePlanLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterUpgradePlanLifecycle)
+}
+
+type clusterUpgradePlanLister struct {
+	ns         string
+	controller *clusterUpgradePlanController
+}
+
+func (l *clusterUpgradePlanLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterUpgradePlan, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterUpgradePlan))
+	})
+	return
+}
+
+func (l *clusterUpgradePlanLister) Get(namespace, name string) (*v3.ClusterUpgradePlan, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterUpgradePlanGroupVersionKind.Group,
+			Resource: ClusterUpgradePlanGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ClusterUpgradePlan), nil
+}
+
+type clusterUpgradePlanController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *clusterUpgradePlanController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterUpgradePlanController) Lister() ClusterUpgradePlanLister {
+	return &clusterUpgradePlanLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *clusterUpgradePlanController) AddHandler(ctx context.Context, name string, handler ClusterUpgradePlanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUpgradePlan); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUpgradePlanController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ClusterUpgradePlanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUpgradePlan); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUpgradePlanController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ClusterUpgradePlanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUpgradePlan); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUpgradePlanController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ClusterUpgradePlanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUpgradePlan); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterUpgradePlanFactory struct {
+}
+
+func (c clusterUpgradePlanFactory) Object() runtime.Object {
+	return &v3.ClusterUpgradePlan{}
+}
+
+func (c clusterUpgradePlanFactory) List() runtime.Object {
+	return &v3.ClusterUpgradePlanList{}
+}
+
+func (s *clusterUpgradePlanClient) Controller() ClusterUpgradePlanController {
+	genericController := controller.NewGenericController(s.ns, ClusterUpgradePlanGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ClusterUpgradePlanGroupVersionResource, ClusterUpgradePlanGroupVersionKind.Kind, true))
+
+	return &clusterUpgradePlanController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type clusterUpgradePlanClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterUpgradePlanController
+}
+
+func (s *clusterUpgradePlanClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterUpgradePlanClient) Create(o *v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) Update(o *v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) UpdateStatus(o *v3.ClusterUpgradePlan) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterUpgradePlanClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterUpgradePlanClient) List(opts metav1.ListOptions) (*v3.ClusterUpgradePlanList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ClusterUpgradePlanList), err
+}
+
+func (s *clusterUpgradePlanClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ClusterUpgradePlanList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ClusterUpgradePlanList), err
+}
+
+func (s *clusterUpgradePlanClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterUpgradePlanClient) Patch(o *v3.ClusterUpgradePlan, patchType types.PatchType, data []byte, subresources ...string) (*v3.ClusterUpgradePlan, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ClusterUpgradePlan), err
+}
+
+func (s *clusterUpgradePlanClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterUpgradePlanClient) AddHandler(ctx context.Context, name string, sync ClusterUpgradePlanHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ClusterUpgradePlanHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddLifecycle(ctx context.Context, name string, lifecycle ClusterUpgradePlanLifecycle) {
+	sync := NewClusterUpgradePlanLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ClusterUpgradePlanLifecycle) {
+	sync := NewClusterUpgradePlanLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ClusterUpgradePlanHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ClusterUpgradePlanHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ClusterUpgradePlanLifecycle) {
+	sync := NewClusterUpgradePlanLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterUpgradePlanClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ClusterUpgradePlanLifecycle) {
+	sync := NewClusterUpgradePlanLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterUpgradePlanLifecycle interface {
+	Create(obj *v3.ClusterUpgradePlan) (runtime.Object, error)
+	Remove(obj *v3.ClusterUpgradePlan) (runtime.Object, error)
+	Updated(obj *v3.ClusterUpgradePlan) (runtime.Object, error)
+}
+
+type clusterUpgradePlanLifecycleAdapter struct {
+	lifecycle ClusterUpgradePlanLifecycle
+}
+
+func (w *clusterUpgradePlanLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterUpgradePlanLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterUpgradePlanLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterUpgradePlan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterUpgradePlanLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterUpgradePlan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterUpgradePlanLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterUpgradePlan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterUpgradePlanLifecycleAdapter(name string, clusterScoped bool, client ClusterUpgradePlanInterface, l ClusterUpgradePlanLifecycle) ClusterUpgradePlanHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ClusterUpgradePlanGroupVersionResource)
+	}
+	adapter := &clusterUpgradePlanLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ClusterUpgradePlan) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
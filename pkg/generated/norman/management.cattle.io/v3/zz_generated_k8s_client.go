@@ -49,10 +49,15 @@ type Interface interface {
 	ClusterAlertsGetter
 	ProjectAlertsGetter
 	NotifiersGetter
+	OnCallSchedulesGetter
 	ClusterAlertGroupsGetter
 	ProjectAlertGroupsGetter
 	ClusterAlertRulesGetter
+	ClusterAlertSilencesGetter
+	ClusterAlertIncidentsGetter
 	ProjectAlertRulesGetter
+	ProjectAlertSilencesGetter
+	ProjectAlertIncidentsGetter
 	ComposeConfigsGetter
 	ProjectCatalogsGetter
 	ClusterCatalogsGetter
@@ -62,6 +67,7 @@ type Interface interface {
 	GlobalDnsProvidersGetter
 	KontainerDriversGetter
 	EtcdBackupsGetter
+	ClusterUpgradePlansGetter
 	ClusterScansGetter
 	MonitorMetricsGetter
 	ClusterMonitorGraphsGetter
@@ -69,6 +75,9 @@ type Interface interface {
 	CloudCredentialsGetter
 	ClusterTemplatesGetter
 	ClusterTemplateRevisionsGetter
+	ClusterTemplateRolloutsGetter
+	ClusterGroupsGetter
+	ClusterGroupRoleTemplateBindingsGetter
 	RkeK8sSystemImagesGetter
 	RkeK8sServiceOptionsGetter
 	RkeAddonsGetter
@@ -646,6 +655,20 @@ func (c *Client) Notifiers(namespace string) NotifierInterface {
 	}
 }
 
+type OnCallSchedulesGetter interface {
+	OnCallSchedules(namespace string) OnCallScheduleInterface
+}
+
+func (c *Client) OnCallSchedules(namespace string) OnCallScheduleInterface {
+	sharedClient := c.clientFactory.ForResourceKind(OnCallScheduleGroupVersionResource, OnCallScheduleGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &OnCallScheduleResource, OnCallScheduleGroupVersionKind, onCallScheduleFactory{})
+	return &onCallScheduleClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ClusterAlertGroupsGetter interface {
 	ClusterAlertGroups(namespace string) ClusterAlertGroupInterface
 }
@@ -688,6 +711,34 @@ func (c *Client) ClusterAlertRules(namespace string) ClusterAlertRuleInterface {
 	}
 }
 
+type ClusterAlertSilencesGetter interface {
+	ClusterAlertSilences(namespace string) ClusterAlertSilenceInterface
+}
+
+func (c *Client) ClusterAlertSilences(namespace string) ClusterAlertSilenceInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterAlertSilenceGroupVersionResource, ClusterAlertSilenceGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterAlertSilenceResource, ClusterAlertSilenceGroupVersionKind, clusterAlertSilenceFactory{})
+	return &clusterAlertSilenceClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ClusterAlertIncidentsGetter interface {
+	ClusterAlertIncidents(namespace string) ClusterAlertIncidentInterface
+}
+
+func (c *Client) ClusterAlertIncidents(namespace string) ClusterAlertIncidentInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterAlertIncidentGroupVersionResource, ClusterAlertIncidentGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterAlertIncidentResource, ClusterAlertIncidentGroupVersionKind, clusterAlertIncidentFactory{})
+	return &clusterAlertIncidentClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ProjectAlertRulesGetter interface {
 	ProjectAlertRules(namespace string) ProjectAlertRuleInterface
 }
@@ -702,6 +753,34 @@ func (c *Client) ProjectAlertRules(namespace string) ProjectAlertRuleInterface {
 	}
 }
 
+type ProjectAlertSilencesGetter interface {
+	ProjectAlertSilences(namespace string) ProjectAlertSilenceInterface
+}
+
+func (c *Client) ProjectAlertSilences(namespace string) ProjectAlertSilenceInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ProjectAlertSilenceGroupVersionResource, ProjectAlertSilenceGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ProjectAlertSilenceResource, ProjectAlertSilenceGroupVersionKind, projectAlertSilenceFactory{})
+	return &projectAlertSilenceClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ProjectAlertIncidentsGetter interface {
+	ProjectAlertIncidents(namespace string) ProjectAlertIncidentInterface
+}
+
+func (c *Client) ProjectAlertIncidents(namespace string) ProjectAlertIncidentInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ProjectAlertIncidentGroupVersionResource, ProjectAlertIncidentGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ProjectAlertIncidentResource, ProjectAlertIncidentGroupVersionKind, projectAlertIncidentFactory{})
+	return &projectAlertIncidentClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ComposeConfigsGetter interface {
 	ComposeConfigs(namespace string) ComposeConfigInterface
 }
@@ -828,6 +907,20 @@ func (c *Client) EtcdBackups(namespace string) EtcdBackupInterface {
 	}
 }
 
+type ClusterUpgradePlansGetter interface {
+	ClusterUpgradePlans(namespace string) ClusterUpgradePlanInterface
+}
+
+func (c *Client) ClusterUpgradePlans(namespace string) ClusterUpgradePlanInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterUpgradePlanGroupVersionResource, ClusterUpgradePlanGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterUpgradePlanResource, ClusterUpgradePlanGroupVersionKind, clusterUpgradePlanFactory{})
+	return &clusterUpgradePlanClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ClusterScansGetter interface {
 	ClusterScans(namespace string) ClusterScanInterface
 }
@@ -926,6 +1019,48 @@ func (c *Client) ClusterTemplateRevisions(namespace string) ClusterTemplateRevis
 	}
 }
 
+type ClusterTemplateRolloutsGetter interface {
+	ClusterTemplateRollouts(namespace string) ClusterTemplateRolloutInterface
+}
+
+func (c *Client) ClusterTemplateRollouts(namespace string) ClusterTemplateRolloutInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterTemplateRolloutGroupVersionResource, ClusterTemplateRolloutGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterTemplateRolloutResource, ClusterTemplateRolloutGroupVersionKind, clusterTemplateRolloutFactory{})
+	return &clusterTemplateRolloutClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ClusterGroupsGetter interface {
+	ClusterGroups(namespace string) ClusterGroupInterface
+}
+
+func (c *Client) ClusterGroups(namespace string) ClusterGroupInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterGroupGroupVersionResource, ClusterGroupGroupVersionKind.Kind, false)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterGroupResource, ClusterGroupGroupVersionKind, clusterGroupFactory{})
+	return &clusterGroupClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ClusterGroupRoleTemplateBindingsGetter interface {
+	ClusterGroupRoleTemplateBindings(namespace string) ClusterGroupRoleTemplateBindingInterface
+}
+
+func (c *Client) ClusterGroupRoleTemplateBindings(namespace string) ClusterGroupRoleTemplateBindingInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ClusterGroupRoleTemplateBindingGroupVersionResource, ClusterGroupRoleTemplateBindingGroupVersionKind.Kind, false)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ClusterGroupRoleTemplateBindingResource, ClusterGroupRoleTemplateBindingGroupVersionKind, clusterGroupRoleTemplateBindingFactory{})
+	return &clusterGroupRoleTemplateBindingClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type RkeK8sSystemImagesGetter interface {
 	RkeK8sSystemImages(namespace string) RkeK8sSystemImageInterface
 }
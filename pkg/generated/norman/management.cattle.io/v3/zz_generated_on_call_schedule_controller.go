@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	OnCallScheduleGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "OnCallSchedule",
+	}
+	OnCallScheduleResource = metav1.APIResource{
+		Name:         "onCallSchedules",
+		SingularName: "onCallSchedule",
+		Namespaced:   true,
+
+		Kind: OnCallScheduleGroupVersionKind.Kind,
+	}
+
+	OnCallScheduleGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "onCallSchedules",
+	}
+)
+
+func init() {
+	resource.Put(OnCallScheduleGroupVersionResource)
+}
+
+// Deprecated use v3.OnCallSchedule instead
+type OnCallSchedule = v3.OnCallSchedule
+
+func NewOnCallSchedule(namespace, name string, obj v3.OnCallSchedule) *v3.OnCallSchedule {
+	obj.APIVersion, obj.Kind = OnCallScheduleGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type OnCallScheduleHandlerFunc func(key string, obj *v3.OnCallSchedule) (runtime.Object, error)
+
+type OnCallScheduleChangeHandlerFunc func(obj *v3.OnCallSchedule) (runtime.Object, error)
+
+type OnCallScheduleLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.OnCallSchedule, err error)
+	Get(namespace, name string) (*v3.OnCallSchedule, error)
+}
+
+type OnCallScheduleController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() OnCallScheduleLister
+	AddHandler(ctx context.Context, name string, handler OnCallScheduleHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync OnCallScheduleHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler OnCallScheduleHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler OnCallScheduleHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type OnCallScheduleInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.OnCallSchedule) (*v3.OnCallSchedule, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.OnCallSchedule, error)
+	Get(name string, opts metav1.GetOptions) (*v3.OnCallSchedule, error)
+	Update(*v3.OnCallSchedule) (*v3.OnCallSchedule, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.OnCallScheduleList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.OnCallScheduleList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() OnCallScheduleController
+	AddHandler(ctx context.Context, name string, sync OnCallScheduleHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync OnCallScheduleHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle OnCallScheduleLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle OnCallScheduleLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync OnCallScheduleHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync OnCallScheduleHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle OnCallScheduleLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle OnCallScheduleLifecycle)
+}
+
+type onCallScheduleLister struct {
+	ns         string
+	controller *onCallScheduleController
+}
+
+func (l *onCallScheduleLister) List(namespace string, selector labels.Selector) (ret []*v3.OnCallSchedule, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.OnCallSchedule))
+	})
+	return
+}
+
+func (l *onCallScheduleLister) Get(namespace, name string) (*v3.OnCallSchedule, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    OnCallScheduleGroupVersionKind.Group,
+			Resource: OnCallScheduleGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.OnCallSchedule), nil
+}
+
+type onCallScheduleController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *onCallScheduleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *onCallScheduleController) Lister() OnCallScheduleLister {
+	return &onCallScheduleLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *onCallScheduleController) AddHandler(ctx context.Context, name string, handler OnCallScheduleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.OnCallSchedule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *onCallScheduleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler OnCallScheduleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.OnCallSchedule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *onCallScheduleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler OnCallScheduleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.OnCallSchedule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *onCallScheduleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler OnCallScheduleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.OnCallSchedule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type onCallScheduleFactory struct {
+}
+
+func (c onCallScheduleFactory) Object() runtime.Object {
+	return &v3.OnCallSchedule{}
+}
+
+func (c onCallScheduleFactory) List() runtime.Object {
+	return &v3.OnCallScheduleList{}
+}
+
+func (s *onCallScheduleClient) Controller() OnCallScheduleController {
+	genericController := controller.NewGenericController(s.ns, OnCallScheduleGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(OnCallScheduleGroupVersionResource, OnCallScheduleGroupVersionKind.Kind, true))
+
+	return &onCallScheduleController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type onCallScheduleClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   OnCallScheduleController
+}
+
+func (s *onCallScheduleClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *onCallScheduleClient) Create(o *v3.OnCallSchedule) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) Get(name string, opts metav1.GetOptions) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) Update(o *v3.OnCallSchedule) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) UpdateStatus(o *v3.OnCallSchedule) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *onCallScheduleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *onCallScheduleClient) List(opts metav1.ListOptions) (*v3.OnCallScheduleList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.OnCallScheduleList), err
+}
+
+func (s *onCallScheduleClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.OnCallScheduleList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.OnCallScheduleList), err
+}
+
+func (s *onCallScheduleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *onCallScheduleClient) Patch(o *v3.OnCallSchedule, patchType types.PatchType, data []byte, subresources ...string) (*v3.OnCallSchedule, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.OnCallSchedule), err
+}
+
+func (s *onCallScheduleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *onCallScheduleClient) AddHandler(ctx context.Context, name string, sync OnCallScheduleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *onCallScheduleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync OnCallScheduleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *onCallScheduleClient) AddLifecycle(ctx context.Context, name string, lifecycle OnCallScheduleLifecycle) {
+	sync := NewOnCallScheduleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *onCallScheduleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle OnCallScheduleLifecycle) {
+	sync := NewOnCallScheduleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *onCallScheduleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync OnCallScheduleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *onCallScheduleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync OnCallScheduleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *onCallScheduleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle OnCallScheduleLifecycle) {
+	sync := NewOnCallScheduleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *onCallScheduleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle OnCallScheduleLifecycle) {
+	sync := NewOnCallScheduleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
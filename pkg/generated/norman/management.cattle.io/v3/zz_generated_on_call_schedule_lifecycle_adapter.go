@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type OnCallScheduleLifecycle interface {
+	Create(obj *v3.OnCallSchedule) (runtime.Object, error)
+	Remove(obj *v3.OnCallSchedule) (runtime.Object, error)
+	Updated(obj *v3.OnCallSchedule) (runtime.Object, error)
+}
+
+type onCallScheduleLifecycleAdapter struct {
+	lifecycle OnCallScheduleLifecycle
+}
+
+func (w *onCallScheduleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *onCallScheduleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *onCallScheduleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.OnCallSchedule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *onCallScheduleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.OnCallSchedule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *onCallScheduleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.OnCallSchedule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewOnCallScheduleLifecycleAdapter(name string, clusterScoped bool, client OnCallScheduleInterface, l OnCallScheduleLifecycle) OnCallScheduleHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(OnCallScheduleGroupVersionResource)
+	}
+	adapter := &onCallScheduleLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.OnCallSchedule) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
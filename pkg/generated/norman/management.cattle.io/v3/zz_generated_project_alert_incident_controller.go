@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ProjectAlertIncidentGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ProjectAlertIncident",
+	}
+	ProjectAlertIncidentResource = metav1.APIResource{
+		Name:         "projectalertincidents",
+		SingularName: "projectalertincident",
+		Namespaced:   true,
+
+		Kind: ProjectAlertIncidentGroupVersionKind.Kind,
+	}
+
+	ProjectAlertIncidentGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "projectalertincidents",
+	}
+)
+
+func init() {
+	resource.Put(ProjectAlertIncidentGroupVersionResource)
+}
+
+// Deprecated use v3.ProjectAlertIncident instead
+type ProjectAlertIncident = v3.ProjectAlertIncident
+
+func NewProjectAlertIncident(namespace, name string, obj v3.ProjectAlertIncident) *v3.ProjectAlertIncident {
+	obj.APIVersion, obj.Kind = ProjectAlertIncidentGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ProjectAlertIncidentHandlerFunc func(key string, obj *v3.ProjectAlertIncident) (runtime.Object, error)
+
+type ProjectAlertIncidentChangeHandlerFunc func(obj *v3.ProjectAlertIncident) (runtime.Object, error)
+
+type ProjectAlertIncidentLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertIncident, err error)
+	Get(namespace, name string) (*v3.ProjectAlertIncident, error)
+}
+
+type ProjectAlertIncidentController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ProjectAlertIncidentLister
+	AddHandler(ctx context.Context, name string, handler ProjectAlertIncidentHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertIncidentHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ProjectAlertIncidentHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ProjectAlertIncidentHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ProjectAlertIncidentInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ProjectAlertIncident) (*v3.ProjectAlertIncident, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertIncident, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertIncident, error)
+	Update(*v3.ProjectAlertIncident) (*v3.ProjectAlertIncident, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ProjectAlertIncidentList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectAlertIncidentList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ProjectAlertIncidentController
+	AddHandler(ctx context.Context, name string, sync ProjectAlertIncidentHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertIncidentHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ProjectAlertIncidentLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectAlertIncidentLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectAlertIncidentHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectAlertIncidentHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectAlertIncidentLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectAlertIncidentLifecycle)
+}
+
+type projectAlertIncidentLister struct {
+	ns         string
+	controller *projectAlertIncidentController
+}
+
+func (l *projectAlertIncidentLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertIncident, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectAlertIncident))
+	})
+	return
+}
+
+func (l *projectAlertIncidentLister) Get(namespace, name string) (*v3.ProjectAlertIncident, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ProjectAlertIncidentGroupVersionKind.Group,
+			Resource: ProjectAlertIncidentGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ProjectAlertIncident), nil
+}
+
+type projectAlertIncidentController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *projectAlertIncidentController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectAlertIncidentController) Lister() ProjectAlertIncidentLister {
+	return &projectAlertIncidentLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *projectAlertIncidentController) AddHandler(ctx context.Context, name string, handler ProjectAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertIncident); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertIncidentController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ProjectAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertIncident); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertIncidentController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ProjectAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertIncident); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertIncidentController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ProjectAlertIncidentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertIncident); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectAlertIncidentFactory struct {
+}
+
+func (c projectAlertIncidentFactory) Object() runtime.Object {
+	return &v3.ProjectAlertIncident{}
+}
+
+func (c projectAlertIncidentFactory) List() runtime.Object {
+	return &v3.ProjectAlertIncidentList{}
+}
+
+func (s *projectAlertIncidentClient) Controller() ProjectAlertIncidentController {
+	genericController := controller.NewGenericController(s.ns, ProjectAlertIncidentGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ProjectAlertIncidentGroupVersionResource, ProjectAlertIncidentGroupVersionKind.Kind, true))
+
+	return &projectAlertIncidentController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type projectAlertIncidentClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ProjectAlertIncidentController
+}
+
+func (s *projectAlertIncidentClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *projectAlertIncidentClient) Create(o *v3.ProjectAlertIncident) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) Update(o *v3.ProjectAlertIncident) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) UpdateStatus(o *v3.ProjectAlertIncident) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *projectAlertIncidentClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectAlertIncidentClient) List(opts metav1.ListOptions) (*v3.ProjectAlertIncidentList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ProjectAlertIncidentList), err
+}
+
+func (s *projectAlertIncidentClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectAlertIncidentList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ProjectAlertIncidentList), err
+}
+
+func (s *projectAlertIncidentClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *projectAlertIncidentClient) Patch(o *v3.ProjectAlertIncident, patchType types.PatchType, data []byte, subresources ...string) (*v3.ProjectAlertIncident, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ProjectAlertIncident), err
+}
+
+func (s *projectAlertIncidentClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectAlertIncidentClient) AddHandler(ctx context.Context, name string, sync ProjectAlertIncidentHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertIncidentClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertIncidentHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertIncidentClient) AddLifecycle(ctx context.Context, name string, lifecycle ProjectAlertIncidentLifecycle) {
+	sync := NewProjectAlertIncidentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertIncidentClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectAlertIncidentLifecycle) {
+	sync := NewProjectAlertIncidentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertIncidentClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectAlertIncidentHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertIncidentClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectAlertIncidentHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectAlertIncidentClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectAlertIncidentLifecycle) {
+	sync := NewProjectAlertIncidentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertIncidentClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectAlertIncidentLifecycle) {
+	sync := NewProjectAlertIncidentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
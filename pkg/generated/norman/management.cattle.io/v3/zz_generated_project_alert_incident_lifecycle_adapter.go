@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ProjectAlertIncidentLifecycle interface {
+	Create(obj *v3.ProjectAlertIncident) (runtime.Object, error)
+	Remove(obj *v3.ProjectAlertIncident) (runtime.Object, error)
+	Updated(obj *v3.ProjectAlertIncident) (runtime.Object, error)
+}
+
+type projectAlertIncidentLifecycleAdapter struct {
+	lifecycle ProjectAlertIncidentLifecycle
+}
+
+func (w *projectAlertIncidentLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectAlertIncidentLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectAlertIncidentLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertIncidentLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertIncidentLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectAlertIncident))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewProjectAlertIncidentLifecycleAdapter(name string, clusterScoped bool, client ProjectAlertIncidentInterface, l ProjectAlertIncidentLifecycle) ProjectAlertIncidentHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ProjectAlertIncidentGroupVersionResource)
+	}
+	adapter := &projectAlertIncidentLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ProjectAlertIncident) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ProjectAlertSilenceGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ProjectAlertSilence",
+	}
+	ProjectAlertSilenceResource = metav1.APIResource{
+		Name:         "projectalertsilences",
+		SingularName: "projectalertsilence",
+		Namespaced:   true,
+
+		Kind: ProjectAlertSilenceGroupVersionKind.Kind,
+	}
+
+	ProjectAlertSilenceGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "projectalertsilences",
+	}
+)
+
+func init() {
+	resource.Put(ProjectAlertSilenceGroupVersionResource)
+}
+
+// Deprecated use v3.ProjectAlertSilence instead
+type ProjectAlertSilence = v3.ProjectAlertSilence
+
+func NewProjectAlertSilence(namespace, name string, obj v3.ProjectAlertSilence) *v3.ProjectAlertSilence {
+	obj.APIVersion, obj.Kind = ProjectAlertSilenceGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ProjectAlertSilenceHandlerFunc func(key string, obj *v3.ProjectAlertSilence) (runtime.Object, error)
+
+type ProjectAlertSilenceChangeHandlerFunc func(obj *v3.ProjectAlertSilence) (runtime.Object, error)
+
+type ProjectAlertSilenceLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertSilence, err error)
+	Get(namespace, name string) (*v3.ProjectAlertSilence, error)
+}
+
+type ProjectAlertSilenceController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ProjectAlertSilenceLister
+	AddHandler(ctx context.Context, name string, handler ProjectAlertSilenceHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertSilenceHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ProjectAlertSilenceHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ProjectAlertSilenceHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ProjectAlertSilenceInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ProjectAlertSilence) (*v3.ProjectAlertSilence, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertSilence, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertSilence, error)
+	Update(*v3.ProjectAlertSilence) (*v3.ProjectAlertSilence, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ProjectAlertSilenceList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectAlertSilenceList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ProjectAlertSilenceController
+	AddHandler(ctx context.Context, name string, sync ProjectAlertSilenceHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertSilenceHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ProjectAlertSilenceLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectAlertSilenceLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectAlertSilenceHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectAlertSilenceHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectAlertSilenceLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectAlertSilenceLifecycle)
+}
+
+type projectAlertSilenceLister struct {
+	ns         string
+	controller *projectAlertSilenceController
+}
+
+func (l *projectAlertSilenceLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertSilence, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectAlertSilence))
+	})
+	return
+}
+
+func (l *projectAlertSilenceLister) Get(namespace, name string) (*v3.ProjectAlertSilence, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ProjectAlertSilenceGroupVersionKind.Group,
+			Resource: ProjectAlertSilenceGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ProjectAlertSilence), nil
+}
+
+type projectAlertSilenceController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *projectAlertSilenceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectAlertSilenceController) Lister() ProjectAlertSilenceLister {
+	return &projectAlertSilenceLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *projectAlertSilenceController) AddHandler(ctx context.Context, name string, handler ProjectAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertSilence); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertSilenceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ProjectAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertSilence); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertSilenceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ProjectAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertSilence); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertSilenceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ProjectAlertSilenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertSilence); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectAlertSilenceFactory struct {
+}
+
+func (c projectAlertSilenceFactory) Object() runtime.Object {
+	return &v3.ProjectAlertSilence{}
+}
+
+func (c projectAlertSilenceFactory) List() runtime.Object {
+	return &v3.ProjectAlertSilenceList{}
+}
+
+func (s *projectAlertSilenceClient) Controller() ProjectAlertSilenceController {
+	genericController := controller.NewGenericController(s.ns, ProjectAlertSilenceGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ProjectAlertSilenceGroupVersionResource, ProjectAlertSilenceGroupVersionKind.Kind, true))
+
+	return &projectAlertSilenceController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type projectAlertSilenceClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ProjectAlertSilenceController
+}
+
+func (s *projectAlertSilenceClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *projectAlertSilenceClient) Create(o *v3.ProjectAlertSilence) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) Update(o *v3.ProjectAlertSilence) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) UpdateStatus(o *v3.ProjectAlertSilence) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *projectAlertSilenceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectAlertSilenceClient) List(opts metav1.ListOptions) (*v3.ProjectAlertSilenceList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ProjectAlertSilenceList), err
+}
+
+func (s *projectAlertSilenceClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectAlertSilenceList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ProjectAlertSilenceList), err
+}
+
+func (s *projectAlertSilenceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *projectAlertSilenceClient) Patch(o *v3.ProjectAlertSilence, patchType types.PatchType, data []byte, subresources ...string) (*v3.ProjectAlertSilence, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ProjectAlertSilence), err
+}
+
+func (s *projectAlertSilenceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectAlertSilenceClient) AddHandler(ctx context.Context, name string, sync ProjectAlertSilenceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertSilenceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectAlertSilenceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertSilenceClient) AddLifecycle(ctx context.Context, name string, lifecycle ProjectAlertSilenceLifecycle) {
+	sync := NewProjectAlertSilenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertSilenceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectAlertSilenceLifecycle) {
+	sync := NewProjectAlertSilenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertSilenceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectAlertSilenceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertSilenceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectAlertSilenceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectAlertSilenceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectAlertSilenceLifecycle) {
+	sync := NewProjectAlertSilenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertSilenceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectAlertSilenceLifecycle) {
+	sync := NewProjectAlertSilenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
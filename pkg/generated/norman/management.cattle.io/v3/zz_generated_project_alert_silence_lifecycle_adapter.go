@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ProjectAlertSilenceLifecycle interface {
+	Create(obj *v3.ProjectAlertSilence) (runtime.Object, error)
+	Remove(obj *v3.ProjectAlertSilence) (runtime.Object, error)
+	Updated(obj *v3.ProjectAlertSilence) (runtime.Object, error)
+}
+
+type projectAlertSilenceLifecycleAdapter struct {
+	lifecycle ProjectAlertSilenceLifecycle
+}
+
+func (w *projectAlertSilenceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectAlertSilenceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectAlertSilenceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertSilenceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertSilenceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectAlertSilence))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewProjectAlertSilenceLifecycleAdapter(name string, clusterScoped bool, client ProjectAlertSilenceInterface, l ProjectAlertSilenceLifecycle) ProjectAlertSilenceHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ProjectAlertSilenceGroupVersionResource)
+	}
+	adapter := &projectAlertSilenceLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ProjectAlertSilence) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,204 @@
+package doks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const DefaultBaseURL = "https://api.digitalocean.com"
+
+// Client is a minimal client for the DigitalOcean Kubernetes API
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+type APIError struct {
+	StatusCode int
+	ID         string `json:"id"`
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("digitalocean api returned %d: %s", e.StatusCode, e.Message)
+}
+
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+type Cluster struct {
+	ID           string        `json:"id,omitempty"`
+	Name         string        `json:"name"`
+	Region       string        `json:"region"`
+	Version      string        `json:"version"`
+	VPCUUID      string        `json:"vpc_uuid,omitempty"`
+	Endpoint     string        `json:"endpoint,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	NodePools    []NodePool    `json:"node_pools"`
+	AutoUpgrade  bool          `json:"auto_upgrade"`
+	SurgeUpgrade bool          `json:"surge_upgrade"`
+	Status       ClusterStatus `json:"status,omitempty"`
+}
+
+type ClusterStatus struct {
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type NodePool struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Size      string   `json:"size,omitempty"`
+	Count     int64    `json:"count"`
+	AutoScale bool     `json:"auto_scale"`
+	MinNodes  int64    `json:"min_nodes,omitempty"`
+	MaxNodes  int64    `json:"max_nodes,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+}
+
+type Options struct {
+	Regions  []Option  `json:"regions"`
+	Versions []Version `json:"versions"`
+	Sizes    []Option  `json:"sizes"`
+}
+
+type Option struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type Version struct {
+	Slug              string `json:"slug"`
+	KubernetesVersion string `json:"kubernetes_version"`
+}
+
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *Client) CreateCluster(ctx context.Context, cluster *Cluster) (*Cluster, error) {
+	var resp struct {
+		Cluster *Cluster `json:"kubernetes_cluster"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/v2/kubernetes/clusters", cluster, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Cluster, nil
+}
+
+func (c *Client) GetCluster(ctx context.Context, id string) (*Cluster, error) {
+	var resp struct {
+		Cluster *Cluster `json:"kubernetes_cluster"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v2/kubernetes/clusters/"+id, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Cluster, nil
+}
+
+func (c *Client) DeleteCluster(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v2/kubernetes/clusters/"+id, nil, nil)
+}
+
+func (c *Client) UpgradeCluster(ctx context.Context, id, version string) error {
+	body := map[string]string{"version": version}
+	return c.do(ctx, http.MethodPost, "/v2/kubernetes/clusters/"+id+"/upgrade", body, nil)
+}
+
+func (c *Client) UpdateNodePool(ctx context.Context, clusterID string, pool *NodePool) (*NodePool, error) {
+	var resp struct {
+		NodePool *NodePool `json:"node_pool"`
+	}
+	path := fmt.Sprintf("/v2/kubernetes/clusters/%s/node_pools/%s", clusterID, pool.ID)
+	if err := c.do(ctx, http.MethodPut, path, pool, &resp); err != nil {
+		return nil, err
+	}
+	return resp.NodePool, nil
+}
+
+// GetKubeconfig returns the raw kubeconfig yaml of a cluster
+func (c *Client) GetKubeconfig(ctx context.Context, id string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.do(ctx, http.MethodGet, "/v2/kubernetes/clusters/"+id+"/kubeconfig", nil, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GetOptions lists the regions, versions and node sizes available for DOKS clusters
+func (c *Client) GetOptions(ctx context.Context) (*Options, error) {
+	var resp struct {
+		Options *Options `json:"options"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v2/kubernetes/options", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Options, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(data, apiErr); err != nil || apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+		return apiErr
+	}
+
+	switch o := out.(type) {
+	case nil:
+		return nil
+	case *bytes.Buffer:
+		_, err = o.Write(data)
+		return err
+	default:
+		return json.Unmarshal(data, out)
+	}
+}
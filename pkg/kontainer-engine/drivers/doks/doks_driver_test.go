@@ -0,0 +1,291 @@
+package doks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/kontainer-engine/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clusterPath = "/v2/kubernetes/clusters/bd5f5959-5e1e-4205-a714-a914373942af"
+	poolPath    = clusterPath + "/node_pools/cdda885e-7663-40c8-bc74-3a036c66545d"
+)
+
+type fixture struct {
+	status int
+	file   string
+}
+
+// fixtureServer replays recorded DigitalOcean API responses and records the request bodies it receives
+type fixtureServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	fixtures map[string][]fixture
+	requests map[string][]map[string]interface{}
+}
+
+func newFixtureServer(t *testing.T, fixtures map[string][]fixture) *fixtureServer {
+	s := &fixtureServer{
+		fixtures: fixtures,
+		requests: map[string][]map[string]interface{}{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		key := req.Method + " " + req.URL.Path
+		body := map[string]interface{}{}
+		if data, _ := ioutil.ReadAll(req.Body); len(data) > 0 {
+			assert.NoError(t, json.Unmarshal(data, &body))
+		}
+		s.requests[key] = append(s.requests[key], body)
+
+		responses := s.fixtures[key]
+		if len(responses) == 0 {
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		response := responses[0]
+		// the last response is repeated for every further request
+		if len(responses) > 1 {
+			s.fixtures[key] = responses[1:]
+		}
+
+		w.WriteHeader(response.status)
+		if response.file != "" {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", response.file))
+			assert.NoError(t, err)
+			w.Write(data)
+		}
+	}))
+	return s
+}
+
+func newTestDriver(server *fixtureServer) *Driver {
+	d := NewDriver().(*Driver)
+	d.baseURL = server.URL
+	d.pollInterval = 0
+	return d
+}
+
+func testDriverOptions() *types.DriverOptions {
+	return &types.DriverOptions{
+		StringOptions: map[string]string{
+			"name":              "c-7xk2p",
+			"displayName":       "production",
+			"accessToken":       "test-token",
+			"region":            "nyc1",
+			"kubernetesVersion": "1.19.3-do.2",
+			"nodeSize":          "s-2vcpu-4gb",
+		},
+		IntOptions: map[string]int64{
+			"nodeCount": 3,
+		},
+		BoolOptions: map[string]bool{
+			"surgeUpgrade": true,
+		},
+		StringSliceOptions: map[string]*types.StringSlice{
+			"tags": {Value: []string{"rancher"}},
+		},
+	}
+}
+
+func testClusterInfo(t *testing.T) *types.ClusterInfo {
+	state, err := getStateFromOpts(testDriverOptions())
+	require.NoError(t, err)
+	state.ClusterID = "bd5f5959-5e1e-4205-a714-a914373942af"
+	state.NodePoolID = "cdda885e-7663-40c8-bc74-3a036c66545d"
+
+	info := &types.ClusterInfo{}
+	require.NoError(t, storeState(info, state))
+	return info
+}
+
+func TestGetStateFromOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*types.DriverOptions)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(*types.DriverOptions) {},
+		},
+		{
+			name:    "missing token",
+			modify:  func(o *types.DriverOptions) { delete(o.StringOptions, "accessToken") },
+			wantErr: "access token is required",
+		},
+		{
+			name:    "missing region",
+			modify:  func(o *types.DriverOptions) { delete(o.StringOptions, "region") },
+			wantErr: "region is required",
+		},
+		{
+			name: "autoscaling without bounds",
+			modify: func(o *types.DriverOptions) {
+				o.BoolOptions["autoScale"] = true
+				o.IntOptions["minNodes"] = 3
+				o.IntOptions["maxNodes"] = 2
+			},
+			wantErr: "minNodes must be >= 1 and <= maxNodes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testDriverOptions()
+			tt.modify(opts)
+			_, err := getStateFromOpts(opts)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"POST /v2/kubernetes/clusters": {{http.StatusCreated, "cluster_provisioning.json"}},
+		"GET " + clusterPath:           {{http.StatusOK, "cluster_provisioning.json"}, {http.StatusOK, "cluster_running.json"}},
+	})
+	defer server.Close()
+
+	info, err := newTestDriver(server).Create(context.Background(), testDriverOptions(), nil)
+	require.NoError(t, err)
+
+	state, err := getState(info)
+	require.NoError(t, err)
+	assert.Equal(t, "bd5f5959-5e1e-4205-a714-a914373942af", state.ClusterID)
+	assert.Equal(t, "cdda885e-7663-40c8-bc74-3a036c66545d", state.NodePoolID)
+	assert.Len(t, server.requests["GET "+clusterPath], 2)
+
+	request := server.requests["POST /v2/kubernetes/clusters"][0]
+	assert.Equal(t, "c-7xk2p", request["name"])
+	assert.Equal(t, "nyc1", request["region"])
+	assert.Equal(t, "1.19.3-do.2", request["version"])
+	assert.Equal(t, true, request["surge_upgrade"])
+	pool := request["node_pools"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "s-2vcpu-4gb", pool["size"])
+	assert.Equal(t, float64(3), pool["count"])
+	assert.Equal(t, false, pool["auto_scale"])
+}
+
+func TestCreateResumesInterruptedCreate(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"GET " + clusterPath: {{http.StatusOK, "cluster_running.json"}},
+	})
+	defer server.Close()
+
+	info, err := newTestDriver(server).Create(context.Background(), testDriverOptions(), testClusterInfo(t))
+	require.NoError(t, err)
+	assert.Equal(t, "bd5f5959-5e1e-4205-a714-a914373942af", info.Metadata["cluster-id"])
+	assert.Empty(t, server.requests["POST /v2/kubernetes/clusters"])
+}
+
+func TestUpdate(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"POST " + clusterPath + "/upgrade": {{http.StatusAccepted, ""}},
+		"PUT " + poolPath:                  {{http.StatusAccepted, "node_pool.json"}},
+		"GET " + clusterPath:               {{http.StatusOK, "cluster_running.json"}},
+	})
+	defer server.Close()
+
+	opts := testDriverOptions()
+	opts.StringOptions["kubernetesVersion"] = "1.19.3-do.3"
+	opts.IntOptions["nodeCount"] = 5
+
+	info, err := newTestDriver(server).Update(context.Background(), testClusterInfo(t), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.19.3-do.3", server.requests["POST "+clusterPath+"/upgrade"][0]["version"])
+	assert.Equal(t, float64(5), server.requests["PUT "+poolPath][0]["count"])
+
+	state, err := getState(info)
+	require.NoError(t, err)
+	assert.Equal(t, "1.19.3-do.3", state.KubernetesVersion)
+	assert.Equal(t, int64(5), state.NodeCount)
+}
+
+func TestVersionAndClusterSize(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"POST " + clusterPath + "/upgrade": {{http.StatusAccepted, ""}},
+		"PUT " + poolPath:                  {{http.StatusAccepted, "node_pool.json"}},
+		"GET " + clusterPath:               {{http.StatusOK, "cluster_running.json"}},
+	})
+	defer server.Close()
+
+	d := newTestDriver(server)
+	ctx := context.Background()
+	info := testClusterInfo(t)
+
+	version, err := d.GetVersion(ctx, info)
+	require.NoError(t, err)
+	assert.Equal(t, "1.19.3-do.2", version.Version)
+
+	size, err := d.GetClusterSize(ctx, info)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), size.Count)
+
+	require.NoError(t, d.SetVersion(ctx, info, &types.KubernetesVersion{Version: "1.19.3-do.3"}))
+	assert.Equal(t, "1.19.3-do.3", server.requests["POST "+clusterPath+"/upgrade"][0]["version"])
+
+	require.NoError(t, d.SetClusterSize(ctx, info, &types.NodeCount{Count: 5}))
+	request := server.requests["PUT "+poolPath][0]
+	assert.Equal(t, float64(5), request["count"])
+	assert.Equal(t, "rancher-pool", request["name"])
+}
+
+func TestRemove(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"DELETE " + clusterPath: {{http.StatusNoContent, ""}, {http.StatusNotFound, "not_found.json"}},
+	})
+	defer server.Close()
+
+	d := newTestDriver(server)
+	info := testClusterInfo(t)
+
+	assert.NoError(t, d.Remove(context.Background(), info))
+	// removing a cluster that is already gone succeeds
+	assert.NoError(t, d.Remove(context.Background(), info))
+}
+
+func TestGetRestConfig(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"GET " + clusterPath + "/kubeconfig": {{http.StatusOK, "kubeconfig.yaml"}},
+	})
+	defer server.Close()
+
+	state, err := getState(testClusterInfo(t))
+	require.NoError(t, err)
+
+	d := newTestDriver(server)
+	config, err := d.getRestConfig(context.Background(), d.getClient(state), state)
+	require.NoError(t, err)
+	assert.Equal(t, "https://bd5f5959-5e1e-4205-a714-a914373942af.k8s.ondigitalocean.com", config.Host)
+	assert.Equal(t, []byte("test-ca"), config.CAData)
+	assert.NotEmpty(t, config.BearerToken)
+}
+
+func TestGetCapabilities(t *testing.T) {
+	capabilities, err := NewDriver().GetCapabilities(context.Background())
+	require.NoError(t, err)
+	assert.True(t, capabilities.HasGetVersionCapability())
+	assert.True(t, capabilities.HasSetVersionCapability())
+	assert.True(t, capabilities.HasGetClusterSizeCapability())
+	assert.True(t, capabilities.HasSetClusterSizeCapability())
+	assert.False(t, capabilities.HasEtcdBackupCapability())
+}
@@ -0,0 +1,267 @@
+package lke
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const DefaultBaseURL = "https://api.linode.com"
+
+// Client is a minimal client for the Linode Kubernetes Engine API
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+type APIError struct {
+	StatusCode int
+	Errors     []ErrorReason `json:"errors"`
+}
+
+type ErrorReason struct {
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func (e *APIError) Error() string {
+	var reasons []string
+	for _, err := range e.Errors {
+		if err.Field != "" {
+			reasons = append(reasons, err.Field+": "+err.Reason)
+		} else {
+			reasons = append(reasons, err.Reason)
+		}
+	}
+	return fmt.Sprintf("linode api returned %d: %s", e.StatusCode, strings.Join(reasons, ", "))
+}
+
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsUnavailable is true while a freshly created cluster does not serve its kubeconfig yet
+func IsUnavailable(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusServiceUnavailable
+}
+
+type Cluster struct {
+	ID         int64      `json:"id,omitempty"`
+	Label      string     `json:"label"`
+	Region     string     `json:"region"`
+	K8sVersion string     `json:"k8s_version"`
+	Status     string     `json:"status,omitempty"`
+	Tags       []string   `json:"tags,omitempty"`
+	NodePools  []NodePool `json:"node_pools,omitempty"`
+}
+
+type NodePool struct {
+	ID         int64       `json:"id,omitempty"`
+	Type       string      `json:"type,omitempty"`
+	Count      int64       `json:"count"`
+	Autoscaler *Autoscaler `json:"autoscaler,omitempty"`
+	Nodes      []Node      `json:"nodes,omitempty"`
+}
+
+type Autoscaler struct {
+	Enabled bool  `json:"enabled"`
+	Min     int64 `json:"min,omitempty"`
+	Max     int64 `json:"max,omitempty"`
+}
+
+type Node struct {
+	ID         string `json:"id"`
+	InstanceID int64  `json:"instance_id"`
+	Status     string `json:"status"`
+}
+
+type Version struct {
+	ID string `json:"id"`
+}
+
+type Region struct {
+	ID           string   `json:"id"`
+	Country      string   `json:"country"`
+	Capabilities []string `json:"capabilities"`
+	Status       string   `json:"status"`
+}
+
+type Type struct {
+	ID       string `json:"id"`
+	Label    string `json:"label"`
+	Class    string `json:"class"`
+	VCPUs    int64  `json:"vcpus"`
+	Memory   int64  `json:"memory"`
+	Disk     int64  `json:"disk"`
+	Transfer int64  `json:"transfer"`
+}
+
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *Client) CreateCluster(ctx context.Context, cluster *Cluster) (*Cluster, error) {
+	result := &Cluster{}
+	if err := c.do(ctx, http.MethodPost, "/v4/lke/clusters", cluster, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetCluster(ctx context.Context, id int64) (*Cluster, error) {
+	result := &Cluster{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v4/lke/clusters/%d", id), nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) UpdateClusterVersion(ctx context.Context, id int64, version string) (*Cluster, error) {
+	result := &Cluster{}
+	body := map[string]string{"k8s_version": version}
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/v4/lke/clusters/%d", id), body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RecycleCluster replaces all nodes of the cluster, which rolls them onto the cluster's kubernetes version
+func (c *Client) RecycleCluster(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/v4/lke/clusters/%d/recycle", id), struct{}{}, nil)
+}
+
+func (c *Client) DeleteCluster(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/v4/lke/clusters/%d", id), nil, nil)
+}
+
+func (c *Client) ListNodePools(ctx context.Context, clusterID int64) ([]NodePool, error) {
+	var resp struct {
+		Data []NodePool `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v4/lke/clusters/%d/pools", clusterID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) UpdateNodePool(ctx context.Context, clusterID int64, pool *NodePool) (*NodePool, error) {
+	result := &NodePool{}
+	body := &NodePool{Count: pool.Count, Autoscaler: pool.Autoscaler}
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/v4/lke/clusters/%d/pools/%d", clusterID, pool.ID), body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetKubeconfig returns the decoded kubeconfig yaml of a cluster
+func (c *Client) GetKubeconfig(ctx context.Context, id int64) ([]byte, error) {
+	var resp struct {
+		Kubeconfig []byte `json:"kubeconfig"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v4/lke/clusters/%d/kubeconfig", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Kubeconfig, nil
+}
+
+func (c *Client) ListVersions(ctx context.Context) ([]Version, error) {
+	var resp struct {
+		Data []Version `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v4/lke/versions", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ListRegions returns the regions LKE clusters can be created in
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	var resp struct {
+		Data []Region `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v4/regions", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	var regions []Region
+	for _, region := range resp.Data {
+		for _, capability := range region.Capabilities {
+			if capability == "Kubernetes" {
+				regions = append(regions, region)
+				break
+			}
+		}
+	}
+	return regions, nil
+}
+
+func (c *Client) ListTypes(ctx context.Context) ([]Type, error) {
+	var resp struct {
+		Data []Type `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v4/linode/types", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(data, apiErr); err != nil || len(apiErr.Errors) == 0 {
+			apiErr.Errors = []ErrorReason{{Reason: strings.TrimSpace(string(data))}}
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,613 @@
+package lke
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/options"
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/util"
+	"github.com/rancher/rancher/pkg/kontainer-engine/types"
+	"github.com/rancher/rke/log"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	nodeReadyStatus = "ready"
+	pollInterval    = 10 * time.Second
+)
+
+// Driver defines the struct of the Linode Kubernetes Engine driver
+type Driver struct {
+	driverCapabilities types.Capabilities
+	baseURL            string
+	pollInterval       time.Duration
+}
+
+type state struct {
+	// The label of the cluster in Linode
+	Name string
+	// The displayed name of the cluster
+	DisplayName string
+	// The personal access token used to access the Linode API
+	AccessToken string
+	// The region to launch the cluster in
+	Region string
+	// The LKE kubernetes version, e.g. 1.19
+	KubernetesVersion string
+	// Tags applied to the cluster
+	Tags []string
+
+	// The Linode type of the nodes
+	NodeType string
+	// The number of nodes in the node pool
+	NodeCount int64
+	// Enable the cluster autoscaler for the node pool
+	AutoScale bool
+	MinNodes  int64
+	MaxNodes  int64
+
+	// Set once the cluster is created
+	ClusterID  int64
+	NodePoolID int64
+}
+
+func NewDriver() types.Driver {
+	driver := &Driver{
+		driverCapabilities: types.Capabilities{
+			Capabilities: make(map[int64]bool),
+		},
+		baseURL:      DefaultBaseURL,
+		pollInterval: pollInterval,
+	}
+
+	driver.driverCapabilities.AddCapability(types.GetVersionCapability)
+	driver.driverCapabilities.AddCapability(types.SetVersionCapability)
+	driver.driverCapabilities.AddCapability(types.GetClusterSizeCapability)
+	driver.driverCapabilities.AddCapability(types.SetClusterSizeCapability)
+
+	return driver
+}
+
+// GetDriverCreateOptions implements driver interface
+func (d *Driver) GetDriverCreateOptions(ctx context.Context) (*types.DriverFlags, error) {
+	driverFlag := types.DriverFlags{
+		Options: make(map[string]*types.Flag),
+	}
+	driverFlag.Options["name"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "the internal name of the cluster in Rancher",
+	}
+	driverFlag.Options["display-name"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "the name of the cluster that should be displayed to the user",
+	}
+	driverFlag.Options["access-token"] = &types.Flag{
+		Type:     types.StringType,
+		Password: true,
+		Usage:    "The Linode personal access token",
+	}
+	driverFlag.Options["region"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "The region to launch the cluster in",
+	}
+	driverFlag.Options["kubernetes-version"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "The LKE kubernetes version to create the cluster with",
+	}
+	driverFlag.Options["tags"] = &types.Flag{
+		Type:  types.StringSliceType,
+		Usage: "The tags applied to the cluster",
+	}
+	driverFlag.Options["node-type"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "The Linode type of the nodes",
+		Default: &types.Default{
+			DefaultString: "g6-standard-2",
+		},
+	}
+	driverFlag.Options["node-count"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The number of nodes to create in this cluster",
+		Default: &types.Default{
+			DefaultInt: 3,
+		},
+	}
+	driverFlag.Options["auto-scale"] = &types.Flag{
+		Type:  types.BoolType,
+		Usage: "Enable the cluster autoscaler for the node pool",
+	}
+	driverFlag.Options["min-nodes"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The minimum number of nodes when autoscaling is enabled",
+	}
+	driverFlag.Options["max-nodes"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The maximum number of nodes when autoscaling is enabled",
+	}
+	return &driverFlag, nil
+}
+
+// GetDriverUpdateOptions implements driver interface
+func (d *Driver) GetDriverUpdateOptions(ctx context.Context) (*types.DriverFlags, error) {
+	driverFlag := types.DriverFlags{
+		Options: make(map[string]*types.Flag),
+	}
+	driverFlag.Options["access-token"] = &types.Flag{
+		Type:     types.StringType,
+		Password: true,
+		Usage:    "The Linode personal access token",
+	}
+	driverFlag.Options["kubernetes-version"] = &types.Flag{
+		Type:  types.StringType,
+		Usage: "The LKE kubernetes version to upgrade the cluster to",
+	}
+	driverFlag.Options["node-count"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The node number for your cluster to update. 0 means no updates",
+	}
+	driverFlag.Options["auto-scale"] = &types.Flag{
+		Type:  types.BoolType,
+		Usage: "Enable the cluster autoscaler for the node pool",
+	}
+	driverFlag.Options["min-nodes"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The minimum number of nodes when autoscaling is enabled",
+	}
+	driverFlag.Options["max-nodes"] = &types.Flag{
+		Type:  types.IntType,
+		Usage: "The maximum number of nodes when autoscaling is enabled",
+	}
+	return &driverFlag, nil
+}
+
+func getStateFromOpts(driverOptions *types.DriverOptions) (state, error) {
+	s := state{}
+	s.Name = options.GetValueFromDriverOptions(driverOptions, types.StringType, "name").(string)
+	s.DisplayName = options.GetValueFromDriverOptions(driverOptions, types.StringType, "display-name", "displayName").(string)
+	s.AccessToken = options.GetValueFromDriverOptions(driverOptions, types.StringType, "access-token", "accessToken").(string)
+	s.Region = options.GetValueFromDriverOptions(driverOptions, types.StringType, "region").(string)
+	s.KubernetesVersion = options.GetValueFromDriverOptions(driverOptions, types.StringType, "kubernetes-version", "kubernetesVersion").(string)
+	s.Tags = options.GetValueFromDriverOptions(driverOptions, types.StringSliceType, "tags").(*types.StringSlice).Value
+	s.NodeType = options.GetValueFromDriverOptions(driverOptions, types.StringType, "node-type", "nodeType").(string)
+	s.NodeCount = options.GetValueFromDriverOptions(driverOptions, types.IntType, "node-count", "nodeCount").(int64)
+	s.AutoScale = options.GetValueFromDriverOptions(driverOptions, types.BoolType, "auto-scale", "autoScale").(bool)
+	s.MinNodes = options.GetValueFromDriverOptions(driverOptions, types.IntType, "min-nodes", "minNodes").(int64)
+	s.MaxNodes = options.GetValueFromDriverOptions(driverOptions, types.IntType, "max-nodes", "maxNodes").(int64)
+
+	return s, s.validate()
+}
+
+func (s *state) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("cluster name is required")
+	} else if s.AccessToken == "" {
+		return fmt.Errorf("access token is required")
+	} else if s.Region == "" {
+		return fmt.Errorf("region is required")
+	} else if s.KubernetesVersion == "" {
+		return fmt.Errorf("kubernetes version is required")
+	}
+
+	if s.AutoScale && (s.MinNodes < 1 || s.MaxNodes < s.MinNodes) {
+		return fmt.Errorf("minNodes must be >= 1 and <= maxNodes")
+	}
+
+	return nil
+}
+
+// Create implements driver interface
+func (d *Driver) Create(ctx context.Context, opts *types.DriverOptions, info *types.ClusterInfo) (*types.ClusterInfo, error) {
+	state, err := getStateFromOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// retrying an interrupted create, only wait for the cluster created before
+	if info != nil {
+		if previous, err := getState(info); err == nil && previous.ClusterID != 0 {
+			state.ClusterID = previous.ClusterID
+			state.NodePoolID = previous.NodePoolID
+		}
+	}
+
+	info = &types.ClusterInfo{}
+	client := d.getClient(state)
+
+	if state.ClusterID == 0 {
+		cluster, err := client.CreateCluster(ctx, d.generateClusterCreateRequest(state))
+		if err != nil {
+			return info, err
+		}
+		logrus.Debugf("Cluster %s create is called for region %s", state.Name, state.Region)
+		state.ClusterID = cluster.ID
+	}
+
+	if err := storeState(info, state); err != nil {
+		return info, err
+	}
+
+	if _, err := d.waitCluster(ctx, client, &state); err != nil {
+		return info, err
+	}
+	return info, storeState(info, state)
+}
+
+func (d *Driver) generateClusterCreateRequest(state state) *Cluster {
+	pool := NodePool{
+		Type:  state.NodeType,
+		Count: state.NodeCount,
+	}
+	if state.AutoScale {
+		pool.Autoscaler = &Autoscaler{
+			Enabled: true,
+			Min:     state.MinNodes,
+			Max:     state.MaxNodes,
+		}
+	}
+
+	return &Cluster{
+		Label:      state.Name,
+		Region:     state.Region,
+		K8sVersion: state.KubernetesVersion,
+		Tags:       state.Tags,
+		NodePools:  []NodePool{pool},
+	}
+}
+
+func storeState(info *types.ClusterInfo, state state) error {
+	bytes, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if info.Metadata == nil {
+		info.Metadata = map[string]string{}
+	}
+	info.Metadata["state"] = string(bytes)
+	info.Metadata["cluster-id"] = fmt.Sprint(state.ClusterID)
+	info.Metadata["region"] = state.Region
+	return nil
+}
+
+func getState(info *types.ClusterInfo) (state, error) {
+	state := state{}
+	err := json.Unmarshal([]byte(info.Metadata["state"]), &state)
+	return state, err
+}
+
+// Update implements driver interface
+func (d *Driver) Update(ctx context.Context, info *types.ClusterInfo, opts *types.DriverOptions) (*types.ClusterInfo, error) {
+	state, err := getState(info)
+	if err != nil {
+		return nil, err
+	}
+
+	newState, err := getStateFromOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	if newState.AccessToken != "" {
+		state.AccessToken = newState.AccessToken
+	}
+
+	client := d.getClient(state)
+
+	if newState.KubernetesVersion != "" && newState.KubernetesVersion != state.KubernetesVersion {
+		log.Infof(ctx, "Updating kubernetes version to %v", newState.KubernetesVersion)
+		if err := d.upgrade(ctx, client, &state, newState.KubernetesVersion); err != nil {
+			return nil, err
+		}
+		state.KubernetesVersion = newState.KubernetesVersion
+	}
+
+	poolChanged := newState.AutoScale != state.AutoScale ||
+		(newState.AutoScale && (newState.MinNodes != state.MinNodes || newState.MaxNodes != state.MaxNodes)) ||
+		(!newState.AutoScale && newState.NodeCount != 0 && newState.NodeCount != state.NodeCount)
+	if poolChanged {
+		log.Infof(ctx, "Updating node pool %v", state.NodePoolID)
+		pool := &NodePool{
+			ID:         state.NodePoolID,
+			Count:      state.NodeCount,
+			Autoscaler: &Autoscaler{Enabled: newState.AutoScale},
+		}
+		if newState.NodeCount != 0 {
+			pool.Count = newState.NodeCount
+		}
+		if newState.AutoScale {
+			pool.Autoscaler.Min = newState.MinNodes
+			pool.Autoscaler.Max = newState.MaxNodes
+		}
+		if _, err := client.UpdateNodePool(ctx, state.ClusterID, pool); err != nil {
+			return nil, err
+		}
+		if _, err := d.waitCluster(ctx, client, &state); err != nil {
+			return nil, err
+		}
+		state.NodeCount = pool.Count
+		state.AutoScale = newState.AutoScale
+		state.MinNodes = pool.Autoscaler.Min
+		state.MaxNodes = pool.Autoscaler.Max
+	}
+
+	return info, storeState(info, state)
+}
+
+// upgrade moves the control plane to the new version and recycles the nodes so they pick it up as well
+func (d *Driver) upgrade(ctx context.Context, client *Client, state *state, version string) error {
+	if _, err := client.UpdateClusterVersion(ctx, state.ClusterID, version); err != nil {
+		return fmt.Errorf("error while upgrading cluster: %v", err)
+	}
+	if err := client.RecycleCluster(ctx, state.ClusterID); err != nil {
+		return fmt.Errorf("error while recycling nodes: %v", err)
+	}
+	_, err := d.waitCluster(ctx, client, state)
+	return err
+}
+
+// PostCheck implements driver interface
+func (d *Driver) PostCheck(ctx context.Context, info *types.ClusterInfo) (*types.ClusterInfo, error) {
+	state, err := getState(info)
+	if err != nil {
+		return nil, err
+	}
+
+	client := d.getClient(state)
+	pools, err := d.waitCluster(ctx, client, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := client.GetCluster(ctx, state.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := d.getRestConfig(ctx, client, state)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Endpoint = config.Host
+	info.Version = cluster.K8sVersion
+	info.RootCaCertificate = base64.StdEncoding.EncodeToString(config.CAData)
+	info.NodeCount = nodeCount(pools)
+	info.Metadata["nodePool"] = fmt.Sprint(state.NodePoolID)
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating clientset: %v", err)
+	}
+	info.ServiceAccountToken, err = util.GenerateServiceAccountToken(clientset)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Remove implements driver interface
+func (d *Driver) Remove(ctx context.Context, info *types.ClusterInfo) error {
+	state, err := getState(info)
+	if err != nil {
+		return err
+	}
+	if state.ClusterID == 0 {
+		return nil
+	}
+
+	logrus.Debugf("Removing cluster %v from region %v", state.Name, state.Region)
+	err = d.getClient(state).DeleteCluster(ctx, state.ClusterID)
+	if IsNotFound(err) {
+		logrus.Debugf("Cluster %s doesn't exist", state.Name)
+		return nil
+	}
+	return err
+}
+
+func (d *Driver) getClient(state state) *Client {
+	return NewClient(d.baseURL, state.AccessToken)
+}
+
+func (d *Driver) getRestConfig(ctx context.Context, client *Client, state state) (*rest.Config, error) {
+	kubeconfig, err := client.GetKubeconfig(ctx, state.ClusterID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubeconfig: %v", err)
+	}
+	return clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+}
+
+// waitCluster waits until the api server serves a kubeconfig and every node of the cluster is ready
+func (d *Driver) waitCluster(ctx context.Context, client *Client, state *state) ([]NodePool, error) {
+	lastMsg := ""
+	for {
+		msg, pools, err := clusterProgress(ctx, client, state)
+		if err != nil {
+			return nil, err
+		}
+		if msg == "" {
+			log.Infof(ctx, "Cluster %v is ready", state.Name)
+			return pools, nil
+		}
+		if msg != lastMsg {
+			log.Infof(ctx, "%v......", msg)
+			lastMsg = msg
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// clusterProgress returns a message describing what the cluster is waiting for, or an empty message once it is ready
+func clusterProgress(ctx context.Context, client *Client, state *state) (string, []NodePool, error) {
+	if _, err := client.GetKubeconfig(ctx, state.ClusterID); IsUnavailable(err) {
+		return fmt.Sprintf("waiting for api server of cluster %v", state.Name), nil, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+
+	pools, err := client.ListNodePools(ctx, state.ClusterID)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(pools) > 0 && state.NodePoolID == 0 {
+		state.NodePoolID = pools[0].ID
+	}
+
+	var ready, total int
+	for _, pool := range pools {
+		for _, node := range pool.Nodes {
+			total++
+			if node.Status == nodeReadyStatus {
+				ready++
+			}
+		}
+	}
+	if total == 0 || ready < total {
+		return fmt.Sprintf("%d of %d nodes of cluster %v are ready", ready, total, state.Name), pools, nil
+	}
+	return "", pools, nil
+}
+
+func (d *Driver) getCluster(ctx context.Context, info *types.ClusterInfo) (*Client, *state, *Cluster, error) {
+	state, err := getState(info)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client := d.getClient(state)
+	cluster, err := client.GetCluster(ctx, state.ClusterID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting cluster info: %v", err)
+	}
+	return client, &state, cluster, nil
+}
+
+func (d *Driver) GetClusterSize(ctx context.Context, info *types.ClusterInfo) (*types.NodeCount, error) {
+	state, err := getState(info)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := d.getClient(state).ListNodePools(ctx, state.ClusterID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node pools: %v", err)
+	}
+	return &types.NodeCount{Count: nodeCount(pools)}, nil
+}
+
+func (d *Driver) GetVersion(ctx context.Context, info *types.ClusterInfo) (*types.KubernetesVersion, error) {
+	_, _, cluster, err := d.getCluster(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	return &types.KubernetesVersion{Version: cluster.K8sVersion}, nil
+}
+
+func (d *Driver) SetClusterSize(ctx context.Context, info *types.ClusterInfo, count *types.NodeCount) error {
+	state, err := getState(info)
+	if err != nil {
+		return err
+	}
+
+	client := d.getClient(state)
+	pools, err := client.ListNodePools(ctx, state.ClusterID)
+	if err != nil {
+		return fmt.Errorf("error getting node pools: %v", err)
+	}
+	if len(pools) == 0 {
+		return fmt.Errorf("cluster %s has no node pool", state.Name)
+	}
+
+	logrus.Infof("[linodekubernetesengine] updating cluster [%s] size", state.Name)
+
+	pool := pools[0]
+	pool.Count = count.Count
+	if _, err := client.UpdateNodePool(ctx, state.ClusterID, &pool); err != nil {
+		return err
+	}
+	if _, err := d.waitCluster(ctx, client, &state); err != nil {
+		return err
+	}
+
+	logrus.Infof("[linodekubernetesengine] cluster [%s] size updated successfully", state.Name)
+	return nil
+}
+
+func (d *Driver) SetVersion(ctx context.Context, info *types.ClusterInfo, version *types.KubernetesVersion) error {
+	client, state, _, err := d.getCluster(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("[linodekubernetesengine] upgrading cluster [%s] to %s", state.Name, version.Version)
+
+	if err := d.upgrade(ctx, client, state, version.Version); err != nil {
+		return err
+	}
+
+	logrus.Infof("[linodekubernetesengine] cluster [%s] upgraded successfully", state.Name)
+	return nil
+}
+
+func (d *Driver) GetCapabilities(ctx context.Context) (*types.Capabilities, error) {
+	return &d.driverCapabilities, nil
+}
+
+func (d *Driver) ETCDSave(ctx context.Context, clusterInfo *types.ClusterInfo, opts *types.DriverOptions, snapshotName string) error {
+	return fmt.Errorf("ETCD backup operations are not implemented")
+}
+
+func (d *Driver) ETCDRestore(ctx context.Context, clusterInfo *types.ClusterInfo, opts *types.DriverOptions, snapshotName string) (*types.ClusterInfo, error) {
+	return nil, fmt.Errorf("ETCD backup operations are not implemented")
+}
+
+func (d *Driver) ETCDRemoveSnapshot(ctx context.Context, clusterInfo *types.ClusterInfo, opts *types.DriverOptions, snapshotName string) error {
+	return fmt.Errorf("ETCD backup operations are not implemented")
+}
+
+func (d *Driver) GetK8SCapabilities(ctx context.Context, options *types.DriverOptions) (*types.K8SCapabilities, error) {
+	return &types.K8SCapabilities{
+		L4LoadBalancer: &types.LoadBalancerCapabilities{
+			Enabled:              true,
+			Provider:             "Linode NodeBalancer",
+			ProtocolsSupported:   []string{"TCP"},
+			HealthCheckSupported: true,
+		},
+		NodePoolScalingSupported: true,
+	}, nil
+}
+
+func (d *Driver) RemoveLegacyServiceAccount(ctx context.Context, info *types.ClusterInfo) error {
+	state, err := getState(info)
+	if err != nil {
+		return err
+	}
+
+	config, err := d.getRestConfig(ctx, d.getClient(state), state)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	return util.DeleteLegacyServiceAccountAndRoleBinding(clientset)
+}
+
+func nodeCount(pools []NodePool) int64 {
+	var count int64
+	for _, pool := range pools {
+		count += pool.Count
+	}
+	return count
+}
@@ -0,0 +1,251 @@
+package lke
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/kontainer-engine/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clusterPath = "/v4/lke/clusters/11532"
+	poolsPath   = clusterPath + "/pools"
+	poolPath    = poolsPath + "/14727"
+)
+
+type fixture struct {
+	status int
+	file   string
+}
+
+// fixtureServer replays recorded Linode API responses and records the request bodies it receives
+type fixtureServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	fixtures map[string][]fixture
+	requests map[string][]map[string]interface{}
+}
+
+func newFixtureServer(t *testing.T, fixtures map[string][]fixture) *fixtureServer {
+	s := &fixtureServer{
+		fixtures: fixtures,
+		requests: map[string][]map[string]interface{}{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		key := req.Method + " " + req.URL.Path
+		body := map[string]interface{}{}
+		if data, _ := ioutil.ReadAll(req.Body); len(data) > 0 {
+			assert.NoError(t, json.Unmarshal(data, &body))
+		}
+		s.requests[key] = append(s.requests[key], body)
+
+		responses := s.fixtures[key]
+		if len(responses) == 0 {
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		response := responses[0]
+		// the last response is repeated for every further request
+		if len(responses) > 1 {
+			s.fixtures[key] = responses[1:]
+		}
+
+		w.WriteHeader(response.status)
+		if response.file != "" {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", response.file))
+			assert.NoError(t, err)
+			w.Write(data)
+		}
+	}))
+	return s
+}
+
+func newTestDriver(server *fixtureServer) *Driver {
+	d := NewDriver().(*Driver)
+	d.baseURL = server.URL
+	d.pollInterval = 0
+	return d
+}
+
+func testDriverOptions() *types.DriverOptions {
+	return &types.DriverOptions{
+		StringOptions: map[string]string{
+			"name":              "c-7xk2p",
+			"displayName":       "production",
+			"accessToken":       "test-token",
+			"region":            "us-central",
+			"kubernetesVersion": "1.18",
+			"nodeType":          "g6-standard-2",
+		},
+		IntOptions: map[string]int64{
+			"nodeCount": 3,
+		},
+		BoolOptions: map[string]bool{},
+		StringSliceOptions: map[string]*types.StringSlice{
+			"tags": {Value: []string{"rancher"}},
+		},
+	}
+}
+
+func testClusterInfo(t *testing.T) *types.ClusterInfo {
+	state, err := getStateFromOpts(testDriverOptions())
+	require.NoError(t, err)
+	state.ClusterID = 11532
+	state.NodePoolID = 14727
+
+	info := &types.ClusterInfo{}
+	require.NoError(t, storeState(info, state))
+	return info
+}
+
+func TestCreate(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"POST /v4/lke/clusters":              {{http.StatusOK, "cluster.json"}},
+		"GET " + clusterPath + "/kubeconfig": {{http.StatusServiceUnavailable, "unavailable.json"}, {http.StatusOK, "kubeconfig.json"}},
+		"GET " + poolsPath:                   {{http.StatusOK, "pools.json"}},
+	})
+	defer server.Close()
+
+	opts := testDriverOptions()
+	opts.BoolOptions["autoScale"] = true
+	opts.IntOptions["minNodes"] = 3
+	opts.IntOptions["maxNodes"] = 6
+
+	info, err := newTestDriver(server).Create(context.Background(), opts, nil)
+	require.NoError(t, err)
+
+	state, err := getState(info)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11532), state.ClusterID)
+	assert.Equal(t, int64(14727), state.NodePoolID)
+	assert.Equal(t, "11532", info.Metadata["cluster-id"])
+	assert.Len(t, server.requests["GET "+clusterPath+"/kubeconfig"], 2)
+
+	request := server.requests["POST /v4/lke/clusters"][0]
+	assert.Equal(t, "c-7xk2p", request["label"])
+	assert.Equal(t, "us-central", request["region"])
+	assert.Equal(t, "1.18", request["k8s_version"])
+	pool := request["node_pools"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "g6-standard-2", pool["type"])
+	assert.Equal(t, float64(3), pool["count"])
+	assert.Equal(t, map[string]interface{}{"enabled": true, "min": float64(3), "max": float64(6)}, pool["autoscaler"])
+}
+
+func TestUpdate(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"PUT " + clusterPath:                 {{http.StatusOK, "cluster.json"}},
+		"POST " + clusterPath + "/recycle":   {{http.StatusOK, ""}},
+		"PUT " + poolPath:                    {{http.StatusOK, "pool.json"}},
+		"GET " + clusterPath + "/kubeconfig": {{http.StatusOK, "kubeconfig.json"}},
+		"GET " + poolsPath:                   {{http.StatusOK, "pools.json"}},
+	})
+	defer server.Close()
+
+	opts := testDriverOptions()
+	opts.StringOptions["kubernetesVersion"] = "1.19"
+	opts.IntOptions["nodeCount"] = 5
+
+	info, err := newTestDriver(server).Update(context.Background(), testClusterInfo(t), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.19", server.requests["PUT "+clusterPath][0]["k8s_version"])
+	assert.Len(t, server.requests["POST "+clusterPath+"/recycle"], 1)
+	assert.Equal(t, float64(5), server.requests["PUT "+poolPath][0]["count"])
+
+	state, err := getState(info)
+	require.NoError(t, err)
+	assert.Equal(t, "1.19", state.KubernetesVersion)
+	assert.Equal(t, int64(5), state.NodeCount)
+}
+
+func TestVersionAndClusterSize(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"GET " + clusterPath:                 {{http.StatusOK, "cluster.json"}},
+		"PUT " + poolPath:                    {{http.StatusOK, "pool.json"}},
+		"GET " + clusterPath + "/kubeconfig": {{http.StatusOK, "kubeconfig.json"}},
+		"GET " + poolsPath:                   {{http.StatusOK, "pools.json"}},
+	})
+	defer server.Close()
+
+	d := newTestDriver(server)
+	ctx := context.Background()
+	info := testClusterInfo(t)
+
+	version, err := d.GetVersion(ctx, info)
+	require.NoError(t, err)
+	assert.Equal(t, "1.18", version.Version)
+
+	size, err := d.GetClusterSize(ctx, info)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), size.Count)
+
+	require.NoError(t, d.SetClusterSize(ctx, info, &types.NodeCount{Count: 5}))
+	request := server.requests["PUT "+poolPath][0]
+	assert.Equal(t, float64(5), request["count"])
+}
+
+func TestRemove(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"DELETE " + clusterPath: {{http.StatusOK, ""}, {http.StatusNotFound, "not_found.json"}},
+	})
+	defer server.Close()
+
+	d := newTestDriver(server)
+	info := testClusterInfo(t)
+
+	assert.NoError(t, d.Remove(context.Background(), info))
+	// removing a cluster that is already gone succeeds
+	assert.NoError(t, d.Remove(context.Background(), info))
+}
+
+func TestGetRestConfig(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"GET " + clusterPath + "/kubeconfig": {{http.StatusOK, "kubeconfig.json"}},
+	})
+	defer server.Close()
+
+	state, err := getState(testClusterInfo(t))
+	require.NoError(t, err)
+
+	d := newTestDriver(server)
+	config, err := d.getRestConfig(context.Background(), d.getClient(state), state)
+	require.NoError(t, err)
+	assert.Equal(t, "https://a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d.us-central-1.linodelke.net:443", config.Host)
+	assert.Equal(t, []byte("test-ca"), config.CAData)
+	assert.NotEmpty(t, config.BearerToken)
+}
+
+func TestAPIError(t *testing.T) {
+	server := newFixtureServer(t, map[string][]fixture{
+		"GET " + clusterPath: {{http.StatusNotFound, "not_found.json"}},
+	})
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "test-token").GetCluster(context.Background(), 11532)
+	assert.True(t, IsNotFound(err))
+	assert.EqualError(t, err, "linode api returned 404: Not found")
+}
+
+func TestGetCapabilities(t *testing.T) {
+	capabilities, err := NewDriver().GetCapabilities(context.Background())
+	require.NoError(t, err)
+	assert.True(t, capabilities.HasGetVersionCapability())
+	assert.True(t, capabilities.HasSetVersionCapability())
+	assert.True(t, capabilities.HasGetClusterSizeCapability())
+	assert.True(t, capabilities.HasSetClusterSizeCapability())
+	assert.False(t, capabilities.HasEtcdBackupCapability())
+}
@@ -2,9 +2,11 @@
 
 import (
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/aks"
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/doks"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/eks"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/gke"
 	kubeimport "github.com/rancher/rancher/pkg/kontainer-engine/drivers/import"
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/lke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/types"
 )
@@ -16,6 +18,8 @@ func init() {
 		"googlekubernetesengine":        gke.NewDriver(),
 		"azurekubernetesservice":        aks.NewDriver(),
 		"amazonelasticcontainerservice": eks.NewDriver(),
+		"digitaloceankubernetesservice": doks.NewDriver(),
+		"linodekubernetesengine":        lke.NewDriver(),
 		"import":                        kubeimport.NewDriver(),
 		"rke":                           rke.NewDriver(),
 	}
@@ -20,9 +20,11 @@
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/cluster"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/aks"
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/doks"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/eks"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/gke"
 	kubeimport "github.com/rancher/rancher/pkg/kontainer-engine/drivers/import"
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/lke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/types"
 	"github.com/sirupsen/logrus"
@@ -35,6 +37,8 @@
 		GoogleKubernetesEngineDriverName:        gke.NewDriver(),
 		AzureKubernetesServiceDriverName:        aks.NewDriver(),
 		AmazonElasticContainerServiceDriverName: eks.NewDriver(),
+		DigitalOceanKubernetesServiceDriverName: doks.NewDriver(),
+		LinodeKubernetesEngineDriverName:        lke.NewDriver(),
 		ImportDriverName:                        kubeimport.NewDriver(),
 		RancherKubernetesEngineDriverName:       rke.NewDriver(),
 	}
@@ -45,6 +49,8 @@
 	GoogleKubernetesEngineDriverName        = "googlekubernetesengine"
 	AzureKubernetesServiceDriverName        = "azurekubernetesservice"
 	AmazonElasticContainerServiceDriverName = "amazonelasticcontainerservice"
+	DigitalOceanKubernetesServiceDriverName = "digitaloceankubernetesservice"
+	LinodeKubernetesEngineDriverName        = "linodekubernetesengine"
 	ImportDriverName                        = "import"
 	RancherKubernetesEngineDriverName       = "rancherkubernetesengine"
 )
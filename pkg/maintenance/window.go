@@ -0,0 +1,192 @@
+package maintenance
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/robfig/cron"
+)
+
+const (
+	// maxBlackoutSkips bounds the search for a window that is not in a blackout
+	maxBlackoutSkips       = 100
+	defaultDurationMinutes = 120
+)
+
+// Validate checks the schedules and dates of a maintenance window can be parsed
+func Validate(window *v3.MaintenanceWindow) error {
+	_, _, err := parse(window)
+	return err
+}
+
+// Next returns the first time from t on when disruptive operations can start on a cluster with the given window
+func Next(window *v3.MaintenanceWindow, t time.Time) (time.Time, error) {
+	if window == nil {
+		return t, nil
+	}
+	schedules, blackouts, err := parse(window)
+	if err != nil {
+		return t, err
+	}
+	if Overridden(window, t) {
+		return t, nil
+	}
+
+	for i := 0; i < maxBlackoutSkips; i++ {
+		next := t
+		if len(schedules) > 0 {
+			next = schedules[0].start(t)
+			for _, schedule := range schedules[1:] {
+				if start := schedule.start(t); start.Before(next) {
+					next = start
+				}
+			}
+		}
+		end, inBlackout := blackoutEnd(blackouts, next)
+		if !inBlackout {
+			return next, nil
+		}
+		t = end
+	}
+	return t, fmt.Errorf("no maintenance window outside of the blackouts after %s", t.Format(time.RFC3339))
+}
+
+// Overridden returns whether the window is lifted for an emergency at t
+func Overridden(window *v3.MaintenanceWindow, t time.Time) bool {
+	if window == nil || window.OverrideUntil == "" {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, window.OverrideUntil)
+	return err == nil && t.Before(until)
+}
+
+// IsOpen returns whether disruptive operations can start at t and, if not, when they can
+func IsOpen(window *v3.MaintenanceWindow, t time.Time) (bool, time.Time, error) {
+	next, err := Next(window, t)
+	if err != nil {
+		return false, t, err
+	}
+	return !next.After(t), next, nil
+}
+
+// Plan records an operation waiting for the maintenance window on the cluster status, it returns whether the status
+// changed
+func Plan(cluster *v3.Cluster, action, message string, at time.Time) bool {
+	planned := v3.PlannedMaintenanceAction{
+		Action:      action,
+		Message:     message,
+		PlannedTime: at.UTC().Format(time.RFC3339),
+	}
+	if cluster.Status.MaintenanceStatus == nil {
+		cluster.Status.MaintenanceStatus = &v3.MaintenanceStatus{}
+	}
+	status := cluster.Status.MaintenanceStatus
+	for i, existing := range status.PlannedActions {
+		if existing.Action != action {
+			continue
+		}
+		if existing == planned {
+			return false
+		}
+		status.PlannedActions[i] = planned
+		sortActions(status.PlannedActions)
+		return true
+	}
+	status.PlannedActions = append(status.PlannedActions, planned)
+	sortActions(status.PlannedActions)
+	return true
+}
+
+// Done removes an operation from the planned ones once it started, it returns whether the status changed
+func Done(cluster *v3.Cluster, action string) bool {
+	status := cluster.Status.MaintenanceStatus
+	if status == nil {
+		return false
+	}
+	for i, existing := range status.PlannedActions {
+		if existing.Action == action {
+			status.PlannedActions = append(status.PlannedActions[:i], status.PlannedActions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type schedule struct {
+	cron     cron.Schedule
+	duration time.Duration
+}
+
+// start returns t when a window of the schedule is open at t, the start of the next one otherwise
+func (s schedule) start(t time.Time) time.Time {
+	// a window opening after t-duration and before t is still open at t
+	next := s.cron.Next(t.Add(-s.duration))
+	if !next.After(t) {
+		return t
+	}
+	return next
+}
+
+type blackout struct {
+	start, end time.Time
+}
+
+func parse(window *v3.MaintenanceWindow) ([]schedule, []blackout, error) {
+	if window == nil {
+		return nil, nil, nil
+	}
+	var schedules []schedule
+	for _, w := range window.Windows {
+		s, err := cron.ParseStandard(w.CronSchedule)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid window schedule %q: %v", w.CronSchedule, err)
+		}
+		duration := w.DurationMinutes
+		if duration < 1 {
+			duration = defaultDurationMinutes
+		}
+		schedules = append(schedules, schedule{cron: s, duration: time.Duration(duration) * time.Minute})
+	}
+
+	var blackouts []blackout
+	for _, b := range window.Blackouts {
+		start, err := time.Parse(time.RFC3339, b.Start)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid blackout start %q: %v", b.Start, err)
+		}
+		end, err := time.Parse(time.RFC3339, b.End)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid blackout end %q: %v", b.End, err)
+		}
+		if !end.After(start) {
+			return nil, nil, fmt.Errorf("blackout ending on %s has to end after its start", b.End)
+		}
+		blackouts = append(blackouts, blackout{start: start, end: end})
+	}
+
+	if window.OverrideUntil != "" {
+		if _, err := time.Parse(time.RFC3339, window.OverrideUntil); err != nil {
+			return nil, nil, fmt.Errorf("invalid overrideUntil %q: %v", window.OverrideUntil, err)
+		}
+	}
+	return schedules, blackouts, nil
+}
+
+// blackoutEnd returns the end of the blackouts t falls in
+func blackoutEnd(blackouts []blackout, t time.Time) (time.Time, bool) {
+	end, found := t, false
+	for _, b := range blackouts {
+		if !t.Before(b.start) && t.Before(b.end) && b.end.After(end) {
+			end, found = b.end, true
+		}
+	}
+	return end, found
+}
+
+func sortActions(actions []v3.PlannedMaintenanceAction) {
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].PlannedTime < actions[j].PlannedTime
+	})
+}
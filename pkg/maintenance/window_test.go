@@ -0,0 +1,101 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNext(t *testing.T) {
+	now := time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC) // a Wednesday
+	nightly := v3.MaintenanceWindowSchedule{CronSchedule: "0 2 * * *", DurationMinutes: 120}
+	noon := v3.MaintenanceWindowSchedule{CronSchedule: "30 11 * * 3", DurationMinutes: 60}
+
+	tests := []struct {
+		name   string
+		window *v3.MaintenanceWindow
+		want   time.Time
+	}{
+		{
+			name: "no window",
+			want: now,
+		},
+		{
+			name:   "closed window",
+			window: &v3.MaintenanceWindow{Windows: []v3.MaintenanceWindowSchedule{nightly}},
+			want:   time.Date(2020, time.October, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "open window",
+			window: &v3.MaintenanceWindow{Windows: []v3.MaintenanceWindowSchedule{nightly, noon}},
+			want:   now,
+		},
+		{
+			name: "window in a blackout",
+			window: &v3.MaintenanceWindow{
+				Windows: []v3.MaintenanceWindowSchedule{nightly},
+				Blackouts: []v3.MaintenanceBlackout{{
+					Start: "2020-10-14T00:00:00Z",
+					End:   "2020-10-16T00:00:00Z",
+				}},
+			},
+			want: time.Date(2020, time.October, 16, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "blackout without windows",
+			window: &v3.MaintenanceWindow{
+				Blackouts: []v3.MaintenanceBlackout{{Start: "2020-10-14T00:00:00Z", End: "2020-10-14T18:00:00Z"}},
+			},
+			want: time.Date(2020, time.October, 14, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "emergency override",
+			window: &v3.MaintenanceWindow{
+				Windows:       []v3.MaintenanceWindowSchedule{nightly},
+				OverrideUntil: "2020-10-14T13:00:00Z",
+			},
+			want: now,
+		},
+		{
+			name: "expired override",
+			window: &v3.MaintenanceWindow{
+				Windows:       []v3.MaintenanceWindowSchedule{nightly},
+				OverrideUntil: "2020-10-14T11:00:00Z",
+			},
+			want: time.Date(2020, time.October, 15, 2, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Next(tt.window, now)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(&v3.MaintenanceWindow{Windows: []v3.MaintenanceWindowSchedule{{CronSchedule: "0 2 * * 6", DurationMinutes: 60}}}))
+	assert.Error(t, Validate(&v3.MaintenanceWindow{Windows: []v3.MaintenanceWindowSchedule{{CronSchedule: "every night", DurationMinutes: 60}}}))
+	assert.Error(t, Validate(&v3.MaintenanceWindow{Blackouts: []v3.MaintenanceBlackout{{Start: "2020-10-16T00:00:00Z", End: "2020-10-14T00:00:00Z"}}}))
+	assert.Error(t, Validate(&v3.MaintenanceWindow{OverrideUntil: "tomorrow"}))
+}
+
+func TestPlan(t *testing.T) {
+	cluster := &v3.Cluster{}
+	at := time.Date(2020, time.October, 15, 2, 0, 0, 0, time.UTC)
+
+	assert.True(t, Plan(cluster, v3.MaintenanceActionRKEUpdate, "rke config changed", at))
+	assert.True(t, Plan(cluster, v3.MaintenanceActionEtcdBackup, "backup due", at.Add(-time.Hour)))
+	assert.False(t, Plan(cluster, v3.MaintenanceActionEtcdBackup, "backup due", at.Add(-time.Hour)))
+	if assert.Len(t, cluster.Status.MaintenanceStatus.PlannedActions, 2) {
+		assert.Equal(t, v3.MaintenanceActionEtcdBackup, cluster.Status.MaintenanceStatus.PlannedActions[0].Action)
+	}
+
+	assert.True(t, Done(cluster, v3.MaintenanceActionEtcdBackup))
+	assert.False(t, Done(cluster, v3.MaintenanceActionEtcdBackup))
+	assert.Len(t, cluster.Status.MaintenanceStatus.PlannedActions, 1)
+}
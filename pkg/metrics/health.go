@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	clusterHealthProbe = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "health_probe_status",
+			Help:      "Result of the last health probe of a downstream cluster component, 1 when healthy and 0 otherwise",
+		},
+		[]string{"cluster", "probe"},
+	)
+
+	clusterHealthProbeDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "health_probe_duration_seconds",
+			Help:      "Duration of the last health probe of a downstream cluster component, through the cluster agent tunnel",
+		},
+		[]string{"cluster", "probe"},
+	)
+
+	clusterEtcdDBSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "cluster_manager",
+			Name:      "etcd_db_size_bytes",
+			Help:      "Size of the database of the etcd members of a downstream cluster, as reported by its API server",
+		},
+		[]string{"cluster", "member"},
+	)
+)
+
+func SetClusterHealthProbe(clusterID, probe string, healthy bool, duration time.Duration) {
+	if prometheusMetrics {
+		labels := prometheus.Labels{
+			"cluster": clusterID,
+			"probe":   probe,
+		}
+		status := float64(0)
+		if healthy {
+			status = 1
+		}
+		clusterHealthProbe.With(labels).Set(status)
+		clusterHealthProbeDuration.With(labels).Set(duration.Seconds())
+	}
+}
+
+func SetClusterEtcdDBSize(clusterID, member string, bytes float64) {
+	if prometheusMetrics {
+		clusterEtcdDBSize.With(
+			prometheus.Labels{
+				"cluster": clusterID,
+				"member":  member,
+			}).Set(bytes)
+	}
+}
@@ -80,6 +80,17 @@ func Register(ctx context.Context, scaledContext *config.ScaledContext) {
 	// Cluster Owner
 	prometheus.MustRegister(clusterOwner)
 
+	// Cluster health probes
+	prometheus.MustRegister(clusterHealthProbe)
+	prometheus.MustRegister(clusterHealthProbeDuration)
+	prometheus.MustRegister(clusterEtcdDBSize)
+
+	// Tunnel sessions
+	prometheus.MustRegister(tunnelConnects)
+	prometheus.MustRegister(tunnelDisconnects)
+	prometheus.MustRegister(tunnelDialDuration)
+	prometheus.MustRegister(tunnelSessions)
+
 	gc := metricGarbageCollector{
 		clusterLister:  scaledContext.Management.Clusters("").Controller().Lister(),
 		nodeLister:     scaledContext.Management.Nodes("").Controller().Lister(),
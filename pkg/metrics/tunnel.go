@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tunnelConnects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_connects_total",
+			Help:      "Total count of tunnel sessions opened by the agents of a cluster",
+		},
+		[]string{"cluster", "node"},
+	)
+
+	tunnelDisconnects = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_disconnects_total",
+			Help:      "Total count of tunnel sessions of the agents of a cluster that ended",
+		},
+		[]string{"cluster", "node"},
+	)
+
+	tunnelDialDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: "cluster_manager",
+			Name:      "tunnel_dial_duration_seconds",
+			Help:      "Duration of the connections dialed through the tunnel of the agents of a cluster",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"cluster", "node"},
+	)
+
+	tunnelSessions = &tunnelSessionCollector{
+		age: prometheus.NewDesc("cluster_manager_tunnel_session_age_seconds",
+			"Age of the current tunnel session of an agent", []string{"cluster", "node"}, nil),
+		receiveBytes: prometheus.NewDesc("cluster_manager_tunnel_session_receive_bytes_total",
+			"Bytes received over the current tunnel session of an agent", []string{"cluster", "node"}, nil),
+		transmitBytes: prometheus.NewDesc("cluster_manager_tunnel_session_transmit_bytes_total",
+			"Bytes transmitted over the current tunnel session of an agent", []string{"cluster", "node"}, nil),
+		activeStreams: prometheus.NewDesc("cluster_manager_tunnel_active_streams",
+			"Connections currently proxied through the tunnel of an agent", []string{"cluster", "node"}, nil),
+	}
+)
+
+// TunnelSession is the state of the tunnel of an agent, Connected is zero for agents connected to another server
+// that this server proxies connections to
+type TunnelSession struct {
+	Cluster       string
+	Node          string
+	Connected     time.Time
+	ReceiveBytes  int64
+	TransmitBytes int64
+	ActiveStreams int
+}
+
+type tunnelSessionCollector struct {
+	sync.Mutex
+	source func() []TunnelSession
+
+	age, receiveBytes, transmitBytes, activeStreams *prometheus.Desc
+}
+
+func (c *tunnelSessionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.age
+	ch <- c.receiveBytes
+	ch <- c.transmitBytes
+	ch <- c.activeStreams
+}
+
+func (c *tunnelSessionCollector) Collect(ch chan<- prometheus.Metric) {
+	c.Lock()
+	source := c.source
+	c.Unlock()
+	if source == nil {
+		return
+	}
+	for _, s := range source() {
+		if !s.Connected.IsZero() {
+			ch <- prometheus.MustNewConstMetric(c.age, prometheus.GaugeValue, time.Since(s.Connected).Seconds(), s.Cluster, s.Node)
+			ch <- prometheus.MustNewConstMetric(c.receiveBytes, prometheus.CounterValue, float64(s.ReceiveBytes), s.Cluster, s.Node)
+			ch <- prometheus.MustNewConstMetric(c.transmitBytes, prometheus.CounterValue, float64(s.TransmitBytes), s.Cluster, s.Node)
+		}
+		ch <- prometheus.MustNewConstMetric(c.activeStreams, prometheus.GaugeValue, float64(s.ActiveStreams), s.Cluster, s.Node)
+	}
+}
+
+// SetTunnelSessionSource sets the function listing the tunnel sessions, one per cluster and node
+func SetTunnelSessionSource(source func() []TunnelSession) {
+	tunnelSessions.Lock()
+	defer tunnelSessions.Unlock()
+	tunnelSessions.source = source
+}
+
+func IncTunnelConnect(clusterID, node string) {
+	if prometheusMetrics {
+		tunnelConnects.With(
+			prometheus.Labels{
+				"cluster": clusterID,
+				"node":    node,
+			}).Inc()
+	}
+}
+
+func IncTunnelDisconnect(clusterID, node string) {
+	if prometheusMetrics {
+		tunnelDisconnects.With(
+			prometheus.Labels{
+				"cluster": clusterID,
+				"node":    node,
+			}).Inc()
+	}
+}
+
+func ObserveTunnelDial(clusterID, node string, duration time.Duration) {
+	if prometheusMetrics {
+		tunnelDialDuration.With(
+			prometheus.Labels{
+				"cluster": clusterID,
+				"node":    node,
+			}).Observe(duration.Seconds())
+	}
+}
@@ -67,6 +67,10 @@ func buildScaledContext(ctx context.Context, wranglerContext *wrangler.Context,
 	if err != nil {
 		return nil, nil, err
 	}
+	if scaledContext.PeerManager != nil {
+		dialerFactory.TunnelDiagnostics.WatchPeers(ctx, scaledContext.PeerManager)
+		dialerFactory.TunnelSharder.WatchPeers(ctx, scaledContext.PeerManager)
+	}
 
 	userManager, err := common.NewUserManager(scaledContext)
 	if err != nil {
@@ -131,6 +135,13 @@ func (m *mcm) Start(ctx context.Context) error {
 		}
 	}
 
+	// telemetry and the refresh of the EKS clusters run on the servers that own them rather than on the leader, see
+	// peermanager.IsOwner
+	if err := telemetry.Start(ctx, m.httpsListenPort, m.ScaledContext); err != nil {
+		return errors.Wrap(err, "failed to telemetry")
+	}
+	eksupstreamrefresh.StartEKSUpstreamCronJob(ctx, m.wranglerContext, m.ScaledContext.PeerManager)
+
 	m.wranglerContext.OnLeader(func(ctx context.Context) error {
 		err := m.wranglerContext.StartWithTransaction(ctx, func(ctx context.Context) error {
 			var (
@@ -160,14 +171,12 @@ func (m *mcm) Start(ctx context.Context) error {
 			return err
 		}
 
-		if err := telemetry.Start(ctx, m.httpsListenPort, m.ScaledContext); err != nil {
-			return errors.Wrap(err, "failed to telemetry")
-		}
-
+		// these stay on the leader: the token purge and the provider refresh are also started by the auth server on
+		// the leader, they are single passes over all tokens and users scheduled by leader controllers, and the
+		// cleanup of the system users runs once after the management data is added
 		tokens.StartPurgeDaemon(ctx, management)
 		providerrefresh.StartRefreshDaemon(ctx, m.ScaledContext, management)
 		managementdata.CleanupOrphanedSystemUsers(ctx, management)
-		eksupstreamrefresh.StartEKSUpstreamCronJob(m.wranglerContext)
 		logrus.Infof("Rancher startup complete")
 		return nil
 	})
@@ -0,0 +1,140 @@
+package capabilities
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFixtureServer serves the recorded provider API response in testdata/file for path
+func newFixtureServer(t *testing.T, path, file string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+		if req.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, err := ioutil.ReadFile(filepath.Join("testdata", file))
+		assert.NoError(t, err)
+		w.Write(data)
+	}))
+}
+
+func serve(handler http.Handler, method, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, "/meta/test", strings.NewReader(body)))
+	return recorder
+}
+
+func TestAccessTokenPreCheck(t *testing.T) {
+	handler := &DOKSOptionsHandler{BaseURL: "http://127.0.0.1:0"}
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		status int
+	}{
+		{name: "wrong method", method: http.MethodGet, status: http.StatusMethodNotAllowed},
+		{name: "malformed body", method: http.MethodPost, body: "{", status: http.StatusBadRequest},
+		{name: "missing token", method: http.MethodPost, body: "{}", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, serve(handler, tt.method, tt.body).Code)
+		})
+	}
+}
+
+func TestDOKSOptionsHandler(t *testing.T) {
+	server := newFixtureServer(t, "/v2/kubernetes/options", "doks_options.json")
+	defer server.Close()
+
+	recorder := serve(&DOKSOptionsHandler{BaseURL: server.URL}, http.MethodPost, `{"accessToken":"test-token"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var result struct {
+		Regions []struct {
+			Slug string `json:"slug"`
+		} `json:"regions"`
+		Versions []struct {
+			Slug string `json:"slug"`
+		} `json:"versions"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+	require.Len(t, result.Regions, 2)
+	assert.Equal(t, "nyc1", result.Regions[0].Slug)
+	require.Len(t, result.Versions, 2)
+	assert.Equal(t, "1.19.3-do.2", result.Versions[0].Slug)
+}
+
+func TestLKEHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler *LKEHandler
+		path    string
+		file    string
+		wantIDs []string
+	}{
+		{
+			name:    "versions",
+			handler: NewLKEVersionsHandler(),
+			path:    "/v4/lke/versions",
+			file:    "lke_versions.json",
+			wantIDs: []string{"1.18", "1.17"},
+		},
+		{
+			name:    "regions without kubernetes are filtered",
+			handler: NewLKERegionsHandler(),
+			path:    "/v4/regions",
+			file:    "lke_regions.json",
+			wantIDs: []string{"ap-west", "us-central"},
+		},
+		{
+			name:    "types",
+			handler: NewLKETypesHandler(),
+			path:    "/v4/linode/types",
+			file:    "lke_types.json",
+			wantIDs: []string{"g6-standard-1", "g6-standard-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFixtureServer(t, tt.path, tt.file)
+			defer server.Close()
+			tt.handler.BaseURL = server.URL
+
+			recorder := serve(tt.handler, http.MethodPost, `{"accessToken":"test-token"}`)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var result []struct {
+				ID string `json:"id"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+			var ids []string
+			for _, item := range result {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestLKEHandlerProviderError(t *testing.T) {
+	server := newFixtureServer(t, "/v4/lke/versions", "lke_versions.json")
+	defer server.Close()
+
+	handler := NewLKERegionsHandler()
+	handler.BaseURL = server.URL
+
+	recorder := serve(handler, http.MethodPost, `{"accessToken":"test-token"}`)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "linode api returned 404")
+}
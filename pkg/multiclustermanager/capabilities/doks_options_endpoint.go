@@ -0,0 +1,58 @@
+package capabilities
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/doks"
+)
+
+// NewDOKSOptionsHandler creates a new DOKSOptionsHandler
+func NewDOKSOptionsHandler() *DOKSOptionsHandler {
+	return &DOKSOptionsHandler{
+		BaseURL: doks.DefaultBaseURL,
+	}
+}
+
+// DOKSOptionsHandler for listing the regions, Kubernetes versions and node sizes available in DOKS
+type DOKSOptionsHandler struct {
+	BaseURL string
+}
+
+type accessTokenRequestBody struct {
+	AccessToken string `json:"accessToken"`
+}
+
+func (h *DOKSOptionsHandler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	body := accessTokenPreCheck(writer, req)
+	if body == nil {
+		return
+	}
+
+	client := doks.NewClient(h.BaseURL, body.AccessToken)
+	result, err := client.GetOptions(req.Context())
+
+	postCheck(writer, result, err)
+}
+
+func accessTokenPreCheck(writer http.ResponseWriter, req *http.Request) *accessTokenRequestBody {
+	if req.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	var body accessTokenRequestBody
+	if err := extractRequestBody(writer, req, &body); err != nil {
+		handleErr(writer, err)
+		return nil
+	}
+
+	if body.AccessToken == "" {
+		writer.WriteHeader(http.StatusBadRequest)
+		handleErr(writer, fmt.Errorf("invalid accessToken"))
+		return nil
+	}
+	return &body
+}
@@ -0,0 +1,59 @@
+package capabilities
+
+import (
+	"net/http"
+
+	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/lke"
+)
+
+const (
+	lkeVersions = "versions"
+	lkeRegions  = "regions"
+	lkeTypes    = "types"
+)
+
+// NewLKEVersionsHandler creates a handler listing the Kubernetes versions available in LKE
+func NewLKEVersionsHandler() *LKEHandler {
+	return &LKEHandler{BaseURL: lke.DefaultBaseURL, Field: lkeVersions}
+}
+
+// NewLKERegionsHandler creates a handler listing the regions LKE clusters can be created in
+func NewLKERegionsHandler() *LKEHandler {
+	return &LKEHandler{BaseURL: lke.DefaultBaseURL, Field: lkeRegions}
+}
+
+// NewLKETypesHandler creates a handler listing the Linode types available for LKE nodes
+func NewLKETypesHandler() *LKEHandler {
+	return &LKEHandler{BaseURL: lke.DefaultBaseURL, Field: lkeTypes}
+}
+
+// LKEHandler for listing LKE versions, regions or node types
+type LKEHandler struct {
+	BaseURL string
+	Field   string
+}
+
+func (h *LKEHandler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	body := accessTokenPreCheck(writer, req)
+	if body == nil {
+		return
+	}
+
+	client := lke.NewClient(h.BaseURL, body.AccessToken)
+
+	var result interface{}
+	var err error
+	switch h.Field {
+	case lkeVersions:
+		result, err = client.ListVersions(req.Context())
+	case lkeRegions:
+		result, err = client.ListRegions(req.Context())
+	case lkeTypes:
+		result, err = client.ListTypes(req.Context())
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	postCheck(writer, result, err)
+}
@@ -8,6 +8,7 @@
 
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rancher/rancher/pkg/alertrelay"
 	"github.com/rancher/rancher/pkg/api/norman"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clusterregistrationtokens"
 	"github.com/rancher/rancher/pkg/api/norman/customization/oci"
@@ -31,6 +32,7 @@
 	"github.com/rancher/rancher/pkg/rbac"
 	"github.com/rancher/rancher/pkg/rkenodeconfigserver"
 	"github.com/rancher/rancher/pkg/telemetry"
+	"github.com/rancher/rancher/pkg/tunnelserver"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/steve/pkg/auth"
 )
@@ -38,8 +40,9 @@
 func router(ctx context.Context, localClusterEnabled bool, scaledContext *config.ScaledContext, clusterManager *clustermanager.Manager) (func(http.Handler) http.Handler, error) {
 	var (
 		k8sProxy             = k8sProxyPkg.New(scaledContext, scaledContext.Dialer)
-		connectHandler       = scaledContext.Dialer.(*rancherdialer.Factory).TunnelServer
-		connectConfigHandler = rkenodeconfigserver.Handler(scaledContext.Dialer.(*rancherdialer.Factory).TunnelAuthorizer, scaledContext)
+		dialerFactory        = scaledContext.Dialer.(*rancherdialer.Factory)
+		connectHandler       = dialerFactory.TunnelSharder.Forward(dialerFactory.TunnelDiagnostics.Wrap(dialerFactory.TunnelServer))
+		connectConfigHandler = rkenodeconfigserver.Handler(dialerFactory.TunnelAuthorizer, scaledContext)
 	)
 
 	tokenAPI, err := tokens.NewAPIHandler(ctx, scaledContext, norman.ConfigureAPIUI)
@@ -65,6 +68,7 @@ func router(ctx context.Context, localClusterEnabled bool, scaledContext *config
 	unauthed.Handle("/v3/connect/config", connectConfigHandler)
 	unauthed.Handle("/v3/connect", connectHandler)
 	unauthed.Handle("/v3/connect/register", connectHandler)
+	unauthed.Handle("/v3/connect/diagnostics", dialerFactory.TunnelDiagnostics.PeerHandler())
 	unauthed.Handle("/v3/import/{token}.yaml", http.HandlerFunc(clusterregistrationtokens.ClusterImportHandler))
 	unauthed.Handle("/v3/settings/cacerts", managementAPI).MatcherFunc(onlyGet)
 	unauthed.Handle("/v3/settings/first-login", managementAPI).MatcherFunc(onlyGet)
@@ -73,6 +77,7 @@ func router(ctx context.Context, localClusterEnabled bool, scaledContext *config
 	unauthed.Handle("/v3/settings/ui-pl", managementAPI).MatcherFunc(onlyGet)
 	unauthed.Handle("/v3/settings/ui-default-landing", managementAPI).MatcherFunc(onlyGet)
 	unauthed.PathPrefix("/hooks").Handler(hooks.New(scaledContext))
+	unauthed.Handle(alertrelay.Path, alertrelay.New(scaledContext))
 	unauthed.PathPrefix("/v1-{prefix}-release/release").Handler(channelserver.NewProxy(ctx))
 	unauthed.PathPrefix("/v1-saml").Handler(saml.AuthHandler())
 	unauthed.PathPrefix("/v3-public").Handler(publicAPI)
@@ -86,15 +91,20 @@ func router(ctx context.Context, localClusterEnabled bool, scaledContext *config
 
 	authed.Path("/meta/aksVersions").Handler(capabilities.NewAKSVersionsHandler())
 	authed.Path("/meta/aksVirtualNetworks").Handler(capabilities.NewAKSVirtualNetworksHandler())
+	authed.Path("/meta/doksOptions").Handler(capabilities.NewDOKSOptionsHandler())
 	authed.Path("/meta/gkeMachineTypes").Handler(capabilities.NewGKEMachineTypesHandler())
 	authed.Path("/meta/gkeNetworks").Handler(capabilities.NewGKENetworksHandler())
 	authed.Path("/meta/gkeServiceAccounts").Handler(capabilities.NewGKEServiceAccountsHandler())
 	authed.Path("/meta/gkeSubnetworks").Handler(capabilities.NewGKESubnetworksHandler())
 	authed.Path("/meta/gkeVersions").Handler(capabilities.NewGKEVersionsHandler())
 	authed.Path("/meta/gkeZones").Handler(capabilities.NewGKEZonesHandler())
+	authed.Path("/meta/lkeRegions").Handler(capabilities.NewLKERegionsHandler())
+	authed.Path("/meta/lkeTypes").Handler(capabilities.NewLKETypesHandler())
+	authed.Path("/meta/lkeVersions").Handler(capabilities.NewLKEVersionsHandler())
 	authed.Path("/meta/oci/{resource}").Handler(oci.NewOCIHandler(scaledContext))
 	authed.Path("/meta/vsphere/{field}").Handler(vsphere.NewVsphereHandler(scaledContext))
 	authed.Path("/v3/tokenreview").Methods(http.MethodPost).Handler(&webhook.TokenReviewer{})
+	authed.Path("/v3/tunneldiagnostics").Methods(http.MethodGet).Handler(tunnelserver.NewDiagnosticsHandler(scaledContext, dialerFactory.TunnelDiagnostics))
 	authed.PathPrefix("/k8s/clusters/").Handler(k8sProxy)
 	authed.PathPrefix("/meta/proxy").Handler(httpproxy.NewProxy("/proxy/", whitelist.Proxy.Get, scaledContext))
 	authed.PathPrefix("/metrics").Handler(metrics.NewMetricsHandler(scaledContext, promhttp.Handler()))
@@ -29,11 +29,18 @@
 	"github.com/rancher/rancher/pkg/types/config/dialer"
 )
 
-const contentTypeJSON = "application/json"
+const (
+	contentTypeJSON = "application/json"
+
+	DefaultOpsgenieAPIURL = "https://api.opsgenie.com/"
+	DefaultTelegramAPIURL = "https://api.telegram.org/"
+)
 
 type Message struct {
 	Title   string
 	Content string
+	// Payload is posted instead of the message by the slack and webhook notifiers when it is set
+	Payload string
 }
 
 type wechatToken struct {
@@ -50,8 +57,16 @@ type dingtalkResponse struct {
 	Errmsg  string `json:"errmsg"`
 }
 
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
 func SendMessage(ctx context.Context, notifier *v3.Notifier, recipient string, msg *Message, dialer dialer.Dialer) error {
 	if notifier.Spec.SlackConfig != nil {
+		if msg.Payload != "" {
+			return PostPayload(notifier.Spec.SlackConfig.URL, msg.Payload, notifier.Spec.SlackConfig.HTTPClientConfig, dialer)
+		}
 		if recipient == "" {
 			recipient = notifier.Spec.SlackConfig.DefaultRecipient
 		}
@@ -80,6 +95,12 @@ func SendMessage(ctx context.Context, notifier *v3.Notifier, recipient string, m
 	}
 
 	if notifier.Spec.WebhookConfig != nil {
+		if msg.Payload != "" {
+			if recipient == "" {
+				recipient = notifier.Spec.WebhookConfig.URL
+			}
+			return PostPayload(recipient, msg.Payload, notifier.Spec.WebhookConfig.HTTPClientConfig, dialer)
+		}
 		return TestWebhook(notifier.Spec.WebhookConfig.URL, msg.Content, notifier.Spec.WebhookConfig.HTTPClientConfig, dialer)
 	}
 
@@ -91,6 +112,34 @@ func SendMessage(ctx context.Context, notifier *v3.Notifier, recipient string, m
 		return TestMicrosoftTeams(notifier.Spec.MSTeamsConfig.URL, msg.Content, notifier.Spec.MSTeamsConfig.HTTPClientConfig, dialer)
 	}
 
+	if notifier.Spec.OpsgenieConfig != nil {
+		s := notifier.Spec.OpsgenieConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestOpsgenie(s.APIURL, s.APIKey, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.TelegramConfig != nil {
+		s := notifier.Spec.TelegramConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestTelegram(s.APIURL, s.Token, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.MattermostConfig != nil {
+		s := notifier.Spec.MattermostConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestMattermost(s.URL, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.GoogleChatConfig != nil {
+		return TestGoogleChat(notifier.Spec.GoogleChatConfig.URL, msg.Content, notifier.Spec.GoogleChatConfig.HTTPClientConfig, dialer)
+	}
+
 	return errors.New("Notifier not configured")
 }
 
@@ -305,6 +354,184 @@ func TestMicrosoftTeams(url, msg string, cfg *v32.HTTPClientConfig, dialer diale
 	return nil
 }
 
+func TestOpsgenie(apiURL, key, team, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Opsgenie setting validated"
+	}
+	if apiURL == "" {
+		apiURL = DefaultOpsgenieAPIURL
+	}
+
+	alert := &opsgenieAlert{
+		Message:  msg,
+		Source:   "rancher",
+		Priority: "P5",
+		Tags:     []string{"Rancher alert testing"},
+	}
+	if team != "" {
+		alert.Responders = []opsgenieResponder{{Name: team, Type: "team"}}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(alert); err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(apiURL, "/")+"/v2/alerts", &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("Authorization", "GenieKey "+key)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func TestTelegram(apiURL, token, chatID, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Telegram setting validated"
+	}
+	if apiURL == "" {
+		apiURL = DefaultTelegramAPIURL
+	}
+
+	req := struct {
+		ChatID string `json:"chat_id"`
+		Text   string `json:"text"`
+	}{
+		ChatID: chatID,
+		Text:   msg,
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimSuffix(apiURL, "/"), token)
+	resp, err := post(client, url, contentTypeJSON, bytes.NewBuffer(data))
+	if err != nil {
+		// the token is part of the URL, the errors of the request must not show it
+		if token != "" {
+			return errors.New(strings.Replace(err.Error(), token, "<token>", -1))
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var tgResp telegramResponse
+	if err := json.Unmarshal(respBytes, &tgResp); err != nil {
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+		}
+		return err
+	}
+
+	if !tgResp.OK {
+		return fmt.Errorf("Failed to send Telegram message. %s", tgResp.Description)
+	}
+
+	return nil
+}
+
+// TestMattermost sends a Slack compatible message, it is accepted by the incoming webhooks of Mattermost and
+// Rocket.Chat
+func TestMattermost(url, channel, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Mattermost setting validated"
+	}
+	req := struct {
+		Text     string `json:"text"`
+		Channel  string `json:"channel,omitempty"`
+		Username string `json:"username"`
+	}{
+		Text:     msg,
+		Channel:  channel,
+		Username: "rancher",
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := post(client, url, contentTypeJSON, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		res, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes, response: %v", resp.StatusCode, string(res))
+	}
+
+	return nil
+}
+
+func TestGoogleChat(url, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Google Chat setting validated"
+	}
+	req := struct {
+		Text string `json:"text"`
+	}{
+		Text: msg,
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := post(client, url, contentTypeJSON, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func TestWebhook(url, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
 	if msg == "" {
 		msg = "Webhook setting validated"
@@ -340,6 +567,26 @@ func TestWebhook(url, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Diale
 	return nil
 }
 
+// PostPayload posts a rendered notification template, the payload is sent as is
+func PostPayload(url, payload string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := post(client, url, contentTypeJSON, strings.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func TestSlack(url, channel, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
 	if msg == "" {
 		msg = "Slack setting validated"
@@ -551,6 +798,19 @@ type pagerDutyEvent struct {
 	Payload     pagerDutyEventPayload `json:"payload"`
 }
 
+type opsgenieResponder struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type opsgenieAlert struct {
+	Message    string              `json:"message"`
+	Source     string              `json:"source"`
+	Priority   string              `json:"priority"`
+	Tags       []string            `json:"tags,omitempty"`
+	Responders []opsgenieResponder `json:"responders,omitempty"`
+}
+
 func hashKey(s string) string {
 	h := sha256.New()
 	h.Write([]byte(s))
@@ -1,9 +1,15 @@
 package notifiers
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,3 +45,136 @@ type testCase struct {
 	}
 
 }
+
+func TestSendOpsgenie(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		path, auth string
+		alert      opsgenieAlert
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		assert.Nil(json.NewDecoder(r.Body).Decode(&alert))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	assert.Nil(TestOpsgenie(server.URL+"/", "key", "ops", "", nil, nil))
+	assert.Equal("/v2/alerts", path)
+	assert.Equal("GenieKey key", auth)
+	assert.Equal("Opsgenie setting validated", alert.Message)
+	assert.Equal([]opsgenieResponder{{Name: "ops", Type: "team"}}, alert.Responders)
+
+	alert = opsgenieAlert{}
+	assert.Nil(TestOpsgenie(server.URL, "key", "", "message", nil, nil))
+	assert.Equal("message", alert.Message)
+	assert.Empty(alert.Responders)
+
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	assert.NotNil(TestOpsgenie(unauthorized.URL, "key", "", "", nil, nil))
+}
+
+func TestSendTelegram(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		path string
+		body map[string]string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		assert.Nil(json.NewDecoder(r.Body).Decode(&body))
+		if body["chat_id"] == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"ok":false,"description":"Bad Request: chat not found"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	assert.Nil(TestTelegram(server.URL, "123:abc", "-100", "", nil, nil))
+	assert.Equal("/bot123:abc/sendMessage", path)
+	assert.Equal(map[string]string{"chat_id": "-100", "text": "Telegram setting validated"}, body)
+
+	body = nil
+	err := TestTelegram(server.URL, "123:abc", "", "", nil, nil)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "chat not found")
+	}
+
+	err = TestTelegram("http://127.0.0.1:0", "123:abc", "-100", "", nil, nil)
+	if assert.NotNil(err) {
+		assert.NotContains(err.Error(), "123:abc")
+	}
+}
+
+func TestSendMattermost(t *testing.T) {
+	assert := assert.New(t)
+
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(contentTypeJSON, r.Header.Get("Content-Type"))
+		assert.Nil(json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	assert.Nil(TestMattermost(server.URL, "town-square", "", nil, nil))
+	assert.Equal(map[string]string{"text": "Mattermost setting validated", "channel": "town-square", "username": "rancher"}, body)
+
+	body = nil
+	assert.Nil(TestMattermost(server.URL, "", "message", nil, nil))
+	assert.Equal(map[string]string{"text": "message", "username": "rancher"}, body)
+
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	assert.NotNil(TestMattermost(notFound.URL, "", "", nil, nil))
+}
+
+func TestSendGoogleChat(t *testing.T) {
+	assert := assert.New(t)
+
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("key=k", r.URL.RawQuery)
+		assert.Nil(json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	assert.Nil(TestGoogleChat(server.URL+"/v1/spaces/space/messages?key=k", "", nil, nil))
+	assert.Equal(map[string]string{"text": "Google Chat setting validated"}, body)
+
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	assert.NotNil(TestGoogleChat(notFound.URL, "", nil, nil))
+}
+
+func TestSendPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+	}))
+	defer server.Close()
+
+	notifier := &v3.Notifier{Spec: v32.NotifierSpec{WebhookConfig: &v32.WebhookConfig{URL: server.URL + "/default"}}}
+	msg := &Message{Content: "ignored", Payload: `{"status":"firing"}`}
+	assert.Nil(SendMessage(context.Background(), notifier, server.URL+"/recipient", msg, nil))
+	assert.Equal("/recipient", path)
+	assert.Equal(`{"status":"firing"}`, body)
+
+	notifier = &v3.Notifier{Spec: v32.NotifierSpec{SlackConfig: &v32.SlackConfig{URL: server.URL + "/slack"}}}
+	assert.Nil(SendMessage(context.Background(), notifier, "#alerts", msg, nil))
+	assert.Equal("/slack", path)
+	assert.Equal(`{"status":"firing"}`, body)
+}
@@ -9,6 +9,8 @@ type Peers struct {
 
 type PeerManager interface {
 	IsLeader() bool
+	// IsOwner returns whether this server owns the key, keys are sharded over the servers by consistent hashing
+	IsOwner(key string) bool
 	Leader()
 	AddListener(l chan<- Peers)
 	RemoveListener(l chan<- Peers)
@@ -0,0 +1,94 @@
+package peermanager
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// replicas is the number of points each peer has on the ring, enough for the keys to spread evenly over a few peers
+const replicas = 128
+
+// Ring assigns keys to peers by consistent hashing, when a peer joins or leaves only the keys it owns move
+type Ring struct {
+	points []uint32
+	owners map[uint32]string
+}
+
+// NewRing returns the ring of the given peers, duplicate IDs are ignored
+func NewRing(ids ...string) *Ring {
+	r := &Ring{
+		owners: map[uint32]string{},
+	}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		for i := 0; i < replicas; i++ {
+			point := hash(strconv.Itoa(i) + "/" + id)
+			// on a collision the lowest ID wins, so that every peer builds the same ring
+			if owner, ok := r.owners[point]; ok && owner < id {
+				continue
+			} else if !ok {
+				r.points = append(r.points, point)
+			}
+			r.owners[point] = id
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// Owner returns the peer that owns the key, or an empty string if the ring has no peers
+func (r *Ring) Owner(key string) string {
+	if r == nil || len(r.points) == 0 {
+		return ""
+	}
+	point := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= point })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hash spreads similar keys, like the IPs of the peers or the names of the clusters, evenly over the ring
+func hash(key string) uint32 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint32(sum[:4])
+}
+
+// Ring returns the ring of these peers, including this server
+func (p Peers) Ring() *Ring {
+	return NewRing(append([]string{p.SelfID}, p.IDs...)...)
+}
+
+// IsOwner returns whether this server owns the key. A server that is not ready owns nothing, and neither does a
+// server that is alone but not the leader, as it may not have seen the other peers yet
+func (p Peers) IsOwner(ring *Ring, key string) bool {
+	if !p.Ready || (len(p.others()) == 0 && !p.Leader) {
+		return false
+	}
+	return ring.Owner(key) == p.SelfID
+}
+
+func (p Peers) others() []string {
+	var others []string
+	for _, id := range p.IDs {
+		if id != p.SelfID {
+			others = append(others, id)
+		}
+	}
+	return others
+}
+
+// IsOwner returns whether this server owns the key, every server owns everything when running in single server mode
+func IsOwner(pm PeerManager, key string) bool {
+	if pm == nil {
+		return true
+	}
+	return pm.IsOwner(key)
+}
@@ -0,0 +1,87 @@
+package peermanager
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRing(t *testing.T) {
+	keys := make([]string, 3000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("c-%05d", i)
+	}
+
+	ring := NewRing("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.2")
+	owners := map[string]string{}
+	counts := map[string]int{}
+	for _, key := range keys {
+		owners[key] = ring.Owner(key)
+		counts[owners[key]]++
+	}
+	assert.Len(t, counts, 3)
+	for id, count := range counts {
+		assert.InDelta(t, 1000, count, 250, "keys owned by %s", id)
+	}
+
+	// every server builds the same ring whatever the order of its peers
+	reordered := NewRing("10.0.0.3", "10.0.0.1", "10.0.0.2")
+	for _, key := range keys {
+		assert.Equal(t, owners[key], reordered.Owner(key))
+	}
+
+	// only the keys the new peer takes move
+	grown := NewRing("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4")
+	moved := 0
+	for _, key := range keys {
+		if owner := grown.Owner(key); owner != owners[key] {
+			assert.Equal(t, "10.0.0.4", owner)
+			moved++
+		}
+	}
+	assert.InDelta(t, 750, moved, 250)
+
+	assert.Equal(t, "", NewRing().Owner("c-00001"))
+	assert.Equal(t, "", (*Ring)(nil).Owner("c-00001"))
+}
+
+func TestPeersIsOwner(t *testing.T) {
+	tests := []struct {
+		name  string
+		peers Peers
+		want  bool
+	}{
+		{
+			name:  "not ready",
+			peers: Peers{SelfID: "10.0.0.1", Leader: true},
+		},
+		{
+			name:  "alone and not the leader",
+			peers: Peers{SelfID: "10.0.0.1", Ready: true},
+		},
+		{
+			name:  "alone and the leader",
+			peers: Peers{SelfID: "10.0.0.1", Ready: true, Leader: true},
+			want:  true,
+		},
+		{
+			name:  "owned by a peer",
+			peers: Peers{SelfID: "10.0.0.1", IDs: []string{"10.0.0.2"}, Ready: true, Leader: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "c-1"
+			if !tt.want && tt.peers.Ready && len(tt.peers.IDs) > 0 {
+				// pick a key owned by the peer
+				for i := 0; tt.peers.Ring().Owner(key) == tt.peers.SelfID; i++ {
+					key = fmt.Sprintf("c-%d", i)
+				}
+			}
+			assert.Equal(t, tt.want, tt.peers.IsOwner(tt.peers.Ring(), key))
+		})
+	}
+
+	assert.True(t, IsOwner(nil, "c-1"))
+}
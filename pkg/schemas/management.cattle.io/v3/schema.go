@@ -43,11 +43,13 @@
 		Init(globalDNSTypes).
 		Init(kontainerTypes).
 		Init(etcdBackupTypes).
+		Init(clusterUpgradePlanTypes).
 		Init(clusterScanTypes).
 		Init(monitorTypes).
 		Init(credTypes).
 		Init(mgmtSecretTypes).
 		Init(clusterTemplateTypes).
+		Init(clusterGroupTypes).
 		Init(driverMetadataTypes).
 		Init(driverMetadataCisTypes).
 		Init(encryptionTypes)
@@ -241,6 +243,9 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.RestoreFromEtcdBackupInput{}).
 		MustImport(&Version, v3.SaveAsTemplateInput{}).
 		MustImport(&Version, v3.SaveAsTemplateOutput{}).
+		MustImport(&Version, v3.CapacityHistoryInput{}).
+		MustImport(&Version, v3.CapacityHistoryOutput{}).
+		MustImport(&Version, v3.DecommissionConfig{}).
 		MustImportAndCustomize(&Version, rketypes.ETCDService{}, func(schema *types.Schema) {
 			schema.MustCustomizeField("extraArgs", func(field types.Field) types.Field {
 				field.Default = map[string]interface{}{
@@ -291,6 +296,13 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 				Input:  "saveAsTemplateInput",
 				Output: "saveAsTemplateOutput",
 			}
+			schema.ResourceActions[v3.ClusterActionCapacityHistory] = types.Action{
+				Input:  "capacityHistoryInput",
+				Output: "capacityHistoryOutput",
+			}
+			schema.ResourceActions[v3.ClusterActionDecommission] = types.Action{
+				Input: "decommissionConfig",
+			}
 		})
 }
 
@@ -649,10 +661,12 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 			m.DisplayName{}).
 		MustImport(&Version, v3.ClusterTestInput{}).
 		MustImport(&Version, v3.ProjectTestInput{}).
+		MustImport(&Version, v3.LoggingTestOutput{}).
 		MustImportAndCustomize(&Version, v3.ClusterLogging{}, func(schema *types.Schema) {
 			schema.CollectionActions = map[string]types.Action{
 				"test": {
-					Input: "clusterTestInput",
+					Input:  "clusterTestInput",
+					Output: "loggingTestOutput",
 				},
 				"dryRun": {
 					Input: "clusterTestInput",
@@ -662,7 +676,8 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 		MustImportAndCustomize(&Version, v3.ProjectLogging{}, func(schema *types.Schema) {
 			schema.CollectionActions = map[string]types.Action{
 				"test": {
-					Input: "projectTestInput",
+					Input:  "projectTestInput",
+					Output: "loggingTestOutput",
 				},
 				"dryRun": {
 					Input: "projectTestInput",
@@ -694,16 +709,26 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.ClusterAlert{}).
 		MustImport(&Version, v3.ProjectAlert{}).
 		MustImport(&Version, v3.Notification{}).
+		MustImport(&Version, v3.NotificationPreviewInput{}).
+		MustImport(&Version, v3.NotificationPreviewOutput{}).
 		MustImportAndCustomize(&Version, v3.Notifier{}, func(schema *types.Schema) {
 			schema.CollectionActions = map[string]types.Action{
 				"send": {
 					Input: "notification",
 				},
+				"preview": {
+					Input:  "notificationPreviewInput",
+					Output: "notificationPreviewOutput",
+				},
 			}
 			schema.ResourceActions = map[string]types.Action{
 				"send": {
 					Input: "notification",
 				},
+				"preview": {
+					Input:  "notificationPreviewInput",
+					Output: "notificationPreviewOutput",
+				},
 			}
 		}).
 		MustImport(&Version, v3.AlertStatus{}).
@@ -719,8 +744,26 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 		AddMapperForType(&Version, v3.ProjectAlertRule{},
 			&m.Embed{Field: "status"},
 			m.DisplayName{}).
-		MustImport(&Version, v3.ClusterAlertGroup{}).
-		MustImport(&Version, v3.ProjectAlertGroup{}).
+		AddMapperForType(&Version, v3.ClusterAlertSilence{},
+			&m.Embed{Field: "status"}).
+		AddMapperForType(&Version, v3.ProjectAlertSilence{},
+			&m.Embed{Field: "status"}).
+		MustImportAndCustomize(&Version, v3.ClusterAlertGroup{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				"preview": {
+					Input:  "notificationPreviewInput",
+					Output: "notificationPreviewOutput",
+				},
+			}
+		}).
+		MustImportAndCustomize(&Version, v3.ProjectAlertGroup{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				"preview": {
+					Input:  "notificationPreviewInput",
+					Output: "notificationPreviewOutput",
+				},
+			}
+		}).
 		MustImportAndCustomize(&Version, v3.ClusterAlertRule{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
@@ -736,6 +779,39 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 				"mute":       {},
 				"unmute":     {},
 			}
+		}).
+		MustImport(&Version, v3.ClusterAlertSilence{}).
+		MustImport(&Version, v3.ProjectAlertSilence{}).
+		AddMapperForType(&Version, v3.OnCallSchedule{},
+			m.DisplayName{}).
+		MustImport(&Version, v3.OnCallSchedule{}).
+		AddMapperForType(&Version, v3.ClusterAlertIncident{},
+			&m.Embed{Field: "status"},
+			m.DisplayName{}).
+		AddMapperForType(&Version, v3.ProjectAlertIncident{},
+			&m.Embed{Field: "status"},
+			m.DisplayName{}).
+		MustImport(&Version, v3.AlertIncidentSummaryInput{}).
+		MustImport(&Version, v3.AlertIncidentSummaryOutput{}).
+		MustImportAndCustomize(&Version, v3.ClusterAlertIncident{}, func(schema *types.Schema) {
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
+			schema.CollectionActions = map[string]types.Action{
+				"summary": {
+					Input:  "alertIncidentSummaryInput",
+					Output: "alertIncidentSummaryOutput",
+				},
+			}
+		}).
+		MustImportAndCustomize(&Version, v3.ProjectAlertIncident{}, func(schema *types.Schema) {
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
+			schema.CollectionActions = map[string]types.Action{
+				"summary": {
+					Input:  "alertIncidentSummaryInput",
+					Output: "alertIncidentSummaryOutput",
+				},
+			}
 		})
 
 }
@@ -899,28 +975,74 @@ func etcdBackupTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.MustImport(&Version, v3.EtcdBackup{})
 }
 
+func clusterUpgradePlanTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		AddMapperForType(&Version, v3.ClusterUpgradePlan{}, m.DisplayName{}).
+		MustImportAndCustomize(&Version, v3.ClusterUpgradePlan{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				v3.ClusterUpgradePlanActionPause:    {},
+				v3.ClusterUpgradePlanActionResume:   {},
+				v3.ClusterUpgradePlanActionRollback: {},
+			}
+		})
+}
+
 func clusterTemplateTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.
 		TypeName("clusterTemplate", v3.ClusterTemplate{}).
 		TypeName("clusterTemplateRevision", v3.ClusterTemplateRevision{}).
 		AddMapperForType(&Version, v3.ClusterTemplate{}, m.Drop{Field: "namespaceId"}, m.DisplayName{}).
 		AddMapperForType(&Version, v3.ClusterTemplateRevision{}, m.Drop{Field: "namespaceId"}, m.DisplayName{}).
+		AddMapperForType(&Version, v3.ClusterTemplateRollout{}, m.Drop{Field: "namespaceId"}, m.DisplayName{}).
 		MustImport(&Version, v3.ClusterTemplateQuestionsOutput{}).
+		MustImport(&Version, v3.ClusterTemplateDriftReport{}).
 		MustImport(&Version, v3.ClusterTemplate{}).
 		MustImportAndCustomize(&Version, v3.ClusterTemplateRevision{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"disable": {},
 				"enable":  {},
+				"driftreport": {
+					Output: "clusterTemplateDriftReport",
+				},
 			}
 			schema.CollectionActions = map[string]types.Action{
 				"listquestions": {
 					Output: "clusterTemplateQuestionsOutput",
 				},
 			}
+		}).
+		MustImportAndCustomize(&Version, v3.ClusterTemplateRollout{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				v3.ClusterTemplateRolloutActionPause:  {},
+				v3.ClusterTemplateRolloutActionResume: {},
+			}
 		})
 
 }
 
+func clusterGroupTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		AddMapperForType(&Version, v3.ClusterGroup{}, m.DisplayName{}).
+		MustImport(&Version, v3.ClusterGroupUpgradeInput{}).
+		MustImport(&Version, v3.ClusterGroupActionOutput{}).
+		MustImportAndCustomize(&Version, v3.ClusterGroup{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				v3.ClusterGroupActionBackupEtcd: {
+					Output: "clusterGroupActionOutput",
+				},
+				v3.ClusterGroupActionRotateCertificates: {
+					Input:  "rotateCertificateInput",
+					Output: "clusterGroupActionOutput",
+				},
+				v3.ClusterGroupActionUpgradeKubernetes: {
+					Input:  "clusterGroupUpgradeInput",
+					Output: "clusterGroupActionOutput",
+				},
+			}
+		}).
+		MustImport(&Version, v3.ClusterGroupRoleTemplateBinding{})
+}
+
 func clusterScanTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.MustImportAndCustomize(&Version, v3.ClusterScan{}, func(schema *types.Schema) {
 		schema.CollectionMethods = []string{http.MethodGet}
@@ -83,6 +83,7 @@
 	APIUIVersion                      = NewSetting("api-ui-version", "1.1.6")                // Please update the CATTLE_API_UI_VERSION in package/Dockerfile when updating the version here.
 	RotateCertsIfExpiringInDays       = NewSetting("rotate-certs-if-expiring-in-days", "7")  // 7 days
 	ClusterTemplateEnforcement        = NewSetting("cluster-template-enforcement", "false")
+	ClusterDecommissionRequired       = NewSetting("cluster-decommission-required", "false") // clusters can only be deleted through the decommission action
 	InitialDockerRootDir              = NewSetting("initial-docker-root-dir", "/var/lib/docker")
 	SystemCatalog                     = NewSetting("system-catalog", "external") // Options are 'external' or 'bundled'
 	ChartDefaultBranch                = NewSetting("chart-default-branch", "dev-v2.5")
@@ -93,6 +94,7 @@
 	NoDefaultAdmin                    = NewSetting("no-default-admin", "")
 	RestrictedDefaultAdmin            = NewSetting("restricted-default-admin", "false") // When bootstrapping the admin for the first time, give them the global role restricted-admin
 	EKSUpstreamRefreshCron            = NewSetting("eks-refresh-cron", "*/5 * * * *")
+	AlertHistoryLimit                 = NewSetting("alert-history-limit", "1000") // alert incidents kept per cluster, the oldest resolved ones are removed first
 )
 
 func FullShellImage() string {
@@ -9,6 +9,7 @@
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/peermanager"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/wrangler/pkg/ticker"
@@ -16,6 +17,8 @@
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+const telemetryKey = "telemetry"
+
 var adminRole = "Default Admin"
 
 type process struct {
@@ -44,11 +47,9 @@ func (p *process) setRunningState(state bool) {
 	p.running = state
 }
 
-func isLeader(management *config.ScaledContext) bool {
-	if management.PeerManager == nil {
-		return true
-	}
-	return management.PeerManager.IsLeader()
+// isOwner returns whether this server runs the telemetry client, it is sharded over the servers like the clusters
+func isOwner(management *config.ScaledContext) bool {
+	return peermanager.IsOwner(management.PeerManager, telemetryKey)
 }
 
 func Start(ctx context.Context, httpsPort int, management *config.ScaledContext) error {
@@ -65,7 +66,7 @@ func Start(ctx context.Context, httpsPort int, management *config.ScaledContext)
 		}()
 		defer t.Stop()
 		for range t.C {
-			if settings.TelemetryOpt.Get() == "in" && isLeader(management) {
+			if settings.TelemetryOpt.Get() == "in" && isOwner(management) {
 				if !p.running {
 					var token string
 					var e error
@@ -81,7 +82,7 @@ func Start(ctx context.Context, httpsPort int, management *config.ScaledContext)
 					}
 					if token == "" {
 						logrus.Infof("Unable to obtain token for telemetry service. Telemetry will not be launched.")
-						return
+						continue
 					}
 					cmd := exec.Command("telemetry", "client", "--url", fmt.Sprintf("https://localhost:%d/v3", httpsPort), "--token-key", token)
 					cmd.Stdout = os.Stdout
@@ -102,7 +103,7 @@ func Start(ctx context.Context, httpsPort int, management *config.ScaledContext)
 
 	go func() {
 		for range ticker.Context(ctx, time.Second*5) {
-			if settings.TelemetryOpt.Get() != "in" || !isLeader(management) {
+			if settings.TelemetryOpt.Get() != "in" || !isOwner(management) {
 				if p.getRunningState() {
 					p.kill()
 					p.setRunningState(false)
@@ -0,0 +1,612 @@
+package tunnelserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rancher/rancher/pkg/auth/util"
+	"github.com/rancher/rancher/pkg/metrics"
+	"github.com/rancher/rancher/pkg/peermanager"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/remotedialer"
+	"github.com/sirupsen/logrus"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Version is the header the agents report their version in
+	Version = "X-API-Tunnel-Version"
+
+	maxDisconnects    = 100
+	maxRejectBodySize = 256
+	peerReportTimeout = 10 * time.Second
+)
+
+// Session is a tunnel session opened on a server, by an agent or by another server
+type Session struct {
+	Server        string    `json:"server,omitempty"`
+	ClientKey     string    `json:"clientKey"`
+	Cluster       string    `json:"cluster,omitempty"`
+	Node          string    `json:"node,omitempty"`
+	Peer          bool      `json:"peer,omitempty"`
+	AgentVersion  string    `json:"agentVersion,omitempty"`
+	Address       string    `json:"address"`
+	Connected     time.Time `json:"connected"`
+	ReceiveBytes  int64     `json:"receiveBytes"`
+	TransmitBytes int64     `json:"transmitBytes"`
+	ActiveStreams int       `json:"activeStreams"`
+}
+
+// Disconnect is a tunnel session that ended, or a connection that was rejected before it became one
+type Disconnect struct {
+	Server       string    `json:"server,omitempty"`
+	ClientKey    string    `json:"clientKey,omitempty"`
+	Cluster      string    `json:"cluster,omitempty"`
+	Node         string    `json:"node,omitempty"`
+	Peer         bool      `json:"peer,omitempty"`
+	AgentVersion string    `json:"agentVersion,omitempty"`
+	Address      string    `json:"address"`
+	Connected    time.Time `json:"connected,omitempty"`
+	Disconnected time.Time `json:"disconnected"`
+	Reason       string    `json:"reason"`
+}
+
+// DiagnosticsReport lists the tunnel sessions and the recent disconnects of the servers
+type DiagnosticsReport struct {
+	Sessions    []Session    `json:"sessions"`
+	Disconnects []Disconnect `json:"disconnects"`
+	// PeerErrors are the reasons the report of the other servers could not be read, by server
+	PeerErrors map[string]string `json:"peerErrors,omitempty"`
+}
+
+// Diagnostics tracks the sessions of the tunnel server and the connections dialed through them
+type Diagnostics struct {
+	sync.Mutex
+	server      *remotedialer.Server
+	nextID      int64
+	sessions    map[int64]*trackedSession
+	streams     map[string]int
+	disconnects []Disconnect
+	peers       []string
+	// clientKeys are the client keys given by the authorizer to the requests being upgraded
+	clientKeys sync.Map
+}
+
+type trackedSession struct {
+	Session
+	conn *countingConn
+}
+
+func NewDiagnostics() *Diagnostics {
+	d := &Diagnostics{
+		sessions: map[int64]*trackedSession{},
+		streams:  map[string]int{},
+	}
+	metrics.SetTunnelSessionSource(d.metricsSessions)
+	return d
+}
+
+// authorizer records the client key of the requests the tunnel server authorizes
+func (d *Diagnostics) authorizer(next remotedialer.Authorizer) remotedialer.Authorizer {
+	return func(req *http.Request) (string, bool, error) {
+		clientKey, authed, err := next(req)
+		if clientKey != "" {
+			d.clientKeys.Store(req, clientKey)
+		}
+		return clientKey, authed, err
+	}
+}
+
+// Wrap tracks the sessions served by the tunnel server
+func (d *Diagnostics) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		defer d.clientKeys.Delete(req)
+		w := &trackingWriter{ResponseWriter: rw, diagnostics: d, req: req}
+		next.ServeHTTP(w, req)
+		if w.session != nil {
+			d.closed(w.id, w.session)
+		} else if w.status >= http.StatusBadRequest {
+			d.rejected(req, w.status, w.body.String())
+		}
+	})
+}
+
+// Dialer tracks the connections dialed through the tunnel of an agent
+func (d *Diagnostics) Dialer(clientKey string, dial remotedialer.Dialer) remotedialer.Dialer {
+	cluster, node := splitClientKey(clientKey)
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		start := time.Now()
+		conn, err := dial(ctx, network, address)
+		metrics.ObserveTunnelDial(cluster, node, time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		d.Lock()
+		d.streams[clientKey]++
+		d.Unlock()
+		return &streamConn{Conn: conn, closed: func() {
+			d.Lock()
+			defer d.Unlock()
+			if d.streams[clientKey]--; d.streams[clientKey] <= 0 {
+				delete(d.streams, clientKey)
+			}
+		}}, nil
+	}
+}
+
+// WatchPeers keeps the list of the other servers up to date, their reports are included in the diagnostics
+func (d *Diagnostics) WatchPeers(ctx context.Context, pm peermanager.PeerManager) {
+	c := make(chan peermanager.Peers, 1)
+	pm.AddListener(c)
+	go func() {
+		<-ctx.Done()
+		pm.RemoveListener(c)
+		close(c)
+	}()
+	go func() {
+		for peers := range c {
+			d.Lock()
+			d.peers = append([]string(nil), peers.IDs...)
+			d.Unlock()
+		}
+	}()
+}
+
+func (d *Diagnostics) opened(req *http.Request, conn *countingConn) (int64, *trackedSession) {
+	session := &trackedSession{
+		Session: Session{
+			AgentVersion: req.Header.Get(Version),
+			Address:      remoteAddress(req),
+			Connected:    time.Now(),
+		},
+		conn: conn,
+	}
+	if clientKey, ok := d.clientKeys.Load(req); ok {
+		session.ClientKey = clientKey.(string)
+		session.Cluster, session.Node = splitClientKey(session.ClientKey)
+		metrics.IncTunnelConnect(session.Cluster, session.Node)
+	} else {
+		// servers authenticate with their peer ID and token, without going through the authorizer
+		session.ClientKey = req.Header.Get(remotedialer.ID)
+		session.Peer = true
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	d.nextID++
+	d.sessions[d.nextID] = session
+	return d.nextID, session
+}
+
+func (d *Diagnostics) closed(id int64, session *trackedSession) {
+	reason := "closed by the server"
+	if err := session.conn.err(); errors.Is(err, io.EOF) {
+		reason = "closed by the agent"
+	} else if err != nil {
+		reason = err.Error()
+	}
+	if !session.Peer {
+		metrics.IncTunnelDisconnect(session.Cluster, session.Node)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	delete(d.sessions, id)
+	d.addDisconnect(Disconnect{
+		ClientKey:    session.ClientKey,
+		Cluster:      session.Cluster,
+		Node:         session.Node,
+		Peer:         session.Peer,
+		AgentVersion: session.AgentVersion,
+		Address:      session.Address,
+		Connected:    session.Connected,
+		Disconnected: time.Now(),
+		Reason:       reason,
+	})
+}
+
+func (d *Diagnostics) rejected(req *http.Request, status int, body string) {
+	disconnect := Disconnect{
+		AgentVersion: req.Header.Get(Version),
+		Address:      remoteAddress(req),
+		Disconnected: time.Now(),
+		Reason:       strings.TrimSpace(fmt.Sprintf("rejected with status %d: %s", status, body)),
+	}
+	if clientKey, ok := d.clientKeys.Load(req); ok {
+		disconnect.ClientKey = clientKey.(string)
+		disconnect.Cluster, disconnect.Node = splitClientKey(disconnect.ClientKey)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	d.addDisconnect(disconnect)
+}
+
+func (d *Diagnostics) addDisconnect(disconnect Disconnect) {
+	d.disconnects = append(d.disconnects, disconnect)
+	if len(d.disconnects) > maxDisconnects {
+		d.disconnects = d.disconnects[len(d.disconnects)-maxDisconnects:]
+	}
+}
+
+// Report returns the sessions of this server and its recent disconnects, for the given cluster only if it is set
+func (d *Diagnostics) Report(cluster string) *DiagnosticsReport {
+	d.Lock()
+	defer d.Unlock()
+
+	report := &DiagnosticsReport{
+		Sessions:    []Session{},
+		Disconnects: []Disconnect{},
+	}
+	server := d.serverID()
+	for _, s := range d.sessions {
+		if cluster != "" && s.Cluster != cluster {
+			continue
+		}
+		session := s.Session
+		session.Server = server
+		session.ReceiveBytes, session.TransmitBytes = s.conn.bytes()
+		session.ActiveStreams = d.streams[s.ClientKey]
+		report.Sessions = append(report.Sessions, session)
+	}
+	for _, disconnect := range d.disconnects {
+		if cluster != "" && disconnect.Cluster != cluster {
+			continue
+		}
+		disconnect.Server = server
+		report.Disconnects = append(report.Disconnects, disconnect)
+	}
+	report.sort()
+	return report
+}
+
+// agentSessions returns the sessions opened by agents on this server, by ID
+func (d *Diagnostics) agentSessions() map[int64]Session {
+	d.Lock()
+	defer d.Unlock()
+
+	sessions := map[int64]Session{}
+	for id, s := range d.sessions {
+		if !s.Peer {
+			sessions[id] = s.Session
+		}
+	}
+	return sessions
+}
+
+// closeSession ends a session, reason is recorded as the reason of its disconnect
+func (d *Diagnostics) closeSession(id int64, reason error) bool {
+	d.Lock()
+	session, ok := d.sessions[id]
+	d.Unlock()
+	if !ok {
+		return false
+	}
+	session.conn.setErr(reason)
+	return session.conn.Close() == nil
+}
+
+func (d *Diagnostics) serverID() string {
+	if d.server != nil {
+		return d.server.PeerID
+	}
+	return ""
+}
+
+// metricsSessions returns the latest session of each agent, and the agents connected to other servers this server
+// dials through
+func (d *Diagnostics) metricsSessions() []metrics.TunnelSession {
+	d.Lock()
+	defer d.Unlock()
+
+	latest := map[string]*trackedSession{}
+	for _, s := range d.sessions {
+		if s.Peer {
+			continue
+		}
+		if existing, ok := latest[s.ClientKey]; !ok || s.Connected.After(existing.Connected) {
+			latest[s.ClientKey] = s
+		}
+	}
+	var result []metrics.TunnelSession
+	for clientKey, s := range latest {
+		receive, transmit := s.conn.bytes()
+		result = append(result, metrics.TunnelSession{
+			Cluster:       s.Cluster,
+			Node:          s.Node,
+			Connected:     s.Connected,
+			ReceiveBytes:  receive,
+			TransmitBytes: transmit,
+			ActiveStreams: d.streams[clientKey],
+		})
+	}
+	for clientKey, streams := range d.streams {
+		if _, ok := latest[clientKey]; ok {
+			continue
+		}
+		cluster, node := splitClientKey(clientKey)
+		result = append(result, metrics.TunnelSession{Cluster: cluster, Node: node, ActiveStreams: streams})
+	}
+	return result
+}
+
+// PeerHandler serves the report of this server to the other servers, they authenticate with their peer token
+func (d *Diagnostics) PeerHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !d.isPeer(req.Header.Get(remotedialer.ID), req.Header.Get(remotedialer.Token)) {
+			util.ReturnHTTPError(rw, req, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		writeReport(rw, d.Report(req.URL.Query().Get("cluster")))
+	})
+}
+
+func (d *Diagnostics) isPeer(id, token string) bool {
+	if d.server == nil || d.server.PeerToken == "" || token != d.server.PeerToken {
+		return false
+	}
+	d.Lock()
+	defer d.Unlock()
+	for _, peer := range d.peers {
+		if peer == id {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDiagnosticsHandler serves the report of all the servers to the users allowed to get tunneldiagnostics
+func NewDiagnosticsHandler(scaledContext *config.ScaledContext, d *Diagnostics) http.Handler {
+	return &diagnosticsHandler{
+		k8sClient:   scaledContext.K8sClient,
+		diagnostics: d,
+		client: &http.Client{
+			Timeout: peerReportTimeout,
+			Transport: &http.Transport{
+				// servers use self signed certificates, like the peer connections of the tunnel server
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+	}
+}
+
+type diagnosticsHandler struct {
+	k8sClient   kubernetes.Interface
+	diagnostics *Diagnostics
+	client      *http.Client
+}
+
+func (h *diagnosticsHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	review := authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   req.Header.Get("Impersonate-User"),
+			Groups: req.Header["Impersonate-Group"],
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Verb:     "get",
+				Resource: "tunneldiagnostics",
+				Group:    "management.cattle.io",
+			},
+		},
+	}
+	result, err := h.k8sClient.AuthorizationV1().SubjectAccessReviews().Create(req.Context(), &review, metav1.CreateOptions{})
+	if err != nil {
+		util.ReturnHTTPError(rw, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !result.Status.Allowed {
+		util.ReturnHTTPError(rw, req, http.StatusForbidden, "Forbidden")
+		return
+	}
+
+	cluster := req.URL.Query().Get("cluster")
+	report := h.diagnostics.Report(cluster)
+
+	h.diagnostics.Lock()
+	peers := append([]string(nil), h.diagnostics.peers...)
+	h.diagnostics.Unlock()
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, peer := range peers {
+		wg.Add(1)
+		go func(peer string) {
+			defer wg.Done()
+			peerReport, err := h.peerReport(req.Context(), peer, cluster)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				logrus.Debugf("[tunnel-diagnostics] failed to get the report of peer %s: %v", peer, err)
+				if report.PeerErrors == nil {
+					report.PeerErrors = map[string]string{}
+				}
+				report.PeerErrors[peer] = err.Error()
+				return
+			}
+			report.Sessions = append(report.Sessions, peerReport.Sessions...)
+			report.Disconnects = append(report.Disconnects, peerReport.Disconnects...)
+		}(peer)
+	}
+	wg.Wait()
+
+	report.sort()
+	writeReport(rw, report)
+}
+
+func (h *diagnosticsHandler) peerReport(ctx context.Context, peer, cluster string) (*DiagnosticsReport, error) {
+	u := url.URL{Scheme: "https", Host: peer, Path: "/v3/connect/diagnostics"}
+	if cluster != "" {
+		u.RawQuery = url.Values{"cluster": {cluster}}.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(remotedialer.ID, h.diagnostics.serverID())
+	req.Header.Set(remotedialer.Token, h.diagnostics.server.PeerToken)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	report := &DiagnosticsReport{}
+	if err := json.NewDecoder(resp.Body).Decode(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *DiagnosticsReport) sort() {
+	sort.Slice(r.Sessions, func(i, j int) bool {
+		if r.Sessions[i].ClientKey != r.Sessions[j].ClientKey {
+			return r.Sessions[i].ClientKey < r.Sessions[j].ClientKey
+		}
+		return r.Sessions[i].Connected.Before(r.Sessions[j].Connected)
+	})
+	// most recent first
+	sort.SliceStable(r.Disconnects, func(i, j int) bool {
+		return r.Disconnects[i].Disconnected.After(r.Disconnects[j].Disconnected)
+	})
+}
+
+func writeReport(rw http.ResponseWriter, report *DiagnosticsReport) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		logrus.Errorf("[tunnel-diagnostics] failed to write report: %v", err)
+	}
+}
+
+// splitClientKey returns the cluster and node of the client key of an agent, node agents connect as cluster:node
+func splitClientKey(clientKey string) (string, string) {
+	parts := strings.SplitN(clientKey, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func remoteAddress(req *http.Request) string {
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	return req.RemoteAddr
+}
+
+// trackingWriter starts tracking a session when the tunnel server hijacks the connection to upgrade it
+type trackingWriter struct {
+	http.ResponseWriter
+	diagnostics *Diagnostics
+	req         *http.Request
+	status      int
+	body        strings.Builder
+	id          int64
+	session     *trackedSession
+}
+
+func (w *trackingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	if w.status >= http.StatusBadRequest && w.body.Len() < maxRejectBodySize {
+		remaining := maxRejectBodySize - w.body.Len()
+		if len(b) < remaining {
+			remaining = len(b)
+		}
+		w.body.Write(b[:remaining])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *trackingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	// reads go through the buffered reader of the server, it may hold data read ahead
+	counted := &countingConn{Conn: conn, reader: rw.Reader}
+	w.id, w.session = w.diagnostics.opened(w.req, counted)
+	return counted, bufio.NewReadWriter(bufio.NewReader(counted), rw.Writer), nil
+}
+
+// countingConn counts the bytes of a session and keeps the error that ended it
+type countingConn struct {
+	net.Conn
+	reader        io.Reader
+	receiveBytes  int64
+	transmitBytes int64
+
+	errLock  sync.Mutex
+	firstErr error
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.reader.Read(b)
+	atomic.AddInt64(&c.receiveBytes, int64(n))
+	c.setErr(err)
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	atomic.AddInt64(&c.transmitBytes, int64(n))
+	c.setErr(err)
+	return n, err
+}
+
+func (c *countingConn) setErr(err error) {
+	if err == nil {
+		return
+	}
+	c.errLock.Lock()
+	defer c.errLock.Unlock()
+	if c.firstErr == nil {
+		c.firstErr = err
+	}
+}
+
+func (c *countingConn) err() error {
+	c.errLock.Lock()
+	defer c.errLock.Unlock()
+	return c.firstErr
+}
+
+func (c *countingConn) bytes() (int64, int64) {
+	return atomic.LoadInt64(&c.receiveBytes), atomic.LoadInt64(&c.transmitBytes)
+}
+
+// streamConn is a connection dialed through a tunnel
+type streamConn struct {
+	net.Conn
+	once   sync.Once
+	closed func()
+}
+
+func (c *streamConn) Close() error {
+	c.once.Do(c.closed)
+	return c.Conn.Close()
+}
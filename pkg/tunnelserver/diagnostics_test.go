@@ -0,0 +1,104 @@
+package tunnelserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsSessions(t *testing.T) {
+	d := NewDiagnostics()
+	auth := d.authorizer(func(req *http.Request) (string, bool, error) {
+		if req.Header.Get(Token) == "" {
+			return "", false, nil
+		}
+		return "c-1:m-1", true, nil
+	})
+
+	served := make(chan struct{})
+	server := httptest.NewServer(d.Wrap(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if _, authed, _ := auth(req); !authed {
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte("failed authentication"))
+			return
+		}
+		conn, brw, err := rw.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		defer conn.Close()
+		conn.Write([]byte("hello\n"))
+		brw.ReadString('\n')
+		served <- struct{}{}
+		// wait for the agent to close its side
+		ioutil.ReadAll(brw)
+	})))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	require.NoError(t, err)
+	fmt.Fprintf(conn, "GET / HTTP/1.1\r\nHost: rancher\r\n%s: token\r\n%s: v2.5.0\r\n\r\n", Token, Version)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", line)
+	conn.Write([]byte("ping\n"))
+	<-served
+
+	report := d.Report("c-1")
+	if assert.Len(t, report.Sessions, 1) {
+		session := report.Sessions[0]
+		assert.Equal(t, "c-1:m-1", session.ClientKey)
+		assert.Equal(t, "c-1", session.Cluster)
+		assert.Equal(t, "m-1", session.Node)
+		assert.Equal(t, "v2.5.0", session.AgentVersion)
+		assert.Equal(t, int64(5), session.ReceiveBytes)
+		assert.Equal(t, int64(6), session.TransmitBytes)
+	}
+	assert.Empty(t, d.Report("c-2").Sessions)
+
+	conn.Close()
+	assert.Eventually(t, func() bool { return len(d.Report("").Sessions) == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	disconnects := d.Report("").Disconnects
+	if assert.Len(t, disconnects, 2) {
+		assert.Equal(t, "c-1:m-1", disconnects[0].ClientKey)
+		assert.Equal(t, "closed by the agent", disconnects[0].Reason)
+		assert.Equal(t, "rejected with status 401: failed authentication", disconnects[1].Reason)
+	}
+}
+
+func TestDiagnosticsDialer(t *testing.T) {
+	d := NewDiagnostics()
+	dial := d.Dialer("c-1", func(ctx context.Context, network, address string) (net.Conn, error) {
+		client, _ := net.Pipe()
+		return client, nil
+	})
+
+	first, err := dial(context.Background(), "tcp", "10.0.0.1:6443")
+	require.NoError(t, err)
+	second, err := dial(context.Background(), "tcp", "10.0.0.1:6443")
+	require.NoError(t, err)
+
+	sessions := d.metricsSessions()
+	if assert.Len(t, sessions, 1) {
+		assert.Equal(t, "c-1", sessions[0].Cluster)
+		assert.Equal(t, 2, sessions[0].ActiveStreams)
+	}
+
+	first.Close()
+	first.Close()
+	assert.Equal(t, 1, d.metricsSessions()[0].ActiveStreams)
+	second.Close()
+	assert.Empty(t, d.metricsSessions())
+}
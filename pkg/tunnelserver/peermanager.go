@@ -5,6 +5,7 @@
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 
@@ -32,6 +33,7 @@ type peerManager struct {
 	urlFormat string
 	server    *remotedialer.Server
 	peers     map[string]bool
+	ring      *peermanager.Ring
 	listeners map[chan<- peermanager.Peers]bool
 }
 
@@ -117,10 +119,11 @@ func (p *peerManager) addRemovePeers(endpoints *v1.Endpoints) {
 
 	p.peers = newSet
 	p.ready = ready
+	p.ring = p.peersLocked().Ring()
 	p.notify()
 }
 
-func (p *peerManager) notify() {
+func (p *peerManager) peersLocked() peermanager.Peers {
 	peers := peermanager.Peers{
 		Leader: p.leader,
 		Ready:  p.ready,
@@ -130,7 +133,12 @@ func (p *peerManager) notify() {
 	for id := range p.peers {
 		peers.IDs = append(peers.IDs, id)
 	}
+	sort.Strings(peers.IDs)
+	return peers
+}
 
+func (p *peerManager) notify() {
+	peers := p.peersLocked()
 	for c := range p.listeners {
 		c <- peers
 	}
@@ -158,6 +166,12 @@ func (p *peerManager) IsLeader() bool {
 	return p.leader
 }
 
+func (p *peerManager) IsOwner(key string) bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.peersLocked().IsOwner(p.ring, key)
+}
+
 func (p *peerManager) Leader() {
 	p.Lock()
 	defer p.Unlock()
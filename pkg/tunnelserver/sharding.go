@@ -0,0 +1,193 @@
+package tunnelserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+	"time"
+
+	"github.com/rancher/rancher/pkg/peermanager"
+	"github.com/rancher/remotedialer"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// forwardedHeader marks a tunnel session forwarded by a server to the owner of its cluster, the owner serves it
+	// even if it sees the ring differently so that a session is forwarded at most once
+	forwardedHeader = "X-API-Tunnel-Forwarded-By"
+	// rebalanceDelay lets the peers settle before sessions are moved, they flap while the servers are rolled out
+	rebalanceDelay    = 30 * time.Second
+	rebalanceInterval = time.Second
+)
+
+// Sharder keeps the tunnel sessions of the agents of a cluster on the server that owns the cluster, clusters are
+// sharded over the servers by consistent hashing like their controllers
+type Sharder struct {
+	sync.Mutex
+	diagnostics *Diagnostics
+	peers       peermanager.Peers
+	ring        *peermanager.Ring
+	cancel      context.CancelFunc
+
+	// authorize is the authorizer of the tunnel server, authorized holds its result for the requests Forward
+	// authorized already so that an agent is not authorized twice
+	authorize  remotedialer.Authorizer
+	authorized sync.Map
+	transport  http.RoundTripper
+}
+
+type authorization struct {
+	clientKey string
+	authed    bool
+	err       error
+}
+
+func NewSharder(diagnostics *Diagnostics) *Sharder {
+	return &Sharder{
+		diagnostics: diagnostics,
+		transport: &http.Transport{
+			// the servers do not have certificates for their IPs, peers connect to each other the same way
+			TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func (s *Sharder) authorizer(next remotedialer.Authorizer) remotedialer.Authorizer {
+	s.authorize = next
+	return func(req *http.Request) (string, bool, error) {
+		if result, ok := s.authorized.Load(req); ok {
+			a := result.(authorization)
+			return a.clientKey, a.authed, a.err
+		}
+		return next(req)
+	}
+}
+
+// Forward proxies the tunnel sessions of the agents of clusters owned by another server to that server. The agents
+// only reach the servers through a load balancer that picks one at random, the servers reach each other directly.
+// Sessions are served by this server when the owner can not be reached.
+func (s *Sharder) Forward(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// peers connect with their ID, they are authorized by the tunnel server itself
+		if s.authorize == nil || req.Header.Get(forwardedHeader) != "" || req.Header.Get(remotedialer.ID) != "" {
+			next.ServeHTTP(rw, req)
+			return
+		}
+
+		clientKey, authed, err := s.authorize(req)
+		s.authorized.Store(req, authorization{clientKey: clientKey, authed: authed, err: err})
+		defer s.authorized.Delete(req)
+
+		if authed && err == nil {
+			cluster, _ := splitClientKey(clientKey)
+			if owner := s.forwardTarget(cluster); owner != "" {
+				s.proxy(owner, clientKey, next).ServeHTTP(rw, req)
+				return
+			}
+		}
+		next.ServeHTTP(rw, req)
+	})
+}
+
+// forwardTarget returns the server that owns the cluster, or an empty string if the session is served by this one
+func (s *Sharder) forwardTarget(cluster string) string {
+	s.Lock()
+	defer s.Unlock()
+	if !s.peers.Ready {
+		return ""
+	}
+	if owner := s.ring.Owner(cluster); owner != s.peers.SelfID {
+		return owner
+	}
+	return ""
+}
+
+func (s *Sharder) proxy(owner, clientKey string, next http.Handler) http.Handler {
+	self := s.selfID()
+	return &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "https"
+			req.URL.Host = owner
+			req.Header.Set(forwardedHeader, self)
+		},
+		Transport: s.transport,
+		ErrorHandler: func(rw http.ResponseWriter, req *http.Request, err error) {
+			logrus.Infof("Serving tunnel session [%s] owned by %s, it could not be forwarded: %v", clientKey, owner, err)
+			next.ServeHTTP(rw, req)
+		},
+	}
+}
+
+func (s *Sharder) selfID() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.peers.SelfID
+}
+
+// WatchPeers reshards the sessions when servers join or leave, the sessions of the clusters this server no longer
+// owns are closed one by one so that their agents reconnect to the new owner
+func (s *Sharder) WatchPeers(ctx context.Context, pm peermanager.PeerManager) {
+	c := make(chan peermanager.Peers, 1)
+	pm.AddListener(c)
+	go func() {
+		<-ctx.Done()
+		pm.RemoveListener(c)
+		close(c)
+	}()
+	go func() {
+		for peers := range c {
+			s.setPeers(ctx, peers)
+		}
+	}()
+}
+
+func (s *Sharder) setPeers(ctx context.Context, peers peermanager.Peers) {
+	s.Lock()
+	defer s.Unlock()
+
+	previous := s.ring
+	wasReady := s.peers.Ready
+	s.peers = peers
+	s.ring = peers.Ring()
+
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	if !wasReady || !peers.Ready {
+		return
+	}
+	rebalanceCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	go s.rebalance(rebalanceCtx, previous, s.ring, peers.SelfID)
+}
+
+// rebalance closes the sessions of the clusters this server owned on the previous ring that another server owns
+// on the current one, the sessions of the clusters it did not own are where they are because their owner could not be
+// reached
+func (s *Sharder) rebalance(ctx context.Context, previous, current *peermanager.Ring, self string) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(rebalanceDelay):
+	}
+
+	for id, session := range s.diagnostics.agentSessions() {
+		owner := current.Owner(session.Cluster)
+		if previous.Owner(session.Cluster) != self || owner == self {
+			continue
+		}
+		if s.diagnostics.closeSession(id, fmt.Errorf("moved to %s", owner)) {
+			logrus.Infof("Closed tunnel session [%s], cluster [%s] is now served by %s", session.ClientKey, session.Cluster, owner)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rebalanceInterval):
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package tunnelserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/peermanager"
+	"github.com/rancher/remotedialer"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSharder shards over this server and owner, it authorizes the agents by the cluster header. It returns the
+// authorizer of the tunnel server and how many times agents were authorized.
+func testSharder(owner string) (*Sharder, remotedialer.Authorizer, *int) {
+	s := NewSharder(NewDiagnostics())
+	authorizations := 0
+	authorizer := s.authorizer(func(req *http.Request) (string, bool, error) {
+		authorizations++
+		return req.Header.Get("X-Cluster") + ":m-1", true, nil
+	})
+	s.setPeers(context.Background(), peermanager.Peers{SelfID: "10.0.0.1", IDs: []string{owner}, Ready: true})
+	return s, authorizer, &authorizations
+}
+
+// clusters returns a cluster owned by this server and one owned by the other
+func clusters(s *Sharder) (string, string) {
+	var owned, other string
+	for i := 0; owned == "" || other == ""; i++ {
+		cluster := fmt.Sprintf("c-%d", i)
+		if s.ring.Owner(cluster) == s.peers.SelfID {
+			owned = cluster
+		} else {
+			other = cluster
+		}
+	}
+	return owned, other
+}
+
+func connect(handler http.Handler, cluster string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/v3/connect", nil)
+	for k, v := range header {
+		req.Header[http.CanonicalHeaderKey(k)] = v
+	}
+	req.Header.Set("X-Cluster", cluster)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	return rw
+}
+
+func TestSharderForward(t *testing.T) {
+	var forwardedBy string
+	ownerServer := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		forwardedBy = req.Header.Get(forwardedHeader)
+		rw.Write([]byte("owner"))
+	}))
+	defer ownerServer.Close()
+	owner := strings.TrimPrefix(ownerServer.URL, "https://")
+
+	s, authorizer, authorizations := testSharder(owner)
+	local := s.Forward(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		clientKey, _, _ := authorizer(req)
+		rw.Write([]byte("local " + clientKey))
+	}))
+	owned, other := clusters(s)
+
+	assert.Equal(t, "local "+owned+":m-1", connect(local, owned, nil).Body.String())
+	assert.Equal(t, 1, *authorizations, "the tunnel server reuses the authorization")
+
+	assert.Equal(t, "owner", connect(local, other, nil).Body.String(), "sessions are forwarded to the owner")
+	assert.Equal(t, "10.0.0.1", forwardedBy)
+
+	assert.Equal(t, "local "+other+":m-1", connect(local, other, http.Header{forwardedHeader: {"10.0.0.3"}}).Body.String(),
+		"forwarded sessions are not forwarded again")
+}
+
+func TestSharderForwardUnreachableOwner(t *testing.T) {
+	ownerServer := httptest.NewTLSServer(http.NotFoundHandler())
+	owner := strings.TrimPrefix(ownerServer.URL, "https://")
+	ownerServer.Close()
+
+	s, _, _ := testSharder(owner)
+	local := s.Forward(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte("local"))
+	}))
+	_, other := clusters(s)
+
+	assert.Equal(t, "local", connect(local, other, nil).Body.String(), "sessions are served when the owner is down")
+}
+
+func TestSharderSingleServer(t *testing.T) {
+	s := NewSharder(NewDiagnostics())
+	s.authorizer(func(req *http.Request) (string, bool, error) {
+		return "c-1:m-1", true, nil
+	})
+	assert.Empty(t, s.forwardTarget("c-1"), "sessions are not sharded in single server mode")
+}
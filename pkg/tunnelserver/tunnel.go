@@ -51,8 +51,10 @@ type input struct {
 	NodeVersion int          `json:"nodeVersion"`
 }
 
-func NewTunnelServer(authorizer *Authorizer) *remotedialer.Server {
-	return remotedialer.New(authorizer.authorizeTunnel, remotedialer.DefaultErrorWriter)
+func NewTunnelServer(authorizer *Authorizer, diagnostics *Diagnostics, sharder *Sharder) *remotedialer.Server {
+	server := remotedialer.New(diagnostics.authorizer(sharder.authorizer(authorizer.authorizeTunnel)), remotedialer.DefaultErrorWriter)
+	diagnostics.server = server
+	return server
 }
 
 func NewAuthorizer(context *config.ScaledContext) *Authorizer {